@@ -1,19 +1,33 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	goConfig "github.com/liampulles/go-config"
 
 	"github.com/liampulles/matchstick-video/pkg/wire"
 )
 
+// shutdownTimeout is how long to wait for work in progress to finish,
+// and buffered work to be flushed, once the app has finished.
+const shutdownTimeout = 5 * time.Second
+
 func main() {
 	// Delegate most logic elsewhere, since we can't
 	// test this function.
-	app := wire.CreateApp(goConfig.NewEnvSource())
+	app, shutdown := wire.CreateApp(os.Args[1:], goConfig.NewEnvSource())
 	err := app()
+
+	// Flush anything still buffered, e.g. spans, before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	if shutdownErr := shutdown(ctx); shutdownErr != nil {
+		fmt.Printf("SHUTDOWN ERROR: %s\n", shutdownErr.Error())
+	}
+	cancel()
+
 	if err != nil {
 		fmt.Printf("APP ERROR - PANICKING: %s\n", err.Error())
 		panic(err)
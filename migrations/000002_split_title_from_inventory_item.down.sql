@@ -0,0 +1,34 @@
+ALTER TABLE inventory_item
+   ADD COLUMN name VARCHAR(511);
+
+UPDATE inventory_item i
+SET name = CASE
+   WHEN t.year = 0 THEN t.name
+   ELSE t.name || ' (' || t.year || ')'
+END
+FROM title t
+WHERE t.id = i.title_id;
+
+-- Before titles were split out, each copy had its own name and location.
+-- Copies which now share either can't be told apart, so refuse to lose
+-- them - they must be deleted or moved before migrating down. The file
+-- runs as one transaction, so nothing is changed when this fails.
+DO $$
+BEGIN
+   IF EXISTS (SELECT 1 FROM inventory_item GROUP BY name HAVING COUNT(*) > 1) THEN
+      RAISE EXCEPTION 'cannot migrate down - some titles have more than one copy. Delete the extra copies first';
+   END IF;
+   IF EXISTS (SELECT 1 FROM inventory_item GROUP BY location HAVING COUNT(*) > 1) THEN
+      RAISE EXCEPTION 'cannot migrate down - some locations hold more than one copy. Move the extra copies first';
+   END IF;
+END
+$$;
+
+ALTER TABLE inventory_item
+   ALTER COLUMN name SET NOT NULL,
+   ADD CONSTRAINT inventory_item_name_key UNIQUE (name),
+   ADD CONSTRAINT inventory_item_location_key UNIQUE (location),
+   DROP COLUMN barcode,
+   DROP COLUMN title_id;
+
+DROP TABLE IF EXISTS title;
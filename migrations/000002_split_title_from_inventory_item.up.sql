@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS title(
+   id SERIAL PRIMARY KEY,
+   name VARCHAR(511) NOT NULL,
+   year INTEGER NOT NULL,
+   runtime INTEGER NOT NULL DEFAULT 0,
+   synopsis TEXT NOT NULL DEFAULT '',
+   genres JSONB NOT NULL DEFAULT '[]',
+   cast_members JSONB NOT NULL DEFAULT '[]',
+   rating VARCHAR(31) NOT NULL DEFAULT '',
+   UNIQUE (name, year)
+);
+
+-- Existing names look like "The Matrix (1999)", so split out the year
+-- where there is one. Titles without a year get 0.
+INSERT INTO title (name, year)
+SELECT DISTINCT
+   COALESCE(NULLIF(regexp_replace(name, '\s*\(\d{4}\)\s*$', ''), ''), name),
+   COALESCE(substring(name from '\((\d{4})\)\s*$')::INTEGER, 0)
+FROM inventory_item;
+
+ALTER TABLE inventory_item
+   ADD COLUMN title_id INTEGER REFERENCES title(id),
+   ADD COLUMN barcode VARCHAR(255);
+
+UPDATE inventory_item i
+SET title_id = t.id
+FROM title t
+WHERE
+   t.name = COALESCE(NULLIF(regexp_replace(i.name, '\s*\(\d{4}\)\s*$', ''), ''), i.name)
+   AND t.year = COALESCE(substring(i.name from '\((\d{4})\)\s*$')::INTEGER, 0);
+
+-- Existing copies get an internal barcode derived from their id.
+UPDATE inventory_item
+SET barcode = 'MV' || lpad(id::TEXT, 8, '0');
+
+-- Many copies of a title may share a shelf, so location is no longer unique.
+ALTER TABLE inventory_item
+   ALTER COLUMN title_id SET NOT NULL,
+   ALTER COLUMN barcode SET NOT NULL,
+   ADD CONSTRAINT inventory_item_barcode_key UNIQUE (barcode),
+   DROP CONSTRAINT inventory_item_location_key,
+   DROP COLUMN name;
+
+CREATE INDEX IF NOT EXISTS inventory_item_title_id_idx ON inventory_item(title_id);
@@ -0,0 +1,4 @@
+ALTER TABLE inventory_item
+   DROP COLUMN format;
+
+DROP TABLE IF EXISTS media_format;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS media_format(
+   format VARCHAR(15) PRIMARY KEY,
+   name VARCHAR(63) NOT NULL,
+   rental_price BIGINT NOT NULL CHECK (rental_price >= 0),
+   rental_period_days INTEGER NOT NULL CHECK (rental_period_days BETWEEN 1 AND 365),
+   sort_order INTEGER NOT NULL
+);
+
+-- Prices are in cents.
+INSERT INTO media_format (format, name, rental_price, rental_period_days, sort_order) VALUES
+   ('vhs', 'VHS', 150, 7, 1),
+   ('dvd', 'DVD', 300, 3, 2),
+   ('bluray', 'Blu-ray', 400, 3, 3),
+   ('4k', '4K Ultra HD', 500, 2, 4);
+
+-- Everything stocked so far is assumed to be a DVD.
+ALTER TABLE inventory_item
+   ADD COLUMN format VARCHAR(15) NOT NULL DEFAULT 'dvd' REFERENCES media_format(format);
+
+ALTER TABLE inventory_item
+   ALTER COLUMN format DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS inventory_item_format_idx ON inventory_item(format);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS rental;
+
+DROP TABLE IF EXISTS account;
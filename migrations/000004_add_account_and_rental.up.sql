@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS account(
+   id SERIAL PRIMARY KEY,
+   name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rental(
+   id SERIAL PRIMARY KEY,
+   inventory_item_id INTEGER NOT NULL REFERENCES inventory_item(id) ON DELETE CASCADE,
+   account_id INTEGER NOT NULL REFERENCES account(id),
+   checked_out_at TIMESTAMPTZ NOT NULL,
+   due_at TIMESTAMPTZ NOT NULL,
+   returned_at TIMESTAMPTZ
+);
+
+-- An item may only be rented out once at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS rental_active_item_idx ON rental(inventory_item_id) WHERE returned_at IS NULL;
+CREATE INDEX IF NOT EXISTS rental_account_idx ON rental(account_id);
+CREATE INDEX IF NOT EXISTS rental_due_at_idx ON rental(due_at) WHERE returned_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE rental
+   DROP COLUMN late_fee;
+
+ALTER TABLE account
+   DROP COLUMN balance;
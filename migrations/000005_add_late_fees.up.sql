@@ -0,0 +1,6 @@
+-- Amounts are in cents.
+ALTER TABLE account
+   ADD COLUMN balance BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE rental
+   ADD COLUMN late_fee BIGINT NOT NULL DEFAULT 0 CHECK (late_fee >= 0);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS hold(
+   id SERIAL PRIMARY KEY,
+   title_id INTEGER NOT NULL REFERENCES title(id) ON DELETE CASCADE,
+   account_id INTEGER NOT NULL REFERENCES account(id) ON DELETE CASCADE,
+   placed_at TIMESTAMPTZ NOT NULL,
+   status VARCHAR(15) NOT NULL CHECK (status IN ('waiting', 'ready', 'fulfilled', 'cancelled', 'expired')),
+   inventory_item_id INTEGER REFERENCES inventory_item(id) ON DELETE CASCADE,
+   expires_at TIMESTAMPTZ
+);
+
+-- An account may only queue once for a title at a time, and a copy
+-- may only be put aside for one hold at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS hold_active_account_idx ON hold(title_id, account_id) WHERE status IN ('waiting', 'ready');
+CREATE UNIQUE INDEX IF NOT EXISTS hold_ready_item_idx ON hold(inventory_item_id) WHERE status = 'ready';
+CREATE INDEX IF NOT EXISTS hold_queue_idx ON hold(title_id, placed_at) WHERE status = 'waiting';
@@ -0,0 +1,2 @@
+ALTER TABLE rental
+   DROP COLUMN renewals;
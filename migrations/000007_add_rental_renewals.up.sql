@@ -0,0 +1,2 @@
+ALTER TABLE rental
+   ADD COLUMN renewals INT NOT NULL DEFAULT 0 CHECK (renewals >= 0);
@@ -0,0 +1,10 @@
+ALTER TABLE account
+   ADD COLUMN balance BIGINT NOT NULL DEFAULT 0;
+
+UPDATE account
+   SET balance = account_balance.balance
+   FROM account_balance
+   WHERE account_balance.account_id = account.id;
+
+DROP VIEW IF EXISTS account_balance;
+DROP TABLE IF EXISTS ledger_entry;
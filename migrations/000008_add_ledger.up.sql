@@ -0,0 +1,31 @@
+-- Amounts are in cents. Charges and payments are always positive,
+-- adjustments may go either way.
+CREATE TABLE IF NOT EXISTS ledger_entry(
+   id SERIAL PRIMARY KEY,
+   account_id INTEGER NOT NULL REFERENCES account(id),
+   kind VARCHAR(15) NOT NULL CHECK (kind IN ('charge', 'payment', 'adjustment')),
+   amount BIGINT NOT NULL CHECK (amount > 0 OR (kind = 'adjustment' AND amount <> 0)),
+   method VARCHAR(15) CHECK (method IN ('cash', 'card')),
+   rental_id INTEGER REFERENCES rental(id) ON DELETE SET NULL,
+   note VARCHAR(255) NOT NULL DEFAULT '',
+   recorded_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entry_account_idx ON ledger_entry(account_id, recorded_at);
+
+-- What each account owes is totalled from its ledger.
+CREATE OR REPLACE VIEW account_balance AS
+   SELECT
+      account_id,
+      SUM(CASE WHEN kind = 'payment' THEN -amount ELSE amount END) AS balance
+   FROM ledger_entry
+   GROUP BY account_id;
+
+-- Carry over what accounts already owe.
+INSERT INTO ledger_entry(account_id, kind, amount, note, recorded_at)
+   SELECT id, 'adjustment', balance, 'Opening balance', NOW()
+   FROM account
+   WHERE balance <> 0;
+
+ALTER TABLE account
+   DROP COLUMN balance;
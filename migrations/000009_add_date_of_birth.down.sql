@@ -0,0 +1,2 @@
+ALTER TABLE account
+   DROP COLUMN date_of_birth;
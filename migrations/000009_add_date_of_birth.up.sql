@@ -0,0 +1,3 @@
+-- Unknown for accounts opened before dates of birth were recorded.
+ALTER TABLE account
+   ADD COLUMN date_of_birth DATE;
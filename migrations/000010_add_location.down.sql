@@ -0,0 +1,19 @@
+DROP TABLE IF EXISTS location_move;
+
+ALTER TABLE inventory_item
+   ADD COLUMN location VARCHAR(255);
+
+UPDATE inventory_item
+SET location = CASE
+   WHEN location_store = 'legacy' THEN location_slot
+   ELSE location_store || '-' || location_aisle || '-' || location_shelf || '-' || location_slot
+END;
+
+ALTER TABLE inventory_item
+   ALTER COLUMN location SET NOT NULL,
+   DROP COLUMN location_store,
+   DROP COLUMN location_aisle,
+   DROP COLUMN location_shelf,
+   DROP COLUMN location_slot;
+
+DROP TABLE IF EXISTS location;
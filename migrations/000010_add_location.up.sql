@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS location(
+   store VARCHAR(63) NOT NULL,
+   aisle VARCHAR(63) NOT NULL,
+   shelf VARCHAR(63) NOT NULL,
+   slot VARCHAR(63) NOT NULL,
+   PRIMARY KEY (store, aisle, shelf, slot)
+);
+
+ALTER TABLE inventory_item
+   ADD COLUMN location_store VARCHAR(63),
+   ADD COLUMN location_aisle VARCHAR(63),
+   ADD COLUMN location_shelf VARCHAR(63),
+   ADD COLUMN location_slot VARCHAR(63);
+
+-- Existing locations are free text, so keep them as slots of a "legacy"
+-- shelf until the copies are moved. Slots may only have letters and digits.
+UPDATE inventory_item
+SET
+   location_store = 'legacy',
+   location_aisle = '0',
+   location_shelf = '0',
+   location_slot = COALESCE(NULLIF(regexp_replace(location, '[^A-Za-z0-9]', '', 'g'), ''), 'item' || id);
+
+INSERT INTO location (store, aisle, shelf, slot)
+SELECT DISTINCT location_store, location_aisle, location_shelf, location_slot
+FROM inventory_item;
+
+ALTER TABLE inventory_item
+   ALTER COLUMN location_store SET NOT NULL,
+   ALTER COLUMN location_aisle SET NOT NULL,
+   ALTER COLUMN location_shelf SET NOT NULL,
+   ALTER COLUMN location_slot SET NOT NULL,
+   ADD CONSTRAINT inventory_item_location_fkey
+      FOREIGN KEY (location_store, location_aisle, location_shelf, location_slot)
+      REFERENCES location(store, aisle, shelf, slot),
+   DROP COLUMN location;
+
+CREATE INDEX IF NOT EXISTS inventory_item_shelf_idx
+   ON inventory_item(location_store, location_aisle, location_shelf);
+
+CREATE TABLE IF NOT EXISTS location_move(
+   id SERIAL PRIMARY KEY,
+   inventory_item_id INTEGER NOT NULL REFERENCES inventory_item(id) ON DELETE CASCADE,
+   from_store VARCHAR(63) NOT NULL,
+   from_aisle VARCHAR(63) NOT NULL,
+   from_shelf VARCHAR(63) NOT NULL,
+   from_slot VARCHAR(63) NOT NULL,
+   to_store VARCHAR(63) NOT NULL,
+   to_aisle VARCHAR(63) NOT NULL,
+   to_shelf VARCHAR(63) NOT NULL,
+   to_slot VARCHAR(63) NOT NULL,
+   moved_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS location_move_inventory_item_idx ON location_move(inventory_item_id, moved_at);
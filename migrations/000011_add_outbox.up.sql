@@ -0,0 +1,14 @@
+-- Events waiting to be published to other systems. They are written in
+-- the same transaction as the change they record. entity_id has no
+-- foreign key, since deleted entities still have events.
+CREATE TABLE IF NOT EXISTS outbox_message(
+   id SERIAL PRIMARY KEY,
+   entity_id INTEGER NOT NULL,
+   type VARCHAR(63) NOT NULL,
+   data JSONB NOT NULL,
+   occurred_at TIMESTAMPTZ NOT NULL,
+   published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_message_unpublished_idx ON outbox_message(id)
+   WHERE published_at IS NULL;
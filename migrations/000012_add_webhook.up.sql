@@ -0,0 +1,33 @@
+-- Subscriptions of partner URLs to events.
+CREATE TABLE IF NOT EXISTS webhook_subscription(
+   id SERIAL PRIMARY KEY,
+   url VARCHAR(2047) NOT NULL,
+   event_types JSONB NOT NULL DEFAULT '[]',
+   secret VARCHAR(255) NOT NULL,
+   created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Each event queued for a subscription, and the outcome of its latest
+-- attempt. event_id has no foreign key, since published outbox messages
+-- may be cleared out.
+CREATE TABLE IF NOT EXISTS webhook_delivery(
+   id SERIAL PRIMARY KEY,
+   subscription_id INTEGER NOT NULL REFERENCES webhook_subscription(id) ON DELETE CASCADE,
+   event_id INTEGER NOT NULL,
+   event_type VARCHAR(63) NOT NULL,
+   entity_id INTEGER NOT NULL,
+   data JSONB NOT NULL,
+   occurred_at TIMESTAMPTZ NOT NULL,
+   status VARCHAR(15) NOT NULL CHECK (status IN ('pending', 'delivered', 'dead')),
+   attempts INTEGER NOT NULL DEFAULT 0,
+   next_attempt_at TIMESTAMPTZ NOT NULL,
+   last_attempt_at TIMESTAMPTZ,
+   last_status_code INTEGER NOT NULL DEFAULT 0,
+   last_error VARCHAR(1023) NOT NULL DEFAULT ''
+);
+
+-- An event is only delivered once to each subscription, even if it is
+-- published again.
+CREATE UNIQUE INDEX IF NOT EXISTS webhook_delivery_event_idx ON webhook_delivery(subscription_id, event_id);
+CREATE INDEX IF NOT EXISTS webhook_delivery_due_idx ON webhook_delivery(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_delivery_dead_idx ON webhook_delivery(id) WHERE status = 'dead';
@@ -0,0 +1,16 @@
+-- Requests made with an Idempotency-Key header, and the responses given
+-- to them, so that retries can be answered without handling them again.
+-- Keys are kept per client, so that one client can't be given another's
+-- response. The response is NULL while the request is being handled.
+CREATE TABLE IF NOT EXISTS idempotency_key(
+   client VARCHAR(80) NOT NULL,
+   key VARCHAR(255) NOT NULL,
+   fingerprint CHAR(64) NOT NULL,
+   status_code INTEGER,
+   content_type VARCHAR(255),
+   body BYTEA,
+   created_at TIMESTAMPTZ NOT NULL,
+   PRIMARY KEY (client, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_created_at_idx ON idempotency_key(created_at);
@@ -0,0 +1,9 @@
+// Package migrations embeds the SQL migrations for the DB, so that the
+// binary can migrate without the files being present on disk.
+package migrations
+
+import "embed"
+
+// FS contains every migration in this folder
+//go:embed *.sql
+var FS embed.FS
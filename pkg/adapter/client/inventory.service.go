@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// InventoryServiceImpl implements inventory.Service by calling the HTTP
+// API of a running server, e.g. so that the CLI can be used remotely.
+// Only what the CLI needs is implemented.
+type InventoryServiceImpl struct {
+	baseURL        string
+	client         *http.Client
+	encoderService json.EncoderService
+	decoderService json.DecoderService
+}
+
+// Check we implement the interface
+var _ inventory.Service = &InventoryServiceImpl{}
+
+// NewInventoryServiceImpl is a constructor
+func NewInventoryServiceImpl(
+	baseURL string,
+	client *http.Client,
+	encoderService json.EncoderService,
+	decoderService json.DecoderService,
+) *InventoryServiceImpl {
+
+	return &InventoryServiceImpl{
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+		client:         client,
+		encoderService: encoderService,
+		decoderService: decoderService,
+	}
+}
+
+// Create posts the vo to the server, and returns the id it gives the
+// new item.
+func (s *InventoryServiceImpl) Create(ctx context.Context, vo *inventory.CreateItemVO) (entity.ID, error) {
+	body, err := s.encoderService.FromInventoryCreateItemVo(vo)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create inventory item - encode error: %w", err)
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, "/inventory", body, http.StatusCreated)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create inventory item - %w", err)
+	}
+
+	id, err := strconv.ParseInt(string(resp), 10, 64)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create inventory item - server gave an invalid id: %s", resp)
+	}
+	return entity.ID(id), nil
+}
+
+// ReadDetails gets the item from the server.
+func (s *InventoryServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*inventory.ViewVO, error) {
+	resp, err := s.do(ctx, http.MethodGet, itemPath(id, ""), nil, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory item - %w", err)
+	}
+
+	vo, err := s.decoderService.ToInventoryItemView(resp)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory item - decode error: %w", err)
+	}
+	return vo, nil
+}
+
+// ReadManyDetails is not implemented.
+func (s *InventoryServiceImpl) ReadManyDetails(context.Context, []entity.ID) ([]inventory.ViewVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "ReadManyDetails")
+}
+
+// FindIDByBarcode gets the item with the barcode from the server, and
+// returns its id.
+func (s *InventoryServiceImpl) FindIDByBarcode(ctx context.Context, barcode string) (entity.ID, error) {
+	resp, err := s.do(ctx, http.MethodGet, "/inventory/by-barcode/"+url.PathEscape(barcode), nil, http.StatusOK)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not find inventory item by barcode - %w", err)
+	}
+
+	vo, err := s.decoderService.ToInventoryItemView(resp)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not find inventory item by barcode - decode error: %w", err)
+	}
+	return vo.ID, nil
+}
+
+// ReadAll gets all items from the server.
+func (s *InventoryServiceImpl) ReadAll(ctx context.Context) ([]inventory.ThinViewVO, error) {
+	return s.readAll(ctx, "/inventory")
+}
+
+// ReadAllOfFormat gets the items of the format from the server.
+func (s *InventoryServiceImpl) ReadAllOfFormat(ctx context.Context, format entity.Format) ([]inventory.ThinViewVO, error) {
+	return s.readAll(ctx, "/inventory?format="+url.QueryEscape(string(format)))
+}
+
+func (s *InventoryServiceImpl) readAll(ctx context.Context, path string) ([]inventory.ThinViewVO, error) {
+	resp, err := s.do(ctx, http.MethodGet, path, nil, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - %w", err)
+	}
+
+	vos, err := s.decoderService.ToInventoryItemThinViews(resp)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - decode error: %w", err)
+	}
+	return vos, nil
+}
+
+// ReadAllOfTitles is not implemented.
+func (s *InventoryServiceImpl) ReadAllOfTitles(context.Context, []entity.ID) ([]inventory.ThinViewVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "ReadAllOfTitles")
+}
+
+// Update puts the vo to the server.
+func (s *InventoryServiceImpl) Update(ctx context.Context, id entity.ID, vo *inventory.UpdateItemVO) error {
+	body, err := s.encoderService.FromInventoryUpdateItemVo(vo)
+	if err != nil {
+		return fmt.Errorf("could not update inventory item - encode error: %w", err)
+	}
+
+	if _, err := s.do(ctx, http.MethodPut, itemPath(id, ""), body, http.StatusNoContent); err != nil {
+		return fmt.Errorf("could not update inventory item - %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the item on the server.
+func (s *InventoryServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	if _, err := s.do(ctx, http.MethodDelete, itemPath(id, ""), nil, http.StatusNoContent); err != nil {
+		return fmt.Errorf("could not delete inventory item - %w", err)
+	}
+	return nil
+}
+
+// Checkout checks the item out on the server.
+func (s *InventoryServiceImpl) Checkout(ctx context.Context, id entity.ID, vo *inventory.CheckoutVO) error {
+	body, err := s.encoderService.FromInventoryCheckoutVo(vo)
+	if err != nil {
+		return fmt.Errorf("could not checkout inventory item - encode error: %w", err)
+	}
+
+	if _, err := s.do(ctx, http.MethodPut, itemPath(id, "/checkout"), body, http.StatusNoContent); err != nil {
+		return fmt.Errorf("could not checkout inventory item - %w", err)
+	}
+	return nil
+}
+
+// CheckIn checks the item in on the server, and returns the receipt
+// line it gives. The server gives none (and no content) if the item was
+// not rented out.
+func (s *InventoryServiceImpl) CheckIn(ctx context.Context, id entity.ID) (*rental.ReceiptLineVO, error) {
+	resp, err := s.do(ctx, http.MethodPut, itemPath(id, "/checkin"), nil, http.StatusOK, http.StatusNoContent)
+	if err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - %w", err)
+	}
+	if len(resp) == 0 {
+		return nil, nil
+	}
+
+	vo, err := s.decoderService.ToRentalReceiptLine(resp)
+	if err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - decode error: %w", err)
+	}
+	return vo, nil
+}
+
+// Renew is not implemented.
+func (s *InventoryServiceImpl) Renew(context.Context, entity.ID) (*rental.RenewalReceiptLineVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "Renew")
+}
+
+// FulfilHold is not implemented.
+func (s *InventoryServiceImpl) FulfilHold(context.Context, entity.ID) error {
+	return commonerror.NewNotImplemented("client", "InventoryServiceImpl", "FulfilHold")
+}
+
+// Move is not implemented.
+func (s *InventoryServiceImpl) Move(context.Context, entity.ID, *inventory.MoveVO) error {
+	return commonerror.NewNotImplemented("client", "InventoryServiceImpl", "Move")
+}
+
+// ReadMoves is not implemented.
+func (s *InventoryServiceImpl) ReadMoves(context.Context, entity.ID) ([]inventory.MoveViewVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "ReadMoves")
+}
+
+// ReadShelf is not implemented.
+func (s *InventoryServiceImpl) ReadShelf(context.Context, string, string, string) (*inventory.ShelfViewVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "ReadShelf")
+}
+
+// ReadLabels is not implemented.
+func (s *InventoryServiceImpl) ReadLabels(context.Context, []entity.ID) ([]inventory.LabelVO, error) {
+	return nil, commonerror.NewNotImplemented("client", "InventoryServiceImpl", "ReadLabels")
+}
+
+// do makes a request of the server, and returns the body of the
+// response if it has the expected status. Otherwise, the body is the
+// server's error message.
+func (s *InventoryServiceImpl) do(ctx context.Context, method string, path string, body []byte, expected ...int) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("request error: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("server error: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("server error: %w", err)
+	}
+
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return respBody, nil
+		}
+	}
+	return nil, fmt.Errorf("server responded with status %d: %s", resp.StatusCode, respBody)
+}
+
+func itemPath(id entity.ID, suffix string) string {
+	return fmt.Sprintf("/inventory/%d%s", id, suffix)
+}
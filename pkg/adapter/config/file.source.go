@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	goConfig "github.com/liampulles/go-config"
+	"gopkg.in/yaml.v3"
+)
+
+// FileSource reads properties from a YAML or TOML file. Keys are matched
+// case-insensitively, with dashes treated as underscores, and nested
+// tables are flattened with underscores. So all of these set DB_HOST:
+//
+//	DB_HOST: localhost
+//	db-host: localhost
+//	db:
+//	  host: localhost
+//
+// Lists are joined with commas.
+type FileSource struct {
+	values goConfig.MapSource
+}
+
+// Check we implement the interface
+var _ goConfig.Source = &FileSource{}
+
+// NewFileSource is a constructor. The format is determined by the file
+// extension: .yaml, .yml or .toml
+func NewFileSource(path string) (*FileSource, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file: %w", err)
+	}
+
+	raw := make(map[string]interface{})
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(bytes, &raw)
+	case ".toml":
+		err = toml.Unmarshal(bytes, &raw)
+	default:
+		return nil, fmt.Errorf("could not read config file - unsupported extension: %s", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file - parse error: %w", err)
+	}
+
+	values := make(map[string]string)
+	flatten("", raw, values)
+	return &FileSource{
+		values: goConfig.MapSource(values),
+	}, nil
+}
+
+// GetString implements the goConfig.Source interface
+func (f *FileSource) GetString(property string) (string, error) {
+	return f.values.GetString(property)
+}
+
+func flatten(prefix string, raw map[string]interface{}, into map[string]string) {
+	for key, value := range raw {
+		name := normalizeName(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flatten(name, v, into)
+		case []interface{}:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprint(item)
+			}
+			into[name] = strings.Join(items, ",")
+		case nil:
+			into[name] = ""
+		default:
+			into[name] = fmt.Sprint(v)
+		}
+	}
+}
+
+func normalizeName(name string) string {
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
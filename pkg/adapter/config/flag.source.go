@@ -0,0 +1,63 @@
+package config
+
+import (
+	"flag"
+	"strings"
+
+	goConfig "github.com/liampulles/go-config"
+)
+
+// FlagSource reads properties from command-line flags. Each property
+// in Properties has a flag named in lower-case with dashes, e.g.
+// --db-host for DB_HOST. Only flags which are given are set.
+type FlagSource struct {
+	values goConfig.MapSource
+}
+
+// Check we implement the interface
+var _ goConfig.Source = &FlagSource{}
+
+// NewFlagSource is a constructor. Flags may be interleaved with positional
+// arguments (e.g. subcommands), which are returned in order.
+func NewFlagSource(args []string) (*FlagSource, []string, error) {
+	fs := flag.NewFlagSet("matchstick-video", flag.ContinueOnError)
+	for _, property := range Properties {
+		fs.String(flagName(property.Name), property.Default, property.Description)
+	}
+
+	// Parse until all flags are consumed, collecting positional args.
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	// Only record flags that were actually given
+	values := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		values[propertyName(f.Name)] = f.Value.String()
+	})
+
+	return &FlagSource{
+		values: goConfig.MapSource(values),
+	}, positional, nil
+}
+
+// GetString implements the goConfig.Source interface
+func (f *FlagSource) GetString(property string) (string, error) {
+	return f.values.GetString(property)
+}
+
+func flagName(property string) string {
+	return strings.ToLower(strings.ReplaceAll(property, "_", "-"))
+}
+
+func propertyName(flag string) string {
+	return normalizeName(flag)
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"errors"
+
+	goConfig "github.com/liampulles/go-config"
+)
+
+// LayeredSource looks up properties in a list of sources,
+// in order, returning the first value found. Earlier sources
+// therefore take precedence over later ones.
+type LayeredSource struct {
+	sources []goConfig.Source
+}
+
+// Check we implement the interface
+var _ goConfig.Source = &LayeredSource{}
+
+// NewLayeredSource is a constructor
+func NewLayeredSource(sources ...goConfig.Source) *LayeredSource {
+	return &LayeredSource{
+		sources: sources,
+	}
+}
+
+// GetString implements the goConfig.Source interface
+func (l *LayeredSource) GetString(property string) (string, error) {
+	for _, source := range l.sources {
+		value, err := source.GetString(property)
+		if err == nil {
+			return value, nil
+		}
+		if !isNotSet(err) {
+			return "", err
+		}
+	}
+	return "", &goConfig.ErrPropertyNotSet{Property: property}
+}
+
+func isNotSet(err error) bool {
+	var notSet *goConfig.ErrPropertyNotSet
+	return errors.As(err, &notSet)
+}
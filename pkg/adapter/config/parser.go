@@ -0,0 +1,239 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	goConfig "github.com/liampulles/go-config"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// parser converts raw settings into typed values, keeping
+// the first error it encounters.
+type parser struct {
+	values map[string]string
+	err    error
+}
+
+func newParser(settings []Setting) *parser {
+	values := make(map[string]string)
+	for _, setting := range settings {
+		values[setting.Name] = setting.Value
+	}
+	return &parser{
+		values: values,
+	}
+}
+
+func (p *parser) str(property string) string {
+	return p.values[property]
+}
+
+func (p *parser) int(property string) int {
+	value := p.values[property]
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		p.fail(property, value, goConfig.IntDesiredFormat)
+		return 0
+	}
+	return i
+}
+
+// list parses a comma separated list, ignoring blank entries.
+func (p *parser) list(property string) []string {
+	return splitList(p.values[property])
+}
+
+func (p *parser) bool(property string) bool {
+	value := p.values[property]
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		p.fail(property, value, "bool")
+		return false
+	}
+	return b
+}
+
+func (p *parser) duration(property string) time.Duration {
+	value := p.values[property]
+	d, ok := parseDuration(value)
+	if !ok {
+		p.fail(property, value, "duration")
+	}
+	return d
+}
+
+// durationMap parses a comma separated list of key=duration pairs, e.g.
+// "GET /inventory=5s,PUT /inventory/{id}/checkout=2s". Whitespace in
+// keys is normalized to a single space.
+func (p *parser) durationMap(property string, formatDesc string) map[string]time.Duration {
+	result := make(map[string]time.Duration)
+	for _, entry := range splitList(p.values[property]) {
+		idx := strings.LastIndex(entry, "=")
+		if idx < 0 {
+			p.fail(property, entry, formatDesc)
+			return nil
+		}
+
+		d, ok := parseDuration(entry[idx+1:])
+		if !ok {
+			p.fail(property, entry, formatDesc)
+			return nil
+		}
+		key := strings.Join(strings.Fields(entry[:idx]), " ")
+		result[key] = d
+	}
+	return result
+}
+
+// intsMap parses a comma separated list of key=a/b/... pairs, where
+// each value is a list of size ints, e.g. "vhs=50/1/500,dvd=100/0/0".
+func (p *parser) intsMap(property string, size int, formatDesc string) map[string][]int {
+	result := make(map[string][]int)
+	for _, entry := range splitList(p.values[property]) {
+		idx := strings.LastIndex(entry, "=")
+		if idx < 0 {
+			p.fail(property, entry, formatDesc)
+			return nil
+		}
+
+		parts := strings.Split(entry[idx+1:], "/")
+		if len(parts) != size {
+			p.fail(property, entry, formatDesc)
+			return nil
+		}
+		ints := make([]int, size)
+		for i, part := range parts {
+			v, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				p.fail(property, entry, formatDesc)
+				return nil
+			}
+			ints[i] = v
+		}
+		result[strings.TrimSpace(entry[:idx])] = ints
+	}
+	return result
+}
+
+func (p *parser) fail(property string, value string, formatDesc string) {
+	if p.err != nil {
+		return
+	}
+	p.err = &goConfig.ErrValueFormat{
+		Property:          property,
+		ValueString:       value,
+		DesiredFormatDesc: formatDesc,
+	}
+}
+
+func parseDuration(value string) (time.Duration, bool) {
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// splitList splits a comma separated list, ignoring blank entries.
+func splitList(value string) []string {
+	var result []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// validator checks typed values, keeping the first problem
+// it encounters.
+type validator struct {
+	err error
+}
+
+func (v *validator) portNumber(property string, value int) {
+	if value < 1 || value > 65535 {
+		v.fail("%s must be between 1 and 65535 (is %d)", property, value)
+	}
+}
+
+func (v *validator) notBlank(property string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail("%s must not be blank", property)
+	}
+}
+
+func (v *validator) nonNegative(property string, value int) {
+	if value < 0 {
+		v.fail("%s must not be negative (is %d)", property, value)
+	}
+}
+
+func (v *validator) positive(property string, value int) {
+	if value <= 0 {
+		v.fail("%s must be positive (is %d)", property, value)
+	}
+}
+
+func (v *validator) positiveDuration(property string, value time.Duration) {
+	if value <= 0 {
+		v.fail("%s must be positive (is %s)", property, value)
+	}
+}
+
+// validFormat checks that value is a format which the store stocks.
+func (v *validator) validFormat(property string, value entity.Format) {
+	if err := value.Validate(); err != nil {
+		v.fail("%s has an unknown format (is %s)", property, value)
+	}
+}
+
+// validLocationFormat checks that value is a template which
+// locations can be read and written with.
+func (v *validator) validLocationFormat(property string, value string) {
+	if _, err := domain.NewLocationFormatImpl(value); err != nil {
+		v.fail("%s %v (is %s)", property, err, value)
+	}
+}
+
+// requiredWith checks that property is set whenever other is.
+func (v *validator) requiredWith(property string, value string, other string, otherValue string) {
+	if otherValue != "" && value == "" {
+		v.fail("%s must be set when %s is set", property, other)
+	}
+}
+
+// distinct checks that property is not set to the same value as other.
+func (v *validator) distinct(property string, value int, other string, otherValue int) {
+	if value == otherValue {
+		v.fail("%s must differ from %s (both are %d)", property, other, value)
+	}
+}
+
+func (v *validator) oneOf(property string, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.fail("%s must be one of %s (is %s)", property, strings.Join(allowed, ", "), value)
+}
+
+func (v *validator) notWildcard(property string, value string, other string) {
+	if value == "*" {
+		v.fail("%s must not be * when %s is set", property, other)
+	}
+}
+
+func (v *validator) fail(format string, args ...interface{}) {
+	if v.err != nil {
+		return
+	}
+	v.err = fmt.Errorf(format, args...)
+}
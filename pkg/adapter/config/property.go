@@ -0,0 +1,73 @@
+package config
+
+// Property describes a configuration property which may be set
+// in a config file, the environment or a command-line flag.
+type Property struct {
+	Name        string
+	Default     string
+	Description string
+	Secret      bool
+}
+
+// Properties lists every property which the Store reads.
+var Properties = []Property{
+	{Name: "CONFIG_FILE", Default: "", Description: "Path to a YAML or TOML config file"},
+	{Name: "PORT", Default: "8080", Description: "What port to run the server on"},
+	{Name: "GRPC_PORT", Default: "9090", Description: "What port to run the gRPC server on"},
+	{Name: "MIGRATION_SOURCE", Default: "", Description: "URL of DB migrations, e.g. file://migrations. Uses the migrations built into the binary if blank"},
+	{Name: "AUTO_MIGRATE", Default: "true", Description: "Whether to migrate the DB up when the server starts"},
+	{Name: "DB_USER", Default: "matchvid", Description: "Username for DB"},
+	{Name: "DB_PASSWORD", Default: "password", Description: "Password for DB", Secret: true},
+	{Name: "DB_HOST", Default: "localhost", Description: "Host where the DB can be accessed"},
+	{Name: "DB_PORT", Default: "5432", Description: "Port where the DB can be accessed"},
+	{Name: "DB_NAME", Default: "matchvid", Description: "Name of the database"},
+	{Name: "DATABASE_URL", Default: "", Description: "Full PostgreSQL URL or DSN, used instead of the other DB_ connection properties", Secret: true},
+	{Name: "DB_SSL_MODE", Default: "disable", Description: "SSL mode: disable, allow, prefer, require, verify-ca or verify-full"},
+	{Name: "DB_SSL_ROOT_CERT", Default: "", Description: "Path to the CA certificate used to verify the DB server"},
+	{Name: "DB_SSL_CERT", Default: "", Description: "Path to the client certificate presented to the DB"},
+	{Name: "DB_SSL_KEY", Default: "", Description: "Path to the private key of the client certificate"},
+	{Name: "DB_MAX_OPEN_CONNS", Default: "0", Description: "Maximum open DB connections. 0 means unlimited"},
+	{Name: "DB_MAX_IDLE_CONNS", Default: "2", Description: "Maximum idle DB connections kept in the pool"},
+	{Name: "DB_CONN_MAX_LIFETIME", Default: "0", Description: "How long a DB connection may be reused. 0 means forever"},
+	{Name: "DB_CONN_MAX_IDLE_TIME", Default: "0", Description: "How long a DB connection may sit idle. 0 means forever"},
+	{Name: "DB_CONNECT_TIMEOUT", Default: "30s", Description: "How long to keep retrying the DB at startup"},
+	{Name: "DB_CONNECT_BACKOFF", Default: "500ms", Description: "Delay before the first DB connection retry, doubling each attempt"},
+	{Name: "DB_CONNECT_MAX_BACKOFF", Default: "5s", Description: "Longest delay between DB connection retries"},
+	{Name: "TRACE_EXPORTER", Default: "none", Description: "Where to send traces: none, stdout or otlp"},
+	{Name: "TRACE_OTLP_ENDPOINT", Default: "localhost:4318", Description: "host:port of an OTLP/HTTP collector"},
+	{Name: "TRACE_SERVICE_NAME", Default: "matchstick-video", Description: "Service name attached to traces"},
+	{Name: "REQUEST_TIMEOUT", Default: "30s", Description: "How long a request may run before it is abandoned"},
+	{Name: "ROUTE_TIMEOUTS", Default: "", Description: "Overrides of REQUEST_TIMEOUT, e.g. GET /inventory=5s,PUT /inventory/{id}=2s"},
+	{Name: "LATE_FEE_PER_DAY", Default: "100", Description: "Late fee charged per day late, in cents"},
+	{Name: "LATE_FEE_GRACE_DAYS", Default: "0", Description: "Days late which are not charged for"},
+	{Name: "LATE_FEE_CAP", Default: "0", Description: "Most charged in late fees for one rental, in cents. 0 means no cap"},
+	{Name: "LATE_FEE_FORMAT_OVERRIDES", Default: "", Description: "Late fee rules for specific formats as perDay/graceDays/cap, e.g. vhs=50/1/500,4k=200/0/0"},
+	{Name: "HOLD_EXPIRY", Default: "72h", Description: "How long a copy put aside for a hold waits to be collected"},
+	{Name: "RENEWAL_LIMIT", Default: "2", Description: "Most times a rental may be renewed. 0 disables renewals"},
+	{Name: "CREDIT_LIMIT", Default: "1000", Description: "Most an account may owe and still check out, in cents. 0 means no limit"},
+	{Name: "RATING_SCHEME", Default: "mpaa", Description: "Age rating scheme for titles: mpaa or bbfc"},
+	{Name: "RATING_MINIMUM_AGES", Default: "", Description: "Overrides of the minimum age for ratings, or extra ratings, e.g. R=18,X=18"},
+	{Name: "LOCATION_FORMAT", Default: "{store}-{aisle}-{shelf}-{slot}", Description: "How locations are written, using each of {store}, {aisle}, {shelf} and {slot} once"},
+	{Name: "OUTBOX_SINKS", Default: "", Description: "Comma separated sinks to publish domain events to: stdout, webhook. Events are kept in the outbox if blank"},
+	{Name: "OUTBOX_POLL_INTERVAL", Default: "1s", Description: "How often to publish new domain events"},
+	{Name: "OUTBOX_BATCH_SIZE", Default: "100", Description: "Most domain events published in one transaction"},
+	{Name: "WEBHOOK_TIMEOUT", Default: "10s", Description: "How long a webhook receiver has to respond"},
+	{Name: "WEBHOOK_MAX_ATTEMPTS", Default: "8", Description: "Most times a webhook is attempted before it is dead"},
+	{Name: "WEBHOOK_BACKOFF", Default: "30s", Description: "How long to wait after the first failed webhook attempt. Doubles after each further attempt"},
+	{Name: "WEBHOOK_MAX_BACKOFF", Default: "1h", Description: "Longest wait between webhook attempts"},
+	{Name: "EVENT_STREAM_POLL_INTERVAL", Default: "1s", Description: "How often event streams look for new domain events"},
+	{Name: "EVENT_STREAM_GAP_TIMEOUT", Default: "5s", Description: "How long event streams wait for a domain event which is committed out of order"},
+	{Name: "GRAPHQL_MAX_DEPTH", Default: "5", Description: "How deeply GraphQL queries may nest fields"},
+	{Name: "CLI_OUTPUT", Default: "table", Description: "How CLI commands print results: table or json"},
+	{Name: "CLI_SERVER_URL", Default: "", Description: "URL of a running server for CLI commands to call, e.g. http://localhost:8080. Uses the DB directly if blank"},
+	{Name: "IDEMPOTENCY_KEY_EXPIRY", Default: "24h", Description: "How long an Idempotency-Key is remembered, after which it may be used again"},
+	{Name: "RATE_LIMIT", Default: "0", Description: "Most requests a client may make to a route in a burst, refilled evenly over RATE_LIMIT_PERIOD. 0 means no limit"},
+	{Name: "RATE_LIMIT_PERIOD", Default: "1m", Description: "How long a client's rate limits take to refill completely"},
+	{Name: "ROUTE_RATE_LIMITS", Default: "", Description: "Overrides of RATE_LIMIT, e.g. GET /inventory=60,POST /inventory=10"},
+	{Name: "RATE_LIMIT_API_KEYS", Default: "", Description: "Comma separated API keys which tell clients apart for rate limiting and idempotency keys. Other clients are told apart by IP address", Secret: true},
+	{Name: "CORS_ALLOWED_ORIGINS", Default: "", Description: "Comma separated origins browsers may call the server from, e.g. https://shop.example.com, or * for any. None if blank"},
+	{Name: "CORS_ALLOWED_METHODS", Default: "", Description: "Comma separated methods browsers may use from other origins. Every method a route supports if blank"},
+	{Name: "CORS_ALLOWED_HEADERS", Default: "Content-Type,Idempotency-Key,X-API-Key,traceparent", Description: "Comma separated headers browsers may send from other origins"},
+	{Name: "CORS_ALLOW_CREDENTIALS", Default: "false", Description: "Whether browsers may send cookies and credentials from other origins"},
+	{Name: "CORS_MAX_AGE", Default: "10m", Description: "How long browsers may cache the answer to a preflight request"},
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	goConfig "github.com/liampulles/go-config"
+)
+
+// SecretFileSource allows any property to instead be read from a file
+// named by the property with a _FILE suffix, e.g. DB_PASSWORD_FILE. This
+// is useful for e.g. Docker/Kubernetes secrets. A value for the property
+// itself takes precedence over the _FILE variant.
+type SecretFileSource struct {
+	delegate goConfig.Source
+}
+
+// Check we implement the interface
+var _ goConfig.Source = &SecretFileSource{}
+
+// NewSecretFileSource is a constructor
+func NewSecretFileSource(delegate goConfig.Source) *SecretFileSource {
+	return &SecretFileSource{
+		delegate: delegate,
+	}
+}
+
+// GetString implements the goConfig.Source interface
+func (s *SecretFileSource) GetString(property string) (string, error) {
+	value, err := s.delegate.GetString(property)
+	if err == nil || !isNotSet(err) {
+		return value, err
+	}
+
+	// Try the _FILE variant
+	path, fileErr := s.delegate.GetString(property + "_FILE")
+	if fileErr != nil {
+		return "", err
+	}
+	contents, fileErr := os.ReadFile(path)
+	if fileErr != nil {
+		return "", fmt.Errorf("could not read %s_FILE: %w", property, fileErr)
+	}
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	goConfig "github.com/liampulles/go-config"
+)
+
+// NewCommandLineSource layers the sources of configuration for a run
+// of the app. In order of precedence:
+//
+//  1. Command-line flags
+//  2. The environment
+//  3. The config file named by CONFIG_FILE (if any)
+//  4. Defaults (applied by the Store)
+//
+// Each layer also supports _FILE variants of properties. Any positional
+// command-line arguments are returned.
+func NewCommandLineSource(args []string, env goConfig.Source) (goConfig.Source, []string, error) {
+	flags, positional, err := NewFlagSource(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	layers := []goConfig.Source{
+		NewSecretFileSource(flags),
+		NewSecretFileSource(env),
+	}
+
+	// Add the config file, if there is one
+	path, err := NewLayeredSource(layers...).GetString("CONFIG_FILE")
+	if err != nil && !isNotSet(err) {
+		return nil, nil, err
+	}
+	if path != "" {
+		file, err := NewFileSource(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		layers = append(layers, NewSecretFileSource(file))
+	}
+
+	return NewLayeredSource(layers...), positional, nil
+}
@@ -2,31 +2,141 @@ package config
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	goConfig "github.com/liampulles/go-config"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 // Store encapsulates configuration properties
 // to be injected
 type Store interface {
 	GetPort() int
+	GetGrpcPort() int
 	GetMigrationSource() string
+	GetAutoMigrate() bool
 	GetDbUser() string
 	GetDbPassword() string
 	GetDbHost() string
 	GetDbPort() int
 	GetDbName() string
+	GetDatabaseURL() string
+	GetDbSSLMode() string
+	GetDbSSLRootCert() string
+	GetDbSSLCert() string
+	GetDbSSLKey() string
+	GetDbMaxOpenConns() int
+	GetDbMaxIdleConns() int
+	GetDbConnMaxLifetime() time.Duration
+	GetDbConnMaxIdleTime() time.Duration
+	GetDbConnectTimeout() time.Duration
+	GetDbConnectBackoff() time.Duration
+	GetDbConnectMaxBackoff() time.Duration
+	GetTraceExporter() string
+	GetTraceOTLPEndpoint() string
+	GetTraceServiceName() string
+	GetRequestTimeout() time.Duration
+	GetRouteTimeouts() map[string]time.Duration
+	GetLateFeeRule() domain.LateFeeRule
+	GetLateFeeFormatRules() map[entity.Format]domain.LateFeeRule
+	GetHoldExpiry() time.Duration
+	GetRenewalLimit() int
+	GetCreditLimit() entity.Money
+	GetRatingScheme() string
+	GetRatingMinimumAges() map[string]int
+	GetLocationFormat() string
+	GetOutboxSinks() []string
+	GetOutboxPollInterval() time.Duration
+	GetOutboxBatchSize() int
+	GetWebhookTimeout() time.Duration
+	GetWebhookMaxAttempts() int
+	GetWebhookBackoff() time.Duration
+	GetWebhookMaxBackoff() time.Duration
+	GetEventStreamPollInterval() time.Duration
+	GetEventStreamGapTimeout() time.Duration
+	GetGraphQLMaxDepth() int
+	GetCliOutput() string
+	GetCliServerURL() string
+	GetIdempotencyKeyExpiry() time.Duration
+	GetRateLimit() int
+	GetRateLimitPeriod() time.Duration
+	GetRouteRateLimits() map[string]int
+	GetRateLimitAPIKeys() []string
+	GetCORSAllowedOrigins() []string
+	GetCORSAllowedMethods() []string
+	GetCORSAllowedHeaders() []string
+	GetCORSAllowCredentials() bool
+	GetCORSMaxAge() time.Duration
+}
+
+// Setting is the effective, raw value of a property
+type Setting struct {
+	Property
+	Value string
 }
 
 // StoreImpl implements store
 type StoreImpl struct {
-	port            int
-	migrationSource string
-	dbUser          string
-	dbPassword      string
-	dbHost          string
-	dbPort          int
-	dbName          string
+	settings           []Setting
+	port               int
+	grpcPort           int
+	migrationSource    string
+	autoMigrate        bool
+	dbUser             string
+	dbPassword         string
+	dbHost             string
+	dbPort             int
+	dbName             string
+	databaseURL        string
+	dbSSLMode          string
+	dbSSLRootCert      string
+	dbSSLCert          string
+	dbSSLKey           string
+	dbMaxOpen          int
+	dbMaxIdle          int
+	dbMaxLifetime      time.Duration
+	dbMaxIdleTime      time.Duration
+	dbConnTimeout      time.Duration
+	dbConnBackoff      time.Duration
+	dbConnMaxBackoff   time.Duration
+	traceExporter      string
+	traceEndpoint      string
+	traceService       string
+	requestTimeout     time.Duration
+	routeTimeouts      map[string]time.Duration
+	lateFeeRule        domain.LateFeeRule
+	lateFeeOverrides   map[entity.Format]domain.LateFeeRule
+	holdExpiry         time.Duration
+	renewalLimit       int
+	creditLimit        entity.Money
+	ratingScheme       string
+	ratingAges         map[string]int
+	locationFormat     string
+	outboxSinks        []string
+	outboxInterval     time.Duration
+	outboxBatchSize    int
+	webhookTimeout     time.Duration
+	webhookMaxAttempts int
+	webhookBackoff     time.Duration
+	webhookMaxBackoff  time.Duration
+	streamInterval     time.Duration
+	streamGapTimeout   time.Duration
+	graphqlMaxDepth    int
+	cliOutput          string
+	cliServerURL       string
+	idempotencyExpiry  time.Duration
+	rateLimit          int
+	rateLimitPeriod    time.Duration
+	routeRateLimits    map[string]int
+	rateLimitAPIKeys   []string
+	corsOrigins        []string
+	corsMethods        []string
+	corsHeaders        []string
+	corsCredentials    bool
+	corsMaxAge         time.Duration
 }
 
 // Check we implement the interface
@@ -34,44 +144,130 @@ var _ Store = &StoreImpl{}
 
 // NewStoreImpl is a constructor
 func NewStoreImpl(source goConfig.Source) (*StoreImpl, error) {
-	typedSource := goConfig.NewTypedSource(source)
-	// Set defaults
+	// Read in from source, falling back to defaults
+	settings, err := loadSettings(source)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch config: %w", err)
+	}
+
+	// Convert to typed values
 	store := &StoreImpl{
-		port:            8080,
-		migrationSource: "file://migrations",
-		dbUser:          "matchvid",
-		dbPassword:      "password",
-		dbHost:          "localhost",
-		dbPort:          5432,
-		dbName:          "matchvid",
+		settings: settings,
+	}
+	p := newParser(settings)
+	store.port = p.int("PORT")
+	store.grpcPort = p.int("GRPC_PORT")
+	store.migrationSource = p.str("MIGRATION_SOURCE")
+	store.autoMigrate = p.bool("AUTO_MIGRATE")
+	store.dbUser = p.str("DB_USER")
+	store.dbPassword = p.str("DB_PASSWORD")
+	store.dbHost = p.str("DB_HOST")
+	store.dbPort = p.int("DB_PORT")
+	store.dbName = p.str("DB_NAME")
+	store.databaseURL = p.str("DATABASE_URL")
+	store.dbSSLMode = p.str("DB_SSL_MODE")
+	store.dbSSLRootCert = p.str("DB_SSL_ROOT_CERT")
+	store.dbSSLCert = p.str("DB_SSL_CERT")
+	store.dbSSLKey = p.str("DB_SSL_KEY")
+	store.dbMaxOpen = p.int("DB_MAX_OPEN_CONNS")
+	store.dbMaxIdle = p.int("DB_MAX_IDLE_CONNS")
+	store.dbMaxLifetime = p.duration("DB_CONN_MAX_LIFETIME")
+	store.dbMaxIdleTime = p.duration("DB_CONN_MAX_IDLE_TIME")
+	store.dbConnTimeout = p.duration("DB_CONNECT_TIMEOUT")
+	store.dbConnBackoff = p.duration("DB_CONNECT_BACKOFF")
+	store.dbConnMaxBackoff = p.duration("DB_CONNECT_MAX_BACKOFF")
+	store.traceExporter = p.str("TRACE_EXPORTER")
+	store.traceEndpoint = p.str("TRACE_OTLP_ENDPOINT")
+	store.traceService = p.str("TRACE_SERVICE_NAME")
+	store.requestTimeout = p.duration("REQUEST_TIMEOUT")
+	store.routeTimeouts = p.durationMap("ROUTE_TIMEOUTS", "METHOD /path=duration")
+	store.lateFeeRule = domain.LateFeeRule{
+		PerDay:    entity.Money(p.int("LATE_FEE_PER_DAY")),
+		GraceDays: p.int("LATE_FEE_GRACE_DAYS"),
+		Cap:       entity.Money(p.int("LATE_FEE_CAP")),
+	}
+	store.lateFeeOverrides = make(map[entity.Format]domain.LateFeeRule)
+	for format, v := range p.intsMap("LATE_FEE_FORMAT_OVERRIDES", 3, "format=perDay/graceDays/cap") {
+		store.lateFeeOverrides[entity.Format(format)] = domain.LateFeeRule{
+			PerDay:    entity.Money(v[0]),
+			GraceDays: v[1],
+			Cap:       entity.Money(v[2]),
+		}
+	}
+	store.holdExpiry = p.duration("HOLD_EXPIRY")
+	store.renewalLimit = p.int("RENEWAL_LIMIT")
+	store.creditLimit = entity.Money(p.int("CREDIT_LIMIT"))
+	store.ratingScheme = p.str("RATING_SCHEME")
+	store.ratingAges = make(map[string]int)
+	for rating, v := range p.intsMap("RATING_MINIMUM_AGES", 1, "rating=age") {
+		store.ratingAges[rating] = v[0]
+	}
+	store.locationFormat = p.str("LOCATION_FORMAT")
+	store.outboxSinks = p.list("OUTBOX_SINKS")
+	store.outboxInterval = p.duration("OUTBOX_POLL_INTERVAL")
+	store.outboxBatchSize = p.int("OUTBOX_BATCH_SIZE")
+	store.webhookTimeout = p.duration("WEBHOOK_TIMEOUT")
+	store.webhookMaxAttempts = p.int("WEBHOOK_MAX_ATTEMPTS")
+	store.webhookBackoff = p.duration("WEBHOOK_BACKOFF")
+	store.webhookMaxBackoff = p.duration("WEBHOOK_MAX_BACKOFF")
+	store.streamInterval = p.duration("EVENT_STREAM_POLL_INTERVAL")
+	store.streamGapTimeout = p.duration("EVENT_STREAM_GAP_TIMEOUT")
+	store.graphqlMaxDepth = p.int("GRAPHQL_MAX_DEPTH")
+	store.cliOutput = p.str("CLI_OUTPUT")
+	store.cliServerURL = p.str("CLI_SERVER_URL")
+	store.idempotencyExpiry = p.duration("IDEMPOTENCY_KEY_EXPIRY")
+	store.rateLimit = p.int("RATE_LIMIT")
+	store.rateLimitPeriod = p.duration("RATE_LIMIT_PERIOD")
+	store.routeRateLimits = make(map[string]int)
+	for route, v := range p.intsMap("ROUTE_RATE_LIMITS", 1, "METHOD /path=requests") {
+		store.routeRateLimits[strings.Join(strings.Fields(route), " ")] = v[0]
+	}
+	store.rateLimitAPIKeys = p.list("RATE_LIMIT_API_KEYS")
+	store.corsOrigins = p.list("CORS_ALLOWED_ORIGINS")
+	store.corsMethods = p.list("CORS_ALLOWED_METHODS")
+	store.corsHeaders = p.list("CORS_ALLOWED_HEADERS")
+	store.corsCredentials = p.bool("CORS_ALLOW_CREDENTIALS")
+	store.corsMaxAge = p.duration("CORS_MAX_AGE")
+	if p.err != nil {
+		return nil, fmt.Errorf("could not fetch config: %w", p.err)
 	}
 
-	// Read in from source
-	if err := goConfig.LoadProperties(typedSource,
-		goConfig.IntProp("PORT", &store.port, false),
-		goConfig.StrProp("MIGRATION_SOURCE", &store.migrationSource, false),
-		goConfig.StrProp("DB_USER", &store.dbUser, false),
-		goConfig.StrProp("DB_PASSWORD", &store.dbPassword, false),
-		goConfig.StrProp("DB_HOST", &store.dbHost, false),
-		goConfig.IntProp("DB_PORT", &store.dbPort, false),
-		goConfig.StrProp("DB_NAME", &store.dbName, false),
-	); err != nil {
-		return nil, fmt.Errorf("could not fetch config: %w", err)
+	// Make sure the values make sense
+	if err := store.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
 	return store, nil
 }
 
+// Settings returns the effective raw value of every property, in the
+// same order as Properties.
+func (s *StoreImpl) Settings() []Setting {
+	return s.settings
+}
+
 // GetPort returns the configured port for the server
 func (s *StoreImpl) GetPort() int {
 	return s.port
 }
 
-// GetMigrationSource returns the source for database migrations to run
+// GetGrpcPort returns the configured port for the gRPC server
+func (s *StoreImpl) GetGrpcPort() int {
+	return s.grpcPort
+}
+
+// GetMigrationSource returns the source for database migrations to run.
+// If blank, the migrations embedded in the binary are used.
 func (s *StoreImpl) GetMigrationSource() string {
 	return s.migrationSource
 }
 
+// GetAutoMigrate returns whether the database should be migrated up
+// when the server starts
+func (s *StoreImpl) GetAutoMigrate() bool {
+	return s.autoMigrate
+}
+
 // GetDbUser returns the database user
 func (s *StoreImpl) GetDbUser() string {
 	return s.dbUser
@@ -96,3 +292,357 @@ func (s *StoreImpl) GetDbPort() int {
 func (s *StoreImpl) GetDbName() string {
 	return s.dbName
 }
+
+// GetDatabaseURL returns a full PostgreSQL URL or DSN. If set, it is
+// used instead of the individual DB connection properties.
+func (s *StoreImpl) GetDatabaseURL() string {
+	return s.databaseURL
+}
+
+// GetDbSSLMode returns the libpq sslmode to connect with
+func (s *StoreImpl) GetDbSSLMode() string {
+	return s.dbSSLMode
+}
+
+// GetDbSSLRootCert returns the path of the CA certificate used to
+// verify the database server
+func (s *StoreImpl) GetDbSSLRootCert() string {
+	return s.dbSSLRootCert
+}
+
+// GetDbSSLCert returns the path of the client certificate presented
+// to the database
+func (s *StoreImpl) GetDbSSLCert() string {
+	return s.dbSSLCert
+}
+
+// GetDbSSLKey returns the path of the client certificate's private key
+func (s *StoreImpl) GetDbSSLKey() string {
+	return s.dbSSLKey
+}
+
+// GetDbMaxOpenConns returns the maximum number of open database
+// connections. Zero means unlimited.
+func (s *StoreImpl) GetDbMaxOpenConns() int {
+	return s.dbMaxOpen
+}
+
+// GetDbMaxIdleConns returns the maximum number of idle database
+// connections kept in the pool
+func (s *StoreImpl) GetDbMaxIdleConns() int {
+	return s.dbMaxIdle
+}
+
+// GetDbConnMaxLifetime returns how long a database connection may be
+// reused. Zero means forever.
+func (s *StoreImpl) GetDbConnMaxLifetime() time.Duration {
+	return s.dbMaxLifetime
+}
+
+// GetDbConnMaxIdleTime returns how long a database connection may sit
+// idle before being closed. Zero means forever.
+func (s *StoreImpl) GetDbConnMaxIdleTime() time.Duration {
+	return s.dbMaxIdleTime
+}
+
+// GetDbConnectTimeout returns how long to keep retrying the database
+// at startup. Zero means only try once.
+func (s *StoreImpl) GetDbConnectTimeout() time.Duration {
+	return s.dbConnTimeout
+}
+
+// GetDbConnectBackoff returns the delay before the first database
+// connection retry
+func (s *StoreImpl) GetDbConnectBackoff() time.Duration {
+	return s.dbConnBackoff
+}
+
+// GetDbConnectMaxBackoff returns the longest delay between database
+// connection retries
+func (s *StoreImpl) GetDbConnectMaxBackoff() time.Duration {
+	return s.dbConnMaxBackoff
+}
+
+// GetTraceExporter returns where traces should be exported to:
+// one of "none", "stdout" or "otlp"
+func (s *StoreImpl) GetTraceExporter() string {
+	return s.traceExporter
+}
+
+// GetTraceOTLPEndpoint returns the host:port of the OTLP HTTP
+// collector to export traces to
+func (s *StoreImpl) GetTraceOTLPEndpoint() string {
+	return s.traceEndpoint
+}
+
+// GetTraceServiceName returns the service name to attach to traces
+func (s *StoreImpl) GetTraceServiceName() string {
+	return s.traceService
+}
+
+// GetRequestTimeout returns how long a request may take before it
+// is abandoned. Zero means no timeout.
+func (s *StoreImpl) GetRequestTimeout() time.Duration {
+	return s.requestTimeout
+}
+
+// GetRouteTimeouts returns timeouts which override the request timeout
+// for specific routes. Keys are of the form "METHOD /path/{pattern}".
+func (s *StoreImpl) GetRouteTimeouts() map[string]time.Duration {
+	return s.routeTimeouts
+}
+
+// GetLateFeeRule returns how lateness is charged for, unless
+// overridden for the format of the item
+func (s *StoreImpl) GetLateFeeRule() domain.LateFeeRule {
+	return s.lateFeeRule
+}
+
+// GetLateFeeFormatRules returns how lateness is charged for
+// specific formats
+func (s *StoreImpl) GetLateFeeFormatRules() map[entity.Format]domain.LateFeeRule {
+	return s.lateFeeOverrides
+}
+
+// GetHoldExpiry returns how long a copy put aside for a hold
+// waits to be collected
+func (s *StoreImpl) GetHoldExpiry() time.Duration {
+	return s.holdExpiry
+}
+
+// GetRenewalLimit returns the most times a rental may be renewed
+func (s *StoreImpl) GetRenewalLimit() int {
+	return s.renewalLimit
+}
+
+// GetCreditLimit returns the most an account may owe and still
+// check out. Zero means there is no limit.
+func (s *StoreImpl) GetCreditLimit() entity.Money {
+	return s.creditLimit
+}
+
+// GetRatingScheme returns the name of the age rating scheme
+// used for titles
+func (s *StoreImpl) GetRatingScheme() string {
+	return s.ratingScheme
+}
+
+// GetRatingMinimumAges returns minimum ages which override or
+// add to those of the rating scheme
+func (s *StoreImpl) GetRatingMinimumAges() map[string]int {
+	return s.ratingAges
+}
+
+// GetLocationFormat returns the template locations are written in
+func (s *StoreImpl) GetLocationFormat() string {
+	return s.locationFormat
+}
+
+// GetOutboxSinks returns the names of the sinks domain events are
+// published to. If there are none, events are kept in the outbox.
+func (s *StoreImpl) GetOutboxSinks() []string {
+	return s.outboxSinks
+}
+
+// GetOutboxPollInterval returns how often new domain events
+// are published
+func (s *StoreImpl) GetOutboxPollInterval() time.Duration {
+	return s.outboxInterval
+}
+
+// GetOutboxBatchSize returns the most domain events published
+// in one transaction
+func (s *StoreImpl) GetOutboxBatchSize() int {
+	return s.outboxBatchSize
+}
+
+// GetWebhookTimeout returns how long a webhook receiver has to
+// respond
+func (s *StoreImpl) GetWebhookTimeout() time.Duration {
+	return s.webhookTimeout
+}
+
+// GetWebhookMaxAttempts returns the most times a webhook is
+// attempted before it is dead
+func (s *StoreImpl) GetWebhookMaxAttempts() int {
+	return s.webhookMaxAttempts
+}
+
+// GetWebhookBackoff returns how long to wait after the first
+// failed webhook attempt
+func (s *StoreImpl) GetWebhookBackoff() time.Duration {
+	return s.webhookBackoff
+}
+
+// GetWebhookMaxBackoff returns the longest wait between webhook
+// attempts
+func (s *StoreImpl) GetWebhookMaxBackoff() time.Duration {
+	return s.webhookMaxBackoff
+}
+
+// GetEventStreamPollInterval returns how often event streams look
+// for new domain events
+func (s *StoreImpl) GetEventStreamPollInterval() time.Duration {
+	return s.streamInterval
+}
+
+// GetEventStreamGapTimeout returns how long event streams wait for
+// a domain event which is committed out of order, before skipping it
+func (s *StoreImpl) GetEventStreamGapTimeout() time.Duration {
+	return s.streamGapTimeout
+}
+
+// GetGraphQLMaxDepth returns how deeply GraphQL queries may nest
+// fields
+func (s *StoreImpl) GetGraphQLMaxDepth() int {
+	return s.graphqlMaxDepth
+}
+
+// GetCliOutput returns how CLI commands print results
+func (s *StoreImpl) GetCliOutput() string {
+	return s.cliOutput
+}
+
+// GetCliServerURL returns the URL of the server CLI commands call, or
+// blank if they use the DB directly
+func (s *StoreImpl) GetCliServerURL() string {
+	return s.cliServerURL
+}
+
+// GetIdempotencyKeyExpiry returns how long an idempotency key is
+// remembered for
+func (s *StoreImpl) GetIdempotencyKeyExpiry() time.Duration {
+	return s.idempotencyExpiry
+}
+
+// GetRateLimit returns how many requests a client may make to a route
+// in a burst. Zero means no limit.
+func (s *StoreImpl) GetRateLimit() int {
+	return s.rateLimit
+}
+
+// GetRateLimitPeriod returns how long a client's rate limits take to
+// refill completely
+func (s *StoreImpl) GetRateLimitPeriod() time.Duration {
+	return s.rateLimitPeriod
+}
+
+// GetRouteRateLimits returns limits which override the rate limit for
+// specific routes. Keys are of the form "METHOD /path/{pattern}".
+func (s *StoreImpl) GetRouteRateLimits() map[string]int {
+	return s.routeRateLimits
+}
+
+// GetRateLimitAPIKeys returns the API keys which tell clients apart
+// for rate limiting.
+func (s *StoreImpl) GetRateLimitAPIKeys() []string {
+	return s.rateLimitAPIKeys
+}
+
+// GetCORSAllowedOrigins returns the origins browsers may call the
+// server from. "*" allows any origin.
+func (s *StoreImpl) GetCORSAllowedOrigins() []string {
+	return s.corsOrigins
+}
+
+// GetCORSAllowedMethods returns the methods browsers may use from other
+// origins. Empty means every method a route supports.
+func (s *StoreImpl) GetCORSAllowedMethods() []string {
+	return s.corsMethods
+}
+
+// GetCORSAllowedHeaders returns the headers browsers may send from
+// other origins
+func (s *StoreImpl) GetCORSAllowedHeaders() []string {
+	return s.corsHeaders
+}
+
+// GetCORSAllowCredentials returns whether browsers may send credentials
+// from other origins
+func (s *StoreImpl) GetCORSAllowCredentials() bool {
+	return s.corsCredentials
+}
+
+// GetCORSMaxAge returns how long browsers may cache the answer to a
+// preflight request
+func (s *StoreImpl) GetCORSMaxAge() time.Duration {
+	return s.corsMaxAge
+}
+
+func (s *StoreImpl) validate() error {
+	v := &validator{}
+	v.portNumber("PORT", s.port)
+	v.portNumber("GRPC_PORT", s.grpcPort)
+	v.distinct("GRPC_PORT", s.grpcPort, "PORT", s.port)
+	v.notBlank("DB_USER", s.dbUser)
+	v.notBlank("DB_HOST", s.dbHost)
+	v.portNumber("DB_PORT", s.dbPort)
+	v.notBlank("DB_NAME", s.dbName)
+	v.oneOf("DB_SSL_MODE", s.dbSSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+	v.requiredWith("DB_SSL_KEY", s.dbSSLKey, "DB_SSL_CERT", s.dbSSLCert)
+	v.requiredWith("DB_SSL_CERT", s.dbSSLCert, "DB_SSL_KEY", s.dbSSLKey)
+	v.nonNegative("DB_MAX_OPEN_CONNS", s.dbMaxOpen)
+	v.nonNegative("DB_MAX_IDLE_CONNS", s.dbMaxIdle)
+	v.positiveDuration("DB_CONNECT_BACKOFF", s.dbConnBackoff)
+	v.positiveDuration("DB_CONNECT_MAX_BACKOFF", s.dbConnMaxBackoff)
+	v.oneOf("TRACE_EXPORTER", s.traceExporter, "none", "stdout", "otlp")
+	v.nonNegative("LATE_FEE_PER_DAY", int(s.lateFeeRule.PerDay))
+	v.nonNegative("LATE_FEE_GRACE_DAYS", s.lateFeeRule.GraceDays)
+	v.nonNegative("LATE_FEE_CAP", int(s.lateFeeRule.Cap))
+	for format, rule := range s.lateFeeOverrides {
+		v.validFormat("LATE_FEE_FORMAT_OVERRIDES", format)
+		v.nonNegative("LATE_FEE_FORMAT_OVERRIDES", int(rule.PerDay))
+		v.nonNegative("LATE_FEE_FORMAT_OVERRIDES", rule.GraceDays)
+		v.nonNegative("LATE_FEE_FORMAT_OVERRIDES", int(rule.Cap))
+	}
+	v.positiveDuration("HOLD_EXPIRY", s.holdExpiry)
+	v.nonNegative("RENEWAL_LIMIT", s.renewalLimit)
+	v.nonNegative("CREDIT_LIMIT", int(s.creditLimit))
+	v.oneOf("RATING_SCHEME", s.ratingScheme, "mpaa", "bbfc")
+	for _, age := range s.ratingAges {
+		v.nonNegative("RATING_MINIMUM_AGES", age)
+	}
+	v.validLocationFormat("LOCATION_FORMAT", s.locationFormat)
+	for _, sink := range s.outboxSinks {
+		v.oneOf("OUTBOX_SINKS", sink, "stdout", "webhook")
+	}
+	v.positiveDuration("OUTBOX_POLL_INTERVAL", s.outboxInterval)
+	v.positive("OUTBOX_BATCH_SIZE", s.outboxBatchSize)
+	v.positiveDuration("WEBHOOK_TIMEOUT", s.webhookTimeout)
+	v.positive("WEBHOOK_MAX_ATTEMPTS", s.webhookMaxAttempts)
+	v.positiveDuration("WEBHOOK_BACKOFF", s.webhookBackoff)
+	v.positiveDuration("WEBHOOK_MAX_BACKOFF", s.webhookMaxBackoff)
+	v.positiveDuration("EVENT_STREAM_POLL_INTERVAL", s.streamInterval)
+	v.positive("GRAPHQL_MAX_DEPTH", s.graphqlMaxDepth)
+	v.oneOf("CLI_OUTPUT", s.cliOutput, "table", "json")
+	v.positiveDuration("IDEMPOTENCY_KEY_EXPIRY", s.idempotencyExpiry)
+	v.nonNegative("RATE_LIMIT", s.rateLimit)
+	v.positiveDuration("RATE_LIMIT_PERIOD", s.rateLimitPeriod)
+	for _, limit := range s.routeRateLimits {
+		v.nonNegative("ROUTE_RATE_LIMITS", limit)
+	}
+	if s.corsCredentials {
+		for _, origin := range s.corsOrigins {
+			v.notWildcard("CORS_ALLOWED_ORIGINS", origin, "CORS_ALLOW_CREDENTIALS")
+		}
+	}
+	return v.err
+}
+
+func loadSettings(source goConfig.Source) ([]Setting, error) {
+	typedSource := goConfig.NewTypedSource(source)
+
+	settings := make([]Setting, len(Properties))
+	for i, property := range Properties {
+		settings[i] = Setting{
+			Property: property,
+			Value:    property.Default,
+		}
+		if err := goConfig.LoadProperties(typedSource,
+			goConfig.StrProp(property.Name, &settings[i].Value, false),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
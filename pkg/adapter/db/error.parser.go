@@ -3,11 +3,13 @@ package db
 import "regexp"
 
 var uniqConRegExp = regexp.MustCompile(`(?m)violates unique constraint`)
+var fkConRegExp = regexp.MustCompile(`(?m)violates foreign key constraint`)
 var noRowsRegExp = regexp.MustCompile(`(?m)no rows in result set`)
 
 // ErrorParser analyses external errors to create matchstick-video variants.
 type ErrorParser interface {
 	FromDBRowScan(err error, _type string) error
+	FromDBExec(err error) error
 }
 
 // ErrorParserImpl implements ErrorParser
@@ -23,14 +25,28 @@ func NewErrorParserImpl() *ErrorParserImpl {
 
 // FromDBRowScan tries to extract errors from the response to db row scans
 func (e *ErrorParserImpl) FromDBRowScan(err error, _type string) error {
+	// See if the error indicates no rows were returned
+	if isNoRowsError(err) {
+		return NewNotFoundError(_type)
+	}
+
+	return fromConstraintViolation(err)
+}
+
+// FromDBExec tries to extract errors from the response to db execs
+func (e *ErrorParserImpl) FromDBExec(err error) error {
+	return fromConstraintViolation(err)
+}
+
+func fromConstraintViolation(err error) error {
 	// See if there is a uniqueness constraint violation
 	if isUniquenessConstraintError(err) {
 		return NewUniqueConstraintError(err)
 	}
 
-	// See if the error indicates no rows were returned
-	if isNoRowsError(err) {
-		return NewNotFoundError(_type)
+	// See if there is a foreign key constraint violation
+	if isForeignKeyConstraintError(err) {
+		return NewForeignKeyConstraintError(err)
 	}
 
 	// Else, return the original error
@@ -41,6 +57,10 @@ func isUniquenessConstraintError(err error) bool {
 	return matchesRegExp(err, uniqConRegExp)
 }
 
+func isForeignKeyConstraintError(err error) bool {
+	return matchesRegExp(err, fkConRegExp)
+}
+
 func isNoRowsError(err error) bool {
 	return matchesRegExp(err, noRowsRegExp)
 }
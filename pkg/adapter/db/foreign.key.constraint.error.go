@@ -0,0 +1,23 @@
+package db
+
+import "fmt"
+
+// ForeignKeyConstraintError is returned when a transaction refers to an
+// entity which does not exist, or removes an entity which is still referred to.
+type ForeignKeyConstraintError struct {
+	Cause error
+}
+
+// Check we implement the interface
+var _ error = &ForeignKeyConstraintError{}
+
+// NewForeignKeyConstraintError is a constructor
+func NewForeignKeyConstraintError(cause error) *ForeignKeyConstraintError {
+	return &ForeignKeyConstraintError{
+		Cause: cause,
+	}
+}
+
+func (f *ForeignKeyConstraintError) Error() string {
+	return fmt.Sprintf("foreign key constraint error: %s", f.Cause.Error())
+}
@@ -0,0 +1,143 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseAccount "github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// AccountRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type AccountRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.AccountConstructor
+}
+
+// Check we implement the interface
+var _ usecaseAccount.Repository = &AccountRepositoryImpl{}
+
+// NewAccountRepositoryImpl is a constructor
+func NewAccountRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.AccountConstructor,
+) *AccountRepositoryImpl {
+	return &AccountRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// FindByID finds an account matching the given id
+func (s *AccountRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.Account, error) {
+	query := `
+	SELECT 
+		id, 
+		name, 
+		date_of_birth, 
+		COALESCE(balance, 0) 
+	FROM account
+	LEFT JOIN account_balance ON account_balance.account_id=account.id
+	WHERE 
+		id=$1;`
+	var result entity.Account
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanAccount(row)
+		result = res
+		return err
+	}, "account", id)
+	return result, err
+}
+
+// FindAll retrieves all the accounts in the database, ordered by name
+func (s *AccountRepositoryImpl) FindAll(ctx context.Context) ([]entity.Account, error) {
+	query := `
+	SELECT 
+		id, 
+		name, 
+		date_of_birth, 
+		COALESCE(balance, 0) 
+	FROM account
+	LEFT JOIN account_balance ON account_balance.account_id=account.id
+	ORDER BY 
+		name, id;`
+	var results []entity.Account
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanAccount(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "account")
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *AccountRepositoryImpl) Create(ctx context.Context, e entity.Account) (entity.ID, error) {
+	query := `
+	INSERT INTO account
+		(
+			name, 
+			date_of_birth
+		)
+	VALUES ($1, $2)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "account",
+		e.Name(),
+		e.DateOfBirth(),
+	)
+}
+
+// DeleteByID deletes the account matching the id. If there
+// isn't an entry corresponding to the id - an error is returned.
+func (s *AccountRepositoryImpl) DeleteByID(ctx context.Context, id entity.ID) error {
+	query := `
+	DELETE FROM account
+	WHERE 
+		id=$1;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "account", id)
+}
+
+// Update persists new data for all fields in the given account,
+// excluding the id and balance (which is totalled from the ledger).
+func (s *AccountRepositoryImpl) Update(ctx context.Context, e entity.Account) error {
+	query := `
+	UPDATE account
+	SET
+		name=$1, date_of_birth=$2
+	WHERE 
+		id=$3;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "account",
+		e.Name(),
+		e.DateOfBirth(),
+		e.ID(),
+	)
+}
+
+func (s *AccountRepositoryImpl) scanAccount(row Row) (entity.Account, error) {
+	var id entity.ID
+	var name string
+	var dateOfBirth *time.Time
+	var balance entity.Money
+
+	// Extract data from the row
+	if err := row.Scan(&id, &name, &dateOfBirth, &balance); err != nil {
+		return nil, err
+	}
+	if dateOfBirth != nil {
+		utc := dateOfBirth.UTC()
+		dateOfBirth = &utc
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, name, dateOfBirth, balance)
+	return result, nil
+}
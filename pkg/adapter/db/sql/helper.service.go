@@ -27,12 +27,19 @@ type Rows interface {
 // ScanFunc scans a row and returns any errors
 type ScanFunc func(row Row) error
 
-// HelperService encapsulates some common methods on sql.DB.
+// preparer is implemented by both *goSql.DB and *goSql.Tx.
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*goSql.Stmt, error)
+}
+
+// HelperService encapsulates some common methods on sql.DB. If the
+// context is in a transaction (see TransactorImpl), queries are run in
+// the transaction rather than on the given db.
 type HelperService interface {
-	ExecForSingleItem(db *goSql.DB, query string, args ...interface{}) error
-	SingleRowQuery(db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error
-	ManyRowsQuery(db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error
-	SingleQueryForID(db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error)
+	ExecForSingleItem(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) error
+	SingleRowQuery(ctx context.Context, db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error
+	ManyRowsQuery(ctx context.Context, db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error
+	SingleQueryForID(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error)
 }
 
 // HelperServiceImpl implements the HelperService interface
@@ -51,16 +58,17 @@ func NewHelperServiceImpl(errorParser db.ErrorParser) *HelperServiceImpl {
 
 // ExecForSingleItem will perform exec type SQL and verify a single row
 // is affected.
-func (s *HelperServiceImpl) ExecForSingleItem(d *goSql.DB, query string, args ...interface{}) error {
+func (s *HelperServiceImpl) ExecForSingleItem(ctx context.Context, d *goSql.DB, query string, _type string, args ...interface{}) error {
 	// Run exec to get rows affected
-	rows, err := s.execForRowsAffected(d, query, args...)
+	rows, err := s.execForRowsAffected(ctx, d, query, args...)
 	if err != nil {
+		err = s.errorParser.FromDBExec(err)
 		return fmt.Errorf("cannot execute exec - db exec error: %w", err)
 	}
 
 	// Verify rows affected is 1
 	if rows == 0 {
-		return db.NewNotFoundError("inventory item")
+		return db.NewNotFoundError(_type)
 	}
 	if rows != 1 {
 		return fmt.Errorf("exec error: expected 1 entity to be affected, but was: %d", rows)
@@ -69,13 +77,13 @@ func (s *HelperServiceImpl) ExecForSingleItem(d *goSql.DB, query string, args ..
 }
 
 // SingleRowQuery will run a query type SQL which gives a single Row
-func (s *HelperServiceImpl) SingleRowQuery(db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error {
+func (s *HelperServiceImpl) SingleRowQuery(ctx context.Context, db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error {
 	// Prepare the query
-	ctx := context.TODO()
-	stmt, err := db.PrepareContext(ctx, query)
+	stmt, err := preparerFor(ctx, db).PrepareContext(ctx, query)
 	if err != nil {
 		return fmt.Errorf("cannot execute query - db prepare error: %w", err)
 	}
+	defer stmt.Close()
 
 	// Run the query to get row
 	row := stmt.QueryRowContext(ctx, args...)
@@ -89,19 +97,20 @@ func (s *HelperServiceImpl) SingleRowQuery(db *goSql.DB, query string, scanFunc
 }
 
 // ManyRowsQuery will run a query type SQL which gives many rows
-func (s *HelperServiceImpl) ManyRowsQuery(db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error {
+func (s *HelperServiceImpl) ManyRowsQuery(ctx context.Context, db *goSql.DB, query string, scanFunc ScanFunc, _type string, args ...interface{}) error {
 	// Prepare the query
-	ctx := context.TODO()
-	stmt, err := db.PrepareContext(ctx, query)
+	stmt, err := preparerFor(ctx, db).PrepareContext(ctx, query)
 	if err != nil {
 		return fmt.Errorf("cannot execute query - db prepare error: %w", err)
 	}
+	defer stmt.Close()
 
 	// Run the query to get rows
 	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
 		return fmt.Errorf("cannot execute query - db context error: %w", err)
 	}
+	defer rows.Close()
 
 	// Extract data from the row
 	for rows.Next() {
@@ -119,11 +128,11 @@ func (s *HelperServiceImpl) ManyRowsQuery(db *goSql.DB, query string, scanFunc S
 
 // SingleQueryForID will run SQL which returns an id, and return the entity form of
 // the id
-func (s *HelperServiceImpl) SingleQueryForID(db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error) {
+func (s *HelperServiceImpl) SingleQueryForID(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error) {
 	var id entity.ID
 
 	// Map the ID, if we can
-	err := s.SingleRowQuery(db, query, func(row Row) error {
+	err := s.SingleRowQuery(ctx, db, query, func(row Row) error {
 		return row.Scan(&id)
 	}, _type, args...)
 
@@ -134,9 +143,9 @@ func (s *HelperServiceImpl) SingleQueryForID(db *goSql.DB, query string, _type s
 	return id, nil
 }
 
-func (s *HelperServiceImpl) execForRowsAffected(db *goSql.DB, query string, args ...interface{}) (int64, error) {
+func (s *HelperServiceImpl) execForRowsAffected(ctx context.Context, db *goSql.DB, query string, args ...interface{}) (int64, error) {
 	// Perform the exec
-	res, err := s.exec(db, query, args...)
+	res, err := s.exec(ctx, db, query, args...)
 	if err != nil {
 		return -1, err
 	}
@@ -145,13 +154,13 @@ func (s *HelperServiceImpl) execForRowsAffected(db *goSql.DB, query string, args
 	return res.RowsAffected()
 }
 
-func (s *HelperServiceImpl) exec(db *goSql.DB, query string, args ...interface{}) (sql.Result, error) {
+func (s *HelperServiceImpl) exec(ctx context.Context, db *goSql.DB, query string, args ...interface{}) (sql.Result, error) {
 	// Prepare the exec
-	ctx := context.TODO()
-	stmt, err := db.PrepareContext(ctx, query)
+	stmt, err := preparerFor(ctx, db).PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	defer stmt.Close()
 
 	// Run the exec
 	res, err := stmt.ExecContext(ctx, args...)
@@ -160,3 +169,20 @@ func (s *HelperServiceImpl) exec(db *goSql.DB, query string, args ...interface{}
 	}
 	return res, nil
 }
+
+// preparerFor returns the transaction ctx is in, if any, else d.
+func preparerFor(ctx context.Context, d *goSql.DB) preparer {
+	if tx := txFrom(ctx); tx != nil {
+		return tx
+	}
+	return d
+}
+
+// idArray converts ids into an array parameter, e.g. for "id=ANY($1)".
+func idArray(ids []entity.ID) []int64 {
+	result := make([]int64, len(ids))
+	for i, id := range ids {
+		result[i] = int64(id)
+	}
+	return result
+}
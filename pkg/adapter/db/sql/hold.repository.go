@@ -0,0 +1,223 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseHold "github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// HoldRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type HoldRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.HoldConstructor
+}
+
+// Check we implement the interface
+var _ usecaseHold.Repository = &HoldRepositoryImpl{}
+
+// NewHoldRepositoryImpl is a constructor
+func NewHoldRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.HoldConstructor,
+) *HoldRepositoryImpl {
+	return &HoldRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *HoldRepositoryImpl) Create(ctx context.Context, e entity.Hold) (entity.ID, error) {
+	query := `
+	INSERT INTO hold
+		(
+			title_id, 
+			account_id, 
+			placed_at, 
+			status, 
+			inventory_item_id, 
+			expires_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "hold",
+		e.TitleID(),
+		e.AccountID(),
+		e.PlacedAt(),
+		string(e.Status()),
+		nullableID(e.ItemID()),
+		e.ExpiresAt(),
+	)
+}
+
+// FindByID finds a hold matching the given id
+func (s *HoldRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.Hold, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		id=$1;`
+	var result entity.Hold
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanHold(row)
+		result = res
+		return err
+	}, "hold", id)
+	return result, err
+}
+
+// Update persists new data for the status and assigned copy of the
+// given hold.
+func (s *HoldRepositoryImpl) Update(ctx context.Context, e entity.Hold) error {
+	query := `
+	UPDATE hold
+	SET
+		status=$1, inventory_item_id=$2, expires_at=$3
+	WHERE 
+		id=$4;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "hold",
+		string(e.Status()),
+		nullableID(e.ItemID()),
+		e.ExpiresAt(),
+		e.ID(),
+	)
+}
+
+// FindActiveByTitleID retrieves the holds on the title matching the
+// given id which are waiting or ready, earliest placed first.
+func (s *HoldRepositoryImpl) FindActiveByTitleID(ctx context.Context, titleID entity.ID) ([]entity.Hold, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		title_id=$1 AND status IN ('waiting', 'ready')
+	ORDER BY 
+		placed_at, id;`
+	return s.manyEntityQuery(ctx, query, titleID)
+}
+
+// FindNextWaitingByTitleID finds the earliest placed waiting hold on the
+// title matching the given id. If no one is waiting, nil is returned.
+func (s *HoldRepositoryImpl) FindNextWaitingByTitleID(ctx context.Context, titleID entity.ID) (entity.Hold, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		title_id=$1 AND status='waiting'
+	ORDER BY 
+		placed_at, id
+	LIMIT 1;`
+	results, err := s.manyEntityQuery(ctx, query, titleID)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// FindReadyByItemID finds the hold which the inventory item matching the
+// given id is put aside for. If it is not put aside, nil is returned.
+func (s *HoldRepositoryImpl) FindReadyByItemID(ctx context.Context, itemID entity.ID) (entity.Hold, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		inventory_item_id=$1 AND status='ready';`
+	results, err := s.manyEntityQuery(ctx, query, itemID)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func (s *HoldRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.Hold, error) {
+	var results []entity.Hold
+
+	// Run the query to get a row
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanHold(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "hold", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *HoldRepositoryImpl) scanHold(row Row) (entity.Hold, error) {
+	var id entity.ID
+	var titleID entity.ID
+	var accountID entity.ID
+	var placedAt time.Time
+	var status string
+	var itemID *entity.ID
+	var expiresAt *time.Time
+
+	// Extract data from the row
+	if err := row.Scan(&id, &titleID, &accountID, &placedAt, &status, &itemID, &expiresAt); err != nil {
+		return nil, err
+	}
+
+	if expiresAt != nil {
+		utc := expiresAt.UTC()
+		expiresAt = &utc
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, titleID, accountID, placedAt.UTC(), entity.HoldStatus(status), fromNullableID(itemID), expiresAt)
+	return result, nil
+}
+
+// nullableID stores InvalidID as NULL.
+func nullableID(id entity.ID) *entity.ID {
+	if id == entity.InvalidID {
+		return nil
+	}
+	return &id
+}
+
+// fromNullableID restores NULL as InvalidID.
+func fromNullableID(id *entity.ID) entity.ID {
+	if id == nil {
+		return entity.InvalidID
+	}
+	return *id
+}
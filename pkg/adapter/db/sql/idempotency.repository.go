@@ -0,0 +1,185 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+// IdempotencyRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type IdempotencyRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+}
+
+// Check we implement the interface
+var _ idempotency.Repository = &IdempotencyRepositoryImpl{}
+
+// NewIdempotencyRepositoryImpl is a constructor
+func NewIdempotencyRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+) *IdempotencyRepositoryImpl {
+	return &IdempotencyRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+	}
+}
+
+// Create persists a new record without a response, unless the client
+// has taken the key. Nothing is returned when it has, which is how we
+// tell.
+func (s *IdempotencyRepositoryImpl) Create(ctx context.Context, r *idempotency.Record) (bool, error) {
+	query := `
+	INSERT INTO idempotency_key
+		(
+			client,
+			key,
+			fingerprint,
+			created_at
+		)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (client, key) DO NOTHING
+	RETURNING key;`
+	var key string
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&key)
+	}, "idempotency key",
+		r.Client,
+		r.Key,
+		r.Fingerprint,
+		r.CreatedAt,
+	)
+
+	var notFound *db.NotFoundError
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FindByKey retrieves the record matching the given client and key. If
+// there is none, nil is returned.
+func (s *IdempotencyRepositoryImpl) FindByKey(ctx context.Context, client string, key string) (*idempotency.Record, error) {
+	query := `
+	SELECT
+		client,
+		key,
+		fingerprint,
+		status_code,
+		content_type,
+		body,
+		created_at
+	FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2;`
+	var r idempotency.Record
+	var statusCode *int
+	var contentType *string
+	var body []byte
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&r.Client, &r.Key, &r.Fingerprint, &statusCode, &contentType, &body, &r.CreatedAt)
+	}, "idempotency key", client, key)
+
+	var notFound *db.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is only stored once the request is finished
+	if statusCode != nil {
+		r.Response = &idempotency.ResponseVO{
+			StatusCode: *statusCode,
+			Body:       body,
+		}
+		if contentType != nil {
+			r.Response.ContentType = *contentType
+		}
+	}
+	r.CreatedAt = r.CreatedAt.UTC()
+	return &r, nil
+}
+
+// Finish stores the response of the record matching the given client
+// and key.
+func (s *IdempotencyRepositoryImpl) Finish(ctx context.Context, client string, key string, response *idempotency.ResponseVO) error {
+	query := `
+	UPDATE idempotency_key
+	SET
+		status_code=$1,
+		content_type=$2,
+		body=$3
+	WHERE
+		client=$4 AND key=$5;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "idempotency key",
+		response.StatusCode,
+		response.ContentType,
+		response.Body,
+		client,
+		key,
+	)
+}
+
+// DeleteByKey deletes the record matching the given client and key.
+func (s *IdempotencyRepositoryImpl) DeleteByKey(ctx context.Context, client string, key string) error {
+	query := `
+	DELETE FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "idempotency key", client, key)
+}
+
+// DeleteUnfinishedCreatedBefore deletes the record matching the given
+// client and key, if it has no response and was created before the
+// given time. Nothing is returned when it does not match, which is how
+// we tell.
+func (s *IdempotencyRepositoryImpl) DeleteUnfinishedCreatedBefore(ctx context.Context, client string, key string, before time.Time) (bool, error) {
+	query := `
+	DELETE FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2 AND status_code IS NULL AND created_at < $3
+	RETURNING key;`
+	var deleted string
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&deleted)
+	}, "idempotency key", client, key, before)
+
+	var notFound *db.NotFoundError
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteCreatedBefore deletes all records created before the given
+// time. Any number may be deleted, so the query counts them rather
+// than requiring exactly one.
+func (s *IdempotencyRepositoryImpl) DeleteCreatedBefore(ctx context.Context, before time.Time) error {
+	query := `
+	WITH deleted AS (
+		DELETE FROM idempotency_key
+		WHERE
+			created_at < $1
+		RETURNING key
+	)
+	SELECT
+		COUNT(*)
+	FROM deleted;`
+	var count int
+	return s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&count)
+	}, "idempotency key", before)
+}
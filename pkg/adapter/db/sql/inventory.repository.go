@@ -1,6 +1,8 @@
 package sql
 
 import (
+	"context"
+
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	usecaseInventory "github.com/liampulles/matchstick-video/pkg/usecase/inventory"
 )
@@ -30,82 +32,211 @@ func NewInventoryRepositoryImpl(
 }
 
 // FindByID finds an inventory item matching the given id
-func (s *InventoryRepositoryImpl) FindByID(id entity.ID) (entity.InventoryItem, error) {
+func (s *InventoryRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.InventoryItem, error) {
 	query := `
 	SELECT 
 		id, 
-		name, 
-		location, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
 		available 
 	FROM inventory_item
 	WHERE 
 		id=$1;`
-	return s.singleEntityQuery(query, id)
+	return s.singleEntityQuery(ctx, query, id)
+}
+
+// FindByIDs retrieves the inventory items matching any of the given
+// ids, ordered by id
+func (s *InventoryRepositoryImpl) FindByIDs(ctx context.Context, ids []entity.ID) ([]entity.InventoryItem, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		id=ANY($1)
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query, idArray(ids))
+}
+
+// FindByBarcode finds the inventory item with the given barcode
+func (s *InventoryRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (entity.InventoryItem, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		barcode=$1;`
+	return s.singleEntityQuery(ctx, query, barcode)
 }
 
 // FindAll retrieves all the inventory items in the database
-func (s *InventoryRepositoryImpl) FindAll() ([]entity.InventoryItem, error) {
+func (s *InventoryRepositoryImpl) FindAll(ctx context.Context) ([]entity.InventoryItem, error) {
 	query := `
 	SELECT 
 		id, 
-		name, 
-		location, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
 		available 
 	FROM inventory_item;`
-	return s.manyEntityQuery(query)
+	return s.manyEntityQuery(ctx, query)
+}
+
+// FindAllOfFormat retrieves all the inventory items of the given format
+func (s *InventoryRepositoryImpl) FindAllOfFormat(ctx context.Context, format entity.Format) ([]entity.InventoryItem, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		format=$1;`
+	return s.manyEntityQuery(ctx, query, format)
+}
+
+// FindAllOfTitles retrieves all the inventory items of any of the given
+// titles, ordered by id
+func (s *InventoryRepositoryImpl) FindAllOfTitles(ctx context.Context, titleIDs []entity.ID) ([]entity.InventoryItem, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		title_id=ANY($1)
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query, idArray(titleIDs))
+}
+
+// FindAllOnShelf retrieves all the inventory items kept on the given
+// shelf, ordered by slot.
+func (s *InventoryRepositoryImpl) FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.InventoryItem, error) {
+	query := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		location_store=$1 AND location_aisle=$2 AND location_shelf=$3
+	ORDER BY 
+		location_slot, id;`
+	return s.manyEntityQuery(ctx, query, store, aisle, shelf)
 }
 
 // Create persists a new entity. The ID is ignored in the input entity, and the
 // generated id is then returned.
-func (s *InventoryRepositoryImpl) Create(e entity.InventoryItem) (entity.ID, error) {
+func (s *InventoryRepositoryImpl) Create(ctx context.Context, e entity.InventoryItem) (entity.ID, error) {
 	query := `
 	INSERT INTO inventory_item
 		(
-			name, 
-			location, 
+			title_id, 
+			format, 
+			barcode, 
+			location_store, 
+			location_aisle, 
+			location_shelf, 
+			location_slot, 
 			available
 		)
-	VALUES ($1, $2, $3)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	RETURNING id;`
-	return s.helperService.SingleQueryForID(s.dbService.Get(), query, "inventory item",
-		e.Name(),
-		e.Location(),
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "inventory item",
+		e.TitleID(),
+		e.Format(),
+		e.Barcode(),
+		e.Location().Store,
+		e.Location().Aisle,
+		e.Location().Shelf,
+		e.Location().Slot,
 		e.IsAvailable(),
 	)
 }
 
 // DeleteByID deletes the inventory id matching the id. If there
 // isn't an entry corresponding to the id - an error is returned.
-func (s *InventoryRepositoryImpl) DeleteByID(id entity.ID) error {
+func (s *InventoryRepositoryImpl) DeleteByID(ctx context.Context, id entity.ID) error {
 	query := `
 	DELETE FROM inventory_item
 	WHERE 
 		id=$1;`
-	return s.helperService.ExecForSingleItem(s.dbService.Get(), query, id)
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "inventory item", id)
 }
 
 // Update persists new data for all fields in the given inventory item,
 // excluding the id.
-func (s *InventoryRepositoryImpl) Update(e entity.InventoryItem) error {
+func (s *InventoryRepositoryImpl) Update(ctx context.Context, e entity.InventoryItem) error {
 	query := `
 	UPDATE inventory_item
 	SET
-		name=$1, location=$2, available=$3
+		title_id=$1, format=$2, barcode=$3, location_store=$4, location_aisle=$5, location_shelf=$6, location_slot=$7, available=$8
 	WHERE 
-		id=$4;`
-	return s.helperService.ExecForSingleItem(s.dbService.Get(), query,
-		e.Name(),
-		e.Location(),
+		id=$9;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "inventory item",
+		e.TitleID(),
+		e.Format(),
+		e.Barcode(),
+		e.Location().Store,
+		e.Location().Aisle,
+		e.Location().Shelf,
+		e.Location().Slot,
 		e.IsAvailable(),
 		e.ID(),
 	)
 }
 
-func (s *InventoryRepositoryImpl) singleEntityQuery(query string, args ...interface{}) (entity.InventoryItem, error) {
+func (s *InventoryRepositoryImpl) singleEntityQuery(ctx context.Context, query string, args ...interface{}) (entity.InventoryItem, error) {
 	var result entity.InventoryItem
 
 	// Run the query to get a row
-	err := s.helperService.SingleRowQuery(s.dbService.Get(), query, func(row Row) error {
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
 		res, err := s.scanInventoryItem(row)
 		result = res
 		return err
@@ -114,11 +245,11 @@ func (s *InventoryRepositoryImpl) singleEntityQuery(query string, args ...interf
 	return result, err
 }
 
-func (s *InventoryRepositoryImpl) manyEntityQuery(query string, args ...interface{}) ([]entity.InventoryItem, error) {
+func (s *InventoryRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.InventoryItem, error) {
 	var results []entity.InventoryItem
 
 	// Run the query to get a row
-	err := s.helperService.ManyRowsQuery(s.dbService.Get(), query, func(row Row) error {
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
 		res, err := s.scanInventoryItem(row)
 		if res != nil {
 			results = append(results, res)
@@ -134,16 +265,19 @@ func (s *InventoryRepositoryImpl) manyEntityQuery(query string, args ...interfac
 
 func (s *InventoryRepositoryImpl) scanInventoryItem(row Row) (entity.InventoryItem, error) {
 	var id entity.ID
-	var name string
-	var location string
+	var titleID entity.ID
+	var format entity.Format
+	var barcode string
+	var location entity.Location
 	var available bool
 
 	// Extract data from the row
-	if err := row.Scan(&id, &name, &location, &available); err != nil {
+	if err := row.Scan(&id, &titleID, &format, &barcode,
+		&location.Store, &location.Aisle, &location.Shelf, &location.Slot, &available); err != nil {
 		return nil, err
 	}
 
 	// Restore the entity from the extracted data (bypassing validations).
-	result := s.constructor.Reincarnate(id, name, location, available)
+	result := s.constructor.Reincarnate(id, titleID, format, barcode, location, available)
 	return result, nil
 }
@@ -0,0 +1,146 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseLedger "github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// LedgerRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type LedgerRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.LedgerEntryConstructor
+}
+
+// Check we implement the interface
+var _ usecaseLedger.Repository = &LedgerRepositoryImpl{}
+
+// NewLedgerRepositoryImpl is a constructor
+func NewLedgerRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.LedgerEntryConstructor,
+) *LedgerRepositoryImpl {
+	return &LedgerRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *LedgerRepositoryImpl) Create(ctx context.Context, e entity.LedgerEntry) (entity.ID, error) {
+	query := `
+	INSERT INTO ledger_entry
+		(
+			account_id, 
+			kind, 
+			amount, 
+			method, 
+			rental_id, 
+			note, 
+			recorded_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "ledger entry",
+		e.AccountID(),
+		string(e.Kind()),
+		e.Amount(),
+		nullableMethod(e.Method()),
+		nullableID(e.RentalID()),
+		e.Note(),
+		e.RecordedAt(),
+	)
+}
+
+// FindByAccountID retrieves the entries recorded against the account
+// matching the given id, earliest first.
+func (s *LedgerRepositoryImpl) FindByAccountID(ctx context.Context, accountID entity.ID) ([]entity.LedgerEntry, error) {
+	query := `
+	SELECT 
+		id, 
+		account_id, 
+		kind, 
+		amount, 
+		method, 
+		rental_id, 
+		note, 
+		recorded_at 
+	FROM ledger_entry
+	WHERE 
+		account_id=$1
+	ORDER BY 
+		recorded_at, id;`
+	var results []entity.LedgerEntry
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanLedgerEntry(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "ledger entry", accountID)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// BalanceByAccountID totals what the account matching the given
+// id owes. Accounts with no entries owe nothing.
+func (s *LedgerRepositoryImpl) BalanceByAccountID(ctx context.Context, accountID entity.ID) (entity.Money, error) {
+	query := `
+	SELECT 
+		COALESCE(SUM(balance), 0) 
+	FROM account_balance
+	WHERE 
+		account_id=$1;`
+	var result entity.Money
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&result)
+	}, "account balance", accountID)
+	return result, err
+}
+
+func (s *LedgerRepositoryImpl) scanLedgerEntry(row Row) (entity.LedgerEntry, error) {
+	var id entity.ID
+	var accountID entity.ID
+	var kind string
+	var amount entity.Money
+	var method *string
+	var rentalID *entity.ID
+	var note string
+	var recordedAt time.Time
+
+	// Extract data from the row
+	if err := row.Scan(&id, &accountID, &kind, &amount, &method, &rentalID, &note, &recordedAt); err != nil {
+		return nil, err
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, accountID, entity.LedgerEntryKind(kind), amount,
+		fromNullableMethod(method), fromNullableID(rentalID), note, recordedAt.UTC())
+	return result, nil
+}
+
+// nullableMethod stores no payment method as NULL.
+func nullableMethod(method entity.PaymentMethod) *string {
+	if method == "" {
+		return nil
+	}
+	value := string(method)
+	return &value
+}
+
+// fromNullableMethod restores NULL as no payment method.
+func fromNullableMethod(method *string) entity.PaymentMethod {
+	if method == nil {
+		return ""
+	}
+	return entity.PaymentMethod(*method)
+}
@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseInventory "github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// LocationMoveRepositoryImpl implements MoveRepository to make use
+// of SQL databases which have an associated driver.
+type LocationMoveRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+}
+
+// Check we implement the interface
+var _ usecaseInventory.MoveRepository = &LocationMoveRepositoryImpl{}
+
+// NewLocationMoveRepositoryImpl is a constructor
+func NewLocationMoveRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+) *LocationMoveRepositoryImpl {
+	return &LocationMoveRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+	}
+}
+
+// Create persists a new move, and returns the generated id.
+func (s *LocationMoveRepositoryImpl) Create(ctx context.Context, m entity.LocationMove) (entity.ID, error) {
+	query := `
+	INSERT INTO location_move
+		(
+			inventory_item_id, 
+			from_store, 
+			from_aisle, 
+			from_shelf, 
+			from_slot, 
+			to_store, 
+			to_aisle, 
+			to_shelf, 
+			to_slot, 
+			moved_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "location move",
+		m.ItemID,
+		m.From.Store,
+		m.From.Aisle,
+		m.From.Shelf,
+		m.From.Slot,
+		m.To.Store,
+		m.To.Aisle,
+		m.To.Shelf,
+		m.To.Slot,
+		m.MovedAt,
+	)
+}
+
+// FindByItemID retrieves the moves of the inventory item matching the
+// given id, earliest first.
+func (s *LocationMoveRepositoryImpl) FindByItemID(ctx context.Context, itemID entity.ID) ([]entity.LocationMove, error) {
+	query := `
+	SELECT 
+		inventory_item_id, 
+		from_store, 
+		from_aisle, 
+		from_shelf, 
+		from_slot, 
+		to_store, 
+		to_aisle, 
+		to_shelf, 
+		to_slot, 
+		moved_at 
+	FROM location_move
+	WHERE 
+		inventory_item_id=$1
+	ORDER BY 
+		moved_at, id;`
+	var results []entity.LocationMove
+
+	// Run the query to get a row
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		var m entity.LocationMove
+		if err := row.Scan(&m.ItemID,
+			&m.From.Store, &m.From.Aisle, &m.From.Shelf, &m.From.Slot,
+			&m.To.Store, &m.To.Aisle, &m.To.Shelf, &m.To.Slot,
+			&m.MovedAt); err != nil {
+			return err
+		}
+		m.MovedAt = m.MovedAt.UTC()
+		results = append(results, m)
+		return nil
+	}, "location move", itemID)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseLocation "github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// LocationRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type LocationRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+}
+
+// Check we implement the interface
+var _ usecaseLocation.Repository = &LocationRepositoryImpl{}
+
+// NewLocationRepositoryImpl is a constructor
+func NewLocationRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+) *LocationRepositoryImpl {
+	return &LocationRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+	}
+}
+
+// Create persists a new location.
+func (s *LocationRepositoryImpl) Create(ctx context.Context, l entity.Location) error {
+	query := `
+	INSERT INTO location
+		(
+			store, 
+			aisle, 
+			shelf, 
+			slot
+		)
+	VALUES ($1, $2, $3, $4);`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "location",
+		l.Store,
+		l.Aisle,
+		l.Shelf,
+		l.Slot,
+	)
+}
+
+// Exists returns true if the given location has been persisted.
+func (s *LocationRepositoryImpl) Exists(ctx context.Context, l entity.Location) (bool, error) {
+	query := `
+	SELECT 
+		EXISTS (
+			SELECT 1 
+			FROM location 
+			WHERE 
+				store=$1 AND aisle=$2 AND shelf=$3 AND slot=$4
+		);`
+	var result bool
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&result)
+	}, "location", l.Store, l.Aisle, l.Shelf, l.Slot)
+	return result, err
+}
+
+// FindAll retrieves all the locations in the database
+func (s *LocationRepositoryImpl) FindAll(ctx context.Context) ([]entity.Location, error) {
+	query := `
+	SELECT 
+		store, 
+		aisle, 
+		shelf, 
+		slot 
+	FROM location
+	ORDER BY 
+		store, aisle, shelf, slot;`
+	return s.manyLocationQuery(ctx, query)
+}
+
+// FindAllOnShelf retrieves the locations of the given shelf, ordered
+// by slot.
+func (s *LocationRepositoryImpl) FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.Location, error) {
+	query := `
+	SELECT 
+		store, 
+		aisle, 
+		shelf, 
+		slot 
+	FROM location
+	WHERE 
+		store=$1 AND aisle=$2 AND shelf=$3
+	ORDER BY 
+		slot;`
+	return s.manyLocationQuery(ctx, query, store, aisle, shelf)
+}
+
+// Delete deletes the given location. If it has not been persisted,
+// an error is returned.
+func (s *LocationRepositoryImpl) Delete(ctx context.Context, l entity.Location) error {
+	query := `
+	DELETE FROM location
+	WHERE 
+		store=$1 AND aisle=$2 AND shelf=$3 AND slot=$4;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "location",
+		l.Store,
+		l.Aisle,
+		l.Shelf,
+		l.Slot,
+	)
+}
+
+func (s *LocationRepositoryImpl) manyLocationQuery(ctx context.Context, query string, args ...interface{}) ([]entity.Location, error) {
+	var results []entity.Location
+
+	// Run the query to get a row
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		var l entity.Location
+		if err := row.Scan(&l.Store, &l.Aisle, &l.Shelf, &l.Slot); err != nil {
+			return err
+		}
+		results = append(results, l)
+		return nil
+	}, "location", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
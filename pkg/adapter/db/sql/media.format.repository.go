@@ -0,0 +1,152 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseMediaFormat "github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MediaFormatRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type MediaFormatRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.MediaFormatConstructor
+}
+
+// Check we implement the interface
+var _ usecaseMediaFormat.Repository = &MediaFormatRepositoryImpl{}
+
+// NewMediaFormatRepositoryImpl is a constructor
+func NewMediaFormatRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.MediaFormatConstructor,
+) *MediaFormatRepositoryImpl {
+	return &MediaFormatRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// FindByFormat finds the catalogue entry of the given format
+func (s *MediaFormatRepositoryImpl) FindByFormat(ctx context.Context, format entity.Format) (entity.MediaFormat, error) {
+	query := `
+	SELECT 
+		format, 
+		name, 
+		rental_price, 
+		rental_period_days 
+	FROM media_format
+	WHERE 
+		format=$1;`
+	var result entity.MediaFormat
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanMediaFormat(row)
+		result = res
+		return err
+	}, "media format", format)
+	return result, err
+}
+
+// FindAll retrieves the whole catalogue, oldest format first
+func (s *MediaFormatRepositoryImpl) FindAll(ctx context.Context) ([]entity.MediaFormat, error) {
+	query := `
+	SELECT 
+		format, 
+		name, 
+		rental_price, 
+		rental_period_days 
+	FROM media_format
+	ORDER BY 
+		sort_order;`
+	var results []entity.MediaFormat
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanMediaFormat(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "media format")
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Update persists new data for all fields in the given catalogue
+// entry, excluding the format.
+func (s *MediaFormatRepositoryImpl) Update(ctx context.Context, e entity.MediaFormat) error {
+	query := `
+	UPDATE media_format
+	SET
+		name=$1, rental_price=$2, rental_period_days=$3
+	WHERE 
+		format=$4;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "media format",
+		e.Name(),
+		e.RentalPrice(),
+		e.RentalPeriodDays(),
+		e.Format(),
+	)
+}
+
+// FindStockByFormat counts the copies of the given format
+func (s *MediaFormatRepositoryImpl) FindStockByFormat(ctx context.Context, format entity.Format) (usecaseMediaFormat.Stock, error) {
+	query := `
+	SELECT 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available) 
+	FROM inventory_item
+	WHERE 
+		format=$1;`
+	var result usecaseMediaFormat.Stock
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&result.Copies, &result.Available)
+	}, "media format stock", format)
+	return result, err
+}
+
+// FindAllStock counts the copies of every format which has at least one copy
+func (s *MediaFormatRepositoryImpl) FindAllStock(ctx context.Context) (map[entity.Format]usecaseMediaFormat.Stock, error) {
+	query := `
+	SELECT 
+		format, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available) 
+	FROM inventory_item
+	GROUP BY 
+		format;`
+	results := make(map[entity.Format]usecaseMediaFormat.Stock)
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		var format entity.Format
+		var stock usecaseMediaFormat.Stock
+		if err := row.Scan(&format, &stock.Copies, &stock.Available); err != nil {
+			return err
+		}
+		results[format] = stock
+		return nil
+	}, "media format stock")
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *MediaFormatRepositoryImpl) scanMediaFormat(row Row) (entity.MediaFormat, error) {
+	var format entity.Format
+	var name string
+	var rentalPrice entity.Money
+	var rentalPeriodDays int
+
+	// Extract data from the row
+	if err := row.Scan(&format, &name, &rentalPrice, &rentalPeriodDays); err != nil {
+		return nil, err
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(format, name, rentalPrice, rentalPeriodDays)
+	return result, nil
+}
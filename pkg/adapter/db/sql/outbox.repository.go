@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// OutboxRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type OutboxRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+}
+
+// Check we implement the interface
+var _ outbox.Repository = &OutboxRepositoryImpl{}
+
+// NewOutboxRepositoryImpl is a constructor
+func NewOutboxRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+) *OutboxRepositoryImpl {
+	return &OutboxRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+	}
+}
+
+// Create persists a new message, and returns the generated id.
+func (s *OutboxRepositoryImpl) Create(ctx context.Context, m outbox.Message) (entity.ID, error) {
+	data, err := json.Marshal(m.Data)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create outbox message - marshal error: %w", err)
+	}
+
+	query := `
+	INSERT INTO outbox_message
+		(
+			entity_id, 
+			type, 
+			data, 
+			occurred_at
+		)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "outbox message",
+		m.EntityID,
+		string(m.Type),
+		data,
+		m.OccurredAt,
+	)
+}
+
+// FindUnpublished retrieves at most limit unpublished messages, earliest
+// first. The rows are locked for the rest of the transaction, and rows
+// locked by other transactions are skipped.
+func (s *OutboxRepositoryImpl) FindUnpublished(ctx context.Context, limit int) ([]outbox.Message, error) {
+	query := `
+	SELECT 
+		id, 
+		entity_id, 
+		type, 
+		data, 
+		occurred_at 
+	FROM outbox_message
+	WHERE 
+		published_at IS NULL
+	ORDER BY 
+		id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED;`
+	return s.findMany(ctx, query, limit)
+}
+
+// MarkPublished records the time the message matching the given id
+// was published.
+func (s *OutboxRepositoryImpl) MarkPublished(ctx context.Context, id entity.ID, at time.Time) error {
+	query := `
+	UPDATE outbox_message
+	SET 
+		published_at=$1
+	WHERE 
+		id=$2;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "outbox message",
+		at,
+		id,
+	)
+}
+
+// FindAfter retrieves at most limit messages with an id greater than
+// after, whether published or not, earliest first.
+func (s *OutboxRepositoryImpl) FindAfter(ctx context.Context, after entity.ID, limit int) ([]outbox.Message, error) {
+	query := `
+	SELECT 
+		id, 
+		entity_id, 
+		type, 
+		data, 
+		occurred_at 
+	FROM outbox_message
+	WHERE 
+		id > $1
+	ORDER BY 
+		id
+	LIMIT $2;`
+	return s.findMany(ctx, query, after, limit)
+}
+
+// FindLatestID retrieves the greatest message id, or 0 if there
+// are no messages.
+func (s *OutboxRepositoryImpl) FindLatestID(ctx context.Context) (entity.ID, error) {
+	query := `
+	SELECT 
+		COALESCE(MAX(id), 0) 
+	FROM outbox_message;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "outbox message")
+}
+
+func (s *OutboxRepositoryImpl) findMany(ctx context.Context, query string, args ...interface{}) ([]outbox.Message, error) {
+	var results []outbox.Message
+
+	// Run the query to get rows
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		var m outbox.Message
+		var eventType string
+		var data []byte
+		if err := row.Scan(&m.ID, &m.EntityID, &eventType, &data, &m.OccurredAt); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &m.Data); err != nil {
+			return err
+		}
+		m.Type = entity.EventType(eventType)
+		m.OccurredAt = m.OccurredAt.UTC()
+		results = append(results, m)
+		return nil
+	}, "outbox message", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,239 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseRental "github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// RentalRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type RentalRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.RentalConstructor
+}
+
+// Check we implement the interface
+var _ usecaseRental.Repository = &RentalRepositoryImpl{}
+
+// NewRentalRepositoryImpl is a constructor
+func NewRentalRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.RentalConstructor,
+) *RentalRepositoryImpl {
+	return &RentalRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *RentalRepositoryImpl) Create(ctx context.Context, e entity.Rental) (entity.ID, error) {
+	query := `
+	INSERT INTO rental
+		(
+			inventory_item_id, 
+			account_id, 
+			checked_out_at, 
+			due_at, 
+			returned_at, 
+			late_fee, 
+			renewals
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "rental",
+		e.ItemID(),
+		e.AccountID(),
+		e.CheckedOutAt(),
+		e.DueAt(),
+		e.ReturnedAt(),
+		e.LateFee(),
+		e.Renewals(),
+	)
+}
+
+// Update persists new data for the dates, fee and renewals of the
+// given rental. A rental which has already been returned is not
+// changed - e.g. if it was checked in concurrently - and a conflict
+// error is returned instead.
+func (s *RentalRepositoryImpl) Update(ctx context.Context, e entity.Rental) error {
+	query := `
+	UPDATE rental
+	SET
+		due_at=$1, returned_at=$2, late_fee=$3, renewals=$4
+	WHERE 
+		id=$5 AND returned_at IS NULL;`
+	err := s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "rental",
+		e.DueAt(),
+		e.ReturnedAt(),
+		e.LateFee(),
+		e.Renewals(),
+		e.ID(),
+	)
+
+	var notFound *db.NotFoundError
+	if errors.As(err, &notFound) {
+		return commonerror.NewConflict("rental", "it has already been returned")
+	}
+	return err
+}
+
+// FindActiveByItemID finds the outstanding rental of the inventory item
+// matching the given id. If the item is not rented out, nil is returned.
+func (s *RentalRepositoryImpl) FindActiveByItemID(ctx context.Context, itemID entity.ID) (entity.Rental, error) {
+	query := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=$1 AND returned_at IS NULL;`
+	results, err := s.manyEntityQuery(ctx, query, itemID)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// FindActiveByItemIDForUpdate finds the outstanding rental of the
+// inventory item matching the given id, and locks it until the
+// transaction ends. A rental which is returned while waiting for the
+// lock is not found. If the item is not rented out, nil is returned.
+func (s *RentalRepositoryImpl) FindActiveByItemIDForUpdate(ctx context.Context, itemID entity.ID) (entity.Rental, error) {
+	query := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=$1 AND returned_at IS NULL
+	FOR UPDATE;`
+	results, err := s.manyEntityQuery(ctx, query, itemID)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// FindActiveByAccountID retrieves the outstanding rentals of the account
+// matching the given id, earliest due first.
+func (s *RentalRepositoryImpl) FindActiveByAccountID(ctx context.Context, accountID entity.ID) ([]entity.Rental, error) {
+	query := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		account_id=$1 AND returned_at IS NULL
+	ORDER BY 
+		due_at, id;`
+	return s.manyEntityQuery(ctx, query, accountID)
+}
+
+// FindActiveByItemIDs retrieves the outstanding rentals of the inventory
+// items matching any of the given ids, ordered by id
+func (s *RentalRepositoryImpl) FindActiveByItemIDs(ctx context.Context, itemIDs []entity.ID) ([]entity.Rental, error) {
+	query := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=ANY($1) AND returned_at IS NULL
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query, idArray(itemIDs))
+}
+
+// FindOverdue retrieves the outstanding rentals which were due before
+// now, earliest due first.
+func (s *RentalRepositoryImpl) FindOverdue(ctx context.Context, now time.Time) ([]entity.Rental, error) {
+	query := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		returned_at IS NULL AND due_at < $1
+	ORDER BY 
+		due_at, id;`
+	return s.manyEntityQuery(ctx, query, now)
+}
+
+func (s *RentalRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.Rental, error) {
+	var results []entity.Rental
+
+	// Run the query to get a row
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanRental(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "rental", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *RentalRepositoryImpl) scanRental(row Row) (entity.Rental, error) {
+	var id entity.ID
+	var itemID entity.ID
+	var accountID entity.ID
+	var checkedOutAt time.Time
+	var dueAt time.Time
+	var returnedAt *time.Time
+	var lateFee entity.Money
+	var renewals int
+
+	// Extract data from the row
+	if err := row.Scan(&id, &itemID, &accountID, &checkedOutAt, &dueAt, &returnedAt, &lateFee, &renewals); err != nil {
+		return nil, err
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, itemID, accountID, checkedOutAt.UTC(), dueAt.UTC(), returnedAt, lateFee, renewals)
+	return result, nil
+}
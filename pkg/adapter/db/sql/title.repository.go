@@ -0,0 +1,289 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseTitle "github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// TitleRepositoryImpl implements Repository to make use
+// of SQL databases which have an associated driver.
+type TitleRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.TitleConstructor
+}
+
+// Check we implement the interface
+var _ usecaseTitle.Repository = &TitleRepositoryImpl{}
+
+// NewTitleRepositoryImpl is a constructor
+func NewTitleRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.TitleConstructor,
+) *TitleRepositoryImpl {
+	return &TitleRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// FindByID finds a title matching the given id
+func (s *TitleRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.Title, error) {
+	query := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	WHERE 
+		id=$1;`
+	var result entity.Title
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanTitle(row)
+		result = res
+		return err
+	}, "title", id)
+	return result, err
+}
+
+// FindAll retrieves all the titles in the database, ordered by name
+func (s *TitleRepositoryImpl) FindAll(ctx context.Context) ([]entity.Title, error) {
+	query := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	ORDER BY 
+		name, year;`
+	return s.manyEntityQuery(ctx, query)
+}
+
+// FindByIDs retrieves the titles matching any of the given ids,
+// ordered by id
+func (s *TitleRepositoryImpl) FindByIDs(ctx context.Context, ids []entity.ID) ([]entity.Title, error) {
+	query := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	WHERE 
+		id=ANY($1)
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query, idArray(ids))
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *TitleRepositoryImpl) Create(ctx context.Context, e entity.Title) (entity.ID, error) {
+	query := `
+	INSERT INTO title
+		(
+			name, 
+			year, 
+			runtime, 
+			synopsis, 
+			genres, 
+			cast_members, 
+			rating
+		)
+	VALUES ($1, $2, $3, $4, $5::jsonb, $6::jsonb, $7)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "title",
+		e.Name(),
+		e.Year(),
+		e.Runtime(),
+		e.Synopsis(),
+		encodeStrings(e.Genres()),
+		encodeStrings(e.Cast()),
+		e.Rating(),
+	)
+}
+
+// DeleteByID deletes the title matching the id. If there
+// isn't an entry corresponding to the id - an error is returned.
+func (s *TitleRepositoryImpl) DeleteByID(ctx context.Context, id entity.ID) error {
+	query := `
+	DELETE FROM title
+	WHERE 
+		id=$1;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "title", id)
+}
+
+// Update persists new data for all fields in the given title,
+// excluding the id.
+func (s *TitleRepositoryImpl) Update(ctx context.Context, e entity.Title) error {
+	query := `
+	UPDATE title
+	SET
+		name=$1, year=$2, runtime=$3, synopsis=$4, genres=$5::jsonb, cast_members=$6::jsonb, rating=$7
+	WHERE 
+		id=$8;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "title",
+		e.Name(),
+		e.Year(),
+		e.Runtime(),
+		e.Synopsis(),
+		encodeStrings(e.Genres()),
+		encodeStrings(e.Cast()),
+		e.Rating(),
+		e.ID(),
+	)
+}
+
+// FindStockByID counts the copies of the title matching the given id.
+// Copies put aside for a hold are not available, until the hold expires.
+func (s *TitleRepositoryImpl) FindStockByID(ctx context.Context, id entity.ID) (usecaseTitle.Stock, error) {
+	query := `
+	SELECT 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	WHERE 
+		title_id=$1;`
+	var result usecaseTitle.Stock
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		return row.Scan(&result.Copies, &result.Available)
+	}, "title stock", id)
+	return result, err
+}
+
+// FindAllStock counts the copies of every title which has at least one copy
+func (s *TitleRepositoryImpl) FindAllStock(ctx context.Context) (map[entity.ID]usecaseTitle.Stock, error) {
+	query := `
+	SELECT 
+		title_id, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	GROUP BY 
+		title_id;`
+	return s.manyStockQuery(ctx, query)
+}
+
+// FindStockByIDs counts the copies of the titles with any of the given
+// ids. Titles without copies are left out.
+func (s *TitleRepositoryImpl) FindStockByIDs(ctx context.Context, ids []entity.ID) (map[entity.ID]usecaseTitle.Stock, error) {
+	query := `
+	SELECT 
+		title_id, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	WHERE 
+		title_id=ANY($1)
+	GROUP BY 
+		title_id;`
+	return s.manyStockQuery(ctx, query, idArray(ids))
+}
+
+func (s *TitleRepositoryImpl) manyStockQuery(ctx context.Context, query string, args ...interface{}) (map[entity.ID]usecaseTitle.Stock, error) {
+	results := make(map[entity.ID]usecaseTitle.Stock)
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		var id entity.ID
+		var stock usecaseTitle.Stock
+		if err := row.Scan(&id, &stock.Copies, &stock.Available); err != nil {
+			return err
+		}
+		results[id] = stock
+		return nil
+	}, "title stock", args...)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *TitleRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.Title, error) {
+	var results []entity.Title
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanTitle(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "title", args...)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *TitleRepositoryImpl) scanTitle(row Row) (entity.Title, error) {
+	var id entity.ID
+	var name string
+	var year int
+	var runtime int
+	var synopsis string
+	var genres string
+	var cast string
+	var rating string
+
+	// Extract data from the row
+	if err := row.Scan(&id, &name, &year, &runtime, &synopsis, &genres, &cast, &rating); err != nil {
+		return nil, err
+	}
+	decodedGenres, err := decodeStrings(genres)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode genres: %w", err)
+	}
+	decodedCast, err := decodeStrings(cast)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode cast: %w", err)
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, name, year, runtime, synopsis, decodedGenres, decodedCast, rating)
+	return result, nil
+}
+
+// encodeStrings converts values to a JSON array, for storing in a
+// jsonb column.
+func encodeStrings(values []string) string {
+	if values == nil {
+		values = []string{}
+	}
+	// Marshalling a string slice cannot fail
+	bytes, _ := json.Marshal(values)
+	return string(bytes)
+}
+
+func decodeStrings(str string) ([]string, error) {
+	var result []string
+	if err := json.Unmarshal([]byte(str), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package sql
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// txKey is the context key of the transaction work is running in.
+type txKey struct{}
+
+// TransactorImpl implements domain.Transactor with SQL transactions.
+// HelperService runs its queries in the transaction of the context it
+// is given, if there is one.
+type TransactorImpl struct {
+	dbService DatabaseService
+}
+
+// Check we implement the interface
+var _ domain.Transactor = &TransactorImpl{}
+
+// NewTransactorImpl is a constructor
+func NewTransactorImpl(dbService DatabaseService) *TransactorImpl {
+	return &TransactorImpl{
+		dbService: dbService,
+	}
+}
+
+// InTransaction runs work in a new transaction, which is committed if
+// the work succeeds and rolled back if it fails. If ctx is already in a
+// transaction, work simply joins it.
+func (t *TransactorImpl) InTransaction(ctx context.Context, work func(ctx context.Context) error) error {
+	if txFrom(ctx) != nil {
+		return work(ctx)
+	}
+
+	// Begin
+	tx, err := t.dbService.Get().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not run transaction - db begin error: %w", err)
+	}
+
+	// Do the work
+	if err := work(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("could not run transaction - db rollback error: %v, after: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Commit
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not run transaction - db commit error: %w", err)
+	}
+	return nil
+}
+
+// txFrom returns the transaction ctx is in, or nil if it is in none.
+func txFrom(ctx context.Context) *goSql.Tx {
+	tx, _ := ctx.Value(txKey{}).(*goSql.Tx)
+	return tx
+}
@@ -0,0 +1,245 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// webhookDeliveryColumns are selected by each query for deliveries,
+// in the order scanWebhookDelivery expects.
+const webhookDeliveryColumns = `
+		id, 
+		subscription_id, 
+		event_id, 
+		event_type, 
+		entity_id, 
+		data, 
+		occurred_at, 
+		status, 
+		attempts, 
+		next_attempt_at, 
+		last_attempt_at, 
+		last_status_code, 
+		last_error `
+
+// WebhookDeliveryRepositoryImpl implements DeliveryRepository to make
+// use of SQL databases which have an associated driver.
+type WebhookDeliveryRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.WebhookDeliveryConstructor
+}
+
+// Check we implement the interface
+var _ webhook.DeliveryRepository = &WebhookDeliveryRepositoryImpl{}
+
+// NewWebhookDeliveryRepositoryImpl is a constructor
+func NewWebhookDeliveryRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.WebhookDeliveryConstructor,
+) *WebhookDeliveryRepositoryImpl {
+	return &WebhookDeliveryRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned. If the event was already queued for the
+// subscription, then the existing delivery is left as is and its id is
+// returned.
+func (s *WebhookDeliveryRepositoryImpl) Create(ctx context.Context, e entity.WebhookDelivery) (entity.ID, error) {
+	data, err := json.Marshal(e.Data())
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create webhook delivery - marshal error: %w", err)
+	}
+
+	query := `
+	INSERT INTO webhook_delivery
+		(
+			subscription_id, 
+			event_id, 
+			event_type, 
+			entity_id, 
+			data, 
+			occurred_at, 
+			status, 
+			attempts, 
+			next_attempt_at, 
+			last_attempt_at, 
+			last_status_code, 
+			last_error
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT (subscription_id, event_id) DO UPDATE
+	SET 
+		subscription_id=EXCLUDED.subscription_id
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "webhook delivery",
+		e.SubscriptionID(),
+		e.EventID(),
+		string(e.EventType()),
+		e.EntityID(),
+		data,
+		e.OccurredAt(),
+		string(e.Status()),
+		e.Attempts(),
+		e.NextAttemptAt(),
+		e.LastAttemptAt(),
+		e.LastStatusCode(),
+		e.LastError(),
+	)
+}
+
+// FindByID finds a webhook delivery matching the given id
+func (s *WebhookDeliveryRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.WebhookDelivery, error) {
+	query := `
+	SELECT ` + webhookDeliveryColumns + `
+	FROM webhook_delivery
+	WHERE 
+		id=$1;`
+	var result entity.WebhookDelivery
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanWebhookDelivery(row)
+		result = res
+		return err
+	}, "webhook delivery", id)
+	return result, err
+}
+
+// Update persists the status and the outcome of the latest attempt of
+// the given delivery.
+func (s *WebhookDeliveryRepositoryImpl) Update(ctx context.Context, e entity.WebhookDelivery) error {
+	query := `
+	UPDATE webhook_delivery
+	SET
+		status=$1, attempts=$2, next_attempt_at=$3, last_attempt_at=$4, last_status_code=$5, last_error=$6
+	WHERE 
+		id=$7;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "webhook delivery",
+		string(e.Status()),
+		e.Attempts(),
+		e.NextAttemptAt(),
+		e.LastAttemptAt(),
+		e.LastStatusCode(),
+		truncate(e.LastError(), 1023),
+		e.ID(),
+	)
+}
+
+// FindBySubscriptionID retrieves the deliveries to the subscription
+// matching the given id, latest first.
+func (s *WebhookDeliveryRepositoryImpl) FindBySubscriptionID(ctx context.Context, subscriptionID entity.ID) ([]entity.WebhookDelivery, error) {
+	query := `
+	SELECT ` + webhookDeliveryColumns + `
+	FROM webhook_delivery
+	WHERE 
+		subscription_id=$1
+	ORDER BY 
+		id DESC;`
+	return s.manyEntityQuery(ctx, query, subscriptionID)
+}
+
+// FindDead retrieves the deliveries which were given up on, latest
+// first.
+func (s *WebhookDeliveryRepositoryImpl) FindDead(ctx context.Context) ([]entity.WebhookDelivery, error) {
+	query := `
+	SELECT ` + webhookDeliveryColumns + `
+	FROM webhook_delivery
+	WHERE 
+		status='dead'
+	ORDER BY 
+		id DESC;`
+	return s.manyEntityQuery(ctx, query)
+}
+
+// FindDue retrieves at most limit pending deliveries due at now, earliest
+// first. The rows are locked for the rest of the transaction, and rows
+// locked by other transactions are skipped.
+func (s *WebhookDeliveryRepositoryImpl) FindDue(ctx context.Context, now time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	query := `
+	SELECT ` + webhookDeliveryColumns + `
+	FROM webhook_delivery
+	WHERE 
+		status='pending' AND next_attempt_at<=$1
+	ORDER BY 
+		next_attempt_at, id
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED;`
+	return s.manyEntityQuery(ctx, query, now, limit)
+}
+
+func (s *WebhookDeliveryRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.WebhookDelivery, error) {
+	var results []entity.WebhookDelivery
+
+	// Run the query to get rows
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanWebhookDelivery(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "webhook delivery", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *WebhookDeliveryRepositoryImpl) scanWebhookDelivery(row Row) (entity.WebhookDelivery, error) {
+	var id entity.ID
+	var subscriptionID entity.ID
+	var eventID entity.ID
+	var eventType string
+	var entityID entity.ID
+	var data []byte
+	var occurredAt time.Time
+	var status string
+	var attempts int
+	var nextAttemptAt time.Time
+	var lastAttemptAt *time.Time
+	var lastStatusCode int
+	var lastError string
+
+	// Extract data from the row
+	if err := row.Scan(&id, &subscriptionID, &eventID, &eventType, &entityID, &data, &occurredAt,
+		&status, &attempts, &nextAttemptAt, &lastAttemptAt, &lastStatusCode, &lastError); err != nil {
+		return nil, err
+	}
+	var decodedData map[string]interface{}
+	if err := json.Unmarshal(data, &decodedData); err != nil {
+		return nil, fmt.Errorf("could not decode data: %w", err)
+	}
+
+	if lastAttemptAt != nil {
+		utc := lastAttemptAt.UTC()
+		lastAttemptAt = &utc
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, subscriptionID, eventID, entity.EventType(eventType), entityID, decodedData,
+		occurredAt.UTC(), entity.DeliveryStatus(status), attempts, nextAttemptAt.UTC(), lastAttemptAt, lastStatusCode, lastError)
+	return result, nil
+}
+
+// truncate cuts str down to at most max bytes, without splitting
+// a character.
+func truncate(str string, max int) string {
+	if len(str) <= max {
+		return str
+	}
+	cut := max
+	for cut > 0 && !utf8.RuneStart(str[cut]) {
+		cut--
+	}
+	return str[:cut]
+}
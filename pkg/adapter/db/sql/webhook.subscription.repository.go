@@ -0,0 +1,177 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// WebhookSubscriptionRepositoryImpl implements SubscriptionRepository
+// to make use of SQL databases which have an associated driver.
+type WebhookSubscriptionRepositoryImpl struct {
+	dbService     DatabaseService
+	helperService HelperService
+	constructor   entity.WebhookSubscriptionConstructor
+}
+
+// Check we implement the interface
+var _ webhook.SubscriptionRepository = &WebhookSubscriptionRepositoryImpl{}
+
+// NewWebhookSubscriptionRepositoryImpl is a constructor
+func NewWebhookSubscriptionRepositoryImpl(
+	dbService DatabaseService,
+	helperService HelperService,
+	constructor entity.WebhookSubscriptionConstructor,
+) *WebhookSubscriptionRepositoryImpl {
+	return &WebhookSubscriptionRepositoryImpl{
+		dbService:     dbService,
+		helperService: helperService,
+		constructor:   constructor,
+	}
+}
+
+// Create persists a new entity. The ID is ignored in the input entity, and the
+// generated id is then returned.
+func (s *WebhookSubscriptionRepositoryImpl) Create(ctx context.Context, e entity.WebhookSubscription) (entity.ID, error) {
+	query := `
+	INSERT INTO webhook_subscription
+		(
+			url, 
+			event_types, 
+			secret, 
+			created_at
+		)
+	VALUES ($1, $2::jsonb, $3, $4)
+	RETURNING id;`
+	return s.helperService.SingleQueryForID(ctx, s.dbService.Get(), query, "webhook subscription",
+		e.URL(),
+		encodeEventTypes(e.EventTypes()),
+		e.Secret(),
+		e.CreatedAt(),
+	)
+}
+
+// FindByID finds a webhook subscription matching the given id
+func (s *WebhookSubscriptionRepositoryImpl) FindByID(ctx context.Context, id entity.ID) (entity.WebhookSubscription, error) {
+	query := `
+	SELECT 
+		id, 
+		url, 
+		event_types::text, 
+		secret, 
+		created_at 
+	FROM webhook_subscription
+	WHERE 
+		id=$1;`
+	var result entity.WebhookSubscription
+	err := s.helperService.SingleRowQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanWebhookSubscription(row)
+		result = res
+		return err
+	}, "webhook subscription", id)
+	return result, err
+}
+
+// FindAll retrieves every webhook subscription, earliest first.
+func (s *WebhookSubscriptionRepositoryImpl) FindAll(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	query := `
+	SELECT 
+		id, 
+		url, 
+		event_types::text, 
+		secret, 
+		created_at 
+	FROM webhook_subscription
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query)
+}
+
+// FindByEventType retrieves the webhook subscriptions which ask for
+// events of the given type, earliest first.
+func (s *WebhookSubscriptionRepositoryImpl) FindByEventType(ctx context.Context, eventType entity.EventType) ([]entity.WebhookSubscription, error) {
+	query := `
+	SELECT 
+		id, 
+		url, 
+		event_types::text, 
+		secret, 
+		created_at 
+	FROM webhook_subscription
+	WHERE 
+		event_types @> jsonb_build_array($1::text)
+	ORDER BY 
+		id;`
+	return s.manyEntityQuery(ctx, query, string(eventType))
+}
+
+// DeleteByID deletes the webhook subscription matching the id, along
+// with its deliveries. If there isn't an entry corresponding to the
+// id - an error is returned.
+func (s *WebhookSubscriptionRepositoryImpl) DeleteByID(ctx context.Context, id entity.ID) error {
+	query := `
+	DELETE FROM webhook_subscription
+	WHERE 
+		id=$1;`
+	return s.helperService.ExecForSingleItem(ctx, s.dbService.Get(), query, "webhook subscription", id)
+}
+
+func (s *WebhookSubscriptionRepositoryImpl) manyEntityQuery(ctx context.Context, query string, args ...interface{}) ([]entity.WebhookSubscription, error) {
+	var results []entity.WebhookSubscription
+
+	// Run the query to get rows
+	err := s.helperService.ManyRowsQuery(ctx, s.dbService.Get(), query, func(row Row) error {
+		res, err := s.scanWebhookSubscription(row)
+		if res != nil {
+			results = append(results, res)
+		}
+		return err
+	}, "webhook subscription", args...)
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *WebhookSubscriptionRepositoryImpl) scanWebhookSubscription(row Row) (entity.WebhookSubscription, error) {
+	var id entity.ID
+	var url string
+	var eventTypes string
+	var secret string
+	var createdAt time.Time
+
+	// Extract data from the row
+	if err := row.Scan(&id, &url, &eventTypes, &secret, &createdAt); err != nil {
+		return nil, err
+	}
+	decodedEventTypes, err := decodeStrings(eventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode event types: %w", err)
+	}
+
+	// Restore the entity from the extracted data (bypassing validations).
+	result := s.constructor.Reincarnate(id, url, toEventTypes(decodedEventTypes), secret, createdAt.UTC())
+	return result, nil
+}
+
+// encodeEventTypes converts event types to a JSON array, for storing in
+// a jsonb column.
+func encodeEventTypes(eventTypes []entity.EventType) string {
+	values := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		values[i] = string(eventType)
+	}
+	return encodeStrings(values)
+}
+
+func toEventTypes(values []string) []entity.EventType {
+	results := make([]entity.EventType, len(values))
+	for i, value := range values {
+		results[i] = entity.EventType(value)
+	}
+	return results
+}
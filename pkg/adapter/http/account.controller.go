@@ -0,0 +1,153 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// AccountControllerImpl defines controller methods
+// dealing with the account resource.
+type AccountControllerImpl struct {
+	accountService     account.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &AccountControllerImpl{}
+
+// NewAccountControllerImpl is a constructor
+func NewAccountControllerImpl(
+	accountService account.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *AccountControllerImpl {
+
+	return &AccountControllerImpl{
+		accountService:     accountService,
+		encoderService:     encoderService,
+		decoderService:     decoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (a *AccountControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/accounts", a.Create)
+	addHandler(handlers, http.MethodGet, "/accounts/{id}", a.ReadDetails)
+	addHandler(handlers, http.MethodGet, "/accounts", a.ReadAll)
+	addHandler(handlers, http.MethodPut, "/accounts/{id}", a.Update)
+	addHandler(handlers, http.MethodDelete, "/accounts/{id}", a.Delete)
+
+	return handlers
+}
+
+// Create can be called to open an account
+func (a *AccountControllerImpl) Create(request *Request) *Response {
+	// Decode JSON request
+	vo, err := a.decoderService.ToAccountCreateAccountVo(request.Body)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := a.accountService.Create(request.Context, vo)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return a.responseFactory.CreateFromEntityID(201, id)
+}
+
+// ReadDetails can be called to get details on an account,
+// including what it has rented out
+func (a *AccountControllerImpl) ReadDetails(request *Request) *Response {
+	// Extract ID from path params
+	id, err := a.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vo, err := a.accountService.ReadDetails(request.Context, id)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := a.encoderService.FromAccountView(vo)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return a.responseFactory.CreateJSON(200, json)
+}
+
+// ReadAll can be called to get an outline of all accounts
+func (a *AccountControllerImpl) ReadAll(request *Request) *Response {
+	// Delegate to service
+	vos, err := a.accountService.ReadAll(request.Context)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := a.encoderService.FromAccountThinViews(vos)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return a.responseFactory.CreateJSON(200, json)
+}
+
+// Update can be called to update the details of an account.
+func (a *AccountControllerImpl) Update(request *Request) *Response {
+	// Extract ID from path params
+	id, err := a.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := a.decoderService.ToAccountUpdateAccountVo(request.Body)
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = a.accountService.Update(request.Context, id, vo); err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return a.responseFactory.CreateEmpty(204)
+}
+
+// Delete can be called to remove an account from the system.
+func (a *AccountControllerImpl) Delete(request *Request) *Response {
+	// Extract ID from path params
+	id, err := a.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = a.accountService.Delete(request.Context, id); err != nil {
+		return a.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return a.responseFactory.CreateEmpty(204)
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+// APIKeyHeader is the header which identifies a client. Clients which
+// do not send one of the configured keys are identified by their IP
+// address.
+const APIKeyHeader = "X-API-Key"
+
+// ClientIdentifier identifies the client making a request, e.g. so
+// that it can be rate limited, or given back its own responses.
+type ClientIdentifier interface {
+	Identify(apiKey string, remoteAddr string) string
+}
+
+// ClientIdentifierImpl implements ClientIdentifier
+type ClientIdentifierImpl struct {
+	configStore config.Store
+}
+
+// Check we implement the interface
+var _ ClientIdentifier = &ClientIdentifierImpl{}
+
+// NewClientIdentifierImpl is a constructor
+func NewClientIdentifierImpl(configStore config.Store) *ClientIdentifierImpl {
+	return &ClientIdentifierImpl{
+		configStore: configStore,
+	}
+}
+
+// Identify returns the client's API key if it is one of the configured
+// keys, else its IP address. Only configured keys are trusted, so that
+// a client can't pass itself off as a new client by sending a new key.
+// API keys are hashed so that they are not kept anywhere.
+func (c *ClientIdentifierImpl) Identify(apiKey string, remoteAddr string) string {
+	if apiKey != "" && c.isKnownAPIKey(apiKey) {
+		sum := sha256.Sum256([]byte(apiKey))
+		return "key:" + hex.EncodeToString(sum[:])
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return "ip:" + host
+}
+
+// isKnownAPIKey returns true if the API key is one of the configured
+// keys.
+func (c *ClientIdentifierImpl) isKnownAPIKey(apiKey string) bool {
+	for _, known := range c.configStore.GetRateLimitAPIKeys() {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(apiKey)) == 1 {
+			return true
+		}
+	}
+	return false
+}
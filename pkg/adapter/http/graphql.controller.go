@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+)
+
+// GraphQLControllerImpl defines controller methods
+// dealing with GraphQL queries.
+type GraphQLControllerImpl struct {
+	graphqlService  graphql.Service
+	decoderService  json.DecoderService
+	responseFactory ResponseFactory
+}
+
+// Check we implement the interface
+var _ Controller = &GraphQLControllerImpl{}
+
+// NewGraphQLControllerImpl is a constructor
+func NewGraphQLControllerImpl(
+	graphqlService graphql.Service,
+	decoderService json.DecoderService,
+	responseFactory ResponseFactory,
+) *GraphQLControllerImpl {
+
+	return &GraphQLControllerImpl{
+		graphqlService:  graphqlService,
+		decoderService:  decoderService,
+		responseFactory: responseFactory,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (g *GraphQLControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/graphql", g.Query)
+
+	return handlers
+}
+
+// Query can be called to execute a GraphQL query. Errors in the query
+// are given in the body of a 200 response, as is usual for GraphQL.
+func (g *GraphQLControllerImpl) Query(request *Request) *Response {
+	// Decode JSON request
+	vo, err := g.decoderService.ToGraphQLRequestVo(request.Body)
+	if err != nil {
+		return g.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	body, err := g.graphqlService.Execute(request.Context, vo)
+	if err != nil {
+		return g.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return g.responseFactory.CreateJSON(200, body)
+}
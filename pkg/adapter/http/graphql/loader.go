@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// BatchFunc finds the values for many ids at once. Ids which have no
+// value are left out of the result.
+type BatchFunc func(context.Context, []entity.ID) (map[entity.ID]interface{}, error)
+
+// Loader finds values by id, batching the ids it is asked for so that
+// resolving a list does not mean a call per element. Resolvers which
+// know which ids will be asked for next prime the loader with them;
+// the next load then finds all of them in a single batch. Results,
+// including errors, are kept for the life of the loader - i.e. a
+// single request.
+type Loader struct {
+	batch BatchFunc
+
+	// Priming never waits for a batch, so a batch may prime other
+	// loaders without the risk of deadlock.
+	primeMutex sync.Mutex
+	pending    []entity.ID
+	primed     map[entity.ID]bool
+
+	loadMutex sync.Mutex
+	values    map[entity.ID]interface{}
+	errs      map[entity.ID]error
+}
+
+// NewLoader is a constructor
+func NewLoader(batch BatchFunc) *Loader {
+	return &Loader{
+		batch:  batch,
+		primed: make(map[entity.ID]bool),
+		values: make(map[entity.ID]interface{}),
+		errs:   make(map[entity.ID]error),
+	}
+}
+
+// Prime adds the ids to the next batch.
+func (l *Loader) Prime(ids ...entity.ID) {
+	l.primeMutex.Lock()
+	defer l.primeMutex.Unlock()
+
+	for _, id := range ids {
+		if !l.primed[id] {
+			l.pending = append(l.pending, id)
+			l.primed[id] = true
+		}
+	}
+}
+
+// Load returns the value for the id, or nil if there is none. If it has
+// not been loaded yet, it is loaded along with any primed ids which
+// have not been.
+func (l *Loader) Load(ctx context.Context, id entity.ID) (interface{}, error) {
+	// Loads wait for each other, so that a batch is only made once.
+	l.loadMutex.Lock()
+	defer l.loadMutex.Unlock()
+
+	if !l.loaded(id) {
+		l.load(ctx, l.takePending(id))
+	}
+	return l.values[id], l.errs[id]
+}
+
+// takePending returns the primed ids which have not been loaded, along
+// with the given id, and starts the next batch afresh.
+func (l *Loader) takePending(id entity.ID) []entity.ID {
+	l.primeMutex.Lock()
+	defer l.primeMutex.Unlock()
+
+	var ids []entity.ID
+	for _, pending := range l.pending {
+		if !l.loaded(pending) {
+			ids = append(ids, pending)
+		}
+	}
+	if !l.primed[id] {
+		ids = append(ids, id)
+	}
+	l.pending = nil
+	l.primed = make(map[entity.ID]bool)
+	return ids
+}
+
+func (l *Loader) load(ctx context.Context, ids []entity.ID) {
+	found, err := l.batch(ctx, ids)
+	for _, id := range ids {
+		if err != nil {
+			l.errs[id] = err
+		} else {
+			l.values[id] = found[id]
+		}
+	}
+}
+
+func (l *Loader) loaded(id entity.ID) bool {
+	_, hasValue := l.values[id]
+	_, hasErr := l.errs[id]
+	return hasValue || hasErr
+}
@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type loadersKey struct{}
+
+// loaders are the loaders of a single request. Each primes the loaders
+// which resolving its values is likely to need next.
+type loaders struct {
+	titles       *Loader
+	items        *Loader
+	itemsOfTitle *Loader
+}
+
+func newLoaders(titleService title.Service, inventoryService inventory.Service) *loaders {
+	l := &loaders{}
+	l.titles = NewLoader(func(ctx context.Context, ids []entity.ID) (map[entity.ID]interface{}, error) {
+		vos, err := titleService.ReadManyDetails(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[entity.ID]interface{})
+		for i := range vos {
+			found[vos[i].ID] = &vos[i]
+			l.itemsOfTitle.Prime(vos[i].ID)
+		}
+		return found, nil
+	})
+	l.items = NewLoader(func(ctx context.Context, ids []entity.ID) (map[entity.ID]interface{}, error) {
+		vos, err := inventoryService.ReadManyDetails(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[entity.ID]interface{})
+		for i := range vos {
+			found[vos[i].ID] = &vos[i]
+			l.titles.Prime(vos[i].TitleID)
+		}
+		return found, nil
+	})
+	l.itemsOfTitle = NewLoader(func(ctx context.Context, titleIDs []entity.ID) (map[entity.ID]interface{}, error) {
+		vos, err := inventoryService.ReadAllOfTitles(ctx, titleIDs)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[entity.ID]interface{})
+		for _, id := range titleIDs {
+			found[id] = []entity.ID{}
+		}
+		for _, vo := range vos {
+			found[vo.TitleID] = append(found[vo.TitleID].([]entity.ID), vo.ID)
+			l.items.Prime(vo.ID)
+		}
+		return found, nil
+	})
+	return l
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// loadTitle returns the title with the given id, or nil if there is none.
+func loadTitle(ctx context.Context, id entity.ID) (*title.ViewVO, error) {
+	found, err := loadersFrom(ctx).titles.Load(ctx, id)
+	if err != nil || found == nil {
+		return nil, err
+	}
+	return found.(*title.ViewVO), nil
+}
+
+// loadItem returns the inventory item with the given id, or nil if
+// there is none.
+func loadItem(ctx context.Context, id entity.ID) (*inventory.ViewVO, error) {
+	found, err := loadersFrom(ctx).items.Load(ctx, id)
+	if err != nil || found == nil {
+		return nil, err
+	}
+	return found.(*inventory.ViewVO), nil
+}
+
+// loadItems returns the inventory items with the given ids, leaving out
+// any which do not exist (e.g. because they were deleted meanwhile).
+func loadItems(ctx context.Context, ids []entity.ID) ([]*itemResolver, error) {
+	loadersFrom(ctx).items.Prime(ids...)
+	resolvers := make([]*itemResolver, 0, len(ids))
+	for _, id := range ids {
+		vo, err := loadItem(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not load inventory items - %w", err)
+		}
+		if vo != nil {
+			resolvers = append(resolvers, &itemResolver{vo: vo})
+		}
+	}
+	return resolvers, nil
+}
+
+// loadTitles returns the titles with the given ids, leaving out any
+// which do not exist.
+func loadTitles(ctx context.Context, ids []entity.ID) ([]*titleResolver, error) {
+	loadersFrom(ctx).titles.Prime(ids...)
+	resolvers := make([]*titleResolver, 0, len(ids))
+	for _, id := range ids {
+		vo, err := loadTitle(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not load titles - %w", err)
+		}
+		if vo != nil {
+			resolvers = append(resolvers, &titleResolver{vo: vo})
+		}
+	}
+	return resolvers, nil
+}
@@ -0,0 +1,295 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	goGraphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// queryResolver resolves the fields of the Query type. Anything which
+// may be asked for more than once in a query is found through the
+// request's loaders.
+type queryResolver struct {
+	titleService     title.Service
+	inventoryService inventory.Service
+	accountService   account.Service
+}
+
+type idArgs struct {
+	ID goGraphql.ID
+}
+
+type itemsArgs struct {
+	Format *string
+}
+
+func (q *queryResolver) Titles(ctx context.Context) ([]*titleResolver, error) {
+	vos, err := q.titleService.ReadAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]entity.ID, len(vos))
+	for i, vo := range vos {
+		ids[i] = vo.ID
+	}
+	return loadTitles(ctx, ids)
+}
+
+func (q *queryResolver) Title(ctx context.Context, args idArgs) (*titleResolver, error) {
+	id, err := toEntityID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	vo, err := loadTitle(ctx, id)
+	if err != nil || vo == nil {
+		return nil, err
+	}
+	return &titleResolver{vo: vo}, nil
+}
+
+func (q *queryResolver) Items(ctx context.Context, args itemsArgs) ([]*itemResolver, error) {
+	var vos []inventory.ThinViewVO
+	var err error
+	if args.Format == nil {
+		vos, err = q.inventoryService.ReadAll(ctx)
+	} else {
+		vos, err = q.inventoryService.ReadAllOfFormat(ctx, entity.Format(*args.Format))
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]entity.ID, len(vos))
+	for i, vo := range vos {
+		ids[i] = vo.ID
+	}
+	return loadItems(ctx, ids)
+}
+
+func (q *queryResolver) Item(ctx context.Context, args idArgs) (*itemResolver, error) {
+	id, err := toEntityID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	vo, err := loadItem(ctx, id)
+	if err != nil || vo == nil {
+		return nil, err
+	}
+	return &itemResolver{vo: vo}, nil
+}
+
+func (q *queryResolver) Account(ctx context.Context, args idArgs) (*accountResolver, error) {
+	id, err := toEntityID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	vo, err := q.accountService.ReadDetails(ctx, id)
+	var notFound *db.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Their items will likely be asked for
+	for _, r := range vo.Rentals {
+		loadersFrom(ctx).items.Prime(r.ItemID)
+	}
+	return &accountResolver{vo: vo}, nil
+}
+
+type titleResolver struct {
+	vo *title.ViewVO
+}
+
+func (t *titleResolver) ID() goGraphql.ID {
+	return fromEntityID(t.vo.ID)
+}
+
+func (t *titleResolver) Name() string {
+	return t.vo.Name
+}
+
+func (t *titleResolver) Year() int32 {
+	return int32(t.vo.Year)
+}
+
+func (t *titleResolver) Runtime() int32 {
+	return int32(t.vo.Runtime)
+}
+
+func (t *titleResolver) Synopsis() string {
+	return t.vo.Synopsis
+}
+
+func (t *titleResolver) Genres() []string {
+	return nonNil(t.vo.Genres)
+}
+
+func (t *titleResolver) Cast() []string {
+	return nonNil(t.vo.Cast)
+}
+
+func (t *titleResolver) Rating() string {
+	return t.vo.Rating
+}
+
+func (t *titleResolver) Copies() int32 {
+	return int32(t.vo.Copies)
+}
+
+func (t *titleResolver) AvailableCopies() int32 {
+	return int32(t.vo.AvailableCopies)
+}
+
+func (t *titleResolver) Items(ctx context.Context) ([]*itemResolver, error) {
+	found, err := loadersFrom(ctx).itemsOfTitle.Load(ctx, t.vo.ID)
+	if err != nil {
+		return nil, err
+	}
+	return loadItems(ctx, found.([]entity.ID))
+}
+
+type itemResolver struct {
+	vo *inventory.ViewVO
+}
+
+func (i *itemResolver) ID() goGraphql.ID {
+	return fromEntityID(i.vo.ID)
+}
+
+func (i *itemResolver) Title(ctx context.Context) (*titleResolver, error) {
+	vo, err := loadTitle(ctx, i.vo.TitleID)
+	if err != nil {
+		return nil, err
+	}
+	if vo == nil {
+		return nil, fmt.Errorf("could not resolve title of inventory item - %w",
+			db.NewNotFoundError("title"))
+	}
+	return &titleResolver{vo: vo}, nil
+}
+
+func (i *itemResolver) Format() string {
+	return string(i.vo.Format)
+}
+
+func (i *itemResolver) Barcode() string {
+	return i.vo.Barcode
+}
+
+func (i *itemResolver) Location() string {
+	return i.vo.Location
+}
+
+func (i *itemResolver) Available() bool {
+	return i.vo.Available
+}
+
+func (i *itemResolver) DueAt() *goGraphql.Time {
+	if i.vo.DueAt == nil {
+		return nil
+	}
+	return &goGraphql.Time{Time: *i.vo.DueAt}
+}
+
+func (i *itemResolver) Overdue() bool {
+	return i.vo.Overdue
+}
+
+type accountResolver struct {
+	vo *account.ViewVO
+}
+
+func (a *accountResolver) ID() goGraphql.ID {
+	return fromEntityID(a.vo.ID)
+}
+
+func (a *accountResolver) Name() string {
+	return a.vo.Name
+}
+
+// BalanceCents refuses balances which a GraphQL Int can't hold, rather
+// than give a wrong one.
+func (a *accountResolver) BalanceCents() (int32, error) {
+	if a.vo.Balance > math.MaxInt32 || a.vo.Balance < math.MinInt32 {
+		return 0, fmt.Errorf("could not resolve balanceCents - %d is out of range for Int", a.vo.Balance)
+	}
+	return int32(a.vo.Balance), nil
+}
+
+func (a *accountResolver) Overdue() bool {
+	return a.vo.Overdue
+}
+
+func (a *accountResolver) Rentals() []*rentalResolver {
+	resolvers := make([]*rentalResolver, len(a.vo.Rentals))
+	for i := range a.vo.Rentals {
+		resolvers[i] = &rentalResolver{vo: &a.vo.Rentals[i]}
+	}
+	return resolvers
+}
+
+type rentalResolver struct {
+	vo *rental.ViewVO
+}
+
+func (r *rentalResolver) ID() goGraphql.ID {
+	return fromEntityID(r.vo.ID)
+}
+
+func (r *rentalResolver) Item(ctx context.Context) (*itemResolver, error) {
+	vo, err := loadItem(ctx, r.vo.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if vo == nil {
+		return nil, fmt.Errorf("could not resolve item of rental - %w",
+			db.NewNotFoundError("inventory item"))
+	}
+	return &itemResolver{vo: vo}, nil
+}
+
+func (r *rentalResolver) CheckedOutAt() goGraphql.Time {
+	return goGraphql.Time{Time: r.vo.CheckedOutAt}
+}
+
+func (r *rentalResolver) DueAt() goGraphql.Time {
+	return goGraphql.Time{Time: r.vo.DueAt}
+}
+
+func (r *rentalResolver) Overdue() bool {
+	return r.vo.Overdue
+}
+
+func toEntityID(id goGraphql.ID) (entity.ID, error) {
+	i, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not convert graphql id - %w",
+			commonerror.NewValidation("id", "must be a whole number"))
+	}
+	return entity.ID(i), nil
+}
+
+func fromEntityID(id entity.ID) goGraphql.ID {
+	return goGraphql.ID(strconv.FormatInt(int64(id), 10))
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,60 @@
+package graphql
+
+// schema describes what may be queried at /graphql. Money is given in
+// cents, as in the rest of the API.
+const schema = `
+schema {
+	query: Query
+}
+
+scalar Time
+
+type Query {
+	titles: [Title!]!
+	title(id: ID!): Title
+	items(format: String): [Item!]!
+	item(id: ID!): Item
+	account(id: ID!): Account
+}
+
+type Title {
+	id: ID!
+	name: String!
+	year: Int!
+	runtime: Int!
+	synopsis: String!
+	genres: [String!]!
+	cast: [String!]!
+	rating: String!
+	copies: Int!
+	availableCopies: Int!
+	items: [Item!]!
+}
+
+type Item {
+	id: ID!
+	title: Title!
+	format: String!
+	barcode: String!
+	location: String!
+	available: Boolean!
+	dueAt: Time
+	overdue: Boolean!
+}
+
+type Account {
+	id: ID!
+	name: String!
+	balanceCents: Int!
+	overdue: Boolean!
+	rentals: [Rental!]!
+}
+
+type Rental {
+	id: ID!
+	item: Item!
+	checkedOutAt: Time!
+	dueAt: Time!
+	overdue: Boolean!
+}
+`
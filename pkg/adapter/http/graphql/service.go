@@ -0,0 +1,77 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	goGraphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// RequestVO defines a GraphQL query to execute.
+type RequestVO struct {
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+}
+
+// Service executes GraphQL queries over the catalogue, inventory and
+// accounts.
+type Service interface {
+	// Execute returns the JSON response to the query. Errors in the
+	// query, or in resolving it, are given in the response.
+	Execute(context.Context, *RequestVO) ([]byte, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	schema           *goGraphql.Schema
+	titleService     title.Service
+	inventoryService inventory.Service
+}
+
+// Check we implement the interface
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor. Queries nested deeper than
+// maxDepth are refused.
+func NewServiceImpl(
+	titleService title.Service,
+	inventoryService inventory.Service,
+	accountService account.Service,
+	maxDepth int,
+) (*ServiceImpl, error) {
+
+	resolver := &queryResolver{
+		titleService:     titleService,
+		inventoryService: inventoryService,
+		accountService:   accountService,
+	}
+	schema, err := goGraphql.ParseSchema(schema, resolver, goGraphql.MaxDepth(maxDepth))
+	if err != nil {
+		return nil, fmt.Errorf("could not create graphql service - schema error: %w", err)
+	}
+
+	return &ServiceImpl{
+		schema:           schema,
+		titleService:     titleService,
+		inventoryService: inventoryService,
+	}, nil
+}
+
+// Execute resolves the query with loaders of its own, so that what
+// is loaded is only shared within the request.
+func (s *ServiceImpl) Execute(ctx context.Context, vo *RequestVO) ([]byte, error) {
+	ctx = withLoaders(ctx, newLoaders(s.titleService, s.inventoryService))
+	response := s.schema.Exec(ctx, vo.Query, vo.OperationName, vo.Variables)
+
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute graphql query - marshal error: %w", err)
+	}
+	return bytes, nil
+}
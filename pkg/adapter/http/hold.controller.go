@@ -0,0 +1,139 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// HoldControllerImpl defines controller methods
+// dealing with the hold resource.
+type HoldControllerImpl struct {
+	holdService        hold.Service
+	inventoryService   inventory.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &HoldControllerImpl{}
+
+// NewHoldControllerImpl is a constructor
+func NewHoldControllerImpl(
+	holdService hold.Service,
+	inventoryService inventory.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *HoldControllerImpl {
+
+	return &HoldControllerImpl{
+		holdService:        holdService,
+		inventoryService:   inventoryService,
+		decoderService:     decoderService,
+		encoderService:     encoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (h *HoldControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/titles/{id}/holds", h.Place)
+	addHandler(handlers, http.MethodGet, "/titles/{id}/holds", h.ReadQueue)
+	addHandler(handlers, http.MethodPut, "/holds/{id}/cancel", h.Cancel)
+	addHandler(handlers, http.MethodPut, "/holds/{id}/fulfil", h.Fulfil)
+
+	return handlers
+}
+
+// Place can be called to queue an account for a copy of a title
+func (h *HoldControllerImpl) Place(request *Request) *Response {
+	// Extract ID from path params
+	titleID, err := h.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := h.decoderService.ToHoldPlaceHoldVo(request.Body)
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := h.holdService.Place(request.Context, titleID, vo)
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return h.responseFactory.CreateFromEntityID(201, id)
+}
+
+// ReadQueue can be called to list the active holds on a title,
+// in the order they will be given copies
+func (h *HoldControllerImpl) ReadQueue(request *Request) *Response {
+	// Extract ID from path params
+	titleID, err := h.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vos, err := h.holdService.ReadQueue(request.Context, titleID)
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := h.encoderService.FromHoldViews(vos)
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return h.responseFactory.CreateJSON(200, json)
+}
+
+// Cancel can be called to withdraw a hold
+func (h *HoldControllerImpl) Cancel(request *Request) *Response {
+	// Extract ID from path params
+	id, err := h.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err := h.holdService.Cancel(request.Context, id); err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return h.responseFactory.CreateEmpty(204)
+}
+
+// Fulfil can be called to check out the copy put aside for
+// a hold to the account which placed it
+func (h *HoldControllerImpl) Fulfil(request *Request) *Response {
+	// Extract ID from path params
+	id, err := h.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err := h.inventoryService.FulfilHold(request.Context, id); err != nil {
+		return h.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return h.responseFactory.CreateEmpty(204)
+}
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+// IdempotencyKeyHeader is the header a client sets so that it can
+// retry a request without it being handled twice.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+const maxIdempotencyKeyLength = 255
+
+// idempotentPatterns are the handlers which honour an idempotency key:
+// those which create things, and those which check items out or in.
+var idempotentPatterns = map[HandlerPattern]bool{
+	{Method: http.MethodPost, PathPattern: "/inventory"}:                           true,
+	{Method: http.MethodPost, PathPattern: "/titles"}:                              true,
+	{Method: http.MethodPost, PathPattern: "/titles/{id}/holds"}:                   true,
+	{Method: http.MethodPost, PathPattern: "/accounts"}:                            true,
+	{Method: http.MethodPost, PathPattern: "/accounts/{id}/payments"}:              true,
+	{Method: http.MethodPost, PathPattern: "/accounts/{id}/adjustments"}:           true,
+	{Method: http.MethodPost, PathPattern: "/locations"}:                           true,
+	{Method: http.MethodPost, PathPattern: "/webhooks"}:                            true,
+	{Method: http.MethodPut, PathPattern: "/inventory/{id}/checkout"}:              true,
+	{Method: http.MethodPut, PathPattern: "/inventory/{id}/checkin"}:               true,
+	{Method: http.MethodPut, PathPattern: "/inventory/{id}/renew"}:                 true,
+	{Method: http.MethodPut, PathPattern: "/inventory/by-barcode/{code}/checkout"}: true,
+	{Method: http.MethodPut, PathPattern: "/inventory/by-barcode/{code}/checkin"}:  true,
+}
+
+// IdempotencyWrapper makes handlers replay their first response to a
+// request which is retried with the same idempotency key.
+type IdempotencyWrapper interface {
+	Wrap(HandlerPattern, Handler) Handler
+}
+
+// IdempotencyWrapperImpl implements IdempotencyWrapper
+type IdempotencyWrapperImpl struct {
+	idempotencyService idempotency.Service
+	clientIdentifier   ClientIdentifier
+	responseFactory    ResponseFactory
+}
+
+// Check we implement the interface
+var _ IdempotencyWrapper = &IdempotencyWrapperImpl{}
+
+// NewIdempotencyWrapperImpl is a constructor
+func NewIdempotencyWrapperImpl(
+	idempotencyService idempotency.Service,
+	clientIdentifier ClientIdentifier,
+	responseFactory ResponseFactory,
+) *IdempotencyWrapperImpl {
+
+	return &IdempotencyWrapperImpl{
+		idempotencyService: idempotencyService,
+		clientIdentifier:   clientIdentifier,
+		responseFactory:    responseFactory,
+	}
+}
+
+// Wrap returns the handler as is, unless the pattern is one which
+// honours an idempotency key. Requests without a key are handled as
+// usual.
+func (i *IdempotencyWrapperImpl) Wrap(pattern HandlerPattern, handler Handler) Handler {
+	if !idempotentPatterns[pattern] {
+		return handler
+	}
+
+	return func(request *Request) *Response {
+		key, ok := request.Header[IdempotencyKeyHeader]
+		if !ok {
+			return handler(request)
+		}
+		if key == "" || len(key) > maxIdempotencyKeyLength {
+			return i.responseFactory.CreateFromError(commonerror.NewValidation(IdempotencyKeyHeader,
+				fmt.Sprintf("must be 1 to %d characters", maxIdempotencyKeyLength)))
+		}
+
+		// Replay the response to an earlier try, if there was one. Keys
+		// belong to the client, so that one client can't be given
+		// another's response by reusing its key.
+		client := i.clientIdentifier.Identify(request.Header[http.CanonicalHeaderKey(APIKeyHeader)], request.RemoteAddr)
+		earlier, err := i.idempotencyService.Begin(request.Context, client, key, fingerprint(pattern, request))
+		if err != nil {
+			return i.responseFactory.CreateFromError(err)
+		}
+		if earlier != nil {
+			return &Response{
+				ContentType: earlier.ContentType,
+				StatusCode:  uint(earlier.StatusCode),
+				Body:        earlier.Body,
+			}
+		}
+
+		// Otherwise handle it. Server errors may well not happen again,
+		// so those are not kept, and neither are panics. Failing to keep
+		// a response should not hide it from the client, since the
+		// request was handled - the failure is just logged.
+		ctx := detached{request.Context}
+		defer func() {
+			if r := recover(); r != nil {
+				i.abandon(ctx, client, key)
+				panic(r)
+			}
+		}()
+		response := handler(request)
+		if response.StatusCode >= 500 {
+			i.abandon(ctx, client, key)
+			return response
+		}
+		if err := i.idempotencyService.Finish(ctx, client, key, &idempotency.ResponseVO{
+			StatusCode:  int(response.StatusCode),
+			ContentType: response.ContentType,
+			Body:        response.Body,
+		}); err != nil {
+			fmt.Printf("Could not keep response for idempotency key [%s]: %v\n", key, err)
+		}
+		return response
+	}
+}
+
+// abandon releases the key so that the request may be retried. If it
+// can't be, retries are refused until the claim runs out of time.
+func (i *IdempotencyWrapperImpl) abandon(ctx context.Context, client string, key string) {
+	if err := i.idempotencyService.Abandon(ctx, client, key); err != nil {
+		fmt.Printf("Could not release idempotency key [%s]: %v\n", key, err)
+	}
+}
+
+// fingerprint identifies what a request asks for, so that a key given
+// with a different request can be refused.
+func fingerprint(pattern HandlerPattern, request *Request) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", pattern.Method, pattern.PathPattern)
+	for _, name := range sortedPathParams(request) {
+		fmt.Fprintf(h, "%s=%s\n", name, request.PathParam[name])
+	}
+	for _, name := range sortedQueryParams(request) {
+		fmt.Fprintf(h, "%s=%q\n", name, request.QueryParam[name])
+	}
+	h.Write(request.Body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func sortedPathParams(request *Request) []string {
+	names := make([]string, 0, len(request.PathParam))
+	for name := range request.PathParam {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedQueryParams(request *Request) []string {
+	names := make([]string, 0, len(request.QueryParam))
+	for name := range request.QueryParam {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// detached keeps the values of a context (e.g. the trace), but not its
+// deadline or cancellation, so that the outcome of a request can be
+// kept after it runs out of time.
+type detached struct {
+	context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detached) Done() <-chan struct{} {
+	return nil
+}
+
+func (detached) Err() error {
+	return nil
+}
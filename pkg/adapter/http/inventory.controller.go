@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
 )
 
@@ -13,6 +15,7 @@ type InventoryControllerImpl struct {
 	inventoryService   inventory.Service
 	decoderService     json.DecoderService
 	encoderService     json.EncoderService
+	labelService       label.EncoderService
 	responseFactory    ResponseFactory
 	parameterConverter ParameterConverter
 }
@@ -25,6 +28,7 @@ func NewInventoryControllerImpl(
 	inventoryService inventory.Service,
 	decoderService json.DecoderService,
 	encoderService json.EncoderService,
+	labelService label.EncoderService,
 	responseFactory ResponseFactory,
 	parameterConverter ParameterConverter,
 ) *InventoryControllerImpl {
@@ -33,6 +37,7 @@ func NewInventoryControllerImpl(
 		inventoryService:   inventoryService,
 		encoderService:     encoderService,
 		decoderService:     decoderService,
+		labelService:       labelService,
 		responseFactory:    responseFactory,
 		parameterConverter: parameterConverter,
 	}
@@ -49,6 +54,14 @@ func (i *InventoryControllerImpl) GetHandlers() map[HandlerPattern]Handler {
 	addHandler(handlers, http.MethodDelete, "/inventory/{id}", i.Delete)
 	addHandler(handlers, http.MethodPut, "/inventory/{id}/checkout", i.Checkout)
 	addHandler(handlers, http.MethodPut, "/inventory/{id}/checkin", i.CheckIn)
+	addHandler(handlers, http.MethodPut, "/inventory/{id}/renew", i.Renew)
+	addHandler(handlers, http.MethodPut, "/inventory/{id}/move", i.Move)
+	addHandler(handlers, http.MethodGet, "/inventory/{id}/moves", i.ReadMoves)
+	addHandler(handlers, http.MethodGet, "/inventory/by-barcode/{code}", i.ReadDetailsByBarcode)
+	addHandler(handlers, http.MethodPut, "/inventory/by-barcode/{code}/checkout", i.CheckoutByBarcode)
+	addHandler(handlers, http.MethodPut, "/inventory/by-barcode/{code}/checkin", i.CheckInByBarcode)
+	addHandler(handlers, http.MethodGet, "/inventory/{id}/label", i.ReadLabel)
+	addHandler(handlers, http.MethodGet, "/labels", i.ReadLabels)
 
 	return handlers
 }
@@ -62,7 +75,7 @@ func (i *InventoryControllerImpl) Create(request *Request) *Response {
 	}
 
 	// Delegate to service
-	id, err := i.inventoryService.Create(vo)
+	id, err := i.inventoryService.Create(request.Context, vo)
 	if err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
@@ -79,8 +92,24 @@ func (i *InventoryControllerImpl) ReadDetails(request *Request) *Response {
 		return i.responseFactory.CreateFromError(err)
 	}
 
+	return i.readDetails(request, id)
+}
+
+// ReadDetailsByBarcode can be called to get details on the inventory
+// item with a barcode, e.g. when it is scanned.
+func (i *InventoryControllerImpl) ReadDetailsByBarcode(request *Request) *Response {
+	// Find ID from the barcode in the path params
+	id, err := i.barcodeToID(request)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	return i.readDetails(request, id)
+}
+
+func (i *InventoryControllerImpl) readDetails(request *Request, id entity.ID) *Response {
 	// Delegate to service
-	vo, err := i.inventoryService.ReadDetails(id)
+	vo, err := i.inventoryService.ReadDetails(request.Context, id)
 	if err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
@@ -95,10 +124,18 @@ func (i *InventoryControllerImpl) ReadDetails(request *Request) *Response {
 	return i.responseFactory.CreateJSON(200, json)
 }
 
-// ReadAll can be called to get details on all inventory items
+// ReadAll can be called to get details on all inventory items,
+// optionally only those of the format given by the "format" query
+// parameter.
 func (i *InventoryControllerImpl) ReadAll(request *Request) *Response {
 	// Delegate to service
-	vos, err := i.inventoryService.ReadAll()
+	var vos []inventory.ThinViewVO
+	var err error
+	if formats, ok := request.QueryParam["format"]; ok && len(formats) > 0 {
+		vos, err = i.inventoryService.ReadAllOfFormat(request.Context, entity.Format(formats[0]))
+	} else {
+		vos, err = i.inventoryService.ReadAll(request.Context)
+	}
 	if err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
@@ -129,7 +166,7 @@ func (i *InventoryControllerImpl) Update(request *Request) *Response {
 	}
 
 	// Delegate to service
-	if err = i.inventoryService.Update(id, vo); err != nil {
+	if err = i.inventoryService.Update(request.Context, id, vo); err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
 
@@ -146,7 +183,7 @@ func (i *InventoryControllerImpl) Delete(request *Request) *Response {
 	}
 
 	// Delegate to service
-	if err = i.inventoryService.Delete(id); err != nil {
+	if err = i.inventoryService.Delete(request.Context, id); err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
 
@@ -154,7 +191,8 @@ func (i *InventoryControllerImpl) Delete(request *Request) *Response {
 	return i.responseFactory.CreateEmpty(204)
 }
 
-// Checkout can be called to checkout an inventory item.
+// Checkout can be called to checkout an inventory item
+// to an account.
 func (i *InventoryControllerImpl) Checkout(request *Request) *Response {
 	// Extract ID from path params
 	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
@@ -162,8 +200,30 @@ func (i *InventoryControllerImpl) Checkout(request *Request) *Response {
 		return i.responseFactory.CreateFromError(err)
 	}
 
+	return i.checkout(request, id)
+}
+
+// CheckoutByBarcode can be called to checkout the inventory item
+// with a barcode to an account.
+func (i *InventoryControllerImpl) CheckoutByBarcode(request *Request) *Response {
+	// Find ID from the barcode in the path params
+	id, err := i.barcodeToID(request)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	return i.checkout(request, id)
+}
+
+func (i *InventoryControllerImpl) checkout(request *Request, id entity.ID) *Response {
+	// Decode JSON request
+	vo, err := i.decoderService.ToInventoryCheckoutVo(request.Body)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
 	// Delegate to service
-	if err = i.inventoryService.Checkout(id); err != nil {
+	if err = i.inventoryService.Checkout(request.Context, id, vo); err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
 
@@ -171,7 +231,8 @@ func (i *InventoryControllerImpl) Checkout(request *Request) *Response {
 	return i.responseFactory.CreateEmpty(204)
 }
 
-// CheckIn can be called to check in an inventory item.
+// CheckIn can be called to check in an inventory item. The receipt
+// line of its rental, including any late fee, is returned.
 func (i *InventoryControllerImpl) CheckIn(request *Request) *Response {
 	// Extract ID from path params
 	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
@@ -179,11 +240,169 @@ func (i *InventoryControllerImpl) CheckIn(request *Request) *Response {
 		return i.responseFactory.CreateFromError(err)
 	}
 
+	return i.checkIn(request, id)
+}
+
+// CheckInByBarcode can be called to check in the inventory item with
+// a barcode. The receipt line of its rental is returned.
+func (i *InventoryControllerImpl) CheckInByBarcode(request *Request) *Response {
+	// Find ID from the barcode in the path params
+	id, err := i.barcodeToID(request)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	return i.checkIn(request, id)
+}
+
+func (i *InventoryControllerImpl) checkIn(request *Request, id entity.ID) *Response {
+	// Delegate to service
+	receipt, err := i.inventoryService.CheckIn(request.Context, id)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Items checked out before rentals were recorded have no receipt
+	if receipt == nil {
+		return i.responseFactory.CreateEmpty(204)
+	}
+
+	// Encode to JSON
+	json, err := i.encoderService.FromRentalReceiptLine(receipt)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return i.responseFactory.CreateJSON(200, json)
+}
+
+// Renew can be called to extend the rental of an inventory item. The
+// receipt line of the renewal, including its fee, is returned.
+func (i *InventoryControllerImpl) Renew(request *Request) *Response {
+	// Extract ID from path params
+	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
 	// Delegate to service
-	if err = i.inventoryService.CheckIn(id); err != nil {
+	receipt, err := i.inventoryService.Renew(request.Context, id)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := i.encoderService.FromRentalRenewalReceiptLine(receipt)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return i.responseFactory.CreateJSON(200, json)
+}
+
+// Move can be called to move an inventory item to another
+// location.
+func (i *InventoryControllerImpl) Move(request *Request) *Response {
+	// Extract ID from path params
+	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := i.decoderService.ToInventoryMoveVo(request.Body)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = i.inventoryService.Move(request.Context, id, vo); err != nil {
 		return i.responseFactory.CreateFromError(err)
 	}
 
 	// Create response
 	return i.responseFactory.CreateEmpty(204)
 }
+
+// ReadMoves can be called to get the moves of an inventory item
+// between locations, earliest first.
+func (i *InventoryControllerImpl) ReadMoves(request *Request) *Response {
+	// Extract ID from path params
+	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vos, err := i.inventoryService.ReadMoves(request.Context, id)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := i.encoderService.FromInventoryMoveViews(vos)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return i.responseFactory.CreateJSON(200, json)
+}
+
+// ReadLabel can be called to render the label of an inventory item,
+// in the format given by the "format" query parameter.
+func (i *InventoryControllerImpl) ReadLabel(request *Request) *Response {
+	// Extract ID from path params
+	id, err := i.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	return i.readLabels(request, []entity.ID{id})
+}
+
+// ReadLabels can be called to render the labels of the inventory items
+// given by the "id" query parameters together, in the format given by
+// the "format" query parameter.
+func (i *InventoryControllerImpl) ReadLabels(request *Request) *Response {
+	// Extract IDs from query params
+	ids, err := i.parameterConverter.ToEntityIDs(request.QueryParam, "id")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	return i.readLabels(request, ids)
+}
+
+func (i *InventoryControllerImpl) readLabels(request *Request, ids []entity.ID) *Response {
+	// Extract format from query params
+	format, err := i.parameterConverter.ToLabelFormat(request.QueryParam, "format")
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vos, err := i.inventoryService.ReadLabels(request.Context, ids)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Render labels
+	file, err := i.labelService.FromInventoryLabels(format, vos)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return i.responseFactory.CreateFile(200, format.ContentType(), file)
+}
+
+func (i *InventoryControllerImpl) barcodeToID(request *Request) (entity.ID, error) {
+	barcode, err := i.parameterConverter.ToBarcode(request.PathParam, "code")
+	if err != nil {
+		return entity.InvalidID, err
+	}
+	return i.inventoryService.FindIDByBarcode(request.Context, barcode)
+}
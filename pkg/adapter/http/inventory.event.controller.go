@@ -0,0 +1,110 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+	lastEventIDHeader      = "Last-Event-Id"
+)
+
+// StreamingPatterns are the handlers which stream their response for as
+// long as the client stays connected, so should not be given the usual
+// request timeout.
+var StreamingPatterns = map[HandlerPattern]bool{
+	{Method: http.MethodGet, PathPattern: "/inventory/events"}: true,
+}
+
+// InventoryEventControllerImpl defines controller methods
+// dealing with the events inventory items record.
+type InventoryEventControllerImpl struct {
+	feed               outbox.Feed
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &InventoryEventControllerImpl{}
+
+// NewInventoryEventControllerImpl is a constructor
+func NewInventoryEventControllerImpl(
+	feed outbox.Feed,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *InventoryEventControllerImpl {
+
+	return &InventoryEventControllerImpl{
+		feed:               feed,
+		encoderService:     encoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (i *InventoryEventControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodGet, "/inventory/events", i.Stream)
+
+	return handlers
+}
+
+// Stream can be called to follow changes to inventory items as
+// server-sent events. The id of each event is that of the domain
+// event, so a client which reconnects with a Last-Event-ID header
+// resumes after the last event it saw. Otherwise, only events from
+// now on are sent.
+func (i *InventoryEventControllerImpl) Stream(request *Request) *Response {
+	// Find where to start
+	after, err := i.startAfter(request)
+	if err != nil {
+		return i.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return i.responseFactory.CreateStream(200, eventStreamContentType, func(w StreamWriter) {
+		// The status has been sent, so there is no one to tell if
+		// following fails - the client will reconnect.
+		i.feed.Follow(request.Context, after, func(msgs []outbox.Message) error {
+			return i.writeEvents(w, msgs)
+		})
+	})
+}
+
+func (i *InventoryEventControllerImpl) startAfter(request *Request) (entity.ID, error) {
+	if request.Header[lastEventIDHeader] != "" {
+		return i.parameterConverter.ToEntityID(request.Header, lastEventIDHeader)
+	}
+	return i.feed.Latest(request.Context)
+}
+
+// writeEvents writes each message as an event, or a comment if there
+// are none so that idle connections are kept open.
+func (i *InventoryEventControllerImpl) writeEvents(w StreamWriter, msgs []outbox.Message) error {
+	if len(msgs) == 0 {
+		if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+			return err
+		}
+	}
+	for _, msg := range msgs {
+		data, err := i.encoderService.FromOutboxMessage(msg)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", msg.ID, msg.Type, data); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return nil
+}
@@ -0,0 +1,31 @@
+package json
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// parseDate reads a date without a time, e.g. "2006-01-02". nil is
+// left as nil.
+func parseDate(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(entity.DateLayout, *value)
+	if err != nil {
+		return nil, commonerror.NewValidation(field, "must be a date like "+entity.DateLayout)
+	}
+	return &t, nil
+}
+
+// formatDate writes a date without a time, e.g. "2006-01-02". nil is
+// left as nil.
+func formatDate(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	str := value.Format(entity.DateLayout)
+	return &str
+}
@@ -4,13 +4,39 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 // DecoderService converts JSON to structs
 type DecoderService interface {
 	ToInventoryCreateItemVo(json []byte) (*inventory.CreateItemVO, error)
 	ToInventoryUpdateItemVo(json []byte) (*inventory.UpdateItemVO, error)
+	ToInventoryCheckoutVo(json []byte) (*inventory.CheckoutVO, error)
+	ToInventoryMoveVo(json []byte) (*inventory.MoveVO, error)
+	ToTitleCreateTitleVo(json []byte) (*title.CreateTitleVO, error)
+	ToTitleUpdateTitleVo(json []byte) (*title.UpdateTitleVO, error)
+	ToMediaFormatUpdateFormatVo(json []byte) (*mediaformat.UpdateFormatVO, error)
+	ToAccountCreateAccountVo(json []byte) (*account.CreateAccountVO, error)
+	ToAccountUpdateAccountVo(json []byte) (*account.UpdateAccountVO, error)
+	ToHoldPlaceHoldVo(json []byte) (*hold.PlaceHoldVO, error)
+	ToLedgerRecordPaymentVo(json []byte) (*ledger.RecordPaymentVO, error)
+	ToLedgerRecordAdjustmentVo(json []byte) (*ledger.RecordAdjustmentVO, error)
+	ToLocationCreateLocationVo(json []byte) (*location.CreateLocationVO, error)
+	ToWebhookCreateSubscriptionVo(json []byte) (*webhook.CreateSubscriptionVO, error)
+	ToGraphQLRequestVo(json []byte) (*graphql.RequestVO, error)
+	ToInventoryItemView(json []byte) (*inventory.ViewVO, error)
+	ToInventoryItemThinViews(json []byte) ([]inventory.ThinViewVO, error)
+	ToRentalReceiptLine(json []byte) (*rental.ReceiptLineVO, error)
 }
 
 // DecoderServiceImpl implements DecoderService
@@ -25,8 +51,10 @@ func NewDecoderServiceImpl() *DecoderServiceImpl {
 }
 
 type jsonCreateItemVO struct {
-	Name     string `json:"name"`
-	Location string `json:"location"`
+	TitleID  entity.ID     `json:"titleId"`
+	Format   entity.Format `json:"format"`
+	Barcode  string        `json:"barcode"`
+	Location string        `json:"location"`
 }
 
 // ToInventoryCreateItemVo parses JSON into a CreateItemVO
@@ -37,15 +65,19 @@ func (d *DecoderServiceImpl) ToInventoryCreateItemVo(bytes []byte) (*inventory.C
 	}
 
 	result := &inventory.CreateItemVO{
-		Name:     intermediary.Name,
+		TitleID:  intermediary.TitleID,
+		Format:   intermediary.Format,
+		Barcode:  intermediary.Barcode,
 		Location: intermediary.Location,
 	}
 	return result, nil
 }
 
 type jsonUpdateItemVO struct {
-	Name     string `json:"name"`
-	Location string `json:"location"`
+	TitleID  entity.ID     `json:"titleId"`
+	Format   entity.Format `json:"format"`
+	Barcode  string        `json:"barcode"`
+	Location string        `json:"location"`
 }
 
 // ToInventoryUpdateItemVo parses JSON into a CreateItemVO
@@ -56,8 +88,344 @@ func (d *DecoderServiceImpl) ToInventoryUpdateItemVo(bytes []byte) (*inventory.U
 	}
 
 	result := &inventory.UpdateItemVO{
+		TitleID:  intermediary.TitleID,
+		Format:   intermediary.Format,
+		Barcode:  intermediary.Barcode,
+		Location: intermediary.Location,
+	}
+	return result, nil
+}
+
+type jsonCheckoutVO struct {
+	AccountID entity.ID `json:"accountId"`
+}
+
+// ToInventoryCheckoutVo parses JSON into a CheckoutVO
+func (d *DecoderServiceImpl) ToInventoryCheckoutVo(bytes []byte) (*inventory.CheckoutVO, error) {
+	var intermediary jsonCheckoutVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to inventory checkout vo: %w", err)
+	}
+
+	result := &inventory.CheckoutVO{
+		AccountID: intermediary.AccountID,
+	}
+	return result, nil
+}
+
+type jsonMoveVO struct {
+	Location string `json:"location"`
+}
+
+// ToInventoryMoveVo parses JSON into a MoveVO
+func (d *DecoderServiceImpl) ToInventoryMoveVo(bytes []byte) (*inventory.MoveVO, error) {
+	var intermediary jsonMoveVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to inventory move vo: %w", err)
+	}
+
+	result := &inventory.MoveVO{
+		Location: intermediary.Location,
+	}
+	return result, nil
+}
+
+type jsonCreateTitleVO struct {
+	Name     string   `json:"title"`
+	Year     int      `json:"year"`
+	Runtime  int      `json:"runtime"`
+	Synopsis string   `json:"synopsis"`
+	Genres   []string `json:"genres"`
+	Cast     []string `json:"cast"`
+	Rating   string   `json:"rating"`
+}
+
+// ToTitleCreateTitleVo parses JSON into a CreateTitleVO
+func (d *DecoderServiceImpl) ToTitleCreateTitleVo(bytes []byte) (*title.CreateTitleVO, error) {
+	var intermediary jsonCreateTitleVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to title create title vo: %w", err)
+	}
+
+	result := &title.CreateTitleVO{
+		Name:     intermediary.Name,
+		Year:     intermediary.Year,
+		Runtime:  intermediary.Runtime,
+		Synopsis: intermediary.Synopsis,
+		Genres:   intermediary.Genres,
+		Cast:     intermediary.Cast,
+		Rating:   intermediary.Rating,
+	}
+	return result, nil
+}
+
+type jsonUpdateTitleVO struct {
+	Name     string   `json:"title"`
+	Year     int      `json:"year"`
+	Runtime  int      `json:"runtime"`
+	Synopsis string   `json:"synopsis"`
+	Genres   []string `json:"genres"`
+	Cast     []string `json:"cast"`
+	Rating   string   `json:"rating"`
+}
+
+// ToTitleUpdateTitleVo parses JSON into an UpdateTitleVO
+func (d *DecoderServiceImpl) ToTitleUpdateTitleVo(bytes []byte) (*title.UpdateTitleVO, error) {
+	var intermediary jsonUpdateTitleVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to title update title vo: %w", err)
+	}
+
+	result := &title.UpdateTitleVO{
 		Name:     intermediary.Name,
+		Year:     intermediary.Year,
+		Runtime:  intermediary.Runtime,
+		Synopsis: intermediary.Synopsis,
+		Genres:   intermediary.Genres,
+		Cast:     intermediary.Cast,
+		Rating:   intermediary.Rating,
+	}
+	return result, nil
+}
+
+type jsonUpdateFormatVO struct {
+	Name             string       `json:"name"`
+	RentalPrice      entity.Money `json:"rentalPriceCents"`
+	RentalPeriodDays int          `json:"rentalPeriodDays"`
+}
+
+// ToMediaFormatUpdateFormatVo parses JSON into an UpdateFormatVO
+func (d *DecoderServiceImpl) ToMediaFormatUpdateFormatVo(bytes []byte) (*mediaformat.UpdateFormatVO, error) {
+	var intermediary jsonUpdateFormatVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to media format update format vo: %w", err)
+	}
+
+	result := &mediaformat.UpdateFormatVO{
+		Name:             intermediary.Name,
+		RentalPrice:      intermediary.RentalPrice,
+		RentalPeriodDays: intermediary.RentalPeriodDays,
+	}
+	return result, nil
+}
+
+type jsonCreateAccountVO struct {
+	Name        string  `json:"name"`
+	DateOfBirth *string `json:"dateOfBirth"`
+}
+
+// ToAccountCreateAccountVo parses JSON into a CreateAccountVO
+func (d *DecoderServiceImpl) ToAccountCreateAccountVo(bytes []byte) (*account.CreateAccountVO, error) {
+	var intermediary jsonCreateAccountVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to account create account vo: %w", err)
+	}
+	dateOfBirth, err := parseDate("dateOfBirth", intermediary.DateOfBirth)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal to account create account vo: %w", err)
+	}
+
+	result := &account.CreateAccountVO{
+		Name:        intermediary.Name,
+		DateOfBirth: dateOfBirth,
+	}
+	return result, nil
+}
+
+type jsonUpdateAccountVO struct {
+	Name        string  `json:"name"`
+	DateOfBirth *string `json:"dateOfBirth"`
+}
+
+// ToAccountUpdateAccountVo parses JSON into an UpdateAccountVO
+func (d *DecoderServiceImpl) ToAccountUpdateAccountVo(bytes []byte) (*account.UpdateAccountVO, error) {
+	var intermediary jsonUpdateAccountVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to account update account vo: %w", err)
+	}
+	dateOfBirth, err := parseDate("dateOfBirth", intermediary.DateOfBirth)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal to account update account vo: %w", err)
+	}
+
+	result := &account.UpdateAccountVO{
+		Name:        intermediary.Name,
+		DateOfBirth: dateOfBirth,
+	}
+	return result, nil
+}
+
+type jsonPlaceHoldVO struct {
+	AccountID entity.ID `json:"accountId"`
+}
+
+// ToHoldPlaceHoldVo parses JSON into a PlaceHoldVO
+func (d *DecoderServiceImpl) ToHoldPlaceHoldVo(bytes []byte) (*hold.PlaceHoldVO, error) {
+	var intermediary jsonPlaceHoldVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to hold place hold vo: %w", err)
+	}
+
+	result := &hold.PlaceHoldVO{
+		AccountID: intermediary.AccountID,
+	}
+	return result, nil
+}
+
+type jsonRecordPaymentVO struct {
+	Amount entity.Money         `json:"amountCents"`
+	Method entity.PaymentMethod `json:"method"`
+}
+
+// ToLedgerRecordPaymentVo parses JSON into a RecordPaymentVO
+func (d *DecoderServiceImpl) ToLedgerRecordPaymentVo(bytes []byte) (*ledger.RecordPaymentVO, error) {
+	var intermediary jsonRecordPaymentVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to ledger record payment vo: %w", err)
+	}
+
+	result := &ledger.RecordPaymentVO{
+		Amount: intermediary.Amount,
+		Method: intermediary.Method,
+	}
+	return result, nil
+}
+
+type jsonRecordAdjustmentVO struct {
+	Amount entity.Money `json:"amountCents"`
+	Note   string       `json:"note"`
+}
+
+// ToLedgerRecordAdjustmentVo parses JSON into a RecordAdjustmentVO
+func (d *DecoderServiceImpl) ToLedgerRecordAdjustmentVo(bytes []byte) (*ledger.RecordAdjustmentVO, error) {
+	var intermediary jsonRecordAdjustmentVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to ledger record adjustment vo: %w", err)
+	}
+
+	result := &ledger.RecordAdjustmentVO{
+		Amount: intermediary.Amount,
+		Note:   intermediary.Note,
+	}
+	return result, nil
+}
+
+type jsonCreateLocationVO struct {
+	Location string `json:"location"`
+}
+
+// ToLocationCreateLocationVo parses JSON into a CreateLocationVO
+func (d *DecoderServiceImpl) ToLocationCreateLocationVo(bytes []byte) (*location.CreateLocationVO, error) {
+	var intermediary jsonCreateLocationVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to location create location vo: %w", err)
+	}
+
+	result := &location.CreateLocationVO{
 		Location: intermediary.Location,
 	}
 	return result, nil
 }
+
+type jsonCreateSubscriptionVO struct {
+	URL        string             `json:"url"`
+	EventTypes []entity.EventType `json:"eventTypes"`
+	Secret     string             `json:"secret"`
+}
+
+// ToWebhookCreateSubscriptionVo parses JSON into a CreateSubscriptionVO
+func (d *DecoderServiceImpl) ToWebhookCreateSubscriptionVo(bytes []byte) (*webhook.CreateSubscriptionVO, error) {
+	var intermediary jsonCreateSubscriptionVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to webhook create subscription vo: %w", err)
+	}
+
+	result := &webhook.CreateSubscriptionVO{
+		URL:        intermediary.URL,
+		EventTypes: intermediary.EventTypes,
+		Secret:     intermediary.Secret,
+	}
+	return result, nil
+}
+
+type jsonGraphQLRequestVO struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ToGraphQLRequestVo parses JSON into a graphql RequestVO
+func (d *DecoderServiceImpl) ToGraphQLRequestVo(bytes []byte) (*graphql.RequestVO, error) {
+	var intermediary jsonGraphQLRequestVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to graphql request vo: %w", err)
+	}
+
+	result := &graphql.RequestVO{
+		Query:         intermediary.Query,
+		OperationName: intermediary.OperationName,
+		Variables:     intermediary.Variables,
+	}
+	return result, nil
+}
+
+// ToInventoryItemView parses JSON into a ViewVO, e.g. as given by a
+// server
+func (d *DecoderServiceImpl) ToInventoryItemView(bytes []byte) (*inventory.ViewVO, error) {
+	var intermediary jsonViewVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to inventory item view: %w", err)
+	}
+
+	result := &inventory.ViewVO{
+		ID:        intermediary.ID,
+		TitleID:   intermediary.TitleID,
+		Format:    intermediary.Format,
+		Barcode:   intermediary.Barcode,
+		Location:  intermediary.Location,
+		Available: intermediary.Available,
+		DueAt:     intermediary.DueAt,
+		Overdue:   intermediary.Overdue,
+	}
+	return result, nil
+}
+
+// ToInventoryItemThinViews parses JSON into ThinViewVOs, e.g. as given
+// by a server
+func (d *DecoderServiceImpl) ToInventoryItemThinViews(bytes []byte) ([]inventory.ThinViewVO, error) {
+	var intermediaries []jsonThinViewVO
+	if err := json.Unmarshal(bytes, &intermediaries); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to inventory item views: %w", err)
+	}
+
+	result := make([]inventory.ThinViewVO, len(intermediaries))
+	for i, intermediary := range intermediaries {
+		result[i] = inventory.ThinViewVO{
+			ID:      intermediary.ID,
+			TitleID: intermediary.TitleID,
+			Format:  intermediary.Format,
+			Barcode: intermediary.Barcode,
+		}
+	}
+	return result, nil
+}
+
+// ToRentalReceiptLine parses JSON into a ReceiptLineVO, e.g. as given
+// by a server
+func (d *DecoderServiceImpl) ToRentalReceiptLine(bytes []byte) (*rental.ReceiptLineVO, error) {
+	var intermediary jsonRentalReceiptLineVO
+	if err := json.Unmarshal(bytes, &intermediary); err != nil {
+		return nil, fmt.Errorf("could not unmarshal to rental receipt line: %w", err)
+	}
+
+	result := &rental.ReceiptLineVO{
+		RentalID:   intermediary.RentalID,
+		ItemID:     intermediary.ItemID,
+		AccountID:  intermediary.AccountID,
+		DueAt:      intermediary.DueAt,
+		ReturnedAt: intermediary.ReturnedAt,
+		DaysLate:   intermediary.DaysLate,
+		LateFee:    intermediary.LateFee,
+	}
+	return result, nil
+}
@@ -3,15 +3,47 @@ package json
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 // EncoderService converts items to JSON
 type EncoderService interface {
 	FromInventoryItemView(*inventory.ViewVO) ([]byte, error)
 	FromInventoryItemThinViews([]inventory.ThinViewVO) ([]byte, error)
+	FromInventoryMoveViews([]inventory.MoveViewVO) ([]byte, error)
+	FromInventoryShelfView(*inventory.ShelfViewVO) ([]byte, error)
+	FromTitleView(*title.ViewVO) ([]byte, error)
+	FromTitleThinViews([]title.ThinViewVO) ([]byte, error)
+	FromMediaFormatView(*mediaformat.ViewVO) ([]byte, error)
+	FromMediaFormatViews([]mediaformat.ViewVO) ([]byte, error)
+	FromAccountView(*account.ViewVO) ([]byte, error)
+	FromAccountThinViews([]account.ThinViewVO) ([]byte, error)
+	FromRentalViews([]rental.ViewVO) ([]byte, error)
+	FromRentalReceiptLine(*rental.ReceiptLineVO) ([]byte, error)
+	FromRentalRenewalReceiptLine(*rental.RenewalReceiptLineVO) ([]byte, error)
+	FromHoldViews([]hold.ViewVO) ([]byte, error)
+	FromLedgerStatement(*ledger.StatementVO) ([]byte, error)
+	FromLocationView(*location.ViewVO) ([]byte, error)
+	FromLocationViews([]location.ViewVO) ([]byte, error)
+	FromWebhookSubscriptionView(*webhook.SubscriptionViewVO) ([]byte, error)
+	FromWebhookSubscriptionViews([]webhook.SubscriptionViewVO) ([]byte, error)
+	FromWebhookDeliveryViews([]webhook.DeliveryViewVO) ([]byte, error)
+	FromOutboxMessage(outbox.Message) ([]byte, error)
+	FromInventoryCreateItemVo(*inventory.CreateItemVO) ([]byte, error)
+	FromInventoryUpdateItemVo(*inventory.UpdateItemVO) ([]byte, error)
+	FromInventoryCheckoutVo(*inventory.CheckoutVO) ([]byte, error)
 }
 
 // EncoderServiceImpl implements EncoderService
@@ -26,17 +58,178 @@ func NewEncoderServiceImpl() *EncoderServiceImpl {
 }
 
 type jsonViewVO struct {
-	ID        entity.ID `json:"id"`
-	Name      string    `json:"name"`
-	Location  string    `json:"location"`
-	Available bool      `json:"available"`
+	ID        entity.ID     `json:"id"`
+	TitleID   entity.ID     `json:"titleId"`
+	Format    entity.Format `json:"format"`
+	Barcode   string        `json:"barcode"`
+	Location  string        `json:"location"`
+	Available bool          `json:"available"`
+	DueAt     *time.Time    `json:"dueAt"`
+	Overdue   bool          `json:"overdue"`
 }
 
 type jsonThinViewVO struct {
+	ID      entity.ID     `json:"id"`
+	TitleID entity.ID     `json:"titleId"`
+	Format  entity.Format `json:"format"`
+	Barcode string        `json:"barcode"`
+}
+
+type jsonMoveViewVO struct {
+	ItemID  entity.ID `json:"itemId"`
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+	MovedAt time.Time `json:"movedAt"`
+}
+
+type jsonShelfViewVO struct {
+	Store string           `json:"store"`
+	Aisle string           `json:"aisle"`
+	Shelf string           `json:"shelf"`
+	Slots []jsonSlotViewVO `json:"slots"`
+}
+
+type jsonSlotViewVO struct {
+	Slot     string           `json:"slot"`
+	Location string           `json:"location"`
+	Items    []jsonThinViewVO `json:"items"`
+}
+
+type jsonLocationViewVO struct {
+	Location string `json:"location"`
+	Store    string `json:"store"`
+	Aisle    string `json:"aisle"`
+	Shelf    string `json:"shelf"`
+	Slot     string `json:"slot"`
+}
+
+type jsonTitleViewVO struct {
+	ID              entity.ID `json:"id"`
+	Name            string    `json:"title"`
+	Year            int       `json:"year"`
+	Runtime         int       `json:"runtime"`
+	Synopsis        string    `json:"synopsis"`
+	Genres          []string  `json:"genres"`
+	Cast            []string  `json:"cast"`
+	Rating          string    `json:"rating"`
+	Copies          int       `json:"copies"`
+	AvailableCopies int       `json:"availableCopies"`
+}
+
+type jsonMediaFormatViewVO struct {
+	Format           entity.Format `json:"format"`
+	Name             string        `json:"name"`
+	RentalPrice      entity.Money  `json:"rentalPriceCents"`
+	RentalPeriodDays int           `json:"rentalPeriodDays"`
+	Copies           int           `json:"copies"`
+	AvailableCopies  int           `json:"availableCopies"`
+}
+
+type jsonTitleThinViewVO struct {
+	ID              entity.ID `json:"id"`
+	Name            string    `json:"title"`
+	Year            int       `json:"year"`
+	Copies          int       `json:"copies"`
+	AvailableCopies int       `json:"availableCopies"`
+}
+
+type jsonAccountViewVO struct {
+	ID          entity.ID          `json:"id"`
+	Name        string             `json:"name"`
+	DateOfBirth *string            `json:"dateOfBirth"`
+	Balance     entity.Money       `json:"balanceCents"`
+	Rentals     []jsonRentalViewVO `json:"rentals"`
+	Overdue     bool               `json:"overdue"`
+}
+
+type jsonAccountThinViewVO struct {
 	ID   entity.ID `json:"id"`
 	Name string    `json:"name"`
 }
 
+type jsonRentalViewVO struct {
+	ID           entity.ID `json:"id"`
+	ItemID       entity.ID `json:"itemId"`
+	AccountID    entity.ID `json:"accountId"`
+	CheckedOutAt time.Time `json:"checkedOutAt"`
+	DueAt        time.Time `json:"dueAt"`
+	Overdue      bool      `json:"overdue"`
+}
+
+type jsonRentalReceiptLineVO struct {
+	RentalID   entity.ID    `json:"rentalId"`
+	ItemID     entity.ID    `json:"itemId"`
+	AccountID  entity.ID    `json:"accountId"`
+	DueAt      time.Time    `json:"dueAt"`
+	ReturnedAt time.Time    `json:"returnedAt"`
+	DaysLate   int          `json:"daysLate"`
+	LateFee    entity.Money `json:"lateFeeCents"`
+}
+
+type jsonRentalRenewalReceiptLineVO struct {
+	RentalID   entity.ID    `json:"rentalId"`
+	ItemID     entity.ID    `json:"itemId"`
+	AccountID  entity.ID    `json:"accountId"`
+	DueAt      time.Time    `json:"dueAt"`
+	Renewals   int          `json:"renewals"`
+	RenewalFee entity.Money `json:"renewalFeeCents"`
+}
+
+type jsonWebhookSubscriptionViewVO struct {
+	ID         entity.ID          `json:"id"`
+	URL        string             `json:"url"`
+	EventTypes []entity.EventType `json:"eventTypes"`
+	CreatedAt  time.Time          `json:"createdAt"`
+}
+
+type jsonWebhookDeliveryViewVO struct {
+	ID             entity.ID             `json:"id"`
+	SubscriptionID entity.ID             `json:"subscriptionId"`
+	EventID        entity.ID             `json:"eventId"`
+	EventType      entity.EventType      `json:"eventType"`
+	EntityID       entity.ID             `json:"entityId"`
+	Status         entity.DeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time            `json:"lastAttemptAt"`
+	LastStatusCode *int                  `json:"lastStatusCode"`
+	LastError      string                `json:"lastError"`
+}
+
+type jsonOutboxMessage struct {
+	ID         entity.ID              `json:"id"`
+	Type       entity.EventType       `json:"type"`
+	EntityID   entity.ID              `json:"entityId"`
+	OccurredAt time.Time              `json:"occurredAt"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+type jsonLedgerStatementVO struct {
+	AccountID entity.ID               `json:"accountId"`
+	Balance   entity.Money            `json:"balanceCents"`
+	Entries   []jsonLedgerEntryViewVO `json:"entries"`
+}
+
+type jsonLedgerEntryViewVO struct {
+	ID         entity.ID              `json:"id"`
+	Kind       entity.LedgerEntryKind `json:"kind"`
+	Amount     entity.Money           `json:"amountCents"`
+	Method     *entity.PaymentMethod  `json:"method"`
+	RentalID   *entity.ID             `json:"rentalId"`
+	Note       string                 `json:"note"`
+	RecordedAt time.Time              `json:"recordedAt"`
+}
+
+type jsonHoldViewVO struct {
+	ID        entity.ID         `json:"id"`
+	TitleID   entity.ID         `json:"titleId"`
+	AccountID entity.ID         `json:"accountId"`
+	PlacedAt  time.Time         `json:"placedAt"`
+	Status    entity.HoldStatus `json:"status"`
+	ItemID    *entity.ID        `json:"itemId"`
+	ExpiresAt *time.Time        `json:"expiresAt"`
+}
+
 // FromInventoryItemView converts a view to JSON
 func (e *EncoderServiceImpl) FromInventoryItemView(view *inventory.ViewVO) ([]byte, error) {
 	intermediary := mapViewIntermediary(view)
@@ -63,18 +256,548 @@ func (e *EncoderServiceImpl) FromInventoryItemThinViews(views []inventory.ThinVi
 	return bytes, nil
 }
 
+// FromInventoryMoveViews converts views to JSON
+func (e *EncoderServiceImpl) FromInventoryMoveViews(views []inventory.MoveViewVO) ([]byte, error) {
+	intermediaries := make([]jsonMoveViewVO, 0)
+	for _, view := range views {
+		intermediaries = append(intermediaries, jsonMoveViewVO{
+			ItemID:  view.ItemID,
+			From:    view.From,
+			To:      view.To,
+			MovedAt: view.MovedAt,
+		})
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert inventory move views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromInventoryShelfView converts a view to JSON
+func (e *EncoderServiceImpl) FromInventoryShelfView(view *inventory.ShelfViewVO) ([]byte, error) {
+	intermediary := mapShelfViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert inventory shelf view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromTitleView converts a view to JSON
+func (e *EncoderServiceImpl) FromTitleView(view *title.ViewVO) ([]byte, error) {
+	intermediary := mapTitleViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert title view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromTitleThinViews converts views to JSON
+func (e *EncoderServiceImpl) FromTitleThinViews(views []title.ThinViewVO) ([]byte, error) {
+	intermediaries := make([]jsonTitleThinViewVO, 0)
+	for _, view := range views {
+		intermediary := mapTitleThinViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert title views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromMediaFormatView converts a view to JSON
+func (e *EncoderServiceImpl) FromMediaFormatView(view *mediaformat.ViewVO) ([]byte, error) {
+	intermediary := mapMediaFormatViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert media format view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromMediaFormatViews converts views to JSON
+func (e *EncoderServiceImpl) FromMediaFormatViews(views []mediaformat.ViewVO) ([]byte, error) {
+	intermediaries := make([]jsonMediaFormatViewVO, 0)
+	for _, view := range views {
+		intermediary := mapMediaFormatViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert media format views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromAccountView converts a view to JSON
+func (e *EncoderServiceImpl) FromAccountView(view *account.ViewVO) ([]byte, error) {
+	intermediary := mapAccountViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert account view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromAccountThinViews converts views to JSON
+func (e *EncoderServiceImpl) FromAccountThinViews(views []account.ThinViewVO) ([]byte, error) {
+	intermediaries := make([]jsonAccountThinViewVO, 0)
+	for _, view := range views {
+		intermediary := mapAccountThinViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert account views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromRentalViews converts views to JSON
+func (e *EncoderServiceImpl) FromRentalViews(views []rental.ViewVO) ([]byte, error) {
+	intermediaries := mapRentalViewIntermediaries(views)
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert rental views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromRentalReceiptLine converts a receipt line to JSON
+func (e *EncoderServiceImpl) FromRentalReceiptLine(line *rental.ReceiptLineVO) ([]byte, error) {
+	intermediary := mapRentalReceiptLineIntermediary(line)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert rental receipt line to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromRentalRenewalReceiptLine converts a renewal receipt line to JSON
+func (e *EncoderServiceImpl) FromRentalRenewalReceiptLine(line *rental.RenewalReceiptLineVO) ([]byte, error) {
+	intermediary := mapRentalRenewalReceiptLineIntermediary(line)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert rental renewal receipt line to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromHoldViews converts views to JSON
+func (e *EncoderServiceImpl) FromHoldViews(views []hold.ViewVO) ([]byte, error) {
+	intermediaries := make([]jsonHoldViewVO, 0)
+	for _, view := range views {
+		intermediary := mapHoldViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert hold views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromLedgerStatement converts a statement to JSON
+func (e *EncoderServiceImpl) FromLedgerStatement(statement *ledger.StatementVO) ([]byte, error) {
+	intermediary := mapLedgerStatementIntermediary(statement)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert ledger statement to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromLocationView converts a view to JSON
+func (e *EncoderServiceImpl) FromLocationView(view *location.ViewVO) ([]byte, error) {
+	intermediary := mapLocationViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert location view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromLocationViews converts views to JSON
+func (e *EncoderServiceImpl) FromLocationViews(views []location.ViewVO) ([]byte, error) {
+	intermediaries := make([]jsonLocationViewVO, 0)
+	for _, view := range views {
+		intermediary := mapLocationViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert location views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromWebhookSubscriptionView converts a view to JSON
+func (e *EncoderServiceImpl) FromWebhookSubscriptionView(view *webhook.SubscriptionViewVO) ([]byte, error) {
+	intermediary := mapWebhookSubscriptionViewIntermediary(view)
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert webhook subscription view to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromWebhookSubscriptionViews converts views to JSON
+func (e *EncoderServiceImpl) FromWebhookSubscriptionViews(views []webhook.SubscriptionViewVO) ([]byte, error) {
+	intermediaries := make([]jsonWebhookSubscriptionViewVO, 0)
+	for _, view := range views {
+		intermediary := mapWebhookSubscriptionViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert webhook subscription views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromWebhookDeliveryViews converts views to JSON
+func (e *EncoderServiceImpl) FromWebhookDeliveryViews(views []webhook.DeliveryViewVO) ([]byte, error) {
+	intermediaries := make([]jsonWebhookDeliveryViewVO, 0)
+	for _, view := range views {
+		intermediary := mapWebhookDeliveryViewIntermediary(&view)
+		intermediaries = append(intermediaries, *intermediary)
+	}
+
+	bytes, err := json.Marshal(intermediaries)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert webhook delivery views to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromOutboxMessage converts an event to JSON, in the same form as it
+// is published to sinks
+func (e *EncoderServiceImpl) FromOutboxMessage(msg outbox.Message) ([]byte, error) {
+	data := msg.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	intermediary := &jsonOutboxMessage{
+		ID:         msg.ID,
+		Type:       msg.Type,
+		EntityID:   msg.EntityID,
+		OccurredAt: msg.OccurredAt,
+		Data:       data,
+	}
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert outbox message to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromInventoryCreateItemVo converts a vo to JSON, e.g. to send it
+// to a server
+func (e *EncoderServiceImpl) FromInventoryCreateItemVo(vo *inventory.CreateItemVO) ([]byte, error) {
+	intermediary := &jsonCreateItemVO{
+		TitleID:  vo.TitleID,
+		Format:   vo.Format,
+		Barcode:  vo.Barcode,
+		Location: vo.Location,
+	}
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert inventory create item vo to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromInventoryUpdateItemVo converts a vo to JSON, e.g. to send it
+// to a server
+func (e *EncoderServiceImpl) FromInventoryUpdateItemVo(vo *inventory.UpdateItemVO) ([]byte, error) {
+	intermediary := &jsonUpdateItemVO{
+		TitleID:  vo.TitleID,
+		Format:   vo.Format,
+		Barcode:  vo.Barcode,
+		Location: vo.Location,
+	}
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert inventory update item vo to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
+// FromInventoryCheckoutVo converts a vo to JSON, e.g. to send it
+// to a server
+func (e *EncoderServiceImpl) FromInventoryCheckoutVo(vo *inventory.CheckoutVO) ([]byte, error) {
+	intermediary := &jsonCheckoutVO{
+		AccountID: vo.AccountID,
+	}
+
+	bytes, err := json.Marshal(intermediary)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert inventory checkout vo to json - marshal error: %w", err)
+	}
+	return bytes, nil
+}
+
 func mapViewIntermediary(view *inventory.ViewVO) *jsonViewVO {
 	return &jsonViewVO{
 		ID:        view.ID,
-		Name:      view.Name,
+		TitleID:   view.TitleID,
+		Format:    view.Format,
+		Barcode:   view.Barcode,
 		Location:  view.Location,
 		Available: view.Available,
+		DueAt:     view.DueAt,
+		Overdue:   view.Overdue,
 	}
 }
 
 func mapThinViewIntermediary(view *inventory.ThinViewVO) *jsonThinViewVO {
 	return &jsonThinViewVO{
+		ID:      view.ID,
+		TitleID: view.TitleID,
+		Format:  view.Format,
+		Barcode: view.Barcode,
+	}
+}
+
+func mapShelfViewIntermediary(view *inventory.ShelfViewVO) *jsonShelfViewVO {
+	slots := make([]jsonSlotViewVO, 0)
+	for _, slot := range view.Slots {
+		items := make([]jsonThinViewVO, 0)
+		for _, item := range slot.Items {
+			items = append(items, *mapThinViewIntermediary(&item))
+		}
+		slots = append(slots, jsonSlotViewVO{
+			Slot:     slot.Slot,
+			Location: slot.Location,
+			Items:    items,
+		})
+	}
+	return &jsonShelfViewVO{
+		Store: view.Store,
+		Aisle: view.Aisle,
+		Shelf: view.Shelf,
+		Slots: slots,
+	}
+}
+
+func mapLocationViewIntermediary(view *location.ViewVO) *jsonLocationViewVO {
+	return &jsonLocationViewVO{
+		Location: view.Location,
+		Store:    view.Store,
+		Aisle:    view.Aisle,
+		Shelf:    view.Shelf,
+		Slot:     view.Slot,
+	}
+}
+
+func mapTitleViewIntermediary(view *title.ViewVO) *jsonTitleViewVO {
+	return &jsonTitleViewVO{
+		ID:              view.ID,
+		Name:            view.Name,
+		Year:            view.Year,
+		Runtime:         view.Runtime,
+		Synopsis:        view.Synopsis,
+		Genres:          nonNilStrings(view.Genres),
+		Cast:            nonNilStrings(view.Cast),
+		Rating:          view.Rating,
+		Copies:          view.Copies,
+		AvailableCopies: view.AvailableCopies,
+	}
+}
+
+func mapTitleThinViewIntermediary(view *title.ThinViewVO) *jsonTitleThinViewVO {
+	return &jsonTitleThinViewVO{
+		ID:              view.ID,
+		Name:            view.Name,
+		Year:            view.Year,
+		Copies:          view.Copies,
+		AvailableCopies: view.AvailableCopies,
+	}
+}
+
+func mapMediaFormatViewIntermediary(view *mediaformat.ViewVO) *jsonMediaFormatViewVO {
+	return &jsonMediaFormatViewVO{
+		Format:           view.Format,
+		Name:             view.Name,
+		RentalPrice:      view.RentalPrice,
+		RentalPeriodDays: view.RentalPeriodDays,
+		Copies:           view.Copies,
+		AvailableCopies:  view.AvailableCopies,
+	}
+}
+
+func mapAccountViewIntermediary(view *account.ViewVO) *jsonAccountViewVO {
+	return &jsonAccountViewVO{
+		ID:          view.ID,
+		Name:        view.Name,
+		DateOfBirth: formatDate(view.DateOfBirth),
+		Balance:     view.Balance,
+		Rentals:     mapRentalViewIntermediaries(view.Rentals),
+		Overdue:     view.Overdue,
+	}
+}
+
+func mapAccountThinViewIntermediary(view *account.ThinViewVO) *jsonAccountThinViewVO {
+	return &jsonAccountThinViewVO{
 		ID:   view.ID,
 		Name: view.Name,
 	}
 }
+
+func mapRentalViewIntermediaries(views []rental.ViewVO) []jsonRentalViewVO {
+	intermediaries := make([]jsonRentalViewVO, 0)
+	for _, view := range views {
+		intermediaries = append(intermediaries, jsonRentalViewVO{
+			ID:           view.ID,
+			ItemID:       view.ItemID,
+			AccountID:    view.AccountID,
+			CheckedOutAt: view.CheckedOutAt,
+			DueAt:        view.DueAt,
+			Overdue:      view.Overdue,
+		})
+	}
+	return intermediaries
+}
+
+func mapRentalReceiptLineIntermediary(line *rental.ReceiptLineVO) *jsonRentalReceiptLineVO {
+	return &jsonRentalReceiptLineVO{
+		RentalID:   line.RentalID,
+		ItemID:     line.ItemID,
+		AccountID:  line.AccountID,
+		DueAt:      line.DueAt,
+		ReturnedAt: line.ReturnedAt,
+		DaysLate:   line.DaysLate,
+		LateFee:    line.LateFee,
+	}
+}
+
+func mapRentalRenewalReceiptLineIntermediary(line *rental.RenewalReceiptLineVO) *jsonRentalRenewalReceiptLineVO {
+	return &jsonRentalRenewalReceiptLineVO{
+		RentalID:   line.RentalID,
+		ItemID:     line.ItemID,
+		AccountID:  line.AccountID,
+		DueAt:      line.DueAt,
+		Renewals:   line.Renewals,
+		RenewalFee: line.RenewalFee,
+	}
+}
+
+func mapHoldViewIntermediary(view *hold.ViewVO) *jsonHoldViewVO {
+	// Copies are only put aside once a hold is ready
+	var itemID *entity.ID
+	if view.ItemID != entity.InvalidID {
+		id := view.ItemID
+		itemID = &id
+	}
+	return &jsonHoldViewVO{
+		ID:        view.ID,
+		TitleID:   view.TitleID,
+		AccountID: view.AccountID,
+		PlacedAt:  view.PlacedAt,
+		Status:    view.Status,
+		ItemID:    itemID,
+		ExpiresAt: view.ExpiresAt,
+	}
+}
+
+// nonNilStrings makes sure empty lists are encoded as [] rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func mapLedgerStatementIntermediary(statement *ledger.StatementVO) *jsonLedgerStatementVO {
+	entries := make([]jsonLedgerEntryViewVO, 0)
+	for _, view := range statement.Entries {
+		intermediary := mapLedgerEntryViewIntermediary(&view)
+		entries = append(entries, *intermediary)
+	}
+	return &jsonLedgerStatementVO{
+		AccountID: statement.AccountID,
+		Balance:   statement.Balance,
+		Entries:   entries,
+	}
+}
+
+func mapLedgerEntryViewIntermediary(view *ledger.ViewVO) *jsonLedgerEntryViewVO {
+	// Only payments have a method, and only charges a rental
+	var method *entity.PaymentMethod
+	if view.Method != "" {
+		m := view.Method
+		method = &m
+	}
+	var rentalID *entity.ID
+	if view.RentalID != entity.InvalidID {
+		id := view.RentalID
+		rentalID = &id
+	}
+	return &jsonLedgerEntryViewVO{
+		ID:         view.ID,
+		Kind:       view.Kind,
+		Amount:     view.Amount,
+		Method:     method,
+		RentalID:   rentalID,
+		Note:       view.Note,
+		RecordedAt: view.RecordedAt,
+	}
+}
+
+func mapWebhookSubscriptionViewIntermediary(view *webhook.SubscriptionViewVO) *jsonWebhookSubscriptionViewVO {
+	eventTypes := view.EventTypes
+	if eventTypes == nil {
+		eventTypes = []entity.EventType{}
+	}
+	return &jsonWebhookSubscriptionViewVO{
+		ID:         view.ID,
+		URL:        view.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  view.CreatedAt,
+	}
+}
+
+func mapWebhookDeliveryViewIntermediary(view *webhook.DeliveryViewVO) *jsonWebhookDeliveryViewVO {
+	// Only attempts which got a response have a status code
+	var lastStatusCode *int
+	if view.LastStatusCode != 0 {
+		code := view.LastStatusCode
+		lastStatusCode = &code
+	}
+	return &jsonWebhookDeliveryViewVO{
+		ID:             view.ID,
+		SubscriptionID: view.SubscriptionID,
+		EventID:        view.EventID,
+		EventType:      view.EventType,
+		EntityID:       view.EntityID,
+		Status:         view.Status,
+		Attempts:       view.Attempts,
+		NextAttemptAt:  view.NextAttemptAt,
+		LastAttemptAt:  view.LastAttemptAt,
+		LastStatusCode: lastStatusCode,
+		LastError:      view.LastError,
+	}
+}
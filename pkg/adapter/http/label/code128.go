@@ -0,0 +1,55 @@
+package label
+
+import "fmt"
+
+// code128Patterns are the widths of the alternating bars and spaces of
+// each Code 128 symbol, by value. The last one is the stop symbol.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128StartB = 104
+	code128Stop   = 106
+)
+
+// EncodeCode128 encodes data as a Code 128 barcode, using code set B.
+// Each module of the barcode is true if it is a bar, and false if it is
+// a space. Quiet zones are not included.
+func EncodeCode128(data string) ([]bool, error) {
+	values := []int{code128StartB}
+	checksum := code128StartB
+	position := 1
+	for _, r := range data {
+		if r < ' ' || r > '~' {
+			return nil, fmt.Errorf("could not encode code 128 - %q is not in code set B", r)
+		}
+		value := int(r - ' ')
+		values = append(values, value)
+		checksum += value * position
+		position++
+	}
+	values = append(values, checksum%103, code128Stop)
+
+	var modules []bool
+	for _, value := range values {
+		bar := true
+		for _, width := range code128Patterns[value] {
+			for i := 0; i < int(width-'0'); i++ {
+				modules = append(modules, bar)
+			}
+			bar = !bar
+		}
+	}
+	return modules, nil
+}
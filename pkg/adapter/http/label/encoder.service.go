@@ -0,0 +1,47 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// EncoderService renders labels to print
+type EncoderService interface {
+	FromInventoryLabels(Format, []inventory.LabelVO) ([]byte, error)
+}
+
+// EncoderServiceImpl implements EncoderService
+type EncoderServiceImpl struct{}
+
+// Check we implement the interface
+var _ EncoderService = &EncoderServiceImpl{}
+
+// NewEncoderServiceImpl is a constructor
+func NewEncoderServiceImpl() *EncoderServiceImpl {
+	return &EncoderServiceImpl{}
+}
+
+// FromInventoryLabels renders the labels of inventory items in a format.
+// Each label has a Code 128 barcode, with the title above it and the
+// location below it.
+func (e *EncoderServiceImpl) FromInventoryLabels(format Format, vos []inventory.LabelVO) ([]byte, error) {
+	var layouts []*layout
+	for _, vo := range vos {
+		l, err := newLayout(vo)
+		if err != nil {
+			return nil, fmt.Errorf("could not render labels - layout error: %w", err)
+		}
+		layouts = append(layouts, l)
+	}
+
+	switch format {
+	case FormatPNG:
+		return renderPNG(layouts)
+	case FormatPDF:
+		return renderPDF(layouts)
+	case FormatZPL:
+		return renderZPL(layouts)
+	}
+	return nil, fmt.Errorf("could not render labels - unknown format %q", format)
+}
@@ -0,0 +1,35 @@
+package label
+
+import "github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+
+// Format is a file format labels may be rendered in.
+type Format string
+
+// The formats we render labels in.
+const (
+	FormatPNG Format = "png"
+	FormatPDF Format = "pdf"
+	FormatZPL Format = "zpl"
+)
+
+// ParseFormat returns the Format named by str, or an error if it is not
+// one we render.
+func ParseFormat(str string) (Format, error) {
+	switch Format(str) {
+	case FormatPNG, FormatPDF, FormatZPL:
+		return Format(str), nil
+	}
+	return "", commonerror.NewValidation("format", "must be one of png, pdf or zpl")
+}
+
+// ContentType returns the media type of files in the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatZPL:
+		return "application/zpl"
+	default:
+		return "image/png"
+	}
+}
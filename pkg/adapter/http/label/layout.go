@@ -0,0 +1,96 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// Labels are 3 by 1 inches. Everything on them is laid out in the dots
+// of a 203 dpi thermal printer, measured from the top left.
+const (
+	dotsPerInch = 203
+	labelWidth  = 609
+	labelHeight = 203
+	margin      = 16
+
+	titleY          = 12
+	titleSize       = 24
+	barsY           = 44
+	barsHeight      = 90
+	barcodeTextY    = 140
+	barcodeTextSize = 20
+	locationY       = 170
+	locationSize    = 22
+
+	maxTitleLength = 40
+	quietZone      = 10
+	maxModuleWidth = 3
+)
+
+// layout is where the parts of a label go.
+type layout struct {
+	title       string
+	barcode     string
+	location    string
+	modules     []bool
+	moduleWidth int
+	barsX       int
+}
+
+// bar is a run of bar modules.
+type bar struct {
+	x     int
+	width int
+}
+
+func newLayout(vo inventory.LabelVO) (*layout, error) {
+	modules, err := EncodeCode128(vo.Barcode)
+	if err != nil {
+		return nil, fmt.Errorf("could not lay out label for item %d - encode error: %w", vo.ItemID, err)
+	}
+
+	// Use the widest modules which fit, quiet zones included.
+	moduleWidth := (labelWidth - 2*margin) / (len(modules) + 2*quietZone)
+	if moduleWidth < 1 {
+		return nil, fmt.Errorf("could not lay out label for item %d - barcode is too long", vo.ItemID)
+	}
+	if moduleWidth > maxModuleWidth {
+		moduleWidth = maxModuleWidth
+	}
+
+	return &layout{
+		title:       truncate(vo.Title, maxTitleLength),
+		barcode:     vo.Barcode,
+		location:    vo.Location,
+		modules:     modules,
+		moduleWidth: moduleWidth,
+		barsX:       (labelWidth - len(modules)*moduleWidth) / 2,
+	}, nil
+}
+
+func (l *layout) bars() []bar {
+	var bars []bar
+	for i := 0; i < len(l.modules); i++ {
+		if !l.modules[i] {
+			continue
+		}
+		start := i
+		for i+1 < len(l.modules) && l.modules[i+1] {
+			i++
+		}
+		bars = append(bars, bar{
+			x:     l.barsX + start*l.moduleWidth,
+			width: (i - start + 1) * l.moduleWidth,
+		})
+	}
+	return bars
+}
+
+func truncate(str string, length int) string {
+	runes := []rune(str)
+	if len(runes) <= length {
+		return str
+	}
+	return string(runes[:length-3]) + "..."
+}
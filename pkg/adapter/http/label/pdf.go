@@ -0,0 +1,144 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Labels are printed on A4 sticker sheets of 2 columns and 10 rows,
+// measured in points from the bottom left.
+const (
+	pageWidth     = 595
+	pageHeight    = 842
+	sheetColumns  = 2
+	sheetRows     = 10
+	pointsPerInch = 72
+
+	// Courier glyphs are all 600 thousandths of the font size wide.
+	courierWidth = 0.6
+)
+
+// The objects which come before the pages of a PDF.
+const (
+	catalogObject = 1
+	pagesObject   = 2
+	fontObject    = 3
+	monoObject    = 4
+	firstPage     = 5
+)
+
+// renderPDF lays the labels out over as many sticker sheets as are
+// needed, one sheet to a page.
+func renderPDF(layouts []*layout) ([]byte, error) {
+	perSheet := sheetColumns * sheetRows
+	var pages []string
+	for start := 0; start < len(layouts); start += perSheet {
+		end := start + perSheet
+		if end > len(layouts) {
+			end = len(layouts)
+		}
+		pages = append(pages, pdfPageContent(layouts[start:end]))
+	}
+
+	w := &pdfWriter{}
+	w.buf.WriteString("%PDF-1.4\n")
+
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPage+2*i))
+	}
+	w.object(catalogObject, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObject))
+	w.object(pagesObject, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	w.object(fontObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	w.object(monoObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		page := firstPage + 2*i
+		w.object(page, fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+			pagesObject, pageWidth, pageHeight, fontObject, monoObject, page+1))
+		w.object(page+1, fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+	w.end(catalogObject)
+
+	return w.buf.Bytes(), nil
+}
+
+func pdfPageContent(layouts []*layout) string {
+	scale := float64(pointsPerInch) / dotsPerInch
+	width := labelWidth * scale
+	height := labelHeight * scale
+	left := (pageWidth - sheetColumns*width) / 2
+	top := pageHeight - (pageHeight-sheetRows*height)/2
+
+	var sb strings.Builder
+	for i, l := range layouts {
+		// Where the top left of the label is on the page
+		x := left + float64(i%sheetColumns)*width
+		y := top - float64(i/sheetColumns)*height
+
+		writePDFText(&sb, "F1", x+margin*scale, y-titleY*scale, titleSize*scale, l.title)
+		for _, b := range l.bars() {
+			fmt.Fprintf(&sb, "%.2f %.2f %.2f %.2f re\n",
+				x+float64(b.x)*scale, y-(barsY+barsHeight)*scale, float64(b.width)*scale, barsHeight*scale)
+		}
+		sb.WriteString("f\n")
+		size := barcodeTextSize * scale
+		textWidth := float64(len(l.barcode)) * courierWidth * size
+		writePDFText(&sb, "F2", x+(width-textWidth)/2, y-barcodeTextY*scale, size, l.barcode)
+		writePDFText(&sb, "F1", x+margin*scale, y-locationY*scale, locationSize*scale, l.location)
+	}
+	return sb.String()
+}
+
+// writePDFText writes text in a box of size points high, with its top
+// left corner at x, y.
+func writePDFText(sb *strings.Builder, font string, x, y, size float64, text string) {
+	fmt.Fprintf(sb, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font, size, x, y-size*0.8, escapePDFText(text))
+}
+
+// escapePDFText escapes text for a PDF string in WinAnsi encoding, which
+// agrees with Latin-1 for the characters we keep.
+func escapePDFText(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			sb.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			sb.WriteRune('?')
+		}
+	}
+	return sb.String()
+}
+
+// pdfWriter writes the objects of a PDF, keeping track of where each
+// one starts for the cross-reference table.
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *pdfWriter) object(number int, body string) {
+	for len(w.offsets) < number {
+		w.offsets = append(w.offsets, 0)
+	}
+	w.offsets[number-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", number, body)
+}
+
+func (w *pdfWriter) end(root int) {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n", len(w.offsets)+1)
+	w.buf.WriteString("0000000000 65535 f \n")
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, root, xref)
+}
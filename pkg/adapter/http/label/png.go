@@ -0,0 +1,56 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// renderPNG draws the labels one under the other, a pixel to a dot.
+func renderPNG(layouts []*layout) ([]byte, error) {
+	img := image.NewGray(image.Rect(0, 0, labelWidth, labelHeight*len(layouts)))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	for i, l := range layouts {
+		top := i * labelHeight
+		drawPNGText(img, margin, top+titleY, titleSize, l.title, false)
+		for _, b := range l.bars() {
+			rect := image.Rect(b.x, top+barsY, b.x+b.width, top+barsY+barsHeight)
+			draw.Draw(img, rect, image.Black, image.Point{}, draw.Src)
+		}
+		drawPNGText(img, margin, top+barcodeTextY, barcodeTextSize, l.barcode, true)
+		drawPNGText(img, margin, top+locationY, locationSize, l.location, false)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("could not render png - encode error: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawPNGText draws text in a box of size dots high, with its top left
+// corner at x, y. The font is smaller than size, so it is centered
+// vertically in the box.
+func drawPNGText(img draw.Image, x, y, size int, text string, center bool) {
+	face := basicfont.Face7x13
+	if center {
+		width := font.MeasureString(face, text).Ceil()
+		x = (labelWidth - width) / 2
+	}
+	baseline := y + (size+face.Ascent-face.Descent)/2
+	drawer := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.Black),
+		Face: face,
+		Dot:  fixed.P(x, baseline),
+	}
+	drawer.DrawString(text)
+}
@@ -0,0 +1,38 @@
+package label
+
+import (
+	"fmt"
+	"strings"
+)
+
+// zplEscaper escapes the characters ZPL treats specially in field data,
+// as hex escapes for ^FH.
+var zplEscaper = strings.NewReplacer(
+	"_", "_5F",
+	"^", "_5E",
+	"~", "_7E",
+)
+
+// renderZPL writes a ZPL format for each label. The printer draws the
+// barcode itself, in code set B.
+func renderZPL(layouts []*layout) ([]byte, error) {
+	var sb strings.Builder
+	for _, l := range layouts {
+		sb.WriteString("^XA\n")
+		sb.WriteString("^CI28\n")
+		fmt.Fprintf(&sb, "^PW%d\n", labelWidth)
+		fmt.Fprintf(&sb, "^LL%d\n", labelHeight)
+		writeZPLText(&sb, titleY, titleSize, l.title, "L")
+		fmt.Fprintf(&sb, "^FO%d,%d^BY%d^BCN,%d,N,N,N^FH^FD>:%s^FS\n",
+			l.barsX, barsY, l.moduleWidth, barsHeight, zplEscaper.Replace(l.barcode))
+		writeZPLText(&sb, barcodeTextY, barcodeTextSize, l.barcode, "C")
+		writeZPLText(&sb, locationY, locationSize, l.location, "L")
+		sb.WriteString("^XZ\n")
+	}
+	return []byte(sb.String()), nil
+}
+
+func writeZPLText(sb *strings.Builder, y, size int, text string, justify string) {
+	fmt.Fprintf(sb, "^FO%d,%d^A0N,%d,%d^FB%d,1,0,%s^FH^FD%s^FS\n",
+		margin, y, size, size, labelWidth-2*margin, justify, zplEscaper.Replace(text))
+}
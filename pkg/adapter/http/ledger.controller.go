@@ -0,0 +1,123 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// LedgerControllerImpl defines controller methods
+// dealing with the ledgers of accounts.
+type LedgerControllerImpl struct {
+	ledgerService      ledger.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &LedgerControllerImpl{}
+
+// NewLedgerControllerImpl is a constructor
+func NewLedgerControllerImpl(
+	ledgerService ledger.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *LedgerControllerImpl {
+
+	return &LedgerControllerImpl{
+		ledgerService:      ledgerService,
+		decoderService:     decoderService,
+		encoderService:     encoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (l *LedgerControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodGet, "/accounts/{id}/ledger", l.ReadStatement)
+	addHandler(handlers, http.MethodPost, "/accounts/{id}/payments", l.RecordPayment)
+	addHandler(handlers, http.MethodPost, "/accounts/{id}/adjustments", l.RecordAdjustment)
+
+	return handlers
+}
+
+// ReadStatement can be called to list the ledger of an account,
+// and what it comes to
+func (l *LedgerControllerImpl) ReadStatement(request *Request) *Response {
+	// Extract ID from path params
+	accountID, err := l.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vo, err := l.ledgerService.ReadStatement(request.Context, accountID)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := l.encoderService.FromLedgerStatement(vo)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateJSON(200, json)
+}
+
+// RecordPayment can be called to record a payment made by an account
+func (l *LedgerControllerImpl) RecordPayment(request *Request) *Response {
+	// Extract ID from path params
+	accountID, err := l.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := l.decoderService.ToLedgerRecordPaymentVo(request.Body)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := l.ledgerService.RecordPayment(request.Context, accountID, vo)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateFromEntityID(201, id)
+}
+
+// RecordAdjustment can be called to correct what an account owes
+func (l *LedgerControllerImpl) RecordAdjustment(request *Request) *Response {
+	// Extract ID from path params
+	accountID, err := l.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := l.decoderService.ToLedgerRecordAdjustmentVo(request.Body)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := l.ledgerService.RecordAdjustment(request.Context, accountID, vo)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateFromEntityID(201, id)
+}
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// LocationControllerImpl defines controller methods
+// dealing with locations, and what is kept at them.
+type LocationControllerImpl struct {
+	locationService    location.Service
+	inventoryService   inventory.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &LocationControllerImpl{}
+
+// NewLocationControllerImpl is a constructor
+func NewLocationControllerImpl(
+	locationService location.Service,
+	inventoryService inventory.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *LocationControllerImpl {
+
+	return &LocationControllerImpl{
+		locationService:    locationService,
+		inventoryService:   inventoryService,
+		encoderService:     encoderService,
+		decoderService:     decoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (l *LocationControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/locations", l.Create)
+	addHandler(handlers, http.MethodGet, "/locations", l.ReadAll)
+	addHandler(handlers, http.MethodDelete, "/stores/{store}/aisles/{aisle}/shelves/{shelf}/slots/{slot}", l.Delete)
+	addHandler(handlers, http.MethodGet, "/stores/{store}/aisles/{aisle}/shelves/{shelf}", l.ReadShelf)
+
+	return handlers
+}
+
+// Create can be called to create a location where copies may be
+// kept.
+func (l *LocationControllerImpl) Create(request *Request) *Response {
+	// Decode JSON request
+	vo, err := l.decoderService.ToLocationCreateLocationVo(request.Body)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	view, err := l.locationService.Create(request.Context, vo)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := l.encoderService.FromLocationView(view)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateJSON(201, json)
+}
+
+// ReadAll can be called to get all locations.
+func (l *LocationControllerImpl) ReadAll(request *Request) *Response {
+	// Delegate to service
+	vos, err := l.locationService.ReadAll(request.Context)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := l.encoderService.FromLocationViews(vos)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateJSON(200, json)
+}
+
+// Delete can be called to remove a location where nothing is kept.
+func (l *LocationControllerImpl) Delete(request *Request) *Response {
+	// Extract location from path params
+	store, aisle, shelf, err := l.shelfParams(request)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+	slot, err := l.parameterConverter.ToString(request.PathParam, "slot")
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	loc := entity.Location{
+		Store: store,
+		Aisle: aisle,
+		Shelf: shelf,
+		Slot:  slot,
+	}
+	if err = l.locationService.Delete(request.Context, loc); err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateEmpty(204)
+}
+
+// ReadShelf can be called to get the slots of a shelf, and the
+// inventory items kept in each.
+func (l *LocationControllerImpl) ReadShelf(request *Request) *Response {
+	// Extract shelf from path params
+	store, aisle, shelf, err := l.shelfParams(request)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vo, err := l.inventoryService.ReadShelf(request.Context, store, aisle, shelf)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := l.encoderService.FromInventoryShelfView(vo)
+	if err != nil {
+		return l.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return l.responseFactory.CreateJSON(200, json)
+}
+
+func (l *LocationControllerImpl) shelfParams(request *Request) (string, string, string, error) {
+	store, err := l.parameterConverter.ToString(request.PathParam, "store")
+	if err != nil {
+		return "", "", "", err
+	}
+	aisle, err := l.parameterConverter.ToString(request.PathParam, "aisle")
+	if err != nil {
+		return "", "", "", err
+	}
+	shelf, err := l.parameterConverter.ToString(request.PathParam, "shelf")
+	if err != nil {
+		return "", "", "", err
+	}
+	return store, aisle, shelf, nil
+}
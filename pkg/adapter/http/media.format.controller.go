@@ -0,0 +1,117 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MediaFormatControllerImpl defines controller methods
+// dealing with the format catalogue.
+type MediaFormatControllerImpl struct {
+	formatService      mediaformat.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &MediaFormatControllerImpl{}
+
+// NewMediaFormatControllerImpl is a constructor
+func NewMediaFormatControllerImpl(
+	formatService mediaformat.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *MediaFormatControllerImpl {
+
+	return &MediaFormatControllerImpl{
+		formatService:      formatService,
+		encoderService:     encoderService,
+		decoderService:     decoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (m *MediaFormatControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodGet, "/formats/{format}", m.ReadDetails)
+	addHandler(handlers, http.MethodGet, "/formats", m.ReadAll)
+	addHandler(handlers, http.MethodPut, "/formats/{format}", m.Update)
+
+	return handlers
+}
+
+// ReadDetails can be called to get the pricing of a format,
+// including how many copies are held in it
+func (m *MediaFormatControllerImpl) ReadDetails(request *Request) *Response {
+	// Extract format from path params
+	format, err := m.parameterConverter.ToFormat(request.PathParam, "format")
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vo, err := m.formatService.ReadDetails(request.Context, format)
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := m.encoderService.FromMediaFormatView(vo)
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return m.responseFactory.CreateJSON(200, json)
+}
+
+// ReadAll can be called to get the pricing of all formats,
+// including how many copies are held in each
+func (m *MediaFormatControllerImpl) ReadAll(request *Request) *Response {
+	// Delegate to service
+	vos, err := m.formatService.ReadAll(request.Context)
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := m.encoderService.FromMediaFormatViews(vos)
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return m.responseFactory.CreateJSON(200, json)
+}
+
+// Update can be called to change the pricing of a format.
+func (m *MediaFormatControllerImpl) Update(request *Request) *Response {
+	// Extract format from path params
+	format, err := m.parameterConverter.ToFormat(request.PathParam, "format")
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := m.decoderService.ToMediaFormatUpdateFormatVo(request.Body)
+	if err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = m.formatService.Update(request.Context, format, vo); err != nil {
+		return m.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return m.responseFactory.CreateEmpty(204)
+}
@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 // ParameterConverter converts parameters to various types
 type ParameterConverter interface {
 	ToEntityID(m map[string]string, param string) (entity.ID, error)
+	ToFormat(m map[string]string, param string) (entity.Format, error)
+	ToString(m map[string]string, param string) (string, error)
+	ToBarcode(m map[string]string, param string) (string, error)
+	ToEntityIDs(m map[string][]string, param string) ([]entity.ID, error)
+	ToLabelFormat(m map[string][]string, param string) (label.Format, error)
 }
 
 // ParameterConverterImpl implements ParameterConverter
@@ -37,8 +44,77 @@ func (p *ParameterConverterImpl) ToEntityID(m map[string]string, param string) (
 	return entity.ID(i), nil
 }
 
+// ToFormat extracts an entity.Format from m by the param key
+func (p *ParameterConverterImpl) ToFormat(m map[string]string, param string) (entity.Format, error) {
+	v, err := getParam(m, param, "format")
+	if err != nil {
+		return "", err
+	}
+
+	format := entity.Format(v)
+	if err := format.Validate(); err != nil {
+		return "", fmt.Errorf("could not convert parameters to format - %w", err)
+	}
+	return format, nil
+}
+
+// ToString extracts a string from m by the param key
+func (p *ParameterConverterImpl) ToString(m map[string]string, param string) (string, error) {
+	return getParam(m, param, "string")
+}
+
+// ToBarcode extracts a barcode from m by the param key, if it
+// is valid for its symbology.
+func (p *ParameterConverterImpl) ToBarcode(m map[string]string, param string) (string, error) {
+	v, err := getParam(m, param, "barcode")
+	if err != nil {
+		return "", err
+	}
+
+	if err := entity.ValidateBarcode(v); err != nil {
+		return "", fmt.Errorf("could not convert parameters to barcode - %w", err)
+	}
+	return v, nil
+}
+
+// ToEntityIDs extracts every entity.ID given for the param key in the
+// query parameters m. At least one must be given.
+func (p *ParameterConverterImpl) ToEntityIDs(m map[string][]string, param string) ([]entity.ID, error) {
+	values := m[param]
+	if len(values) == 0 {
+		return nil, fmt.Errorf("could not convert parameters to entity ids - %w",
+			commonerror.NewValidation(param, "must be given"))
+	}
+
+	var ids []entity.ID
+	for _, v := range values {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert parameters to entity ids - %w",
+				commonerror.NewValidation(param, "must be a whole number"))
+		}
+		ids = append(ids, entity.ID(i))
+	}
+	return ids, nil
+}
+
+// ToLabelFormat extracts a label.Format from the query parameters m by
+// the param key. Labels are PNGs unless another format is given.
+func (p *ParameterConverterImpl) ToLabelFormat(m map[string][]string, param string) (label.Format, error) {
+	values := m[param]
+	if len(values) == 0 {
+		return label.FormatPNG, nil
+	}
+
+	format, err := label.ParseFormat(values[0])
+	if err != nil {
+		return "", fmt.Errorf("could not convert parameters to label format - %w", err)
+	}
+	return format, nil
+}
+
 func getParam(m map[string]string, param string, errorType string) (string, error) {
-	v, ok := m["id"]
+	v, ok := m[param]
 	if !ok {
 		return "", fmt.Errorf(
 			"could not convert parameters to %s - \"%s\" is not in the parameter list",
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// RentalControllerImpl defines controller methods
+// dealing with the rental resource.
+type RentalControllerImpl struct {
+	rentalService   rental.Service
+	encoderService  json.EncoderService
+	responseFactory ResponseFactory
+}
+
+// Check we implement the interface
+var _ Controller = &RentalControllerImpl{}
+
+// NewRentalControllerImpl is a constructor
+func NewRentalControllerImpl(
+	rentalService rental.Service,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+) *RentalControllerImpl {
+
+	return &RentalControllerImpl{
+		rentalService:   rentalService,
+		encoderService:  encoderService,
+		responseFactory: responseFactory,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (r *RentalControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodGet, "/rentals/overdue", r.ReadOverdue)
+
+	return handlers
+}
+
+// ReadOverdue can be called to list the rentals which are
+// past their due date
+func (r *RentalControllerImpl) ReadOverdue(request *Request) *Response {
+	// Delegate to service
+	vos, err := r.rentalService.ReadOverdue(request.Context)
+	if err != nil {
+		return r.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := r.encoderService.FromRentalViews(vos)
+	if err != nil {
+		return r.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return r.responseFactory.CreateJSON(200, json)
+}
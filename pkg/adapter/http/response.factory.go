@@ -1,12 +1,14 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/db"
 	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
 )
 
 const (
@@ -21,6 +23,8 @@ type ResponseFactory interface {
 	CreateJSON(statusCode uint, body []byte) *Response
 	CreateFromError(error) *Response
 	CreateFromEntityID(statusCode uint, id entity.ID) *Response
+	CreateFile(statusCode uint, contentType string, body []byte) *Response
+	CreateStream(statusCode uint, contentType string, stream Stream) *Response
 }
 
 // ResponseFactoryImpl implements ResponseFactory
@@ -51,6 +55,26 @@ func (r *ResponseFactoryImpl) CreateJSON(statusCode uint, body []byte) *Response
 	}
 }
 
+// CreateFile creates a response with a file of some Content-Type as
+// the body.
+func (r *ResponseFactoryImpl) CreateFile(statusCode uint, contentType string, body []byte) *Response {
+	return &Response{
+		ContentType: contentType,
+		StatusCode:  statusCode,
+		Body:        body,
+	}
+}
+
+// CreateStream creates a response with a body of some Content-Type
+// which is written as it becomes available.
+func (r *ResponseFactoryImpl) CreateStream(statusCode uint, contentType string, stream Stream) *Response {
+	return &Response{
+		ContentType: contentType,
+		StatusCode:  statusCode,
+		Stream:      stream,
+	}
+}
+
 // CreateFromError parses the error to see if an error in the chain is
 // associated to a specific status code (check source for details) and
 // then creates a Response.
@@ -74,6 +98,14 @@ func (r *ResponseFactoryImpl) createText(statusCode uint, body string) *Response
 }
 
 func determineCodeAndSpecificError(err error) (uint, error) {
+	// The request ran out of time, or the client went away
+	if errors.Is(err, context.DeadlineExceeded) {
+		return 504, err
+	}
+	if errors.Is(err, context.Canceled) {
+		return 503, err
+	}
+
 	nextErr := err
 	for true {
 		switch v := nextErr.(type) {
@@ -81,10 +113,22 @@ func determineCodeAndSpecificError(err error) (uint, error) {
 			return 400, v
 		case *commonerror.NotImplemented:
 			return 501, v
+		case *commonerror.AgeRestriction:
+			return 403, v
+		case *commonerror.CreditLimit:
+			return 402, v
+		case *commonerror.Conflict:
+			return 409, v
 		case *db.NotFoundError:
 			return 404, v
 		case *db.UniqueConstraintError:
 			return 400, v
+		case *db.ForeignKeyConstraintError:
+			return 400, v
+		case *idempotency.InProgressError:
+			return 409, v
+		case *idempotency.ReusedKeyError:
+			return 422, v
 		}
 
 		nextErr = errors.Unwrap(nextErr)
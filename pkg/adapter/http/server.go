@@ -18,24 +18,43 @@ type ServerFactory interface {
 
 // ServerFactoryImpl implements ServerFactory
 type ServerFactoryImpl struct {
-	inventoryController Controller
+	controllers         []Controller
+	idempotencyWrapper  IdempotencyWrapper
 	serverConfiguration ServerConfiguration
+	workers             []domain.Runnable
 }
 
 // Check we implement the interface
 var _ ServerFactory = &ServerFactoryImpl{}
 
-// NewServerFactoryImpl is a constructor
-func NewServerFactoryImpl(inventoryController Controller, serverConfiguration ServerConfiguration) *ServerFactoryImpl {
+// NewServerFactoryImpl is a constructor. workers are run in the
+// background for as long as the server runs.
+func NewServerFactoryImpl(
+	controllers []Controller,
+	idempotencyWrapper IdempotencyWrapper,
+	serverConfiguration ServerConfiguration,
+	workers []domain.Runnable,
+) *ServerFactoryImpl {
+
 	return &ServerFactoryImpl{
-		inventoryController: inventoryController,
+		controllers:         controllers,
+		idempotencyWrapper:  idempotencyWrapper,
 		serverConfiguration: serverConfiguration,
+		workers:             workers,
 	}
 }
 
 // Create provides the configured ServerConfiguration with
-// the configured handlers to create a runnable server.
+// the handlers of every controller to create a runnable server, which
+// runs alongside the workers. Handlers honour idempotency keys where
+// they should.
 func (s *ServerFactoryImpl) Create() domain.Runnable {
-	handlers := s.inventoryController.GetHandlers()
-	return s.serverConfiguration.CreateRunnable(handlers)
+	handlers := make(map[HandlerPattern]Handler)
+	for _, controller := range s.controllers {
+		for pattern, handler := range controller.GetHandlers() {
+			handlers[pattern] = s.idempotencyWrapper.Wrap(pattern, handler)
+		}
+	}
+	server := s.serverConfiguration.CreateRunnable(handlers)
+	return domain.Alongside(server, s.workers...)
 }
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// TitleControllerImpl defines controller methods
+// dealing with the title resource.
+type TitleControllerImpl struct {
+	titleService       title.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &TitleControllerImpl{}
+
+// NewTitleControllerImpl is a constructor
+func NewTitleControllerImpl(
+	titleService title.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *TitleControllerImpl {
+
+	return &TitleControllerImpl{
+		titleService:       titleService,
+		encoderService:     encoderService,
+		decoderService:     decoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (t *TitleControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/titles", t.Create)
+	addHandler(handlers, http.MethodGet, "/titles/{id}", t.ReadDetails)
+	addHandler(handlers, http.MethodGet, "/titles", t.ReadAll)
+	addHandler(handlers, http.MethodPut, "/titles/{id}", t.Update)
+	addHandler(handlers, http.MethodDelete, "/titles/{id}", t.Delete)
+
+	return handlers
+}
+
+// Create can be called to create a title
+func (t *TitleControllerImpl) Create(request *Request) *Response {
+	// Decode JSON request
+	vo, err := t.decoderService.ToTitleCreateTitleVo(request.Body)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := t.titleService.Create(request.Context, vo)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return t.responseFactory.CreateFromEntityID(201, id)
+}
+
+// ReadDetails can be called to get details on a title,
+// including how many copies are held
+func (t *TitleControllerImpl) ReadDetails(request *Request) *Response {
+	// Extract ID from path params
+	id, err := t.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	vo, err := t.titleService.ReadDetails(request.Context, id)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := t.encoderService.FromTitleView(vo)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return t.responseFactory.CreateJSON(200, json)
+}
+
+// ReadAll can be called to get an outline of all titles,
+// including how many copies are held
+func (t *TitleControllerImpl) ReadAll(request *Request) *Response {
+	// Delegate to service
+	vos, err := t.titleService.ReadAll(request.Context)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := t.encoderService.FromTitleThinViews(vos)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return t.responseFactory.CreateJSON(200, json)
+}
+
+// Update can be called to update the details of a title.
+func (t *TitleControllerImpl) Update(request *Request) *Response {
+	// Extract ID from path params
+	id, err := t.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Decode JSON request
+	vo, err := t.decoderService.ToTitleUpdateTitleVo(request.Body)
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = t.titleService.Update(request.Context, id, vo); err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return t.responseFactory.CreateEmpty(204)
+}
+
+// Delete can be called to remove a title from the system.
+func (t *TitleControllerImpl) Delete(request *Request) *Response {
+	// Extract ID from path params
+	id, err := t.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err = t.titleService.Delete(request.Context, id); err != nil {
+		return t.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return t.responseFactory.CreateEmpty(204)
+}
@@ -1,21 +1,47 @@
 package http
 
+import (
+	"context"
+	"io"
+)
+
 // Request defines everything a user can submit
-// via HTTP for us to process
+// via HTTP for us to process. Header holds the first
+// value of each header, by canonical name (e.g.
+// Last-Event-Id). RemoteAddr is the client's address,
+// e.g. 10.0.0.1:5000.
 type Request struct {
+	Context    context.Context
 	PathParam  map[string]string
 	QueryParam map[string][]string
+	Header     map[string]string
 	Body       []byte
+	RemoteAddr string
 }
 
 // Response defines what we return after
-// a given HTTP request.
+// a given HTTP request. If Stream is set, it
+// writes the body instead of Body.
 type Response struct {
 	ContentType string
 	StatusCode  uint
 	Body        []byte
+	Stream      Stream
+}
+
+// StreamWriter is written to by a Stream. Flush
+// sends what has been written so far to the
+// client.
+type StreamWriter interface {
+	io.Writer
+	Flush()
 }
 
+// Stream writes a response body as it becomes
+// available, e.g. server-sent events, until it
+// is done or the request's context is done.
+type Stream func(StreamWriter)
+
 // Handler handles an HTTP request
 // and generates a response.
 type Handler func(*Request) *Response
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// WebhookControllerImpl defines controller methods
+// dealing with the webhook resources.
+type WebhookControllerImpl struct {
+	webhookService     webhook.Service
+	decoderService     json.DecoderService
+	encoderService     json.EncoderService
+	responseFactory    ResponseFactory
+	parameterConverter ParameterConverter
+}
+
+// Check we implement the interface
+var _ Controller = &WebhookControllerImpl{}
+
+// NewWebhookControllerImpl is a constructor
+func NewWebhookControllerImpl(
+	webhookService webhook.Service,
+	decoderService json.DecoderService,
+	encoderService json.EncoderService,
+	responseFactory ResponseFactory,
+	parameterConverter ParameterConverter,
+) *WebhookControllerImpl {
+
+	return &WebhookControllerImpl{
+		webhookService:     webhookService,
+		decoderService:     decoderService,
+		encoderService:     encoderService,
+		responseFactory:    responseFactory,
+		parameterConverter: parameterConverter,
+	}
+}
+
+// GetHandlers implements the Controller interface
+func (w *WebhookControllerImpl) GetHandlers() map[HandlerPattern]Handler {
+	handlers := make(map[HandlerPattern]Handler)
+
+	addHandler(handlers, http.MethodPost, "/webhooks", w.Create)
+	addHandler(handlers, http.MethodGet, "/webhooks", w.ReadAll)
+	addHandler(handlers, http.MethodGet, "/webhooks/{id}", w.ReadDetails)
+	addHandler(handlers, http.MethodDelete, "/webhooks/{id}", w.Delete)
+	addHandler(handlers, http.MethodGet, "/webhooks/{id}/deliveries", w.ReadDeliveries)
+	addHandler(handlers, http.MethodGet, "/webhook-deliveries/dead", w.ReadDeadLetters)
+	addHandler(handlers, http.MethodPut, "/webhook-deliveries/{id}/redeliver", w.Redeliver)
+
+	return handlers
+}
+
+// Create can be called to subscribe a URL to events
+func (w *WebhookControllerImpl) Create(request *Request) *Response {
+	// Decode JSON request
+	vo, err := w.decoderService.ToWebhookCreateSubscriptionVo(request.Body)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	id, err := w.webhookService.Create(request.Context, vo)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateFromEntityID(201, id)
+}
+
+// ReadDetails can be called to get a webhook subscription
+func (w *WebhookControllerImpl) ReadDetails(request *Request) *Response {
+	// Extract ID from path params
+	id, err := w.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	view, err := w.webhookService.ReadDetails(request.Context, id)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := w.encoderService.FromWebhookSubscriptionView(view)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateJSON(200, json)
+}
+
+// ReadAll can be called to list every webhook subscription
+func (w *WebhookControllerImpl) ReadAll(request *Request) *Response {
+	// Delegate to service
+	views, err := w.webhookService.ReadAll(request.Context)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := w.encoderService.FromWebhookSubscriptionViews(views)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateJSON(200, json)
+}
+
+// Delete can be called to unsubscribe
+func (w *WebhookControllerImpl) Delete(request *Request) *Response {
+	// Extract ID from path params
+	id, err := w.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err := w.webhookService.Delete(request.Context, id); err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateEmpty(204)
+}
+
+// ReadDeliveries can be called to list the deliveries to a
+// webhook subscription, latest first
+func (w *WebhookControllerImpl) ReadDeliveries(request *Request) *Response {
+	// Extract ID from path params
+	id, err := w.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	views, err := w.webhookService.ReadDeliveries(request.Context, id)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := w.encoderService.FromWebhookDeliveryViews(views)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateJSON(200, json)
+}
+
+// ReadDeadLetters can be called to list the deliveries which
+// were given up on, latest first
+func (w *WebhookControllerImpl) ReadDeadLetters(request *Request) *Response {
+	// Delegate to service
+	views, err := w.webhookService.ReadDeadLetters(request.Context)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Encode to JSON
+	json, err := w.encoderService.FromWebhookDeliveryViews(views)
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateJSON(200, json)
+}
+
+// Redeliver can be called to try a dead delivery again
+func (w *WebhookControllerImpl) Redeliver(request *Request) *Response {
+	// Extract ID from path params
+	id, err := w.parameterConverter.ToEntityID(request.PathParam, "id")
+	if err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Delegate to service
+	if err := w.webhookService.Redeliver(request.Context, id); err != nil {
+		return w.responseFactory.CreateFromError(err)
+	}
+
+	// Create response
+	return w.responseFactory.CreateEmpty(204)
+}
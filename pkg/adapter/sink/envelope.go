@@ -0,0 +1,35 @@
+package sink
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// envelope is how an event is published as JSON.
+type envelope struct {
+	ID         entity.ID              `json:"id"`
+	Type       entity.EventType       `json:"type"`
+	EntityID   entity.ID              `json:"entityId"`
+	OccurredAt time.Time              `json:"occurredAt"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+func marshalMessage(msg outbox.Message) ([]byte, error) {
+	return marshalEvent(msg.ID, msg.Type, msg.EntityID, msg.OccurredAt, msg.Data)
+}
+
+func marshalEvent(id entity.ID, eventType entity.EventType, entityID entity.ID, occurredAt time.Time, data map[string]interface{}) ([]byte, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	return json.Marshal(envelope{
+		ID:         id,
+		Type:       eventType,
+		EntityID:   entityID,
+		OccurredAt: occurredAt,
+		Data:       data,
+	})
+}
@@ -0,0 +1,85 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// Headers sent with each webhook, alongside the JSON event.
+const (
+	// EventHeader gives the type of the event.
+	EventHeader = "X-Matchstick-Event"
+	// DeliveryHeader gives the id of the delivery, which stays the
+	// same across attempts.
+	DeliveryHeader = "X-Matchstick-Delivery"
+	// SignatureHeader gives the HMAC-SHA256 of the body, keyed by the
+	// subscription's secret, as sha256=<hex>.
+	SignatureHeader = "X-Matchstick-Signature"
+)
+
+// maxDrainedBytes is the most of a receiver's response which is read,
+// so that the connection may be reused.
+const maxDrainedBytes = 64 * 1024
+
+// WebhookSenderImpl implements webhook.Sender by posting each event as
+// JSON, signed with the subscription's secret.
+type WebhookSenderImpl struct {
+	client *http.Client
+}
+
+// Check we implement the interface
+var _ webhook.Sender = &WebhookSenderImpl{}
+
+// NewWebhookSenderImpl is a constructor
+func NewWebhookSenderImpl(client *http.Client) *WebhookSenderImpl {
+	return &WebhookSenderImpl{
+		client: client,
+	}
+}
+
+// Send posts the event to the subscription's URL. Any 2xx response
+// means it was accepted.
+func (w *WebhookSenderImpl) Send(ctx context.Context, subscription entity.WebhookSubscription, delivery entity.WebhookDelivery) (int, error) {
+	body, err := marshalEvent(delivery.EventID(), delivery.EventType(), delivery.EntityID(), delivery.OccurredAt(), delivery.Data())
+	if err != nil {
+		return 0, fmt.Errorf("could not send webhook - marshal error: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("could not send webhook - request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType()))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(int64(delivery.ID()), 10))
+	req.Header.Set(SignatureHeader, sign(subscription.Secret(), body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("could not send webhook - post error: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxDrainedBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("could not send webhook - receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
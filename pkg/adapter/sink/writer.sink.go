@@ -0,0 +1,42 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// WriterSinkImpl implements outbox.Sink by writing each message
+// as a line of JSON.
+type WriterSinkImpl struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// Check we implement the interface
+var _ outbox.Sink = &WriterSinkImpl{}
+
+// NewWriterSinkImpl is a constructor
+func NewWriterSinkImpl(out io.Writer) *WriterSinkImpl {
+	return &WriterSinkImpl{
+		out: out,
+	}
+}
+
+// Publish writes the message as a line of JSON.
+func (w *WriterSinkImpl) Publish(ctx context.Context, msg outbox.Message) error {
+	line, err := marshalMessage(msg)
+	if err != nil {
+		return fmt.Errorf("could not publish outbox message - marshal error: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.out.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("could not publish outbox message - write error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// BackoffPolicy decides when a failed attempt at something should
+// be tried again, and when to give up.
+type BackoffPolicy interface {
+	// Next returns when to try again after the given number of
+	// failed attempts, the last at now. It returns false if no
+	// more attempts should be made.
+	Next(attempts int, now time.Time) (time.Time, bool)
+}
+
+// BackoffPolicyImpl implements BackoffPolicy by doubling the delay
+// after each attempt, up to a maximum.
+type BackoffPolicyImpl struct {
+	initial     time.Duration
+	max         time.Duration
+	maxAttempts int
+}
+
+// Check we implement the interface
+var _ BackoffPolicy = &BackoffPolicyImpl{}
+
+// NewBackoffPolicyImpl is a constructor
+func NewBackoffPolicyImpl(initial time.Duration, max time.Duration, maxAttempts int) *BackoffPolicyImpl {
+	return &BackoffPolicyImpl{
+		initial:     initial,
+		max:         max,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Next waits initial after the first attempt, then twice as long
+// after each further attempt - but never longer than max. Once
+// maxAttempts have been made, it gives up.
+func (b *BackoffPolicyImpl) Next(attempts int, now time.Time) (time.Time, bool) {
+	if attempts >= b.maxAttempts {
+		return time.Time{}, false
+	}
+
+	delay := b.initial
+	for i := 1; i < attempts && delay < b.max; i++ {
+		delay *= 2
+	}
+	if delay > b.max {
+		delay = b.max
+	}
+	return now.Add(delay), true
+}
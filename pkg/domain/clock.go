@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Clock tells the time. Domain logic asks a Clock rather than
+// calling time.Now directly, so that it can be tested.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockImpl implements Clock using the system clock
+type ClockImpl struct{}
+
+// Check we implement the interface
+var _ Clock = &ClockImpl{}
+
+// NewClockImpl is a constructor
+func NewClockImpl() *ClockImpl {
+	return &ClockImpl{}
+}
+
+// Now returns the current time, in UTC.
+func (c *ClockImpl) Now() time.Time {
+	return time.Now().UTC()
+}
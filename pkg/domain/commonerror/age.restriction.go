@@ -0,0 +1,30 @@
+package commonerror
+
+import "fmt"
+
+// AgeRestriction is returned when an account holder
+// is not old enough for a rated title
+type AgeRestriction struct {
+	Rating     string
+	MinimumAge int
+	Problem    string
+}
+
+// Check we implement the interface
+var _ error = &AgeRestriction{}
+
+// NewAgeRestriction is a constructor
+func NewAgeRestriction(rating string, minimumAge int, problem string) *AgeRestriction {
+	return &AgeRestriction{
+		Rating:     rating,
+		MinimumAge: minimumAge,
+		Problem:    problem,
+	}
+}
+
+func (a *AgeRestriction) Error() string {
+	return fmt.Sprintf(
+		"age restriction error: rating=[%s], minimumAge=[%d], problem=[%s]",
+		a.Rating, a.MinimumAge, a.Problem,
+	)
+}
@@ -0,0 +1,28 @@
+package commonerror
+
+import "fmt"
+
+// Conflict is returned when something can't be done
+// to an entity in its current state
+type Conflict struct {
+	Type    string
+	Problem string
+}
+
+// Check we implement the interface
+var _ error = &Conflict{}
+
+// NewConflict is a constructor
+func NewConflict(_type string, problem string) *Conflict {
+	return &Conflict{
+		Type:    _type,
+		Problem: problem,
+	}
+}
+
+func (c *Conflict) Error() string {
+	return fmt.Sprintf(
+		"conflict error: type=[%s], problem=[%s]",
+		c.Type, c.Problem,
+	)
+}
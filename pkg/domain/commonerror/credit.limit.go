@@ -0,0 +1,28 @@
+package commonerror
+
+import "fmt"
+
+// CreditLimit is returned when an account owes more
+// than it is allowed to before renting again
+type CreditLimit struct {
+	BalanceCents int64
+	LimitCents   int64
+}
+
+// Check we implement the interface
+var _ error = &CreditLimit{}
+
+// NewCreditLimit is a constructor
+func NewCreditLimit(balanceCents int64, limitCents int64) *CreditLimit {
+	return &CreditLimit{
+		BalanceCents: balanceCents,
+		LimitCents:   limitCents,
+	}
+}
+
+func (c *CreditLimit) Error() string {
+	return fmt.Sprintf(
+		"credit limit error: balanceCents=[%d], limitCents=[%d]",
+		c.BalanceCents, c.LimitCents,
+	)
+}
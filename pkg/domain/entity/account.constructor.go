@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+// AccountConstructor constructs Accounts
+type AccountConstructor interface {
+	Reincarnate(id ID, name string, dateOfBirth *time.Time, balance Money) Account
+	New(name string, dateOfBirth *time.Time) (Account, error)
+}
+
+// AccountConstructorImpl implements AccountConstructor
+type AccountConstructorImpl struct{}
+
+var _ AccountConstructor = &AccountConstructorImpl{}
+
+// NewAccountConstructorImpl is a constructor
+func NewAccountConstructorImpl() *AccountConstructorImpl {
+	return &AccountConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (a *AccountConstructorImpl) Reincarnate(id ID, name string, dateOfBirth *time.Time, balance Money) Account {
+	return &AccountImpl{
+		id:          id,
+		name:        name,
+		dateOfBirth: dateOfBirth,
+		balance:     balance,
+	}
+}
+
+// New creates a brand new entity from the given parameters. The input
+// is validated and will fail if appropriate. The resulting entity will not have
+// a valid id (you will probably want to persist it to get one).
+func (a *AccountConstructorImpl) New(name string, dateOfBirth *time.Time) (Account, error) {
+	result := &AccountImpl{
+		id: InvalidID,
+	}
+
+	if err := result.ChangeName(name); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeDateOfBirth(dateOfBirth); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// Earliest date of birth we accept for an Account.
+var minDateOfBirth = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Account defines a customer who may rent inventory items.
+type Account interface {
+	ID() ID
+	Name() string
+	DateOfBirth() *time.Time
+	Balance() Money
+	AgeOn(time.Time) (int, bool)
+	ChangeName(string) error
+	ChangeDateOfBirth(*time.Time) error
+}
+
+// AccountImpl implements Account
+type AccountImpl struct {
+	id          ID
+	name        string
+	dateOfBirth *time.Time
+	balance     Money
+}
+
+// Check interface is implemented
+var _ Account = &AccountImpl{}
+
+// TestAccountImplConstructor allows you to create an AccountImpl,
+// directly - bypassing the constructor service. It should ONLY
+// be used in tests.
+func TestAccountImplConstructor(
+	id ID,
+	name string,
+	dateOfBirth *time.Time,
+	balance Money) *AccountImpl {
+
+	return &AccountImpl{
+		id:          id,
+		name:        name,
+		dateOfBirth: dateOfBirth,
+		balance:     balance,
+	}
+}
+
+// ID returns the id.
+func (a *AccountImpl) ID() ID {
+	return a.id
+}
+
+// Name returns the name of the account holder.
+func (a *AccountImpl) Name() string {
+	return a.name
+}
+
+// DateOfBirth returns the date the account holder was born on (at
+// midnight UTC), or nil if it is not known.
+func (a *AccountImpl) DateOfBirth() *time.Time {
+	return a.dateOfBirth
+}
+
+// Balance returns what the account holder owes the store,
+// as totalled from its ledger.
+func (a *AccountImpl) Balance() Money {
+	return a.balance
+}
+
+// AgeOn returns how old, in whole years, the account holder is on
+// the given day. It returns false if the date of birth is not known.
+func (a *AccountImpl) AgeOn(t time.Time) (int, bool) {
+	if a.dateOfBirth == nil {
+		return 0, false
+	}
+	born := *a.dateOfBirth
+	t = t.UTC()
+	age := t.Year() - born.Year()
+	if t.Month() < born.Month() || (t.Month() == born.Month() && t.Day() < born.Day()) {
+		age--
+	}
+	return age, true
+}
+
+// ChangeName will change the name of the account holder,
+// if it is valid. If it is not valid, it will return
+// an error
+func (a *AccountImpl) ChangeName(name string) error {
+	if err := validateStringField("name", name); err != nil {
+		return err
+	}
+	a.name = name
+	return nil
+}
+
+// ChangeDateOfBirth will change the date of birth of the account
+// holder, if it is valid. Only the date is kept. nil means it is
+// not known. If it is not valid, it will return an error
+func (a *AccountImpl) ChangeDateOfBirth(dateOfBirth *time.Time) error {
+	if dateOfBirth == nil {
+		a.dateOfBirth = nil
+		return nil
+	}
+	date := toDate(*dateOfBirth)
+	if date.Before(minDateOfBirth) {
+		return commonerror.NewValidation("dateOfBirth", "must not be before "+minDateOfBirth.Format(DateLayout))
+	}
+	a.dateOfBirth = &date
+	return nil
+}
+
+func toDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// Symbology is a way of encoding a barcode as bars.
+type Symbology string
+
+// The symbologies we read.
+const (
+	SymbologyEAN13   Symbology = "ean13"
+	SymbologyUPCA    Symbology = "upca"
+	SymbologyCode128 Symbology = "code128"
+)
+
+// MaxCode128Length is the longest barcode we print on our own Code 128
+// labels.
+const MaxCode128Length = 32
+
+// SymbologyOf returns the symbology barcode is read as. Barcodes of 13
+// digits are EAN-13, barcodes of 12 digits are UPC-A, and anything else
+// is one of our own Code 128 labels.
+func SymbologyOf(barcode string) Symbology {
+	if !isDigits(barcode) {
+		return SymbologyCode128
+	}
+	switch len(barcode) {
+	case 13:
+		return SymbologyEAN13
+	case 12:
+		return SymbologyUPCA
+	default:
+		return SymbologyCode128
+	}
+}
+
+// ValidateBarcode returns an error if barcode is blank, or is not valid
+// for its symbology: EAN-13 and UPC-A barcodes must end with the right
+// check digit, and our own Code 128 labels may only use letters, digits,
+// "-", "." and "_" so that they can be used in paths.
+func ValidateBarcode(barcode string) error {
+	return validateBarcodeField("barcode", barcode)
+}
+
+func validateBarcodeField(field string, value string) error {
+	if err := validateStringField(field, value); err != nil {
+		return err
+	}
+
+	switch SymbologyOf(value) {
+	case SymbologyEAN13:
+		if !hasCheckDigit(value) {
+			return commonerror.NewValidation(field, "must end with a valid EAN-13 check digit")
+		}
+	case SymbologyUPCA:
+		if !hasCheckDigit(value) {
+			return commonerror.NewValidation(field, "must end with a valid UPC-A check digit")
+		}
+	default:
+		if len(value) > MaxCode128Length {
+			return commonerror.NewValidation(field, fmt.Sprintf("must be at most %d characters", MaxCode128Length))
+		}
+		for _, r := range value {
+			if !isCode128Rune(r) {
+				return commonerror.NewValidation(field, "must only have letters, digits, \"-\", \".\" and \"_\"")
+			}
+		}
+	}
+	return nil
+}
+
+// hasCheckDigit returns true if the last digit of digits is the GS1
+// check digit of the others. Counting from the check digit, the digits
+// are weighted 3, 1, 3, ... - which works for both EAN-13 and UPC-A.
+func hasCheckDigit(digits string) bool {
+	sum := 0
+	weight := 3
+	for i := len(digits) - 2; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight = 4 - weight
+	}
+	check := (10 - sum%10) % 10
+	return int(digits[len(digits)-1]-'0') == check
+}
+
+func isDigits(str string) bool {
+	if str == "" {
+		return false
+	}
+	for _, r := range str {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isCode128Rune(r rune) bool {
+	return (r >= 'a' && r <= 'z') ||
+		(r >= 'A' && r <= 'Z') ||
+		(r >= '0' && r <= '9') ||
+		r == '-' || r == '.' || r == '_'
+}
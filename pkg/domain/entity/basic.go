@@ -5,3 +5,11 @@ type ID int64
 
 // InvalidID corresponds to no entity.
 var InvalidID ID = -1
+
+// Money defines the type used for amounts of money, in the
+// smallest unit of the currency (e.g. cents).
+type Money int64
+
+// DateLayout is how dates without a time, such as dates of birth,
+// are written.
+const DateLayout = "2006-01-02"
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// EventType names something which happened to an entity.
+type EventType string
+
+// The events inventory items record.
+const (
+	EventItemCreated    EventType = "inventory.item.created"
+	EventItemCheckedOut EventType = "inventory.item.checked-out"
+	EventItemCheckedIn  EventType = "inventory.item.checked-in"
+	EventItemHeld       EventType = "inventory.item.held"
+	EventItemRenewed    EventType = "inventory.item.renewed"
+	EventItemDeleted    EventType = "inventory.item.deleted"
+	EventItemMoved      EventType = "inventory.item.moved"
+)
+
+// EventTypes lists every event type which is recorded.
+var EventTypes = []EventType{EventItemCreated, EventItemCheckedOut, EventItemCheckedIn, EventItemHeld, EventItemRenewed, EventItemDeleted, EventItemMoved}
+
+// Validate returns an error if t is not one of EventTypes.
+func (t EventType) Validate() error {
+	return validateEventTypeField("eventType", t)
+}
+
+// Event is something which happened to an entity, which other systems
+// may want to react to. Data holds the details of the event.
+type Event struct {
+	Type EventType
+	Data map[string]interface{}
+}
+
+// EventRecorder is implemented by entities which record events as
+// their state changes.
+type EventRecorder interface {
+	PullEvents() []Event
+}
+
+// eventLog records events for the entity it is embedded in.
+type eventLog struct {
+	events []Event
+}
+
+// PullEvents returns the events recorded since the last pull, and
+// forgets them.
+func (l *eventLog) PullEvents() []Event {
+	events := l.events
+	l.events = nil
+	return events
+}
+
+func (l *eventLog) record(eventType EventType, data map[string]interface{}) {
+	l.events = append(l.events, Event{
+		Type: eventType,
+		Data: data,
+	})
+}
+
+func validateEventTypeField(field string, value EventType) error {
+	for _, known := range EventTypes {
+		if value == known {
+			return nil
+		}
+	}
+	names := make([]string, len(EventTypes))
+	for i, known := range EventTypes {
+		names[i] = string(known)
+	}
+	return commonerror.NewValidation(field, "must be one of "+strings.Join(names, ", "))
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// Format identifies the physical medium of a copy.
+type Format string
+
+// The formats we stock.
+const (
+	FormatVHS    Format = "vhs"
+	FormatDVD    Format = "dvd"
+	FormatBluRay Format = "bluray"
+	Format4K     Format = "4k"
+)
+
+// Formats lists every format we stock, oldest first.
+var Formats = []Format{FormatVHS, FormatDVD, FormatBluRay, Format4K}
+
+// Validate returns an error if f is not one of Formats.
+func (f Format) Validate() error {
+	return validateFormatField("format", f)
+}
+
+func validateFormatField(field string, value Format) error {
+	for _, known := range Formats {
+		if value == known {
+			return nil
+		}
+	}
+	names := make([]string, len(Formats))
+	for i, known := range Formats {
+		names[i] = string(known)
+	}
+	return commonerror.NewValidation(field, "must be one of "+strings.Join(names, ", "))
+}
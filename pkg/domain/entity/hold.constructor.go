@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// HoldConstructor constructs Holds
+type HoldConstructor interface {
+	Reincarnate(id ID, titleID ID, accountID ID, placedAt time.Time, status HoldStatus, itemID ID, expiresAt *time.Time) Hold
+	New(titleID ID, accountID ID, placedAt time.Time) (Hold, error)
+}
+
+// HoldConstructorImpl implements HoldConstructor
+type HoldConstructorImpl struct{}
+
+var _ HoldConstructor = &HoldConstructorImpl{}
+
+// NewHoldConstructorImpl is a constructor
+func NewHoldConstructorImpl() *HoldConstructorImpl {
+	return &HoldConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (h *HoldConstructorImpl) Reincarnate(id ID, titleID ID, accountID ID, placedAt time.Time, status HoldStatus, itemID ID, expiresAt *time.Time) Hold {
+	return &HoldImpl{
+		id:        id,
+		titleID:   titleID,
+		accountID: accountID,
+		placedAt:  placedAt,
+		status:    status,
+		itemID:    itemID,
+		expiresAt: expiresAt,
+	}
+}
+
+// New creates a brand new hold, waiting at the back of the queue
+// for the title. The input is validated and will fail if appropriate.
+// The resulting entity will not have a valid id (you will probably
+// want to persist it to get one).
+func (h *HoldConstructorImpl) New(titleID ID, accountID ID, placedAt time.Time) (Hold, error) {
+	if err := validateIDField("titleId", titleID); err != nil {
+		return nil, err
+	}
+	if err := validateIDField("accountId", accountID); err != nil {
+		return nil, err
+	}
+
+	return &HoldImpl{
+		id:        InvalidID,
+		titleID:   titleID,
+		accountID: accountID,
+		placedAt:  placedAt,
+		status:    HoldWaiting,
+		itemID:    InvalidID,
+	}, nil
+}
@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// HoldStatus identifies where a hold is in its lifecycle.
+type HoldStatus string
+
+// The statuses a hold may have.
+const (
+	// HoldWaiting holds are queued for the next returned copy.
+	HoldWaiting HoldStatus = "waiting"
+	// HoldReady holds have a copy put aside for collection.
+	HoldReady HoldStatus = "ready"
+	// HoldFulfilled holds had their copy checked out to the account.
+	HoldFulfilled HoldStatus = "fulfilled"
+	// HoldCancelled holds were withdrawn before being fulfilled.
+	HoldCancelled HoldStatus = "cancelled"
+	// HoldExpired holds were not collected in time.
+	HoldExpired HoldStatus = "expired"
+)
+
+// Hold records an account queueing for a copy of a title,
+// and the copy put aside for it once one is returned.
+type Hold interface {
+	ID() ID
+	TitleID() ID
+	AccountID() ID
+	PlacedAt() time.Time
+	Status() HoldStatus
+	ItemID() ID
+	ExpiresAt() *time.Time
+	IsActive() bool
+	IsExpired(now time.Time) bool
+	Assign(itemID ID, expiresAt time.Time) error
+	Fulfil() error
+	Cancel() error
+	Expire() error
+}
+
+// HoldImpl implements Hold
+type HoldImpl struct {
+	id        ID
+	titleID   ID
+	accountID ID
+	placedAt  time.Time
+	status    HoldStatus
+	itemID    ID
+	expiresAt *time.Time
+}
+
+// Check interface is implemented
+var _ Hold = &HoldImpl{}
+
+// TestHoldImplConstructor allows you to create a HoldImpl,
+// directly - bypassing the constructor service. It should ONLY
+// be used in tests.
+func TestHoldImplConstructor(
+	id ID,
+	titleID ID,
+	accountID ID,
+	placedAt time.Time,
+	status HoldStatus,
+	itemID ID,
+	expiresAt *time.Time) *HoldImpl {
+
+	return &HoldImpl{
+		id:        id,
+		titleID:   titleID,
+		accountID: accountID,
+		placedAt:  placedAt,
+		status:    status,
+		itemID:    itemID,
+		expiresAt: expiresAt,
+	}
+}
+
+// ID returns the id.
+func (h *HoldImpl) ID() ID {
+	return h.id
+}
+
+// TitleID returns the id of the title which is held.
+func (h *HoldImpl) TitleID() ID {
+	return h.titleID
+}
+
+// AccountID returns the id of the account which placed the hold.
+func (h *HoldImpl) AccountID() ID {
+	return h.accountID
+}
+
+// PlacedAt returns when the hold joined the queue.
+func (h *HoldImpl) PlacedAt() time.Time {
+	return h.placedAt
+}
+
+// Status returns where the hold is in its lifecycle.
+func (h *HoldImpl) Status() HoldStatus {
+	return h.status
+}
+
+// ItemID returns the id of the copy put aside for the hold,
+// or InvalidID if none has been yet.
+func (h *HoldImpl) ItemID() ID {
+	return h.itemID
+}
+
+// ExpiresAt returns when the copy put aside must be collected by,
+// or nil if none has been put aside yet.
+func (h *HoldImpl) ExpiresAt() *time.Time {
+	return h.expiresAt
+}
+
+// IsActive will return true if the hold is still waiting for,
+// or holding, a copy.
+func (h *HoldImpl) IsActive() bool {
+	return h.status == HoldWaiting || h.status == HoldReady
+}
+
+// IsExpired will return true if a copy is put aside for the
+// hold, and now is past when it had to be collected by.
+func (h *HoldImpl) IsExpired(now time.Time) bool {
+	return h.status == HoldReady && h.expiresAt != nil && now.After(*h.expiresAt)
+}
+
+// Assign puts the given copy aside for the hold, until expiresAt.
+// If the hold is not waiting, then an error is returned.
+func (h *HoldImpl) Assign(itemID ID, expiresAt time.Time) error {
+	if h.status != HoldWaiting {
+		return fmt.Errorf("cannot assign hold - %w", h.statusConflict())
+	}
+	if err := validateIDField("itemId", itemID); err != nil {
+		return err
+	}
+	h.status = HoldReady
+	h.itemID = itemID
+	h.expiresAt = &expiresAt
+	return nil
+}
+
+// Fulfil records that the copy put aside was collected. If no
+// copy is put aside, then an error is returned.
+func (h *HoldImpl) Fulfil() error {
+	if h.status != HoldReady {
+		return fmt.Errorf("cannot fulfil hold - %w", h.statusConflict())
+	}
+	h.status = HoldFulfilled
+	return nil
+}
+
+// Cancel withdraws the hold, releasing any copy put aside. If
+// the hold is no longer active, then an error is returned.
+func (h *HoldImpl) Cancel() error {
+	if !h.IsActive() {
+		return fmt.Errorf("cannot cancel hold - %w", h.statusConflict())
+	}
+	h.status = HoldCancelled
+	return nil
+}
+
+// Expire records that the copy put aside was not collected in
+// time, releasing it. If no copy is put aside, then an error
+// is returned.
+func (h *HoldImpl) Expire() error {
+	if h.status != HoldReady {
+		return fmt.Errorf("cannot expire hold - %w", h.statusConflict())
+	}
+	h.status = HoldExpired
+	return nil
+}
+
+// statusConflict describes why the hold can't be changed in its
+// current status.
+func (h *HoldImpl) statusConflict() error {
+	return commonerror.NewConflict("hold", fmt.Sprintf("it is %s", h.status))
+}
@@ -2,8 +2,8 @@ package entity
 
 // InventoryItemConstructor constructs InventoryItems
 type InventoryItemConstructor interface {
-	Reincarnate(id ID, name string, location string, available bool) InventoryItem
-	NewAvailable(name string, location string) (InventoryItem, error)
+	Reincarnate(id ID, titleID ID, format Format, barcode string, location Location, available bool) InventoryItem
+	NewAvailable(titleID ID, format Format, barcode string, location Location) (InventoryItem, error)
 }
 
 // InventoryItemConstructorImpl implements InventoryItemConstructor
@@ -20,10 +20,12 @@ func NewInventoryItemConstructorImpl() *InventoryItemConstructorImpl {
 // just needs to be restored. Thus, this method bypasses validation. It should
 // be used from system-sources, e.g. a database, and not user sources, e.g.
 // a request.
-func (i *InventoryItemConstructorImpl) Reincarnate(id ID, name string, location string, available bool) InventoryItem {
+func (i *InventoryItemConstructorImpl) Reincarnate(id ID, titleID ID, format Format, barcode string, location Location, available bool) InventoryItem {
 	return &InventoryItemImpl{
 		id:        id,
-		name:      name,
+		titleID:   titleID,
+		format:    format,
+		barcode:   barcode,
 		location:  location,
 		available: available,
 	}
@@ -32,22 +34,33 @@ func (i *InventoryItemConstructorImpl) Reincarnate(id ID, name string, location
 // NewAvailable creates a brand new entity from the given parameters. The input
 // is validated and will fail if appropriate. The resulting entity will not have
 // a valid id (you will probably want to persist it to get one).
-func (i *InventoryItemConstructorImpl) NewAvailable(name string, location string) (InventoryItem, error) {
-	result, err := newBaseInventoryItem(name, location)
+func (i *InventoryItemConstructorImpl) NewAvailable(titleID ID, format Format, barcode string, location Location) (InventoryItem, error) {
+	result, err := newBaseInventoryItem(titleID, format, barcode, location)
 	if err != nil {
 		return nil, err
 	}
 	result.available = true
+	result.record(EventItemCreated, map[string]interface{}{
+		"titleId": titleID,
+		"format":  format,
+		"barcode": barcode,
+	})
 	return result, nil
 }
 
-func newBaseInventoryItem(name string, location string) (*InventoryItemImpl, error) {
+func newBaseInventoryItem(titleID ID, format Format, barcode string, location Location) (*InventoryItemImpl, error) {
 	result := &InventoryItemImpl{
 		id:        InvalidID,
 		available: true,
 	}
 
-	if err := result.ChangeName(name); err != nil {
+	if err := result.ChangeTitle(titleID); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeFormat(format); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeBarcode(barcode); err != nil {
 		return nil, err
 	}
 	if err := result.ChangeLocation(location); err != nil {
@@ -2,28 +2,42 @@ package entity
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
 	"github.com/liampulles/matchstick-video/pkg/domain/validation"
 )
 
-// InventoryItem defines a unique entity
+// InventoryItem defines a physical copy of a Title. It records
+// events as it is created, checked out, checked in, put aside for a
+// hold, renewed and deleted.
 type InventoryItem interface {
+	EventRecorder
 	ID() ID
-	Name() string
-	Location() string
+	TitleID() ID
+	Format() Format
+	Barcode() string
+	Location() Location
 	IsAvailable() bool
-	Checkout() error
+	Checkout(accountID ID) error
 	CheckIn() error
-	ChangeName(string) error
-	ChangeLocation(string) error
+	PutAside(holdID ID, accountID ID)
+	Renew(accountID ID, dueAt time.Time) error
+	Delete()
+	ChangeTitle(ID) error
+	ChangeFormat(Format) error
+	ChangeBarcode(string) error
+	ChangeLocation(Location) error
 }
 
 // InventoryItemImpl implements InventoryItem
 type InventoryItemImpl struct {
+	eventLog
 	id        ID
-	name      string
-	location  string
+	titleID   ID
+	format    Format
+	barcode   string
+	location  Location
 	available bool
 }
 
@@ -36,13 +50,17 @@ var _ InventoryItem = &InventoryItemImpl{}
 // in tests.
 func TestInventoryItemImplConstructor(
 	id ID,
-	name string,
-	location string,
+	titleID ID,
+	format Format,
+	barcode string,
+	location Location,
 	available bool) *InventoryItemImpl {
 
 	return &InventoryItemImpl{
 		id:        id,
-		name:      name,
+		titleID:   titleID,
+		format:    format,
+		barcode:   barcode,
 		location:  location,
 		available: available,
 	}
@@ -53,13 +71,23 @@ func (i *InventoryItemImpl) ID() ID {
 	return i.id
 }
 
-// Name returns the name.
-func (i *InventoryItemImpl) Name() string {
-	return i.name
+// TitleID returns the id of the title this is a copy of.
+func (i *InventoryItemImpl) TitleID() ID {
+	return i.titleID
 }
 
-// Location returns the name.
-func (i *InventoryItemImpl) Location() string {
+// Format returns the physical medium of the copy.
+func (i *InventoryItemImpl) Format() Format {
+	return i.format
+}
+
+// Barcode returns the barcode stuck on the copy.
+func (i *InventoryItemImpl) Barcode() string {
+	return i.barcode
+}
+
+// Location returns where the copy is kept.
+func (i *InventoryItemImpl) Location() Location {
 	return i.location
 }
 
@@ -69,14 +97,17 @@ func (i *InventoryItemImpl) IsAvailable() bool {
 	return i.available
 }
 
-// Checkout will mark the inventory item as unavilable.
-// If the inventory item is not available,
+// Checkout will mark the inventory item as unavilable, having been
+// rented out to an account. If the inventory item is not available,
 // then an error is returned.
-func (i *InventoryItemImpl) Checkout() error {
+func (i *InventoryItemImpl) Checkout(accountID ID) error {
 	if !i.available {
 		return fmt.Errorf("cannot check out inventory item - it is unavailable")
 	}
 	i.available = false
+	i.record(EventItemCheckedOut, map[string]interface{}{
+		"accountId": accountID,
+	})
 	return nil
 }
 
@@ -88,37 +119,118 @@ func (i *InventoryItemImpl) CheckIn() error {
 		return fmt.Errorf("cannot check in inventory item - it is already checked in")
 	}
 	i.available = true
+	i.record(EventItemCheckedIn, map[string]interface{}{
+		"titleId": i.titleID,
+	})
+	return nil
+}
+
+// PutAside records that the inventory item has been put aside for a
+// hold, so that only the hold's account may check it out.
+func (i *InventoryItemImpl) PutAside(holdID ID, accountID ID) {
+	i.record(EventItemHeld, map[string]interface{}{
+		"holdId":    holdID,
+		"accountId": accountID,
+	})
+}
+
+// Renew records that the rental of the inventory item to an account
+// was extended, so that it is due back at dueAt. If the inventory item
+// is available, then an error is returned.
+func (i *InventoryItemImpl) Renew(accountID ID, dueAt time.Time) error {
+	if i.available {
+		return fmt.Errorf("cannot renew inventory item - it is not checked out")
+	}
+	i.record(EventItemRenewed, map[string]interface{}{
+		"accountId": accountID,
+		"dueAt":     dueAt,
+	})
+	return nil
+}
+
+// Delete records that the inventory item is being deleted, e.g.
+// because the copy was lost or sold.
+func (i *InventoryItemImpl) Delete() {
+	i.record(EventItemDeleted, map[string]interface{}{
+		"barcode": i.barcode,
+	})
+}
+
+// ChangeTitle will change which title the inventory item is a copy of,
+// if the id is valid. If it is not valid, it will return an error
+func (i *InventoryItemImpl) ChangeTitle(titleID ID) error {
+	if err := validateIDField("titleId", titleID); err != nil {
+		return err
+	}
+	i.titleID = titleID
 	return nil
 }
 
-// ChangeName will change the name of the inventory item,
+// ChangeFormat will change the physical medium of the inventory item,
+// if it is one we stock. If it is not, it will return an error
+func (i *InventoryItemImpl) ChangeFormat(format Format) error {
+	if err := format.Validate(); err != nil {
+		return err
+	}
+	i.format = format
+	return nil
+}
+
+// ChangeBarcode will change the barcode of the inventory item,
 // if it is valid. If it is not valid, it will return
 // an error
-func (i *InventoryItemImpl) ChangeName(name string) error {
-	if err := validateStringField("name", name); err != nil {
+func (i *InventoryItemImpl) ChangeBarcode(barcode string) error {
+	if err := validateBarcodeField("barcode", barcode); err != nil {
 		return err
 	}
-	i.name = name
+	i.barcode = barcode
 	return nil
 }
 
 // ChangeLocation will change the location of the inventory item,
 // if it is valid. If it is not valid, it will return
-// an error
-func (i *InventoryItemImpl) ChangeLocation(location string) error {
-	if err := validateStringField("location", location); err != nil {
+// an error. Moving a copy which has already been placed
+// somewhere is recorded.
+func (i *InventoryItemImpl) ChangeLocation(location Location) error {
+	if err := location.Validate(); err != nil {
 		return err
 	}
+	if i.location != (Location{}) && i.location != location {
+		i.record(EventItemMoved, map[string]interface{}{
+			"from": locationData(i.location),
+			"to":   locationData(location),
+		})
+	}
 	i.location = location
 	return nil
 }
 
+func locationData(l Location) map[string]interface{} {
+	return map[string]interface{}{
+		"store": l.Store,
+		"aisle": l.Aisle,
+		"shelf": l.Shelf,
+		"slot":  l.Slot,
+	}
+}
+
 func validateStringField(field string, value string) error {
 	if validation.IsBlank(value) {
 		return commonerror.NewValidation(field, "must not be blank")
 	}
+	return validateOptionalStringField(field, value)
+}
+
+func validateOptionalStringField(field string, value string) error {
 	if !validation.IsTrimmed(value) {
 		return commonerror.NewValidation(field, "must not have whitespace at the beginning or the end")
 	}
 	return nil
 }
+
+func validateIDField(field string, value ID) error {
+	if value <= 0 {
+		return commonerror.NewValidation(field, "must be a positive id")
+	}
+	return nil
+}
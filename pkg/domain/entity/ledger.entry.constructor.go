@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// LedgerEntryConstructor constructs LedgerEntries
+type LedgerEntryConstructor interface {
+	Reincarnate(id ID, accountID ID, kind LedgerEntryKind, amount Money, method PaymentMethod, rentalID ID, note string, recordedAt time.Time) LedgerEntry
+	NewCharge(accountID ID, rentalID ID, amount Money, note string, recordedAt time.Time) (LedgerEntry, error)
+	NewPayment(accountID ID, amount Money, method PaymentMethod, recordedAt time.Time) (LedgerEntry, error)
+	NewAdjustment(accountID ID, amount Money, note string, recordedAt time.Time) (LedgerEntry, error)
+}
+
+// LedgerEntryConstructorImpl implements LedgerEntryConstructor
+type LedgerEntryConstructorImpl struct{}
+
+var _ LedgerEntryConstructor = &LedgerEntryConstructorImpl{}
+
+// NewLedgerEntryConstructorImpl is a constructor
+func NewLedgerEntryConstructorImpl() *LedgerEntryConstructorImpl {
+	return &LedgerEntryConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (l *LedgerEntryConstructorImpl) Reincarnate(id ID, accountID ID, kind LedgerEntryKind, amount Money, method PaymentMethod, rentalID ID, note string, recordedAt time.Time) LedgerEntry {
+	return &LedgerEntryImpl{
+		id:         id,
+		accountID:  accountID,
+		kind:       kind,
+		amount:     amount,
+		method:     method,
+		rentalID:   rentalID,
+		note:       note,
+		recordedAt: recordedAt,
+	}
+}
+
+// NewCharge creates a brand new charge against an account, for the
+// given rental. The input is validated and will fail if appropriate.
+// The resulting entity will not have a valid id (you will probably
+// want to persist it to get one).
+func (l *LedgerEntryConstructorImpl) NewCharge(accountID ID, rentalID ID, amount Money, note string, recordedAt time.Time) (LedgerEntry, error) {
+	if err := validateIDField("accountId", accountID); err != nil {
+		return nil, err
+	}
+	if err := validateIDField("rentalId", rentalID); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, commonerror.NewValidation("amount", "must be positive")
+	}
+	if err := validateStringField("note", note); err != nil {
+		return nil, err
+	}
+
+	return &LedgerEntryImpl{
+		id:         InvalidID,
+		accountID:  accountID,
+		kind:       LedgerCharge,
+		amount:     amount,
+		rentalID:   rentalID,
+		note:       note,
+		recordedAt: recordedAt,
+	}, nil
+}
+
+// NewPayment creates a brand new payment by an account. The input is
+// validated and will fail if appropriate. The resulting entity will
+// not have a valid id (you will probably want to persist it to get one).
+func (l *LedgerEntryConstructorImpl) NewPayment(accountID ID, amount Money, method PaymentMethod, recordedAt time.Time) (LedgerEntry, error) {
+	if err := validateIDField("accountId", accountID); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, commonerror.NewValidation("amount", "must be positive")
+	}
+	if err := validatePaymentMethodField("method", method); err != nil {
+		return nil, err
+	}
+
+	return &LedgerEntryImpl{
+		id:         InvalidID,
+		accountID:  accountID,
+		kind:       LedgerPayment,
+		amount:     amount,
+		method:     method,
+		rentalID:   InvalidID,
+		recordedAt: recordedAt,
+	}, nil
+}
+
+// NewAdjustment creates a brand new correction to what an account owes.
+// A negative amount reduces it. The input is validated and will fail if
+// appropriate. The resulting entity will not have a valid id (you will
+// probably want to persist it to get one).
+func (l *LedgerEntryConstructorImpl) NewAdjustment(accountID ID, amount Money, note string, recordedAt time.Time) (LedgerEntry, error) {
+	if err := validateIDField("accountId", accountID); err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		return nil, commonerror.NewValidation("amount", "must not be zero")
+	}
+	if err := validateStringField("note", note); err != nil {
+		return nil, err
+	}
+
+	return &LedgerEntryImpl{
+		id:         InvalidID,
+		accountID:  accountID,
+		kind:       LedgerAdjustment,
+		amount:     amount,
+		rentalID:   InvalidID,
+		note:       note,
+		recordedAt: recordedAt,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// LedgerEntryKind identifies how a ledger entry affects a balance.
+type LedgerEntryKind string
+
+// The kinds of ledger entry.
+const (
+	// LedgerCharge entries add to what an account owes.
+	LedgerCharge LedgerEntryKind = "charge"
+	// LedgerPayment entries take away from what an account owes.
+	LedgerPayment LedgerEntryKind = "payment"
+	// LedgerAdjustment entries correct what an account owes, either way.
+	LedgerAdjustment LedgerEntryKind = "adjustment"
+)
+
+// PaymentMethod identifies how a payment was made.
+type PaymentMethod string
+
+// The payment methods we accept.
+const (
+	PaymentCash PaymentMethod = "cash"
+	PaymentCard PaymentMethod = "card"
+)
+
+// PaymentMethods lists every payment method we accept.
+var PaymentMethods = []PaymentMethod{PaymentCash, PaymentCard}
+
+// LedgerEntry records a change to what an account owes the store.
+// Entries are never changed once recorded - mistakes are corrected
+// with adjustments.
+type LedgerEntry interface {
+	ID() ID
+	AccountID() ID
+	Kind() LedgerEntryKind
+	Amount() Money
+	Method() PaymentMethod
+	RentalID() ID
+	Note() string
+	RecordedAt() time.Time
+	BalanceEffect() Money
+}
+
+// LedgerEntryImpl implements LedgerEntry
+type LedgerEntryImpl struct {
+	id         ID
+	accountID  ID
+	kind       LedgerEntryKind
+	amount     Money
+	method     PaymentMethod
+	rentalID   ID
+	note       string
+	recordedAt time.Time
+}
+
+// Check interface is implemented
+var _ LedgerEntry = &LedgerEntryImpl{}
+
+// TestLedgerEntryImplConstructor allows you to create a LedgerEntryImpl,
+// directly - bypassing the constructor service. It should ONLY
+// be used in tests.
+func TestLedgerEntryImplConstructor(
+	id ID,
+	accountID ID,
+	kind LedgerEntryKind,
+	amount Money,
+	method PaymentMethod,
+	rentalID ID,
+	note string,
+	recordedAt time.Time) *LedgerEntryImpl {
+
+	return &LedgerEntryImpl{
+		id:         id,
+		accountID:  accountID,
+		kind:       kind,
+		amount:     amount,
+		method:     method,
+		rentalID:   rentalID,
+		note:       note,
+		recordedAt: recordedAt,
+	}
+}
+
+// ID returns the id.
+func (l *LedgerEntryImpl) ID() ID {
+	return l.id
+}
+
+// AccountID returns the id of the account the entry is recorded against.
+func (l *LedgerEntryImpl) AccountID() ID {
+	return l.accountID
+}
+
+// Kind returns how the entry affects the balance.
+func (l *LedgerEntryImpl) Kind() LedgerEntryKind {
+	return l.kind
+}
+
+// Amount returns the amount recorded. Charges and payments are
+// always positive, adjustments may be negative.
+func (l *LedgerEntryImpl) Amount() Money {
+	return l.amount
+}
+
+// Method returns how a payment was made, or "" for other kinds.
+func (l *LedgerEntryImpl) Method() PaymentMethod {
+	return l.method
+}
+
+// RentalID returns the id of the rental a charge is for, or
+// InvalidID if the entry is not for a rental.
+func (l *LedgerEntryImpl) RentalID() ID {
+	return l.rentalID
+}
+
+// Note returns what the entry is for.
+func (l *LedgerEntryImpl) Note() string {
+	return l.note
+}
+
+// RecordedAt returns when the entry was recorded.
+func (l *LedgerEntryImpl) RecordedAt() time.Time {
+	return l.recordedAt
+}
+
+// BalanceEffect returns how much the entry adds to what the
+// account owes. Payments reduce it.
+func (l *LedgerEntryImpl) BalanceEffect() Money {
+	if l.kind == LedgerPayment {
+		return -l.amount
+	}
+	return l.amount
+}
+
+func validatePaymentMethodField(field string, value PaymentMethod) error {
+	for _, known := range PaymentMethods {
+		if value == known {
+			return nil
+		}
+	}
+	names := make([]string, len(PaymentMethods))
+	for i, known := range PaymentMethods {
+		names[i] = string(known)
+	}
+	return commonerror.NewValidation(field, "must be one of "+strings.Join(names, ", "))
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Location is where a copy is kept: a slot on a shelf, in an
+// aisle of a store.
+type Location struct {
+	Store string
+	Aisle string
+	Shelf string
+	Slot  string
+}
+
+// Validate returns an error if any part of the location is blank, or
+// has whitespace at the beginning or the end.
+func (l Location) Validate() error {
+	if err := validateStringField("location.store", l.Store); err != nil {
+		return err
+	}
+	if err := validateStringField("location.aisle", l.Aisle); err != nil {
+		return err
+	}
+	if err := validateStringField("location.shelf", l.Shelf); err != nil {
+		return err
+	}
+	return validateStringField("location.slot", l.Slot)
+}
+
+// LocationMove records a copy being moved from one location to
+// another.
+type LocationMove struct {
+	ItemID  ID
+	From    Location
+	To      Location
+	MovedAt time.Time
+}
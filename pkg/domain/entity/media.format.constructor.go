@@ -0,0 +1,29 @@
+package entity
+
+// MediaFormatConstructor constructs MediaFormats
+type MediaFormatConstructor interface {
+	Reincarnate(format Format, name string, rentalPrice Money, rentalPeriodDays int) MediaFormat
+}
+
+// MediaFormatConstructorImpl implements MediaFormatConstructor
+type MediaFormatConstructorImpl struct{}
+
+var _ MediaFormatConstructor = &MediaFormatConstructorImpl{}
+
+// NewMediaFormatConstructorImpl is a constructor
+func NewMediaFormatConstructorImpl() *MediaFormatConstructorImpl {
+	return &MediaFormatConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. The
+// catalogue holds one entry per Format and is seeded by migrations, so
+// there is no way to create a brand new entry.
+func (m *MediaFormatConstructorImpl) Reincarnate(format Format, name string, rentalPrice Money, rentalPeriodDays int) MediaFormat {
+	return &MediaFormatImpl{
+		format:           format,
+		name:             name,
+		rentalPrice:      rentalPrice,
+		rentalPeriodDays: rentalPeriodDays,
+	}
+}
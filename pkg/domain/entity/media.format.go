@@ -0,0 +1,98 @@
+package entity
+
+import "github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+
+// Longest rental period we accept for a MediaFormat, in days.
+const maxRentalPeriodDays = 365
+
+// MediaFormat defines the catalogue entry for a Format: what
+// it is called, and the terms on which copies of it are rented.
+type MediaFormat interface {
+	Format() Format
+	Name() string
+	RentalPrice() Money
+	RentalPeriodDays() int
+	ChangeName(string) error
+	ChangeRentalPrice(Money) error
+	ChangeRentalPeriodDays(int) error
+}
+
+// MediaFormatImpl implements MediaFormat
+type MediaFormatImpl struct {
+	format           Format
+	name             string
+	rentalPrice      Money
+	rentalPeriodDays int
+}
+
+// Check interface is implemented
+var _ MediaFormat = &MediaFormatImpl{}
+
+// TestMediaFormatImplConstructor allows you to create a
+// MediaFormatImpl, directly - bypassing the constructor service.
+// It should ONLY be used in tests.
+func TestMediaFormatImplConstructor(
+	format Format,
+	name string,
+	rentalPrice Money,
+	rentalPeriodDays int) *MediaFormatImpl {
+
+	return &MediaFormatImpl{
+		format:           format,
+		name:             name,
+		rentalPrice:      rentalPrice,
+		rentalPeriodDays: rentalPeriodDays,
+	}
+}
+
+// Format returns the format this entry describes.
+func (m *MediaFormatImpl) Format() Format {
+	return m.format
+}
+
+// Name returns the display name, e.g. "Blu-ray".
+func (m *MediaFormatImpl) Name() string {
+	return m.name
+}
+
+// RentalPrice returns the price of renting a copy for one
+// rental period.
+func (m *MediaFormatImpl) RentalPrice() Money {
+	return m.rentalPrice
+}
+
+// RentalPeriodDays returns how many days a copy may be
+// rented for.
+func (m *MediaFormatImpl) RentalPeriodDays() int {
+	return m.rentalPeriodDays
+}
+
+// ChangeName will change the display name, if it is valid.
+// If it is not valid, it will return an error
+func (m *MediaFormatImpl) ChangeName(name string) error {
+	if err := validateStringField("name", name); err != nil {
+		return err
+	}
+	m.name = name
+	return nil
+}
+
+// ChangeRentalPrice will change the rental price, if it is valid.
+// If it is not valid, it will return an error
+func (m *MediaFormatImpl) ChangeRentalPrice(price Money) error {
+	if price < 0 {
+		return commonerror.NewValidation("rentalPrice", "must not be negative")
+	}
+	m.rentalPrice = price
+	return nil
+}
+
+// ChangeRentalPeriodDays will change the rental period, if it is
+// valid. If it is not valid, it will return an error
+func (m *MediaFormatImpl) ChangeRentalPeriodDays(days int) error {
+	if days < 1 || days > maxRentalPeriodDays {
+		return commonerror.NewValidation("rentalPeriodDays", "must be between 1 and 365")
+	}
+	m.rentalPeriodDays = days
+	return nil
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// RentalConstructor constructs Rentals
+type RentalConstructor interface {
+	Reincarnate(id ID, itemID ID, accountID ID, checkedOutAt time.Time, dueAt time.Time, returnedAt *time.Time, lateFee Money, renewals int) Rental
+	New(itemID ID, accountID ID, checkedOutAt time.Time, rentalPeriodDays int) (Rental, error)
+}
+
+// RentalConstructorImpl implements RentalConstructor
+type RentalConstructorImpl struct{}
+
+var _ RentalConstructor = &RentalConstructorImpl{}
+
+// NewRentalConstructorImpl is a constructor
+func NewRentalConstructorImpl() *RentalConstructorImpl {
+	return &RentalConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (r *RentalConstructorImpl) Reincarnate(id ID, itemID ID, accountID ID, checkedOutAt time.Time, dueAt time.Time, returnedAt *time.Time, lateFee Money, renewals int) Rental {
+	return &RentalImpl{
+		id:           id,
+		itemID:       itemID,
+		accountID:    accountID,
+		checkedOutAt: checkedOutAt,
+		dueAt:        dueAt,
+		returnedAt:   returnedAt,
+		lateFee:      lateFee,
+		renewals:     renewals,
+	}
+}
+
+// New creates a brand new, outstanding rental. The item is due back
+// rentalPeriodDays after checkedOutAt. The input is validated and
+// will fail if appropriate. The resulting entity will not have
+// a valid id (you will probably want to persist it to get one).
+func (r *RentalConstructorImpl) New(itemID ID, accountID ID, checkedOutAt time.Time, rentalPeriodDays int) (Rental, error) {
+	if err := validateIDField("itemId", itemID); err != nil {
+		return nil, err
+	}
+	if err := validateIDField("accountId", accountID); err != nil {
+		return nil, err
+	}
+	if rentalPeriodDays < 1 {
+		return nil, commonerror.NewValidation("rentalPeriodDays", "must be positive")
+	}
+
+	return &RentalImpl{
+		id:           InvalidID,
+		itemID:       itemID,
+		accountID:    accountID,
+		checkedOutAt: checkedOutAt,
+		dueAt:        checkedOutAt.AddDate(0, 0, rentalPeriodDays),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// Rental records an inventory item being checked out to an account,
+// when it is due back, how often it was renewed, and when (if ever)
+// it was returned.
+type Rental interface {
+	ID() ID
+	ItemID() ID
+	AccountID() ID
+	CheckedOutAt() time.Time
+	DueAt() time.Time
+	ReturnedAt() *time.Time
+	LateFee() Money
+	Renewals() int
+	IsReturned() bool
+	IsOverdue(now time.Time) bool
+	Return(at time.Time, lateFee Money) error
+	Renew(rentalPeriodDays int) error
+}
+
+// RentalImpl implements Rental
+type RentalImpl struct {
+	id           ID
+	itemID       ID
+	accountID    ID
+	checkedOutAt time.Time
+	dueAt        time.Time
+	returnedAt   *time.Time
+	lateFee      Money
+	renewals     int
+}
+
+// Check interface is implemented
+var _ Rental = &RentalImpl{}
+
+// TestRentalImplConstructor allows you to create a RentalImpl,
+// directly - bypassing the constructor service. It should ONLY
+// be used in tests.
+func TestRentalImplConstructor(
+	id ID,
+	itemID ID,
+	accountID ID,
+	checkedOutAt time.Time,
+	dueAt time.Time,
+	returnedAt *time.Time,
+	lateFee Money,
+	renewals int) *RentalImpl {
+
+	return &RentalImpl{
+		id:           id,
+		itemID:       itemID,
+		accountID:    accountID,
+		checkedOutAt: checkedOutAt,
+		dueAt:        dueAt,
+		returnedAt:   returnedAt,
+		lateFee:      lateFee,
+		renewals:     renewals,
+	}
+}
+
+// ID returns the id.
+func (r *RentalImpl) ID() ID {
+	return r.id
+}
+
+// ItemID returns the id of the inventory item which was rented.
+func (r *RentalImpl) ItemID() ID {
+	return r.itemID
+}
+
+// AccountID returns the id of the account which rented the item.
+func (r *RentalImpl) AccountID() ID {
+	return r.accountID
+}
+
+// CheckedOutAt returns when the item was checked out.
+func (r *RentalImpl) CheckedOutAt() time.Time {
+	return r.checkedOutAt
+}
+
+// DueAt returns when the item must be returned by.
+func (r *RentalImpl) DueAt() time.Time {
+	return r.dueAt
+}
+
+// ReturnedAt returns when the item was returned, or nil
+// if it is still out.
+func (r *RentalImpl) ReturnedAt() *time.Time {
+	return r.returnedAt
+}
+
+// LateFee returns what was charged for returning the item late.
+func (r *RentalImpl) LateFee() Money {
+	return r.lateFee
+}
+
+// Renewals returns how many times the rental was extended.
+func (r *RentalImpl) Renewals() int {
+	return r.renewals
+}
+
+// IsReturned will return true if the item has been returned.
+func (r *RentalImpl) IsReturned() bool {
+	return r.returnedAt != nil
+}
+
+// IsOverdue will return true if the item is still out
+// and now is past the due date.
+func (r *RentalImpl) IsOverdue(now time.Time) bool {
+	return !r.IsReturned() && now.After(r.dueAt)
+}
+
+// Return records that the item was returned at the given time, and
+// what was charged for any lateness. If the item has already been
+// returned, or the fee is negative, then an error is returned.
+func (r *RentalImpl) Return(at time.Time, lateFee Money) error {
+	if r.IsReturned() {
+		return fmt.Errorf("cannot return rental - it is already returned")
+	}
+	if lateFee < 0 {
+		return commonerror.NewValidation("lateFee", "must not be negative")
+	}
+	r.returnedAt = &at
+	r.lateFee = lateFee
+	return nil
+}
+
+// Renew extends when the item is due back by rentalPeriodDays. If the
+// item has already been returned, or the period is not positive, then
+// an error is returned.
+func (r *RentalImpl) Renew(rentalPeriodDays int) error {
+	if r.IsReturned() {
+		return fmt.Errorf("cannot renew rental - it is already returned")
+	}
+	if rentalPeriodDays < 1 {
+		return commonerror.NewValidation("rentalPeriodDays", "must be positive")
+	}
+	r.dueAt = r.dueAt.AddDate(0, 0, rentalPeriodDays)
+	r.renewals++
+	return nil
+}
@@ -0,0 +1,67 @@
+package entity
+
+// TitleConstructor constructs Titles
+type TitleConstructor interface {
+	Reincarnate(id ID, name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) Title
+	New(name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) (Title, error)
+}
+
+// TitleConstructorImpl implements TitleConstructor
+type TitleConstructorImpl struct{}
+
+var _ TitleConstructor = &TitleConstructorImpl{}
+
+// NewTitleConstructorImpl is a constructor
+func NewTitleConstructorImpl() *TitleConstructorImpl {
+	return &TitleConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (t *TitleConstructorImpl) Reincarnate(id ID, name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) Title {
+	return &TitleImpl{
+		id:       id,
+		name:     name,
+		year:     year,
+		runtime:  runtime,
+		synopsis: synopsis,
+		genres:   genres,
+		cast:     cast,
+		rating:   rating,
+	}
+}
+
+// New creates a brand new entity from the given parameters. The input
+// is validated and will fail if appropriate. The resulting entity will not have
+// a valid id (you will probably want to persist it to get one).
+func (t *TitleConstructorImpl) New(name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) (Title, error) {
+	result := &TitleImpl{
+		id: InvalidID,
+	}
+
+	if err := result.ChangeName(name); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeYear(year); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeRuntime(runtime); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeSynopsis(synopsis); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeGenres(genres); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeCast(cast); err != nil {
+		return nil, err
+	}
+	if err := result.ChangeRating(rating); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,199 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// Earliest and latest release years we accept for a Title.
+const (
+	minTitleYear = 1888
+	maxTitleYear = 9999
+)
+
+// Title defines a film (or other work) in the catalogue, of which
+// the store may stock many copies.
+type Title interface {
+	ID() ID
+	Name() string
+	Year() int
+	Runtime() int
+	Synopsis() string
+	Genres() []string
+	Cast() []string
+	Rating() string
+	ChangeName(string) error
+	ChangeYear(int) error
+	ChangeRuntime(int) error
+	ChangeSynopsis(string) error
+	ChangeGenres([]string) error
+	ChangeCast([]string) error
+	ChangeRating(string) error
+}
+
+// TitleImpl implements Title
+type TitleImpl struct {
+	id       ID
+	name     string
+	year     int
+	runtime  int
+	synopsis string
+	genres   []string
+	cast     []string
+	rating   string
+}
+
+// Check interface is implemented
+var _ Title = &TitleImpl{}
+
+// TestTitleImplConstructor allows you to create a TitleImpl,
+// directly - bypassing the constructor service. It should ONLY
+// be used in tests.
+func TestTitleImplConstructor(
+	id ID,
+	name string,
+	year int,
+	runtime int,
+	synopsis string,
+	genres []string,
+	cast []string,
+	rating string) *TitleImpl {
+
+	return &TitleImpl{
+		id:       id,
+		name:     name,
+		year:     year,
+		runtime:  runtime,
+		synopsis: synopsis,
+		genres:   genres,
+		cast:     cast,
+		rating:   rating,
+	}
+}
+
+// ID returns the id.
+func (t *TitleImpl) ID() ID {
+	return t.id
+}
+
+// Name returns the name, e.g. "The Matrix".
+func (t *TitleImpl) Name() string {
+	return t.name
+}
+
+// Year returns the year of release.
+func (t *TitleImpl) Year() int {
+	return t.year
+}
+
+// Runtime returns the running time in minutes, or 0 if unknown.
+func (t *TitleImpl) Runtime() int {
+	return t.runtime
+}
+
+// Synopsis returns a short description of the plot.
+func (t *TitleImpl) Synopsis() string {
+	return t.synopsis
+}
+
+// Genres returns the genres the title belongs to.
+func (t *TitleImpl) Genres() []string {
+	return copyStrings(t.genres)
+}
+
+// Cast returns the names of the principal cast.
+func (t *TitleImpl) Cast() []string {
+	return copyStrings(t.cast)
+}
+
+// Rating returns the classification rating, e.g. "PG-13".
+func (t *TitleImpl) Rating() string {
+	return t.rating
+}
+
+// ChangeName will change the name of the title, if it is valid.
+// If it is not valid, it will return an error
+func (t *TitleImpl) ChangeName(name string) error {
+	if err := validateStringField("title", name); err != nil {
+		return err
+	}
+	t.name = name
+	return nil
+}
+
+// ChangeYear will change the year of release, if it is valid.
+// If it is not valid, it will return an error
+func (t *TitleImpl) ChangeYear(year int) error {
+	if year < minTitleYear || year > maxTitleYear {
+		return commonerror.NewValidation("year",
+			fmt.Sprintf("must be between %d and %d", minTitleYear, maxTitleYear))
+	}
+	t.year = year
+	return nil
+}
+
+// ChangeRuntime will change the running time (in minutes), if it is
+// valid. If it is not valid, it will return an error
+func (t *TitleImpl) ChangeRuntime(runtime int) error {
+	if runtime < 0 {
+		return commonerror.NewValidation("runtime", "must not be negative")
+	}
+	t.runtime = runtime
+	return nil
+}
+
+// ChangeSynopsis will change the synopsis, if it is valid.
+// If it is not valid, it will return an error
+func (t *TitleImpl) ChangeSynopsis(synopsis string) error {
+	if err := validateOptionalStringField("synopsis", synopsis); err != nil {
+		return err
+	}
+	t.synopsis = synopsis
+	return nil
+}
+
+// ChangeGenres will change the genres, if they are valid.
+// If they are not valid, it will return an error
+func (t *TitleImpl) ChangeGenres(genres []string) error {
+	if err := validateStringListField("genres", genres); err != nil {
+		return err
+	}
+	t.genres = copyStrings(genres)
+	return nil
+}
+
+// ChangeCast will change the cast, if they are valid.
+// If they are not valid, it will return an error
+func (t *TitleImpl) ChangeCast(cast []string) error {
+	if err := validateStringListField("cast", cast); err != nil {
+		return err
+	}
+	t.cast = copyStrings(cast)
+	return nil
+}
+
+// ChangeRating will change the rating, if it is valid.
+// If it is not valid, it will return an error
+func (t *TitleImpl) ChangeRating(rating string) error {
+	if err := validateOptionalStringField("rating", rating); err != nil {
+		return err
+	}
+	t.rating = rating
+	return nil
+}
+
+func validateStringListField(field string, values []string) error {
+	for _, value := range values {
+		if err := validateStringField(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyStrings(values []string) []string {
+	result := make([]string, len(values))
+	copy(result, values)
+	return result
+}
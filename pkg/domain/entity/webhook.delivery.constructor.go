@@ -0,0 +1,113 @@
+package entity
+
+import "time"
+
+// WebhookDeliveryConstructor constructs WebhookDeliveries
+type WebhookDeliveryConstructor interface {
+	Reincarnate(
+		id ID,
+		subscriptionID ID,
+		eventID ID,
+		eventType EventType,
+		entityID ID,
+		data map[string]interface{},
+		occurredAt time.Time,
+		status DeliveryStatus,
+		attempts int,
+		nextAttemptAt time.Time,
+		lastAttemptAt *time.Time,
+		lastStatusCode int,
+		lastError string,
+	) WebhookDelivery
+	New(
+		subscriptionID ID,
+		eventID ID,
+		eventType EventType,
+		entityID ID,
+		data map[string]interface{},
+		occurredAt time.Time,
+		now time.Time,
+	) (WebhookDelivery, error)
+}
+
+// WebhookDeliveryConstructorImpl implements WebhookDeliveryConstructor
+type WebhookDeliveryConstructorImpl struct{}
+
+var _ WebhookDeliveryConstructor = &WebhookDeliveryConstructorImpl{}
+
+// NewWebhookDeliveryConstructorImpl is a constructor
+func NewWebhookDeliveryConstructorImpl() *WebhookDeliveryConstructorImpl {
+	return &WebhookDeliveryConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (w *WebhookDeliveryConstructorImpl) Reincarnate(
+	id ID,
+	subscriptionID ID,
+	eventID ID,
+	eventType EventType,
+	entityID ID,
+	data map[string]interface{},
+	occurredAt time.Time,
+	status DeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastAttemptAt *time.Time,
+	lastStatusCode int,
+	lastError string,
+) WebhookDelivery {
+	return &WebhookDeliveryImpl{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		entityID:       entityID,
+		data:           data,
+		occurredAt:     occurredAt,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastAttemptAt:  lastAttemptAt,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+	}
+}
+
+// New creates a brand new delivery of an event to a subscription, to
+// be attempted from now. The input is validated and will fail if
+// appropriate. The resulting entity will not have a valid id (you will
+// probably want to persist it to get one).
+func (w *WebhookDeliveryConstructorImpl) New(
+	subscriptionID ID,
+	eventID ID,
+	eventType EventType,
+	entityID ID,
+	data map[string]interface{},
+	occurredAt time.Time,
+	now time.Time,
+) (WebhookDelivery, error) {
+	if err := validateIDField("subscriptionId", subscriptionID); err != nil {
+		return nil, err
+	}
+	if err := validateIDField("eventId", eventID); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypeField("eventType", eventType); err != nil {
+		return nil, err
+	}
+
+	return &WebhookDeliveryImpl{
+		id:             InvalidID,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		entityID:       entityID,
+		data:           data,
+		occurredAt:     occurredAt,
+		status:         DeliveryPending,
+		nextAttemptAt:  now,
+	}, nil
+}
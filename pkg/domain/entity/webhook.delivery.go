@@ -0,0 +1,217 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// DeliveryStatus identifies where a webhook delivery is in its
+// lifecycle.
+type DeliveryStatus string
+
+// The statuses a webhook delivery may have.
+const (
+	// DeliveryPending deliveries are waiting for their next attempt.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered deliveries were accepted by the receiver.
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead deliveries failed too many times, and are no
+	// longer attempted unless redelivered.
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery records posting an event to a webhook
+// subscription, and the outcome of the latest attempt.
+type WebhookDelivery interface {
+	ID() ID
+	SubscriptionID() ID
+	EventID() ID
+	EventType() EventType
+	EntityID() ID
+	Data() map[string]interface{}
+	OccurredAt() time.Time
+	Status() DeliveryStatus
+	Attempts() int
+	NextAttemptAt() time.Time
+	LastAttemptAt() *time.Time
+	LastStatusCode() int
+	LastError() string
+	Succeed(at time.Time, statusCode int) error
+	Retry(at time.Time, statusCode int, reason string, nextAttemptAt time.Time) error
+	GiveUp(at time.Time, statusCode int, reason string) error
+	Redeliver(at time.Time) error
+}
+
+// WebhookDeliveryImpl implements WebhookDelivery
+type WebhookDeliveryImpl struct {
+	id             ID
+	subscriptionID ID
+	eventID        ID
+	eventType      EventType
+	entityID       ID
+	data           map[string]interface{}
+	occurredAt     time.Time
+	status         DeliveryStatus
+	attempts       int
+	nextAttemptAt  time.Time
+	lastAttemptAt  *time.Time
+	lastStatusCode int
+	lastError      string
+}
+
+// Check interface is implemented
+var _ WebhookDelivery = &WebhookDeliveryImpl{}
+
+// TestWebhookDeliveryImplConstructor allows you to create a
+// pending WebhookDeliveryImpl, directly - bypassing the constructor
+// service. It should ONLY be used in tests.
+func TestWebhookDeliveryImplConstructor(
+	id ID,
+	subscriptionID ID,
+	eventID ID,
+	eventType EventType,
+	entityID ID,
+	occurredAt time.Time,
+	attempts int) *WebhookDeliveryImpl {
+
+	return &WebhookDeliveryImpl{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		entityID:       entityID,
+		occurredAt:     occurredAt,
+		status:         DeliveryPending,
+		attempts:       attempts,
+		nextAttemptAt:  occurredAt,
+	}
+}
+
+// ID returns the id.
+func (w *WebhookDeliveryImpl) ID() ID {
+	return w.id
+}
+
+// SubscriptionID returns the id of the subscription the event
+// is posted to.
+func (w *WebhookDeliveryImpl) SubscriptionID() ID {
+	return w.subscriptionID
+}
+
+// EventID returns the id of the event, which stays the same
+// across subscriptions and attempts.
+func (w *WebhookDeliveryImpl) EventID() ID {
+	return w.eventID
+}
+
+// EventType returns what happened.
+func (w *WebhookDeliveryImpl) EventType() EventType {
+	return w.eventType
+}
+
+// EntityID returns the id of the entity it happened to.
+func (w *WebhookDeliveryImpl) EntityID() ID {
+	return w.entityID
+}
+
+// Data returns the details of the event.
+func (w *WebhookDeliveryImpl) Data() map[string]interface{} {
+	return w.data
+}
+
+// OccurredAt returns when the event happened.
+func (w *WebhookDeliveryImpl) OccurredAt() time.Time {
+	return w.occurredAt
+}
+
+// Status returns where the delivery is in its lifecycle.
+func (w *WebhookDeliveryImpl) Status() DeliveryStatus {
+	return w.status
+}
+
+// Attempts returns how many times posting the event has been
+// attempted.
+func (w *WebhookDeliveryImpl) Attempts() int {
+	return w.attempts
+}
+
+// NextAttemptAt returns when the event should next be posted,
+// if the delivery is pending.
+func (w *WebhookDeliveryImpl) NextAttemptAt() time.Time {
+	return w.nextAttemptAt
+}
+
+// LastAttemptAt returns when the event was last posted, or nil
+// if it has not been yet.
+func (w *WebhookDeliveryImpl) LastAttemptAt() *time.Time {
+	return w.lastAttemptAt
+}
+
+// LastStatusCode returns the HTTP status of the receiver's last
+// response, or 0 if it did not respond.
+func (w *WebhookDeliveryImpl) LastStatusCode() int {
+	return w.lastStatusCode
+}
+
+// LastError returns why the last attempt failed, or "" if it
+// did not.
+func (w *WebhookDeliveryImpl) LastError() string {
+	return w.lastError
+}
+
+// Succeed records an attempt which the receiver accepted. If the
+// delivery is not pending, then an error is returned.
+func (w *WebhookDeliveryImpl) Succeed(at time.Time, statusCode int) error {
+	if err := w.attempt(at, statusCode, ""); err != nil {
+		return err
+	}
+	w.status = DeliveryDelivered
+	return nil
+}
+
+// Retry records a failed attempt, to be tried again at nextAttemptAt.
+// If the delivery is not pending, then an error is returned.
+func (w *WebhookDeliveryImpl) Retry(at time.Time, statusCode int, reason string, nextAttemptAt time.Time) error {
+	if err := w.attempt(at, statusCode, reason); err != nil {
+		return err
+	}
+	w.nextAttemptAt = nextAttemptAt
+	return nil
+}
+
+// GiveUp records a failed attempt, after which the delivery is dead.
+// If the delivery is not pending, then an error is returned.
+func (w *WebhookDeliveryImpl) GiveUp(at time.Time, statusCode int, reason string) error {
+	if err := w.attempt(at, statusCode, reason); err != nil {
+		return err
+	}
+	w.status = DeliveryDead
+	return nil
+}
+
+// Redeliver makes a dead delivery pending again, to be attempted
+// from at with a fresh count of attempts. If the delivery is not
+// dead, then an error is returned.
+func (w *WebhookDeliveryImpl) Redeliver(at time.Time) error {
+	if w.status != DeliveryDead {
+		return fmt.Errorf("cannot redeliver webhook delivery - %w",
+			commonerror.NewConflict("webhook delivery", fmt.Sprintf("it is %s", w.status)))
+	}
+	w.status = DeliveryPending
+	w.attempts = 0
+	w.nextAttemptAt = at
+	return nil
+}
+
+func (w *WebhookDeliveryImpl) attempt(at time.Time, statusCode int, reason string) error {
+	if w.status != DeliveryPending {
+		return fmt.Errorf("cannot attempt webhook delivery - it is %s", w.status)
+	}
+	w.attempts++
+	w.lastAttemptAt = &at
+	w.lastStatusCode = statusCode
+	w.lastError = reason
+	return nil
+}
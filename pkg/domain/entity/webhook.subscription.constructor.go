@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// WebhookSubscriptionConstructor constructs WebhookSubscriptions
+type WebhookSubscriptionConstructor interface {
+	Reincarnate(id ID, url string, eventTypes []EventType, secret string, createdAt time.Time) WebhookSubscription
+	New(url string, eventTypes []EventType, secret string, createdAt time.Time) (WebhookSubscription, error)
+}
+
+// WebhookSubscriptionConstructorImpl implements WebhookSubscriptionConstructor
+type WebhookSubscriptionConstructorImpl struct{}
+
+var _ WebhookSubscriptionConstructor = &WebhookSubscriptionConstructorImpl{}
+
+// NewWebhookSubscriptionConstructorImpl is a constructor
+func NewWebhookSubscriptionConstructorImpl() *WebhookSubscriptionConstructorImpl {
+	return &WebhookSubscriptionConstructorImpl{}
+}
+
+// Reincarnate creates an entity which was already tested and accepted - but
+// just needs to be restored. Thus, this method bypasses validation. It should
+// be used from system-sources, e.g. a database, and not user sources, e.g.
+// a request.
+func (w *WebhookSubscriptionConstructorImpl) Reincarnate(id ID, url string, eventTypes []EventType, secret string, createdAt time.Time) WebhookSubscription {
+	return &WebhookSubscriptionImpl{
+		id:         id,
+		url:        url,
+		eventTypes: eventTypes,
+		secret:     secret,
+		createdAt:  createdAt,
+	}
+}
+
+// New creates a brand new subscription from the given parameters. The
+// input is validated and will fail if appropriate. The resulting entity
+// will not have a valid id (you will probably want to persist it to get
+// one).
+func (w *WebhookSubscriptionConstructorImpl) New(url string, eventTypes []EventType, secret string, createdAt time.Time) (WebhookSubscription, error) {
+	if err := validateWebhookURLField("url", url); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypesField("eventTypes", eventTypes); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookSecretField("secret", secret); err != nil {
+		return nil, err
+	}
+
+	return &WebhookSubscriptionImpl{
+		id:         InvalidID,
+		url:        url,
+		eventTypes: eventTypes,
+		secret:     secret,
+		createdAt:  createdAt,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// MinWebhookSecretLength is the fewest characters a webhook
+// subscription's secret may have.
+const MinWebhookSecretLength = 16
+
+// WebhookSubscription asks for events of certain types to be
+// posted to a URL, signed with a secret shared with the receiver.
+type WebhookSubscription interface {
+	ID() ID
+	URL() string
+	EventTypes() []EventType
+	Secret() string
+	CreatedAt() time.Time
+	Subscribes(EventType) bool
+}
+
+// WebhookSubscriptionImpl implements WebhookSubscription
+type WebhookSubscriptionImpl struct {
+	id         ID
+	url        string
+	eventTypes []EventType
+	secret     string
+	createdAt  time.Time
+}
+
+// Check interface is implemented
+var _ WebhookSubscription = &WebhookSubscriptionImpl{}
+
+// TestWebhookSubscriptionImplConstructor allows you to create a
+// WebhookSubscriptionImpl, directly - bypassing the constructor
+// service. It should ONLY be used in tests.
+func TestWebhookSubscriptionImplConstructor(
+	id ID,
+	url string,
+	eventTypes []EventType,
+	secret string,
+	createdAt time.Time) *WebhookSubscriptionImpl {
+
+	return &WebhookSubscriptionImpl{
+		id:         id,
+		url:        url,
+		eventTypes: eventTypes,
+		secret:     secret,
+		createdAt:  createdAt,
+	}
+}
+
+// ID returns the id.
+func (w *WebhookSubscriptionImpl) ID() ID {
+	return w.id
+}
+
+// URL returns where events are posted to.
+func (w *WebhookSubscriptionImpl) URL() string {
+	return w.url
+}
+
+// EventTypes returns the types of events which are posted.
+func (w *WebhookSubscriptionImpl) EventTypes() []EventType {
+	return w.eventTypes
+}
+
+// Secret returns the key events are signed with.
+func (w *WebhookSubscriptionImpl) Secret() string {
+	return w.secret
+}
+
+// CreatedAt returns when the subscription was made.
+func (w *WebhookSubscriptionImpl) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// Subscribes will return true if events of the given type
+// should be posted.
+func (w *WebhookSubscriptionImpl) Subscribes(eventType EventType) bool {
+	for _, subscribed := range w.eventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func validateWebhookURLField(field string, value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return commonerror.NewValidation(field, "must be an absolute http or https URL")
+	}
+	return nil
+}
+
+func validateEventTypesField(field string, values []EventType) error {
+	if len(values) == 0 {
+		return commonerror.NewValidation(field, "must not be empty")
+	}
+	seen := make(map[EventType]bool)
+	for _, value := range values {
+		if err := validateEventTypeField(field, value); err != nil {
+			return err
+		}
+		if seen[value] {
+			return commonerror.NewValidation(field, "must not repeat "+string(value))
+		}
+		seen[value] = true
+	}
+	return nil
+}
+
+func validateWebhookSecretField(field string, value string) error {
+	if err := validateStringField(field, value); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(value) < MinWebhookSecretLength {
+		return commonerror.NewValidation(field, fmt.Sprintf("must be at least %d characters", MinWebhookSecretLength))
+	}
+	return nil
+}
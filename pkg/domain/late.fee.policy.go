@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// LateFeeRule defines how lateness is charged for.
+type LateFeeRule struct {
+	// PerDay is charged for each day (or part of a day) late.
+	PerDay entity.Money
+	// GraceDays are the number of days late which are not charged for.
+	GraceDays int
+	// Cap is the most which may be charged for a single rental.
+	// Zero means there is no cap.
+	Cap entity.Money
+}
+
+// LateFee is the outcome of applying a LateFeePolicy to a return.
+type LateFee struct {
+	DaysLate int
+	Amount   entity.Money
+}
+
+// LateFeePolicy calculates what to charge for returning an
+// item late.
+type LateFeePolicy interface {
+	Calculate(format entity.Format, dueAt time.Time, returnedAt time.Time) LateFee
+}
+
+// LateFeePolicyImpl implements LateFeePolicy with a default rule,
+// which may be overridden for particular formats.
+type LateFeePolicyImpl struct {
+	defaultRule LateFeeRule
+	overrides   map[entity.Format]LateFeeRule
+}
+
+// Check we implement the interface
+var _ LateFeePolicy = &LateFeePolicyImpl{}
+
+// NewLateFeePolicyImpl is a constructor
+func NewLateFeePolicyImpl(defaultRule LateFeeRule, overrides map[entity.Format]LateFeeRule) *LateFeePolicyImpl {
+	return &LateFeePolicyImpl{
+		defaultRule: defaultRule,
+		overrides:   overrides,
+	}
+}
+
+// Calculate works out how many days late an item of the given format
+// was returned, and what to charge for it.
+func (l *LateFeePolicyImpl) Calculate(format entity.Format, dueAt time.Time, returnedAt time.Time) LateFee {
+	daysLate := daysLate(dueAt, returnedAt)
+	if daysLate == 0 {
+		return LateFee{}
+	}
+
+	rule := l.ruleFor(format)
+	chargeable := daysLate - rule.GraceDays
+	if chargeable < 0 {
+		chargeable = 0
+	}
+	amount := rule.PerDay * entity.Money(chargeable)
+	if rule.Cap > 0 && amount > rule.Cap {
+		amount = rule.Cap
+	}
+
+	return LateFee{
+		DaysLate: daysLate,
+		Amount:   amount,
+	}
+}
+
+func (l *LateFeePolicyImpl) ruleFor(format entity.Format) LateFeeRule {
+	if rule, ok := l.overrides[format]; ok {
+		return rule
+	}
+	return l.defaultRule
+}
+
+// daysLate counts part of a day as a whole day.
+func daysLate(dueAt time.Time, returnedAt time.Time) int {
+	late := returnedAt.Sub(dueAt)
+	if late <= 0 {
+		return 0
+	}
+	day := 24 * time.Hour
+	return int((late + day - 1) / day)
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// The parts of a location, as named in a location format template.
+var locationFields = []string{"store", "aisle", "shelf", "slot"}
+
+var locationFieldPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// LocationFormat reads and writes locations as text.
+type LocationFormat interface {
+	Parse(string) (entity.Location, error)
+	Format(entity.Location) string
+}
+
+// LocationFormatImpl implements LocationFormat with a template such
+// as "{store}-{aisle}-{shelf}-{slot}". Each part of a location is
+// made up of letters and digits.
+type LocationFormatImpl struct {
+	template string
+	pattern  *regexp.Regexp
+	fields   []string
+}
+
+// Check we implement the interface
+var _ LocationFormat = &LocationFormatImpl{}
+
+// NewLocationFormatImpl is a constructor. The template must give each
+// part of a location once, separating them with something which is
+// not a letter or digit.
+func NewLocationFormatImpl(template string) (*LocationFormatImpl, error) {
+	var fields []string
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, match := range locationFieldPattern.FindAllStringSubmatchIndex(template, -1) {
+		literal := template[last:match[0]]
+		field := template[match[2]:match[3]]
+		if !containsString(locationFields, field) {
+			return nil, fmt.Errorf("has an unknown part {%s}", field)
+		}
+		if containsString(fields, field) {
+			return nil, fmt.Errorf("has {%s} more than once", field)
+		}
+		if len(fields) > 0 && !hasSeparator(literal) {
+			return nil, fmt.Errorf("must separate {%s} and {%s} with something which is not a letter or digit", fields[len(fields)-1], field)
+		}
+		pattern.WriteString(regexp.QuoteMeta(literal))
+		pattern.WriteString("([A-Za-z0-9]+)")
+		fields = append(fields, field)
+		last = match[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+	if len(fields) != len(locationFields) {
+		return nil, fmt.Errorf("must have each of {store}, {aisle}, {shelf} and {slot}")
+	}
+
+	return &LocationFormatImpl{
+		template: template,
+		pattern:  regexp.MustCompile(pattern.String()),
+		fields:   fields,
+	}, nil
+}
+
+// Parse reads a location written in the format. It returns a
+// validation error if the text is not in the format.
+func (l *LocationFormatImpl) Parse(text string) (entity.Location, error) {
+	match := l.pattern.FindStringSubmatch(text)
+	if match == nil {
+		return entity.Location{}, commonerror.NewValidation("location", "must look like "+l.template)
+	}
+
+	var result entity.Location
+	for i, field := range l.fields {
+		value := match[i+1]
+		switch field {
+		case "store":
+			result.Store = value
+		case "aisle":
+			result.Aisle = value
+		case "shelf":
+			result.Shelf = value
+		case "slot":
+			result.Slot = value
+		}
+	}
+	return result, nil
+}
+
+// Format writes a location in the format.
+func (l *LocationFormatImpl) Format(location entity.Location) string {
+	return strings.NewReplacer(
+		"{store}", location.Store,
+		"{aisle}", location.Aisle,
+		"{shelf}", location.Shelf,
+		"{slot}", location.Slot,
+	).Replace(l.template)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSeparator returns true if s has something which could not be
+// part of a location, so that the parts either side can be told apart.
+func hasSeparator(s string) bool {
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return true
+		}
+	}
+	return false
+}
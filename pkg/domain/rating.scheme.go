@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Rating is a classification in a rating scheme, along with how old
+// an account holder must be to rent titles given it.
+type Rating struct {
+	Name       string
+	MinimumAge int
+}
+
+// RatingSchemes are the rating schemes we know of, by name. Ratings
+// are listed from least to most restricted.
+var RatingSchemes = map[string][]Rating{
+	"mpaa": {
+		{Name: "G", MinimumAge: 0},
+		{Name: "PG", MinimumAge: 0},
+		{Name: "PG-13", MinimumAge: 13},
+		{Name: "R", MinimumAge: 17},
+		{Name: "NC-17", MinimumAge: 18},
+	},
+	"bbfc": {
+		{Name: "U", MinimumAge: 0},
+		{Name: "PG", MinimumAge: 0},
+		{Name: "12A", MinimumAge: 12},
+		{Name: "12", MinimumAge: 12},
+		{Name: "15", MinimumAge: 15},
+		{Name: "18", MinimumAge: 18},
+		{Name: "R18", MinimumAge: 18},
+	},
+}
+
+// RatingScheme decides which ratings a title may be given, and who
+// may rent titles with each rating.
+type RatingScheme interface {
+	Validate(rating string) error
+	MinimumAge(rating string) int
+	CheckAge(rating string, renter entity.Account, on time.Time) error
+}
+
+// RatingSchemeImpl implements RatingScheme with a list of ratings,
+// whose minimum ages may be overridden.
+type RatingSchemeImpl struct {
+	names       []string
+	minimumAges map[string]int
+}
+
+// Check we implement the interface
+var _ RatingScheme = &RatingSchemeImpl{}
+
+// NewRatingSchemeImpl is a constructor. Overrides for ratings which
+// are not in the list add to it.
+func NewRatingSchemeImpl(ratings []Rating, overrides map[string]int) *RatingSchemeImpl {
+	names := make([]string, 0, len(ratings))
+	minimumAges := make(map[string]int)
+	for _, rating := range ratings {
+		names = append(names, rating.Name)
+		minimumAges[rating.Name] = rating.MinimumAge
+	}
+	var added []string
+	for name, minimumAge := range overrides {
+		if _, ok := minimumAges[name]; !ok {
+			added = append(added, name)
+		}
+		minimumAges[name] = minimumAge
+	}
+	sort.Strings(added)
+	names = append(names, added...)
+	return &RatingSchemeImpl{
+		names:       names,
+		minimumAges: minimumAges,
+	}
+}
+
+// Validate returns an error if the rating is not part of the scheme.
+// A blank rating means the title is unrated, and is allowed.
+func (r *RatingSchemeImpl) Validate(rating string) error {
+	if rating == "" {
+		return nil
+	}
+	if _, ok := r.minimumAges[rating]; !ok {
+		return commonerror.NewValidation("rating", "must be one of "+strings.Join(r.names, ", "))
+	}
+	return nil
+}
+
+// MinimumAge returns how old an account holder must be to rent a
+// title with the rating. Unrated titles, and titles rated before the
+// scheme was in place, are not restricted.
+func (r *RatingSchemeImpl) MinimumAge(rating string) int {
+	return r.minimumAges[rating]
+}
+
+// CheckAge returns an error if the renter is not old enough, on the
+// given day, to rent a title with the rating. Renters whose date of
+// birth is not known may only rent titles which are not restricted.
+func (r *RatingSchemeImpl) CheckAge(rating string, renter entity.Account, on time.Time) error {
+	minimumAge := r.MinimumAge(rating)
+	if minimumAge == 0 {
+		return nil
+	}
+	age, known := renter.AgeOn(on)
+	if !known {
+		return commonerror.NewAgeRestriction(rating, minimumAge, "account has no date of birth")
+	}
+	if age < minimumAge {
+		return commonerror.NewAgeRestriction(rating, minimumAge, fmt.Sprintf("account holder is %d", age))
+	}
+	return nil
+}
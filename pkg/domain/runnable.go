@@ -1,5 +1,40 @@
 package domain
 
+import "context"
+
 // Runnable encapsulates logic that can just be
 // run - it requires no further input or setup.
 type Runnable func() error
+
+// Shutdown releases what was set up for a Runnable once it has
+// finished, e.g. by flushing buffered work. It gives up once ctx is
+// done.
+type Shutdown func(ctx context.Context) error
+
+// NothingToShutdown is a Shutdown for when nothing needs releasing.
+func NothingToShutdown(ctx context.Context) error {
+	return nil
+}
+
+// Alongside returns a Runnable which runs main, with each of workers
+// running in the background. It returns what main returns, or the
+// error of the first worker to fail.
+func Alongside(main Runnable, workers ...Runnable) Runnable {
+	if len(workers) == 0 {
+		return main
+	}
+	return func() error {
+		errs := make(chan error, len(workers)+1)
+		for _, worker := range workers {
+			go func(worker Runnable) {
+				if err := worker(); err != nil {
+					errs <- err
+				}
+			}(worker)
+		}
+		go func() {
+			errs <- main()
+		}()
+		return <-errs
+	}
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+// Transactor runs units of work atomically: either every change made
+// by the work is kept, or none are. Work must use the context it is
+// given for its changes to be part of the transaction.
+type Transactor interface {
+	InTransaction(ctx context.Context, work func(ctx context.Context) error) error
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+const redacted = "********"
+
+// ConfigPrinter displays the effective configuration
+type ConfigPrinter interface {
+	Print() error
+}
+
+// ConfigPrinterImpl implements ConfigPrinter
+type ConfigPrinterImpl struct {
+	settings []config.Setting
+	out      io.Writer
+}
+
+// Check we implement the interface
+var _ ConfigPrinter = &ConfigPrinterImpl{}
+
+// NewConfigPrinterImpl is a constructor
+func NewConfigPrinterImpl(settings []config.Setting, out io.Writer) *ConfigPrinterImpl {
+	return &ConfigPrinterImpl{
+		settings: settings,
+		out:      out,
+	}
+}
+
+// Print writes each setting as NAME=value, with secret values redacted.
+func (c *ConfigPrinterImpl) Print() error {
+	for _, setting := range c.settings {
+		value := setting.Value
+		if setting.Secret && value != "" {
+			value = redacted
+		}
+		if _, err := fmt.Fprintf(c.out, "%s=%s\n", setting.Name, value); err != nil {
+			return fmt.Errorf("could not print config - write error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+const inventoryUsage = "usage: inventory list [FORMAT]|get ID|create TITLE_ID FORMAT BARCODE LOCATION" +
+	"|update ID TITLE_ID FORMAT BARCODE LOCATION|delete ID|checkout ID ACCOUNT_ID|checkin ID"
+
+// InventoryCommand runs inventory subcommands
+type InventoryCommand interface {
+	Run(args []string) error
+}
+
+// InventoryCommandImpl implements InventoryCommand
+type InventoryCommandImpl struct {
+	inventoryService inventory.Service
+	encoderService   json.EncoderService
+	out              io.Writer
+	output           string
+}
+
+// Check we implement the interface
+var _ InventoryCommand = &InventoryCommandImpl{}
+
+// NewInventoryCommandImpl is a constructor. Results are printed as
+// output, i.e. "table" or "json".
+func NewInventoryCommandImpl(
+	inventoryService inventory.Service,
+	encoderService json.EncoderService,
+	out io.Writer,
+	output string,
+) *InventoryCommandImpl {
+
+	return &InventoryCommandImpl{
+		inventoryService: inventoryService,
+		encoderService:   encoderService,
+		out:              out,
+		output:           output,
+	}
+}
+
+// Run performs the subcommand given by args (excluding "inventory"),
+// and prints any result.
+func (i *InventoryCommandImpl) Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing inventory subcommand - %s", inventoryUsage)
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "list":
+		if len(args) > 2 {
+			return fmt.Errorf("list expects at most 1 argument(s) (got %d) - %s", len(args)-1, inventoryUsage)
+		}
+		var vos []inventory.ThinViewVO
+		var err error
+		if len(args) == 1 {
+			vos, err = i.inventoryService.ReadAll(ctx)
+		} else {
+			vos, err = i.inventoryService.ReadAllOfFormat(ctx, entity.Format(args[1]))
+		}
+		if err != nil {
+			return err
+		}
+		return i.printThinViews(vos)
+
+	case "get":
+		if err := expectArgs(args, 1, inventoryUsage); err != nil {
+			return err
+		}
+		id, err := parseID("ID", args[1])
+		if err != nil {
+			return err
+		}
+		vo, err := i.inventoryService.ReadDetails(ctx, id)
+		if err != nil {
+			return err
+		}
+		return i.printView(vo)
+
+	case "create":
+		if err := expectArgs(args, 4, inventoryUsage); err != nil {
+			return err
+		}
+		titleID, err := parseID("TITLE_ID", args[1])
+		if err != nil {
+			return err
+		}
+		id, err := i.inventoryService.Create(ctx, &inventory.CreateItemVO{
+			TitleID:  titleID,
+			Format:   entity.Format(args[2]),
+			Barcode:  args[3],
+			Location: args[4],
+		})
+		if err != nil {
+			return err
+		}
+		return i.print("%d\n", id)
+
+	case "update":
+		if err := expectArgs(args, 5, inventoryUsage); err != nil {
+			return err
+		}
+		id, err := parseID("ID", args[1])
+		if err != nil {
+			return err
+		}
+		titleID, err := parseID("TITLE_ID", args[2])
+		if err != nil {
+			return err
+		}
+		return i.inventoryService.Update(ctx, id, &inventory.UpdateItemVO{
+			TitleID:  titleID,
+			Format:   entity.Format(args[3]),
+			Barcode:  args[4],
+			Location: args[5],
+		})
+
+	case "delete":
+		if err := expectArgs(args, 1, inventoryUsage); err != nil {
+			return err
+		}
+		id, err := parseID("ID", args[1])
+		if err != nil {
+			return err
+		}
+		return i.inventoryService.Delete(ctx, id)
+
+	case "checkout":
+		if err := expectArgs(args, 2, inventoryUsage); err != nil {
+			return err
+		}
+		id, err := parseID("ID", args[1])
+		if err != nil {
+			return err
+		}
+		accountID, err := parseID("ACCOUNT_ID", args[2])
+		if err != nil {
+			return err
+		}
+		return i.inventoryService.Checkout(ctx, id, &inventory.CheckoutVO{AccountID: accountID})
+
+	case "checkin":
+		if err := expectArgs(args, 1, inventoryUsage); err != nil {
+			return err
+		}
+		id, err := parseID("ID", args[1])
+		if err != nil {
+			return err
+		}
+		vo, err := i.inventoryService.CheckIn(ctx, id)
+		if err != nil {
+			return err
+		}
+		return i.printReceiptLine(vo)
+
+	default:
+		return fmt.Errorf("unknown inventory subcommand: %s - %s", args[0], inventoryUsage)
+	}
+}
+
+func (i *InventoryCommandImpl) printThinViews(vos []inventory.ThinViewVO) error {
+	if i.output == "json" {
+		return i.printJSON(i.encoderService.FromInventoryItemThinViews(vos))
+	}
+
+	rows := [][]interface{}{{"ID", "TITLE ID", "FORMAT", "BARCODE"}}
+	for _, vo := range vos {
+		rows = append(rows, []interface{}{vo.ID, vo.TitleID, vo.Format, vo.Barcode})
+	}
+	return i.printTable(rows)
+}
+
+func (i *InventoryCommandImpl) printView(vo *inventory.ViewVO) error {
+	if i.output == "json" {
+		return i.printJSON(i.encoderService.FromInventoryItemView(vo))
+	}
+
+	dueAt := "-"
+	if vo.DueAt != nil {
+		dueAt = vo.DueAt.Format(entity.DateLayout)
+	}
+	return i.printTable([][]interface{}{
+		{"ID", vo.ID},
+		{"TITLE ID", vo.TitleID},
+		{"FORMAT", vo.Format},
+		{"BARCODE", vo.Barcode},
+		{"LOCATION", vo.Location},
+		{"AVAILABLE", vo.Available},
+		{"DUE AT", dueAt},
+		{"OVERDUE", vo.Overdue},
+	})
+}
+
+func (i *InventoryCommandImpl) printReceiptLine(vo *rental.ReceiptLineVO) error {
+	if vo == nil {
+		return i.print("checked in, no rental\n")
+	}
+	if i.output == "json" {
+		return i.printJSON(i.encoderService.FromRentalReceiptLine(vo))
+	}
+
+	return i.printTable([][]interface{}{
+		{"RENTAL ID", vo.RentalID},
+		{"ITEM ID", vo.ItemID},
+		{"ACCOUNT ID", vo.AccountID},
+		{"DUE AT", vo.DueAt.Format(entity.DateLayout)},
+		{"RETURNED AT", vo.ReturnedAt.Format(entity.DateLayout)},
+		{"DAYS LATE", vo.DaysLate},
+		{"LATE FEE (CENTS)", vo.LateFee},
+	})
+}
+
+func (i *InventoryCommandImpl) printJSON(bytes []byte, err error) error {
+	if err != nil {
+		return fmt.Errorf("could not print result - encode error: %w", err)
+	}
+	return i.print("%s\n", bytes)
+}
+
+func (i *InventoryCommandImpl) printTable(rows [][]interface{}) error {
+	w := tabwriter.NewWriter(i.out, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		for j, cell := range row {
+			if j > 0 {
+				fmt.Fprint(w, "\t")
+			}
+			fmt.Fprint(w, cell)
+		}
+		fmt.Fprint(w, "\n")
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not print result - write error: %w", err)
+	}
+	return nil
+}
+
+func (i *InventoryCommandImpl) print(format string, a ...interface{}) error {
+	if _, err := fmt.Fprintf(i.out, format, a...); err != nil {
+		return fmt.Errorf("could not print result - write error: %w", err)
+	}
+	return nil
+}
+
+func parseID(name string, arg string) (entity.ID, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("%s must be a whole number (is %s)", name, arg)
+	}
+	return entity.ID(id), nil
+}
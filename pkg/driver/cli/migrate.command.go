@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/db"
+)
+
+const migrateUsage = "usage: migrate up|down N|goto V|version|force V"
+
+// MigrateCommand runs migrate subcommands
+type MigrateCommand interface {
+	Run(args []string) error
+}
+
+// MigrateCommandImpl implements MigrateCommand
+type MigrateCommandImpl struct {
+	migrator db.Migrator
+	out      io.Writer
+}
+
+// Check we implement the interface
+var _ MigrateCommand = &MigrateCommandImpl{}
+
+// NewMigrateCommandImpl is a constructor
+func NewMigrateCommandImpl(migrator db.Migrator, out io.Writer) *MigrateCommandImpl {
+	return &MigrateCommandImpl{
+		migrator: migrator,
+		out:      out,
+	}
+}
+
+// Run performs the subcommand given by args (excluding "migrate"),
+// and then prints the resulting version.
+func (m *MigrateCommandImpl) Run(args []string) error {
+	if err := m.run(args); err != nil {
+		return err
+	}
+	return m.printVersion()
+}
+
+func (m *MigrateCommandImpl) run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate subcommand - %s", migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		if err := expectArgs(args, 0, migrateUsage); err != nil {
+			return err
+		}
+		return m.migrator.Up()
+
+	case "down":
+		if err := expectArgs(args, 1, migrateUsage); err != nil {
+			return err
+		}
+		steps, err := strconv.Atoi(args[1])
+		if err != nil || steps < 1 {
+			return fmt.Errorf("down requires a positive number of steps (is %s)", args[1])
+		}
+		return m.migrator.Down(steps)
+
+	case "goto":
+		if err := expectArgs(args, 1, migrateUsage); err != nil {
+			return err
+		}
+		version, err := strconv.ParseUint(args[1], 10, 0)
+		if err != nil {
+			return fmt.Errorf("goto requires a version (is %s)", args[1])
+		}
+		return m.migrator.Goto(uint(version))
+
+	case "force":
+		if err := expectArgs(args, 1, migrateUsage); err != nil {
+			return err
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil || version < -1 {
+			return fmt.Errorf("force requires a version (is %s)", args[1])
+		}
+		return m.migrator.Force(version)
+
+	case "version":
+		return expectArgs(args, 0, migrateUsage)
+
+	default:
+		return fmt.Errorf("unknown migrate subcommand: %s - %s", args[0], migrateUsage)
+	}
+}
+
+func (m *MigrateCommandImpl) printVersion() error {
+	v, dirty, err := m.migrator.Version()
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(m.out, "DB Migration Version: %d. Dirty: %v\n", v, dirty); err != nil {
+		return fmt.Errorf("could not print version - write error: %w", err)
+	}
+	return nil
+}
+
+func expectArgs(args []string, n int, usage string) error {
+	if len(args)-1 != n {
+		return fmt.Errorf("%s expects %d argument(s) (got %d) - %s", args[0], n, len(args)-1, usage)
+	}
+	return nil
+}
@@ -15,18 +15,21 @@ type DatabaseServiceImpl struct {
 
 var _ sql.DatabaseService = &DatabaseServiceImpl{}
 
-// NewDatabaseServiceImpl is a constructor
-func NewDatabaseServiceImpl(configStore config.Store) (*DatabaseServiceImpl, error) {
+// NewDatabaseServiceImpl is a constructor. If migrate is true, the DB
+// is migrated up before it is used.
+func NewDatabaseServiceImpl(configStore config.Store, migrate bool) (*DatabaseServiceImpl, error) {
 	// Bring up DB
 	db, err := newPostgreSQLDB(configStore)
 	if err != nil {
 		return nil, fmt.Errorf("could not create database service - could not init db: %w", err)
 	}
 
-	// Perform migrations
-	err = migratePostgreSQLDB(configStore, db)
-	if err != nil {
-		return nil, fmt.Errorf("could not create database service - could not migrate db: %w", err)
+	// Perform migrations, unless they are managed separately
+	if migrate {
+		err = migratePostgreSQLDB(configStore, db)
+		if err != nil {
+			return nil, fmt.Errorf("could not create database service - could not migrate db: %w", err)
+		}
 	}
 
 	// Return ready-to-use DB
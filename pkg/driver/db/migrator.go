@@ -0,0 +1,161 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	// Import file source in the background
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+
+	"github.com/liampulles/matchstick-video/migrations"
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+// Migrator moves the DB schema between migration versions
+type Migrator interface {
+	Up() error
+	Down(steps int) error
+	Goto(version uint) error
+	Force(version int) error
+	Version() (version uint, dirty bool, err error)
+	Close() error
+}
+
+// MigratorImpl implements Migrator
+type MigratorImpl struct {
+	m *migrate.Migrate
+}
+
+// Check we implement the interface
+var _ Migrator = &MigratorImpl{}
+
+// NewMigratorImpl is a constructor. It opens its own connection to the
+// DB, which is closed along with the migrator.
+func NewMigratorImpl(configStore config.Store) (*MigratorImpl, error) {
+	db, err := newPostgreSQLDB(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("could not create migrator - could not init db: %w", err)
+	}
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("could not create migrator - driver error: %w", err)
+	}
+	return newMigratorImpl(configStore, driver)
+}
+
+// newSharedMigratorImpl creates a migrator for a DB which is used for
+// other things as well. It takes a connection from the DB's pool, so
+// that closing the migrator leaves the DB open.
+func newSharedMigratorImpl(cfg config.Store, sqlDB *sql.DB) (*MigratorImpl, error) {
+	conn, err := sqlDB.Conn(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not create migrator - connection error: %w", err)
+	}
+	driver, err := postgres.WithConnection(context.Background(), conn, &postgres.Config{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not create migrator - driver error: %w", err)
+	}
+	return newMigratorImpl(cfg, driver)
+}
+
+func newMigratorImpl(cfg config.Store, driver database.Driver) (*MigratorImpl, error) {
+	// Get migration instance, using the embedded migrations unless
+	// another source is given.
+	var m *migrate.Migrate
+	var err error
+	if source := cfg.GetMigrationSource(); source != "" {
+		m, err = migrate.NewWithDatabaseInstance(source, "postgres", driver)
+	} else {
+		m, err = newEmbeddedMigrate(driver)
+	}
+	if err != nil {
+		driver.Close()
+		return nil, fmt.Errorf("could not create migrator - migrate init error: %w", err)
+	}
+
+	return &MigratorImpl{
+		m: m,
+	}, nil
+}
+
+// Up applies all pending migrations
+func (mi *MigratorImpl) Up() error {
+	if err := ignoreNoChange(mi.m.Up()); err != nil {
+		return fmt.Errorf("could not migrate postgres db - up error: %w", err)
+	}
+	return nil
+}
+
+// Down rolls back the given number of applied migrations
+func (mi *MigratorImpl) Down(steps int) error {
+	if err := ignoreNoChange(mi.m.Steps(-steps)); err != nil {
+		return fmt.Errorf("could not migrate postgres db - down error: %w", err)
+	}
+	return nil
+}
+
+// Goto migrates up or down to the given version
+func (mi *MigratorImpl) Goto(version uint) error {
+	if err := ignoreNoChange(mi.m.Migrate(version)); err != nil {
+		return fmt.Errorf("could not migrate postgres db - goto error: %w", err)
+	}
+	return nil
+}
+
+// Force sets the version without running any migrations, clearing
+// the dirty flag. Use it to recover from a failed migration.
+func (mi *MigratorImpl) Force(version int) error {
+	if err := mi.m.Force(version); err != nil {
+		return fmt.Errorf("could not migrate postgres db - force error: %w", err)
+	}
+	return nil
+}
+
+// Version returns the current version, and whether the last migration
+// failed part way. Version is 0 if no migrations have been applied.
+func (mi *MigratorImpl) Version() (uint, bool, error) {
+	v, dirty, err := mi.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("could not migrate postgres db - version error: %w", err)
+	}
+	return v, dirty, nil
+}
+
+// Close releases the migrator's source and connection to the DB.
+func (mi *MigratorImpl) Close() error {
+	sourceErr, dbErr := mi.m.Close()
+	if sourceErr != nil {
+		return fmt.Errorf("could not close migrator - source error: %w", sourceErr)
+	}
+	if dbErr != nil {
+		return fmt.Errorf("could not close migrator - db error: %w", dbErr)
+	}
+	return nil
+}
+
+func newEmbeddedMigrate(driver database.Driver) (*migrate.Migrate, error) {
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewWithInstance("iofs", source, "postgres", driver)
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
@@ -1,14 +1,12 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/postgres"
-
-	// Import file source in the background
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	// Import the PostgreSQL driver in the background
 	_ "github.com/jackc/pgx/v4/stdlib"
 
@@ -22,32 +20,77 @@ func newPostgreSQLDB(cfg config.Store) (*sql.DB, error) {
 		return nil, fmt.Errorf("could not create postgres db - open error: %w", err)
 	}
 
+	// Configure pool
+	db.SetMaxOpenConns(cfg.GetDbMaxOpenConns())
+	db.SetMaxIdleConns(cfg.GetDbMaxIdleConns())
+	db.SetConnMaxLifetime(cfg.GetDbConnMaxLifetime())
+	db.SetConnMaxIdleTime(cfg.GetDbConnMaxIdleTime())
+
+	// Wait for the DB to come up
+	if err := waitForPostgreSQLDB(cfg, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("could not create postgres db - ping error: %w", err)
+	}
+
 	return db, nil
 }
 
-func migratePostgreSQLDB(cfg config.Store, sqlDB *sql.DB) error {
-	// Get migration driver
-	driver, err := postgres.WithInstance(sqlDB, &postgres.Config{})
-	if err != nil {
-		return fmt.Errorf("could not migrate postgres db - driver error: %w", err)
+// waitForPostgreSQLDB pings the DB until it responds, backing off
+// exponentially between attempts, until the connect timeout passes.
+func waitForPostgreSQLDB(cfg config.Store, db *sql.DB) error {
+	timeout := cfg.GetDbConnectTimeout()
+	if timeout == 0 {
+		return db.Ping()
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-	// Get migration instance
-	source := cfg.GetMigrationSource()
-	m, err := migrate.NewWithDatabaseInstance(source, "postgres", driver)
+	backoff := cfg.GetDbConnectBackoff()
+	maxBackoff := cfg.GetDbConnectMaxBackoff()
+	var lastErr error
+	for {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() == nil || lastErr == nil {
+			lastErr = err
+		}
+
+		fmt.Printf("DB not ready, retrying in %s: %v\n", backoff, err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up after %s: %w", timeout, lastErr)
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func migratePostgreSQLDB(cfg config.Store, sqlDB *sql.DB) (err error) {
+	migrator, err := newSharedMigratorImpl(cfg, sqlDB)
 	if err != nil {
-		return fmt.Errorf("could not migrate postgres db - migrate init error: %w", err)
+		return err
 	}
+	defer func() {
+		if closeErr := migrator.Close(); err == nil {
+			err = closeErr
+		}
+	}()
 
 	// Run migrations
-	if err = m.Up(); err != nil && err != migrate.ErrNoChange {
-		return fmt.Errorf("could not migrate postgres db - up error: %w", err)
+	if err = migrator.Up(); err != nil {
+		return err
 	}
 
 	// Display post-migration status
-	v, dirty, err := m.Version()
+	v, dirty, err := migrator.Version()
 	if err != nil {
-		return fmt.Errorf("could not migrate postgres db - version error: %w", err)
+		return err
 	}
 	fmt.Printf("DB Migration Version: %d. Dirty: %v\n", v, dirty)
 
@@ -55,13 +98,34 @@ func migratePostgreSQLDB(cfg config.Store, sqlDB *sql.DB) error {
 }
 
 func getConnectionString(cfg config.Store) string {
-	host := cfg.GetDbHost()
-	port := cfg.GetDbPort()
-	user := cfg.GetDbUser()
-	pass := cfg.GetDbPassword()
-	name := cfg.GetDbName()
-
-	return fmt.Sprintf("user=%s password=%s host=%s port=%d database=%s sslmode=disable",
-		user, pass, host, port, name,
-	)
+	if url := cfg.GetDatabaseURL(); url != "" {
+		return url
+	}
+
+	params := []string{
+		connParam("user", cfg.GetDbUser()),
+		connParam("password", cfg.GetDbPassword()),
+		connParam("host", cfg.GetDbHost()),
+		connParam("port", fmt.Sprint(cfg.GetDbPort())),
+		connParam("database", cfg.GetDbName()),
+		connParam("sslmode", cfg.GetDbSSLMode()),
+	}
+	optional := [][2]string{
+		{"sslrootcert", cfg.GetDbSSLRootCert()},
+		{"sslcert", cfg.GetDbSSLCert()},
+		{"sslkey", cfg.GetDbSSLKey()},
+	}
+	for _, param := range optional {
+		if param[1] != "" {
+			params = append(params, connParam(param[0], param[1]))
+		}
+	}
+	return strings.Join(params, " ")
+}
+
+// connParam formats a key/value connection parameter, quoting the value
+// so that spaces and quotes (e.g. in passwords) survive.
+func connParam(key string, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+	return fmt.Sprintf("%s='%s'", key, escaped)
 }
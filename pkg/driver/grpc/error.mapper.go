@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+// ErrorMapper converts errors into gRPC status errors.
+type ErrorMapper interface {
+	ToStatus(error) error
+}
+
+// ErrorMapperImpl implements ErrorMapper
+type ErrorMapperImpl struct{}
+
+// Check we implement the interface
+var _ ErrorMapper = &ErrorMapperImpl{}
+
+// NewErrorMapperImpl is a constructor
+func NewErrorMapperImpl() *ErrorMapperImpl {
+	return &ErrorMapperImpl{}
+}
+
+// ToStatus parses the error to see if an error in the chain is
+// associated to a specific status code, in the same way as the HTTP
+// adapter does for status codes (check source for details), and
+// creates a status error with the message of the error.
+func (e *ErrorMapperImpl) ToStatus(err error) error {
+	return status.Error(determineCode(err), err.Error())
+}
+
+func determineCode(err error) codes.Code {
+	// The request ran out of time, or the client went away
+	if errors.Is(err, context.DeadlineExceeded) {
+		return codes.DeadlineExceeded
+	}
+	if errors.Is(err, context.Canceled) {
+		return codes.Canceled
+	}
+
+	for nextErr := err; nextErr != nil; nextErr = errors.Unwrap(nextErr) {
+		switch nextErr.(type) {
+		case *commonerror.Validation:
+			return codes.InvalidArgument
+		case *commonerror.NotImplemented:
+			return codes.Unimplemented
+		case *commonerror.AgeRestriction:
+			return codes.PermissionDenied
+		case *commonerror.CreditLimit:
+			return codes.FailedPrecondition
+		case *commonerror.Conflict:
+			return codes.FailedPrecondition
+		case *db.NotFoundError:
+			return codes.NotFound
+		case *db.UniqueConstraintError:
+			return codes.AlreadyExists
+		case *db.ForeignKeyConstraintError:
+			return codes.InvalidArgument
+		}
+	}
+	return codes.Internal
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc/inventorypb"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// InventoryServerImpl implements the inventory gRPC service on top of
+// inventory.Service.
+type InventoryServerImpl struct {
+	inventorypb.UnimplementedInventoryServiceServer
+	inventoryService inventory.Service
+	errorMapper      ErrorMapper
+}
+
+// Check we implement the interface
+var _ inventorypb.InventoryServiceServer = &InventoryServerImpl{}
+
+// NewInventoryServerImpl is a constructor
+func NewInventoryServerImpl(
+	inventoryService inventory.Service,
+	errorMapper ErrorMapper,
+) *InventoryServerImpl {
+
+	return &InventoryServerImpl{
+		inventoryService: inventoryService,
+		errorMapper:      errorMapper,
+	}
+}
+
+// Create creates an inventory item
+func (i *InventoryServerImpl) Create(ctx context.Context, req *inventorypb.CreateItemRequest) (*inventorypb.CreateItemResponse, error) {
+	vo := &inventory.CreateItemVO{
+		TitleID:  entity.ID(req.GetTitleId()),
+		Format:   entity.Format(req.GetFormat()),
+		Barcode:  req.GetBarcode(),
+		Location: req.GetLocation(),
+	}
+
+	id, err := i.inventoryService.Create(ctx, vo)
+	if err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+
+	return &inventorypb.CreateItemResponse{Id: int64(id)}, nil
+}
+
+// Read reads the details of an inventory item
+func (i *InventoryServerImpl) Read(ctx context.Context, req *inventorypb.ReadItemRequest) (*inventorypb.Item, error) {
+	vo, err := i.inventoryService.ReadDetails(ctx, entity.ID(req.GetId()))
+	if err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+
+	item := &inventorypb.Item{
+		Id:        int64(vo.ID),
+		TitleId:   int64(vo.TitleID),
+		Format:    string(vo.Format),
+		Barcode:   vo.Barcode,
+		Location:  vo.Location,
+		Available: vo.Available,
+		Overdue:   vo.Overdue,
+	}
+	if vo.DueAt != nil {
+		item.DueAt = timestamppb.New(*vo.DueAt)
+	}
+	return item, nil
+}
+
+// List sends an outline of each inventory item, optionally only those
+// of the format given in the request.
+func (i *InventoryServerImpl) List(req *inventorypb.ListItemsRequest, stream inventorypb.InventoryService_ListServer) error {
+	ctx := stream.Context()
+
+	var vos []inventory.ThinViewVO
+	var err error
+	if req.GetFormat() != "" {
+		vos, err = i.inventoryService.ReadAllOfFormat(ctx, entity.Format(req.GetFormat()))
+	} else {
+		vos, err = i.inventoryService.ReadAll(ctx)
+	}
+	if err != nil {
+		return i.errorMapper.ToStatus(err)
+	}
+
+	for _, vo := range vos {
+		summary := &inventorypb.ItemSummary{
+			Id:      int64(vo.ID),
+			TitleId: int64(vo.TitleID),
+			Format:  string(vo.Format),
+			Barcode: vo.Barcode,
+		}
+		if err := stream.Send(summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Update updates the details of an inventory item
+func (i *InventoryServerImpl) Update(ctx context.Context, req *inventorypb.UpdateItemRequest) (*emptypb.Empty, error) {
+	vo := &inventory.UpdateItemVO{
+		TitleID:  entity.ID(req.GetTitleId()),
+		Format:   entity.Format(req.GetFormat()),
+		Barcode:  req.GetBarcode(),
+		Location: req.GetLocation(),
+	}
+
+	if err := i.inventoryService.Update(ctx, entity.ID(req.GetId()), vo); err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Delete removes an inventory item from the system
+func (i *InventoryServerImpl) Delete(ctx context.Context, req *inventorypb.DeleteItemRequest) (*emptypb.Empty, error) {
+	if err := i.inventoryService.Delete(ctx, entity.ID(req.GetId())); err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Checkout checks out an inventory item to an account
+func (i *InventoryServerImpl) Checkout(ctx context.Context, req *inventorypb.CheckoutRequest) (*emptypb.Empty, error) {
+	vo := &inventory.CheckoutVO{
+		AccountID: entity.ID(req.GetAccountId()),
+	}
+
+	if err := i.inventoryService.Checkout(ctx, entity.ID(req.GetId()), vo); err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CheckIn checks in a rented inventory item, and returns a receipt. An
+// item which was not rented out has a receipt with no rental (i.e. a
+// rental_id of 0).
+func (i *InventoryServerImpl) CheckIn(ctx context.Context, req *inventorypb.CheckInRequest) (*inventorypb.Receipt, error) {
+	vo, err := i.inventoryService.CheckIn(ctx, entity.ID(req.GetId()))
+	if err != nil {
+		return nil, i.errorMapper.ToStatus(err)
+	}
+	if vo == nil {
+		return &inventorypb.Receipt{ItemId: req.GetId()}, nil
+	}
+
+	return &inventorypb.Receipt{
+		RentalId:   int64(vo.RentalID),
+		ItemId:     int64(vo.ItemID),
+		AccountId:  int64(vo.AccountID),
+		DueAt:      timestamppb.New(vo.DueAt),
+		ReturnedAt: timestamppb.New(vo.ReturnedAt),
+		DaysLate:   int32(vo.DaysLate),
+		LateFee:    int64(vo.LateFee),
+	}, nil
+}
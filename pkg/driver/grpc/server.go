@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	goGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc/inventorypb"
+)
+
+// Server serves gRPC services.
+type Server interface {
+	Run() error
+	Stop(ctx context.Context) error
+}
+
+// ServerImpl implements Server with grpc-go
+type ServerImpl struct {
+	configStore config.Store
+	server      *goGrpc.Server
+}
+
+// Check we implement the interface
+var _ Server = &ServerImpl{}
+
+// NewServerImpl is a constructor. Calls go through the interceptors in
+// order (e.g. for tracing), and then handle panics.
+func NewServerImpl(
+	configStore config.Store,
+	inventoryServer inventorypb.InventoryServiceServer,
+	unaryInterceptors []goGrpc.UnaryServerInterceptor,
+	streamInterceptors []goGrpc.StreamServerInterceptor,
+) *ServerImpl {
+
+	server := goGrpc.NewServer(
+		goGrpc.ChainUnaryInterceptor(append(unaryInterceptors, recoverUnary)...),
+		goGrpc.ChainStreamInterceptor(append(streamInterceptors, recoverStream)...),
+	)
+	inventorypb.RegisterInventoryServiceServer(server, inventoryServer)
+
+	return &ServerImpl{
+		configStore: configStore,
+		server:      server,
+	}
+}
+
+// Run listens on the configured gRPC port and serves the registered
+// services until the listener fails, or the server is stopped.
+func (s *ServerImpl) Run() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.configStore.GetGrpcPort()))
+	if err != nil {
+		return fmt.Errorf("could not run gRPC server - listen error: %w", err)
+	}
+	return s.server.Serve(lis)
+}
+
+// Stop stops the server taking new calls, and waits for calls in
+// progress to finish. Once ctx is done, those still in progress are
+// cancelled instead.
+func (s *ServerImpl) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return fmt.Errorf("could not stop gRPC server gracefully - %w", ctx.Err())
+	}
+}
+
+// recoverUnary turns a panic in a handler into an INTERNAL status, so
+// that one bad call does not take down the server.
+func recoverUnary(
+	ctx context.Context,
+	req interface{},
+	info *goGrpc.UnaryServerInfo,
+	handler goGrpc.UnaryHandler,
+) (resp interface{}, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoverStream is the streaming equivalent of recoverUnary.
+func recoverStream(
+	srv interface{},
+	stream goGrpc.ServerStream,
+	info *goGrpc.StreamServerInfo,
+	handler goGrpc.StreamHandler,
+) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+func recovered(method string, r interface{}) error {
+	return status.Errorf(codes.Internal, "could not handle %s - panic: %v", method, r)
+}
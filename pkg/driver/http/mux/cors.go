@@ -0,0 +1,101 @@
+package mux
+
+import (
+	goHttp "net/http"
+	"strconv"
+	"strings"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+// CORS lets browsers call the server from other origins.
+type CORS interface {
+	Wrap(goHttp.Handler) goHttp.Handler
+	Preflight(methods []string) Handler
+}
+
+// CORSImpl implements CORS
+type CORSImpl struct {
+	configStore config.Store
+}
+
+// Check we implement the interface
+var _ CORS = &CORSImpl{}
+
+// NewCORSImpl is a constructor
+func NewCORSImpl(configStore config.Store) *CORSImpl {
+	return &CORSImpl{
+		configStore: configStore,
+	}
+}
+
+// Wrap can be used as a Middleware. Responses to allowed origins may be
+// read by the browser.
+func (c *CORSImpl) Wrap(next goHttp.Handler) goHttp.Handler {
+	return goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		header := res.Header()
+		if len(c.configStore.GetCORSAllowedOrigins()) > 0 {
+			header.Add("Vary", "Origin")
+		}
+		if origin := c.allowedOrigin(req); origin != "" {
+			header.Set("Access-Control-Allow-Origin", origin)
+			if c.configStore.GetCORSAllowCredentials() {
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+		next.ServeHTTP(res, req)
+	})
+}
+
+// Preflight answers OPTIONS requests for a path which supports the
+// given methods. Allowed origins are told which of them they may use.
+func (c *CORSImpl) Preflight(methods []string) Handler {
+	allow := strings.Join(append(append([]string{}, methods...), goHttp.MethodOptions), ", ")
+	return func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		header := res.Header()
+		header.Set("Allow", allow)
+		if c.allowedOrigin(req) != "" {
+			header.Set("Access-Control-Allow-Methods", strings.Join(c.allowedMethods(methods), ", "))
+			header.Set("Access-Control-Allow-Headers", strings.Join(c.configStore.GetCORSAllowedHeaders(), ", "))
+			header.Set("Access-Control-Max-Age", strconv.Itoa(int(c.configStore.GetCORSMaxAge().Seconds())))
+		}
+		res.WriteHeader(goHttp.StatusNoContent)
+	}
+}
+
+// allowedOrigin returns the value for Access-Control-Allow-Origin, or
+// blank if the request's origin is not allowed.
+func (c *CORSImpl) allowedOrigin(req *goHttp.Request) string {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return ""
+	}
+	for _, allowed := range c.configStore.GetCORSAllowedOrigins() {
+		if allowed == "*" {
+			return "*"
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// allowedMethods returns those of the path's methods which may be used
+// from other origins.
+func (c *CORSImpl) allowedMethods(methods []string) []string {
+	configured := c.configStore.GetCORSAllowedMethods()
+	if len(configured) == 0 {
+		return methods
+	}
+	var result []string
+	for _, method := range methods {
+		for _, allowed := range configured {
+			if strings.EqualFold(allowed, method) {
+				result = append(result, method)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -34,24 +34,38 @@ func NewIOMapperImpl(wrapper Wrapper) *IOMapperImpl {
 func (i *IOMapperImpl) MapRequest(req *http.Request) (*adapterHttp.Request, error) {
 	pathParam := i.extractPathParam(req)
 	queryParam := extractQueryParam(req)
+	header := extractHeader(req)
 	body, err := extractBody(req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &adapterHttp.Request{
+		Context:    req.Context(),
 		PathParam:  pathParam,
 		QueryParam: queryParam,
+		Header:     header,
 		Body:       body,
+		RemoteAddr: req.RemoteAddr,
 	}, nil
 }
 
 // MapResponse converts the adapter's notion of a response to mux's (i.e. Go's)
-// version
+// version. A streamed response is sent to the client as it is written, and
+// must not be cached.
 func (i *IOMapperImpl) MapResponse(adapterResp *adapterHttp.Response, goResp http.ResponseWriter) {
 	goResp.Header().Set("Content-Type", adapterResp.ContentType)
+	if adapterResp.Stream == nil {
+		goResp.WriteHeader(int(adapterResp.StatusCode))
+		goResp.Write(adapterResp.Body)
+		return
+	}
+
+	goResp.Header().Set("Cache-Control", "no-cache")
 	goResp.WriteHeader(int(adapterResp.StatusCode))
-	goResp.Write(adapterResp.Body)
+	writer := &streamWriter{ResponseWriter: goResp}
+	writer.Flush()
+	adapterResp.Stream(writer)
 }
 
 func (i *IOMapperImpl) extractPathParam(req *http.Request) map[string]string {
@@ -62,6 +76,14 @@ func extractQueryParam(req *http.Request) map[string][]string {
 	return req.URL.Query()
 }
 
+func extractHeader(req *http.Request) map[string]string {
+	header := make(map[string]string)
+	for name, values := range req.Header {
+		header[name] = values[0]
+	}
+	return header
+}
+
 func extractBody(req *http.Request) ([]byte, error) {
 	bytes, err := ioutil.ReadAll(req.Body)
 	if err != nil {
@@ -69,3 +91,14 @@ func extractBody(req *http.Request) ([]byte, error) {
 	}
 	return bytes, nil
 }
+
+// streamWriter flushes to the client, if the response writer supports it.
+type streamWriter struct {
+	http.ResponseWriter
+}
+
+func (s *streamWriter) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
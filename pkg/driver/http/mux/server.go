@@ -1,8 +1,11 @@
 package mux
 
 import (
+	"context"
 	"fmt"
 	goHttp "net/http"
+	"sort"
+	"time"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/config"
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
@@ -14,6 +17,8 @@ type ServerConfigurationImpl struct {
 	configStore   config.Store
 	handlerMapper HandlerMapper
 	muxWrapper    Wrapper
+	cors          CORS
+	middlewares   []Middleware
 }
 
 // Check we implement the interface
@@ -24,12 +29,16 @@ func NewServerConfigurationImpl(
 	configStore config.Store,
 	handlerMapper HandlerMapper,
 	muxWrapper Wrapper,
+	cors CORS,
+	middlewares []Middleware,
 ) *ServerConfigurationImpl {
 
 	return &ServerConfigurationImpl{
 		configStore:   configStore,
 		handlerMapper: handlerMapper,
 		muxWrapper:    muxWrapper,
+		cors:          cors,
+		middlewares:   middlewares,
 	}
 }
 
@@ -38,15 +47,28 @@ func NewServerConfigurationImpl(
 func (m *ServerConfigurationImpl) CreateRunnable(handlers map[http.HandlerPattern]http.Handler) domain.Runnable {
 
 	r := m.muxWrapper.NewRouter()
+	r.Use(m.middlewares...)
+
 	// Register each handler with mux
-	for pattern, handler := range handlers {
+	patterns := orderPatterns(handlers)
+	methods := methodsByPath(patterns)
+	for i, pattern := range patterns {
+		handler := handlers[pattern]
 		method := pattern.Method
 		pathPattern := pattern.PathPattern
 
 		muxHandler := m.handlerMapper.Map(handler)
+		muxHandler = withTimeout(muxHandler, m.getTimeout(pattern))
 
 		r.HandleFunc(pathPattern, muxHandler).
 			Methods(method)
+
+		// Answer preflight requests once the path's last method is
+		// registered, so that it keeps its place in the order.
+		if i+1 == len(patterns) || patterns[i+1].PathPattern != pathPattern {
+			r.HandleFunc(pathPattern, m.cors.Preflight(methods[pathPattern])).
+				Methods(goHttp.MethodOptions)
+		}
 	}
 
 	// Create a server configuration
@@ -60,3 +82,58 @@ func (m *ServerConfigurationImpl) CreateRunnable(handlers map[http.HandlerPatter
 func (m *ServerConfigurationImpl) getPort() string {
 	return fmt.Sprintf(":%d", m.configStore.GetPort())
 }
+
+// getTimeout returns the route specific timeout for the pattern if there
+// is one, else the general request timeout. Streaming routes have no
+// timeout, unless one is specific to them.
+func (m *ServerConfigurationImpl) getTimeout(pattern http.HandlerPattern) time.Duration {
+	route := fmt.Sprintf("%s %s", pattern.Method, pattern.PathPattern)
+	if timeout, ok := m.configStore.GetRouteTimeouts()[route]; ok {
+		return timeout
+	}
+	if http.StreamingPatterns[pattern] {
+		return 0
+	}
+	return m.configStore.GetRequestTimeout()
+}
+
+// orderPatterns sorts the patterns by path. mux uses the first route
+// which matches a request, and since { sorts after the characters used
+// in our paths, this means a literal segment is tried before a variable
+// in the same place, e.g. /inventory/events before /inventory/{id}.
+func orderPatterns(handlers map[http.HandlerPattern]http.Handler) []http.HandlerPattern {
+	patterns := make([]http.HandlerPattern, 0, len(handlers))
+	for pattern := range handlers {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if patterns[i].PathPattern != patterns[j].PathPattern {
+			return patterns[i].PathPattern < patterns[j].PathPattern
+		}
+		return patterns[i].Method < patterns[j].Method
+	})
+	return patterns
+}
+
+// methodsByPath groups the methods of the (ordered) patterns by path.
+func methodsByPath(patterns []http.HandlerPattern) map[string][]string {
+	result := make(map[string][]string)
+	for _, pattern := range patterns {
+		result[pattern.PathPattern] = append(result[pattern.PathPattern], pattern.Method)
+	}
+	return result
+}
+
+// withTimeout sets a deadline on the request context, so that any work
+// done on behalf of the request (e.g. a DB query) is cancelled once the
+// timeout elapses. A timeout of zero disables the deadline.
+func withTimeout(handler Handler, timeout time.Duration) Handler {
+	if timeout <= 0 {
+		return handler
+	}
+	return func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		handler(res, req.WithContext(ctx))
+	}
+}
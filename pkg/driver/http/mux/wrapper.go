@@ -11,10 +11,15 @@ type Route interface {
 	Methods(...string) *mux.Route
 }
 
+// Middleware decorates a go handler with some behaviour
+// common to all routes.
+type Middleware func(goHttp.Handler) goHttp.Handler
+
 // Router wraps mux.Router
 type Router interface {
 	goHttp.Handler
 	HandleFunc(pathPattern string, handler Handler) Route
+	Use(middleware ...Middleware)
 }
 
 // RouterImpl implements Router
@@ -29,6 +34,13 @@ func (r *RouterImpl) HandleFunc(pathPattern string, handler Handler) Route {
 	return r.MuxRouter.HandleFunc(pathPattern, handler)
 }
 
+// Use wraps mux.Router.Use()
+func (r *RouterImpl) Use(middleware ...Middleware) {
+	for _, m := range middleware {
+		r.MuxRouter.Use(mux.MiddlewareFunc(m))
+	}
+}
+
 // ServeHTTP wraps mux.Router.ServeHTTP()
 func (r *RouterImpl) ServeHTTP(res goHttp.ResponseWriter, req *goHttp.Request) {
 	r.MuxRouter.ServeHTTP(res, req)
@@ -39,6 +51,7 @@ func (r *RouterImpl) ServeHTTP(res goHttp.ResponseWriter, req *goHttp.Request) {
 type Wrapper interface {
 	NewRouter() Router
 	Vars(*goHttp.Request) map[string]string
+	PathTemplate(*goHttp.Request) string
 }
 
 // WrapperImpl implements Wrapper
@@ -62,3 +75,18 @@ func (w *WrapperImpl) NewRouter() Router {
 func (w *WrapperImpl) Vars(req *goHttp.Request) map[string]string {
 	return mux.Vars(req)
 }
+
+// PathTemplate wraps mux.CurrentRoute().GetPathTemplate(). If
+// the request has not been matched to a route, an empty string
+// is returned.
+func (w *WrapperImpl) PathTemplate(req *goHttp.Request) string {
+	route := mux.CurrentRoute(req)
+	if route == nil {
+		return ""
+	}
+	tmpl, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return tmpl
+}
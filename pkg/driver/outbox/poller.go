@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Batch handles a batch of pending work, such as relaying outbox
+// messages, and returns how much it handled.
+type Batch func(context.Context) (int, error)
+
+// Poller handles pending work as it comes in.
+type Poller interface {
+	Run() error
+}
+
+// PollerImpl implements Poller by running a batch at a fixed
+// interval.
+type PollerImpl struct {
+	ctx      context.Context
+	batch    Batch
+	interval time.Duration
+}
+
+// Check we implement the interface
+var _ Poller = &PollerImpl{}
+
+// NewPollerImpl is a constructor. The poller stops when ctx is done.
+func NewPollerImpl(ctx context.Context, batch Batch, interval time.Duration) *PollerImpl {
+	return &PollerImpl{
+		ctx:      ctx,
+		batch:    batch,
+		interval: interval,
+	}
+}
+
+// Run runs the batch every interval until the poller is stopped. A
+// backlog is handled batch after batch without waiting. Batch errors
+// are not returned, since the batch is simply run again - they should
+// be traced by whatever runs it, e.g. the relay.
+func (p *PollerImpl) Run() error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.drain()
+		}
+	}
+}
+
+func (p *PollerImpl) drain() {
+	for p.ctx.Err() == nil {
+		handled, err := p.batch(p.ctx)
+		if err != nil || handled == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit is the size of a token bucket, and how long it takes to refill
+// completely. Tokens are refilled evenly over the period.
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until a token is available, if none was.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+// BucketStore keeps a token bucket for each key. Implementations may
+// share buckets between servers.
+type BucketStore interface {
+	Take(ctx context.Context, key string, limit Limit) (*Result, error)
+}
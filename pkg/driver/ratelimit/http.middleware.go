@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	goHttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/driver/http/mux"
+)
+
+// HTTPMiddleware limits how often each client may call each route.
+type HTTPMiddleware interface {
+	Wrap(goHttp.Handler) goHttp.Handler
+}
+
+// HTTPMiddlewareImpl implements HTTPMiddleware
+type HTTPMiddlewareImpl struct {
+	configStore      config.Store
+	muxWrapper       mux.Wrapper
+	clientIdentifier http.ClientIdentifier
+	bucketStore      BucketStore
+}
+
+// Check we implement the interface
+var _ HTTPMiddleware = &HTTPMiddlewareImpl{}
+
+// NewHTTPMiddlewareImpl is a constructor
+func NewHTTPMiddlewareImpl(
+	configStore config.Store,
+	muxWrapper mux.Wrapper,
+	clientIdentifier http.ClientIdentifier,
+	bucketStore BucketStore,
+) *HTTPMiddlewareImpl {
+
+	return &HTTPMiddlewareImpl{
+		configStore:      configStore,
+		muxWrapper:       muxWrapper,
+		clientIdentifier: clientIdentifier,
+		bucketStore:      bucketStore,
+	}
+}
+
+// Wrap can be used as a mux.Middleware. Requests beyond the limit get a
+// 429, and every limited response says how much of the limit is left.
+// OPTIONS requests are not limited, since browsers send them on their
+// own before other requests (as CORS preflights), and a refused one
+// would hide the limit headers of the request which follows it.
+func (h *HTTPMiddlewareImpl) Wrap(next goHttp.Handler) goHttp.Handler {
+	return goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		if req.Method == goHttp.MethodOptions {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		route := fmt.Sprintf("%s %s", req.Method, h.muxWrapper.PathTemplate(req))
+		limit, ok := h.getLimit(route)
+		if !ok {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		// Rather let requests through than turn everyone away if a
+		// shared store is unavailable.
+		result, err := h.bucketStore.Take(req.Context(), h.clientIdentifier.Identify(req.Header.Get(http.APIKeyHeader), req.RemoteAddr)+" "+route, limit)
+		if err != nil {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		header := res.Header()
+		header.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+		header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		header.Set("RateLimit-Reset", seconds(result.Reset))
+		if !result.Allowed {
+			header.Set("Retry-After", seconds(result.RetryAfter))
+			header.Set("Content-Type", "text/plain; charset=utf-8")
+			res.WriteHeader(goHttp.StatusTooManyRequests)
+			res.Write([]byte(fmt.Sprintf("rate limit exceeded: route=[%s]", route)))
+			return
+		}
+		next.ServeHTTP(res, req)
+	})
+}
+
+// getLimit returns the route specific limit for the route if there is
+// one, else the general limit. A limit of zero means no limit.
+func (h *HTTPMiddlewareImpl) getLimit(route string) (Limit, bool) {
+	burst, ok := h.configStore.GetRouteRateLimits()[route]
+	if !ok {
+		burst = h.configStore.GetRateLimit()
+	}
+	if burst <= 0 {
+		return Limit{}, false
+	}
+	return Limit{Burst: burst, Period: h.configStore.GetRateLimitPeriod()}, true
+}
+
+// seconds rounds the duration up to whole seconds, as headers expect.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
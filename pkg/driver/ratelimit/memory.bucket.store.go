@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// sweepInterval is how often full buckets are forgotten, so that clients
+// which have gone away do not hold on to memory.
+const sweepInterval = time.Minute
+
+// MemoryBucketStoreImpl implements BucketStore in memory, so buckets are
+// not shared between servers.
+type MemoryBucketStoreImpl struct {
+	clock     domain.Clock
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// Check we implement the interface
+var _ BucketStore = &MemoryBucketStoreImpl{}
+
+// NewMemoryBucketStoreImpl is a constructor
+func NewMemoryBucketStoreImpl(clock domain.Clock) *MemoryBucketStoreImpl {
+	return &MemoryBucketStoreImpl{
+		clock:     clock,
+		buckets:   make(map[string]*bucket),
+		lastSweep: clock.Now(),
+	}
+}
+
+// Take takes a token from the bucket for the key, if it has one. A
+// bucket starts out full.
+func (m *MemoryBucketStoreImpl) Take(ctx context.Context, key string, limit Limit) (*Result, error) {
+	now := m.clock.Now()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = b
+	}
+	b.limit = limit
+	b.refill(now)
+
+	result := &Result{}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = b.timeFor(1 - b.tokens)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = b.timeFor(float64(limit.Burst) - b.tokens)
+	return result, nil
+}
+
+func (m *MemoryBucketStoreImpl) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	for key, b := range m.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+	m.lastSweep = now
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   Limit
+}
+
+// refill adds the tokens earned since the bucket was last updated, up
+// to the burst.
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated)
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += float64(elapsed) / float64(b.limit.Period) * float64(b.limit.Burst)
+	if b.tokens > float64(b.limit.Burst) {
+		b.tokens = float64(b.limit.Burst)
+	}
+	b.updated = now
+}
+
+// timeFor returns how long it takes to earn the given tokens.
+func (b *bucket) timeFor(tokens float64) time.Duration {
+	return time.Duration(tokens / float64(b.limit.Burst) * float64(b.limit.Period))
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// AccountServiceImpl decorates an account.Service so that
+// each call is recorded as a span.
+type AccountServiceImpl struct {
+	delegate      account.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ account.Service = &AccountServiceImpl{}
+
+// NewAccountServiceImpl is a constructor
+func NewAccountServiceImpl(delegate account.Service, tracerService TracerService) *AccountServiceImpl {
+	return &AccountServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Create traces account.Service.Create
+func (a *AccountServiceImpl) Create(ctx context.Context, vo *account.CreateAccountVO) (entity.ID, error) {
+	ctx, span := a.start(ctx, "Create")
+	defer span.End()
+
+	id, err := a.delegate.Create(ctx, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadDetails traces account.Service.ReadDetails
+func (a *AccountServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*account.ViewVO, error) {
+	ctx, span := a.start(ctx, "ReadDetails", idAttribute(id))
+	defer span.End()
+
+	vo, err := a.delegate.ReadDetails(ctx, id)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadAll traces account.Service.ReadAll
+func (a *AccountServiceImpl) ReadAll(ctx context.Context) ([]account.ThinViewVO, error) {
+	ctx, span := a.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := a.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Update traces account.Service.Update
+func (a *AccountServiceImpl) Update(ctx context.Context, id entity.ID, vo *account.UpdateAccountVO) error {
+	ctx, span := a.start(ctx, "Update", idAttribute(id))
+	defer span.End()
+
+	err := a.delegate.Update(ctx, id, vo)
+	recordError(span, err)
+	return err
+}
+
+// Delete traces account.Service.Delete
+func (a *AccountServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	ctx, span := a.start(ctx, "Delete", idAttribute(id))
+	defer span.End()
+
+	err := a.delegate.Delete(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+func (a *AccountServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return a.tracerService.Tracer().Start(ctx, "account.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func accountAttribute(id entity.ID) attribute.KeyValue {
+	return attribute.Int64("matchstick.account.id", int64(id))
+}
@@ -0,0 +1,25 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	goGrpc "google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor records each unary gRPC call as a span,
+// continuing the caller's trace if it propagated one.
+func UnaryServerInterceptor(tracerService TracerService) goGrpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor(grpcOptions(tracerService)...)
+}
+
+// StreamServerInterceptor is the streaming equivalent of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(tracerService TracerService) goGrpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor(grpcOptions(tracerService)...)
+}
+
+func grpcOptions(tracerService TracerService) []otelgrpc.Option {
+	return []otelgrpc.Option{
+		otelgrpc.WithTracerProvider(tracerService.Provider()),
+		otelgrpc.WithPropagators(tracerService.Propagator()),
+	}
+}
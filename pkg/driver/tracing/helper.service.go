@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	goSql "database/sql"
+	"strings"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// HelperServiceImpl decorates a sql.HelperService so that
+// each SQL statement is recorded as a span.
+type HelperServiceImpl struct {
+	delegate      sql.HelperService
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ sql.HelperService = &HelperServiceImpl{}
+
+// NewHelperServiceImpl is a constructor
+func NewHelperServiceImpl(delegate sql.HelperService, tracerService TracerService) *HelperServiceImpl {
+	return &HelperServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// ExecForSingleItem traces sql.HelperService.ExecForSingleItem
+func (h *HelperServiceImpl) ExecForSingleItem(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) error {
+	ctx, span := h.start(ctx, "ExecForSingleItem", query)
+	defer span.End()
+
+	err := h.delegate.ExecForSingleItem(ctx, db, query, _type, args...)
+	recordError(span, err)
+	return err
+}
+
+// SingleRowQuery traces sql.HelperService.SingleRowQuery
+func (h *HelperServiceImpl) SingleRowQuery(ctx context.Context, db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
+	ctx, span := h.start(ctx, "SingleRowQuery", query)
+	defer span.End()
+
+	err := h.delegate.SingleRowQuery(ctx, db, query, scanFunc, _type, args...)
+	recordError(span, err)
+	return err
+}
+
+// ManyRowsQuery traces sql.HelperService.ManyRowsQuery
+func (h *HelperServiceImpl) ManyRowsQuery(ctx context.Context, db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
+	ctx, span := h.start(ctx, "ManyRowsQuery", query)
+	defer span.End()
+
+	err := h.delegate.ManyRowsQuery(ctx, db, query, scanFunc, _type, args...)
+	recordError(span, err)
+	return err
+}
+
+// SingleQueryForID traces sql.HelperService.SingleQueryForID
+func (h *HelperServiceImpl) SingleQueryForID(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error) {
+	ctx, span := h.start(ctx, "SingleQueryForID", query)
+	defer span.End()
+
+	id, err := h.delegate.SingleQueryForID(ctx, db, query, _type, args...)
+	recordError(span, err)
+	return id, err
+}
+
+func (h *HelperServiceImpl) start(ctx context.Context, method string, query string) (context.Context, trace.Span) {
+	return h.tracerService.Tracer().Start(ctx, "sql/"+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBStatementKey.String(normalizeQuery(query)),
+		),
+	)
+}
+
+// normalizeQuery collapses the whitespace we use to format
+// queries, so that they are readable in a trace viewer.
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
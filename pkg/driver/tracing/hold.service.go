@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// HoldServiceImpl decorates a hold.Service so that
+// each call is recorded as a span.
+type HoldServiceImpl struct {
+	delegate      hold.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ hold.Service = &HoldServiceImpl{}
+
+// NewHoldServiceImpl is a constructor
+func NewHoldServiceImpl(delegate hold.Service, tracerService TracerService) *HoldServiceImpl {
+	return &HoldServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Place traces hold.Service.Place
+func (h *HoldServiceImpl) Place(ctx context.Context, titleID entity.ID, vo *hold.PlaceHoldVO) (entity.ID, error) {
+	ctx, span := h.start(ctx, "Place", titleAttribute(titleID), accountAttribute(vo.AccountID))
+	defer span.End()
+
+	id, err := h.delegate.Place(ctx, titleID, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadQueue traces hold.Service.ReadQueue
+func (h *HoldServiceImpl) ReadQueue(ctx context.Context, titleID entity.ID) ([]hold.ViewVO, error) {
+	ctx, span := h.start(ctx, "ReadQueue", titleAttribute(titleID))
+	defer span.End()
+
+	vos, err := h.delegate.ReadQueue(ctx, titleID)
+	recordError(span, err)
+	return vos, err
+}
+
+// Cancel traces hold.Service.Cancel
+func (h *HoldServiceImpl) Cancel(ctx context.Context, id entity.ID) error {
+	ctx, span := h.start(ctx, "Cancel", idAttribute(id))
+	defer span.End()
+
+	err := h.delegate.Cancel(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+func (h *HoldServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return h.tracerService.Tracer().Start(ctx, "hold.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func titleAttribute(id entity.ID) attribute.KeyValue {
+	return attribute.Int64("matchstick.title.id", int64(id))
+}
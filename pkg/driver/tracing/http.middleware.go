@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	goHttp "net/http"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/http/mux"
+)
+
+// HTTPMiddleware starts a server span for each HTTP request, continuing
+// any trace given by the caller in the W3C traceparent header.
+type HTTPMiddleware interface {
+	Wrap(goHttp.Handler) goHttp.Handler
+}
+
+// HTTPMiddlewareImpl implements HTTPMiddleware
+type HTTPMiddlewareImpl struct {
+	tracerService TracerService
+	muxWrapper    mux.Wrapper
+}
+
+// Check we implement the interface
+var _ HTTPMiddleware = &HTTPMiddlewareImpl{}
+
+// NewHTTPMiddlewareImpl is a constructor
+func NewHTTPMiddlewareImpl(tracerService TracerService, muxWrapper mux.Wrapper) *HTTPMiddlewareImpl {
+	return &HTTPMiddlewareImpl{
+		tracerService: tracerService,
+		muxWrapper:    muxWrapper,
+	}
+}
+
+// Wrap can be used as a mux.Middleware
+func (h *HTTPMiddlewareImpl) Wrap(next goHttp.Handler) goHttp.Handler {
+	return goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		// Continue the caller's trace, if there is one
+		ctx := h.tracerService.Propagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+		// Start the server span
+		route := h.muxWrapper.PathTemplate(req)
+		ctx, span := h.tracerService.Tracer().Start(ctx, req.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(req.Method),
+				semconv.HTTPRouteKey.String(route),
+				semconv.HTTPTargetKey.String(req.URL.RequestURI()),
+			),
+		)
+		defer span.End()
+
+		// Handle the request, keeping track of the status code
+		recorder := &statusRecorder{ResponseWriter: res, statusCode: goHttp.StatusOK}
+		next.ServeHTTP(recorder, req.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(recorder.statusCode))
+		if recorder.statusCode >= 500 {
+			span.SetStatus(codes.Error, goHttp.StatusText(recorder.statusCode))
+		}
+	})
+}
+
+type statusRecorder struct {
+	goHttp.ResponseWriter
+	statusCode int
+}
+
+func (s *statusRecorder) WriteHeader(statusCode int) {
+	s.statusCode = statusCode
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush lets streamed responses through, if the wrapped writer
+// supports it.
+func (s *statusRecorder) Flush() {
+	if flusher, ok := s.ResponseWriter.(goHttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
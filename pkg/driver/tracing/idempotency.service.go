@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+// IdempotencyServiceImpl decorates an idempotency.Service so that
+// each call is recorded as a span. Callers which can't act on a failure
+// to keep or release a key still have it recorded here.
+type IdempotencyServiceImpl struct {
+	delegate      idempotency.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ idempotency.Service = &IdempotencyServiceImpl{}
+
+// NewIdempotencyServiceImpl is a constructor
+func NewIdempotencyServiceImpl(delegate idempotency.Service, tracerService TracerService) *IdempotencyServiceImpl {
+	return &IdempotencyServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Begin traces idempotency.Service.Begin
+func (i *IdempotencyServiceImpl) Begin(ctx context.Context, client string, key string, fingerprint string) (*idempotency.ResponseVO, error) {
+	ctx, span := i.start(ctx, "Begin")
+	defer span.End()
+
+	response, err := i.delegate.Begin(ctx, client, key, fingerprint)
+	span.SetAttributes(attribute.Bool("matchstick.idempotency.replayed", response != nil))
+	recordError(span, err)
+	return response, err
+}
+
+// Finish traces idempotency.Service.Finish
+func (i *IdempotencyServiceImpl) Finish(ctx context.Context, client string, key string, response *idempotency.ResponseVO) error {
+	ctx, span := i.start(ctx, "Finish",
+		attribute.Int("matchstick.idempotency.status_code", response.StatusCode),
+	)
+	defer span.End()
+
+	err := i.delegate.Finish(ctx, client, key, response)
+	recordError(span, err)
+	return err
+}
+
+// Abandon traces idempotency.Service.Abandon
+func (i *IdempotencyServiceImpl) Abandon(ctx context.Context, client string, key string) error {
+	ctx, span := i.start(ctx, "Abandon")
+	defer span.End()
+
+	err := i.delegate.Abandon(ctx, client, key)
+	recordError(span, err)
+	return err
+}
+
+func (i *IdempotencyServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return i.tracerService.Tracer().Start(ctx, "idempotency.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
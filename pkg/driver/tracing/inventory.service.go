@@ -0,0 +1,218 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// InventoryServiceImpl decorates an inventory.Service so that
+// each call is recorded as a span.
+type InventoryServiceImpl struct {
+	delegate      inventory.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ inventory.Service = &InventoryServiceImpl{}
+
+// NewInventoryServiceImpl is a constructor
+func NewInventoryServiceImpl(delegate inventory.Service, tracerService TracerService) *InventoryServiceImpl {
+	return &InventoryServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Create traces inventory.Service.Create
+func (i *InventoryServiceImpl) Create(ctx context.Context, vo *inventory.CreateItemVO) (entity.ID, error) {
+	ctx, span := i.start(ctx, "Create")
+	defer span.End()
+
+	id, err := i.delegate.Create(ctx, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadDetails traces inventory.Service.ReadDetails
+func (i *InventoryServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*inventory.ViewVO, error) {
+	ctx, span := i.start(ctx, "ReadDetails", idAttribute(id))
+	defer span.End()
+
+	vo, err := i.delegate.ReadDetails(ctx, id)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadManyDetails traces inventory.Service.ReadManyDetails
+func (i *InventoryServiceImpl) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]inventory.ViewVO, error) {
+	ctx, span := i.start(ctx, "ReadManyDetails", idsAttribute(ids))
+	defer span.End()
+
+	vos, err := i.delegate.ReadManyDetails(ctx, ids)
+	recordError(span, err)
+	return vos, err
+}
+
+// FindIDByBarcode traces inventory.Service.FindIDByBarcode
+func (i *InventoryServiceImpl) FindIDByBarcode(ctx context.Context, barcode string) (entity.ID, error) {
+	ctx, span := i.start(ctx, "FindIDByBarcode", attribute.String("matchstick.inventory.barcode", barcode))
+	defer span.End()
+
+	id, err := i.delegate.FindIDByBarcode(ctx, barcode)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadAll traces inventory.Service.ReadAll
+func (i *InventoryServiceImpl) ReadAll(ctx context.Context) ([]inventory.ThinViewVO, error) {
+	ctx, span := i.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := i.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// ReadAllOfFormat traces inventory.Service.ReadAllOfFormat
+func (i *InventoryServiceImpl) ReadAllOfFormat(ctx context.Context, format entity.Format) ([]inventory.ThinViewVO, error) {
+	ctx, span := i.start(ctx, "ReadAllOfFormat", formatAttribute(format))
+	defer span.End()
+
+	vos, err := i.delegate.ReadAllOfFormat(ctx, format)
+	recordError(span, err)
+	return vos, err
+}
+
+// ReadAllOfTitles traces inventory.Service.ReadAllOfTitles
+func (i *InventoryServiceImpl) ReadAllOfTitles(ctx context.Context, titleIDs []entity.ID) ([]inventory.ThinViewVO, error) {
+	ctx, span := i.start(ctx, "ReadAllOfTitles", idsAttribute(titleIDs))
+	defer span.End()
+
+	vos, err := i.delegate.ReadAllOfTitles(ctx, titleIDs)
+	recordError(span, err)
+	return vos, err
+}
+
+// Update traces inventory.Service.Update
+func (i *InventoryServiceImpl) Update(ctx context.Context, id entity.ID, vo *inventory.UpdateItemVO) error {
+	ctx, span := i.start(ctx, "Update", idAttribute(id))
+	defer span.End()
+
+	err := i.delegate.Update(ctx, id, vo)
+	recordError(span, err)
+	return err
+}
+
+// Delete traces inventory.Service.Delete
+func (i *InventoryServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	ctx, span := i.start(ctx, "Delete", idAttribute(id))
+	defer span.End()
+
+	err := i.delegate.Delete(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+// Checkout traces inventory.Service.Checkout
+func (i *InventoryServiceImpl) Checkout(ctx context.Context, id entity.ID, vo *inventory.CheckoutVO) error {
+	ctx, span := i.start(ctx, "Checkout", idAttribute(id), accountAttribute(vo.AccountID))
+	defer span.End()
+
+	err := i.delegate.Checkout(ctx, id, vo)
+	recordError(span, err)
+	return err
+}
+
+// CheckIn traces inventory.Service.CheckIn
+func (i *InventoryServiceImpl) CheckIn(ctx context.Context, id entity.ID) (*rental.ReceiptLineVO, error) {
+	ctx, span := i.start(ctx, "CheckIn", idAttribute(id))
+	defer span.End()
+
+	receipt, err := i.delegate.CheckIn(ctx, id)
+	recordError(span, err)
+	return receipt, err
+}
+
+// Renew traces inventory.Service.Renew
+func (i *InventoryServiceImpl) Renew(ctx context.Context, id entity.ID) (*rental.RenewalReceiptLineVO, error) {
+	ctx, span := i.start(ctx, "Renew", idAttribute(id))
+	defer span.End()
+
+	receipt, err := i.delegate.Renew(ctx, id)
+	recordError(span, err)
+	return receipt, err
+}
+
+// FulfilHold traces inventory.Service.FulfilHold
+func (i *InventoryServiceImpl) FulfilHold(ctx context.Context, id entity.ID) error {
+	ctx, span := i.start(ctx, "FulfilHold", idAttribute(id))
+	defer span.End()
+
+	err := i.delegate.FulfilHold(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+// Move traces inventory.Service.Move
+func (i *InventoryServiceImpl) Move(ctx context.Context, id entity.ID, vo *inventory.MoveVO) error {
+	ctx, span := i.start(ctx, "Move", idAttribute(id))
+	defer span.End()
+
+	err := i.delegate.Move(ctx, id, vo)
+	recordError(span, err)
+	return err
+}
+
+// ReadMoves traces inventory.Service.ReadMoves
+func (i *InventoryServiceImpl) ReadMoves(ctx context.Context, id entity.ID) ([]inventory.MoveViewVO, error) {
+	ctx, span := i.start(ctx, "ReadMoves", idAttribute(id))
+	defer span.End()
+
+	vos, err := i.delegate.ReadMoves(ctx, id)
+	recordError(span, err)
+	return vos, err
+}
+
+// ReadShelf traces inventory.Service.ReadShelf
+func (i *InventoryServiceImpl) ReadShelf(ctx context.Context, store, aisle, shelf string) (*inventory.ShelfViewVO, error) {
+	ctx, span := i.start(ctx, "ReadShelf", shelfAttributes(store, aisle, shelf)...)
+	defer span.End()
+
+	vo, err := i.delegate.ReadShelf(ctx, store, aisle, shelf)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadLabels traces inventory.Service.ReadLabels
+func (i *InventoryServiceImpl) ReadLabels(ctx context.Context, ids []entity.ID) ([]inventory.LabelVO, error) {
+	ctx, span := i.start(ctx, "ReadLabels", idsAttribute(ids))
+	defer span.End()
+
+	vos, err := i.delegate.ReadLabels(ctx, ids)
+	recordError(span, err)
+	return vos, err
+}
+
+func (i *InventoryServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return i.tracerService.Tracer().Start(ctx, "inventory.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func idAttribute(id entity.ID) attribute.KeyValue {
+	return attribute.Int64("matchstick.entity.id", int64(id))
+}
+
+func idsAttribute(ids []entity.ID) attribute.KeyValue {
+	values := make([]int64, len(ids))
+	for i, id := range ids {
+		values[i] = int64(id)
+	}
+	return attribute.Int64Slice("matchstick.entity.ids", values)
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// LedgerServiceImpl decorates a ledger.Service so that
+// each call is recorded as a span.
+type LedgerServiceImpl struct {
+	delegate      ledger.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ ledger.Service = &LedgerServiceImpl{}
+
+// NewLedgerServiceImpl is a constructor
+func NewLedgerServiceImpl(delegate ledger.Service, tracerService TracerService) *LedgerServiceImpl {
+	return &LedgerServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// RecordPayment traces ledger.Service.RecordPayment
+func (l *LedgerServiceImpl) RecordPayment(ctx context.Context, accountID entity.ID, vo *ledger.RecordPaymentVO) (entity.ID, error) {
+	ctx, span := l.start(ctx, "RecordPayment", accountAttribute(accountID))
+	defer span.End()
+
+	id, err := l.delegate.RecordPayment(ctx, accountID, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// RecordAdjustment traces ledger.Service.RecordAdjustment
+func (l *LedgerServiceImpl) RecordAdjustment(ctx context.Context, accountID entity.ID, vo *ledger.RecordAdjustmentVO) (entity.ID, error) {
+	ctx, span := l.start(ctx, "RecordAdjustment", accountAttribute(accountID))
+	defer span.End()
+
+	id, err := l.delegate.RecordAdjustment(ctx, accountID, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadStatement traces ledger.Service.ReadStatement
+func (l *LedgerServiceImpl) ReadStatement(ctx context.Context, accountID entity.ID) (*ledger.StatementVO, error) {
+	ctx, span := l.start(ctx, "ReadStatement", accountAttribute(accountID))
+	defer span.End()
+
+	vo, err := l.delegate.ReadStatement(ctx, accountID)
+	recordError(span, err)
+	return vo, err
+}
+
+func (l *LedgerServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return l.tracerService.Tracer().Start(ctx, "ledger.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
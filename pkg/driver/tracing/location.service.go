@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// LocationServiceImpl decorates a location.Service so that
+// each call is recorded as a span.
+type LocationServiceImpl struct {
+	delegate      location.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ location.Service = &LocationServiceImpl{}
+
+// NewLocationServiceImpl is a constructor
+func NewLocationServiceImpl(delegate location.Service, tracerService TracerService) *LocationServiceImpl {
+	return &LocationServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Create traces location.Service.Create
+func (l *LocationServiceImpl) Create(ctx context.Context, vo *location.CreateLocationVO) (*location.ViewVO, error) {
+	ctx, span := l.start(ctx, "Create")
+	defer span.End()
+
+	view, err := l.delegate.Create(ctx, vo)
+	recordError(span, err)
+	return view, err
+}
+
+// ReadAll traces location.Service.ReadAll
+func (l *LocationServiceImpl) ReadAll(ctx context.Context) ([]location.ViewVO, error) {
+	ctx, span := l.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := l.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Delete traces location.Service.Delete
+func (l *LocationServiceImpl) Delete(ctx context.Context, loc entity.Location) error {
+	attrs := append(shelfAttributes(loc.Store, loc.Aisle, loc.Shelf),
+		attribute.String("matchstick.location.slot", loc.Slot),
+	)
+	ctx, span := l.start(ctx, "Delete", attrs...)
+	defer span.End()
+
+	err := l.delegate.Delete(ctx, loc)
+	recordError(span, err)
+	return err
+}
+
+func (l *LocationServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return l.tracerService.Tracer().Start(ctx, "location.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func shelfAttributes(store, aisle, shelf string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("matchstick.location.store", store),
+		attribute.String("matchstick.location.aisle", aisle),
+		attribute.String("matchstick.location.shelf", shelf),
+	}
+}
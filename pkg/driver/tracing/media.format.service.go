@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MediaFormatServiceImpl decorates a mediaformat.Service so that
+// each call is recorded as a span.
+type MediaFormatServiceImpl struct {
+	delegate      mediaformat.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ mediaformat.Service = &MediaFormatServiceImpl{}
+
+// NewMediaFormatServiceImpl is a constructor
+func NewMediaFormatServiceImpl(delegate mediaformat.Service, tracerService TracerService) *MediaFormatServiceImpl {
+	return &MediaFormatServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// ReadDetails traces mediaformat.Service.ReadDetails
+func (m *MediaFormatServiceImpl) ReadDetails(ctx context.Context, format entity.Format) (*mediaformat.ViewVO, error) {
+	ctx, span := m.start(ctx, "ReadDetails", formatAttribute(format))
+	defer span.End()
+
+	vo, err := m.delegate.ReadDetails(ctx, format)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadAll traces mediaformat.Service.ReadAll
+func (m *MediaFormatServiceImpl) ReadAll(ctx context.Context) ([]mediaformat.ViewVO, error) {
+	ctx, span := m.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := m.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Update traces mediaformat.Service.Update
+func (m *MediaFormatServiceImpl) Update(ctx context.Context, format entity.Format, vo *mediaformat.UpdateFormatVO) error {
+	ctx, span := m.start(ctx, "Update", formatAttribute(format))
+	defer span.End()
+
+	err := m.delegate.Update(ctx, format, vo)
+	recordError(span, err)
+	return err
+}
+
+func (m *MediaFormatServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return m.tracerService.Tracer().Start(ctx, "mediaformat.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func formatAttribute(format entity.Format) attribute.KeyValue {
+	return attribute.String("matchstick.format", string(format))
+}
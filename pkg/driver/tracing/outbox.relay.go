@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// OutboxRelayImpl decorates an outbox.Relay so that
+// each call is recorded as a span.
+type OutboxRelayImpl struct {
+	delegate      outbox.Relay
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ outbox.Relay = &OutboxRelayImpl{}
+
+// NewOutboxRelayImpl is a constructor
+func NewOutboxRelayImpl(delegate outbox.Relay, tracerService TracerService) *OutboxRelayImpl {
+	return &OutboxRelayImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// RelayPending traces outbox.Relay.RelayPending
+func (o *OutboxRelayImpl) RelayPending(ctx context.Context) (int, error) {
+	ctx, span := o.tracerService.Tracer().Start(ctx, "outbox.Relay/RelayPending")
+	defer span.End()
+
+	relayed, err := o.delegate.RelayPending(ctx)
+	span.SetAttributes(attribute.Int("matchstick.outbox.relayed", relayed))
+	recordError(span, err)
+	return relayed, err
+}
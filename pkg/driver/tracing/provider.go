@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// newTracerProvider creates the provider for the configured exporter,
+// along with a func which flushes and stops it.
+func newTracerProvider(cfg config.Store) (trace.TracerProvider, domain.Shutdown, error) {
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(cfg.GetTraceServiceName()),
+	)
+
+	switch exporter := cfg.GetTraceExporter(); exporter {
+	case "", "none":
+		return trace.NewNoopTracerProvider(), domain.NothingToShutdown, nil
+
+	case "stdout":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create tracer provider - stdout exporter error: %w", err)
+		}
+		// Write spans as they end, so that nothing is lost when the process is killed.
+		provider := sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(exp),
+			sdktrace.WithResource(res),
+		)
+		return provider, provider.Shutdown, nil
+
+	case "otlp":
+		exp, err := otlptracehttp.New(context.Background(), otlpOptions(cfg.GetTraceOTLPEndpoint())...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create tracer provider - otlp exporter error: %w", err)
+		}
+		// Spans are exported in batches, so they must be flushed on shutdown.
+		provider := sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exp),
+			sdktrace.WithResource(res),
+		)
+		return provider, provider.Shutdown, nil
+
+	default:
+		return nil, nil, fmt.Errorf("could not create tracer provider - unknown exporter: %s", exporter)
+	}
+}
+
+func otlpOptions(endpoint string) []otlptracehttp.Option {
+	if strings.HasPrefix(endpoint, "https://") {
+		return []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(strings.TrimPrefix(endpoint, "https://")),
+		}
+	}
+	return []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(strings.TrimPrefix(endpoint, "http://")),
+		otlptracehttp.WithInsecure(),
+	}
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// RentalServiceImpl decorates a rental.Service so that
+// each call is recorded as a span.
+type RentalServiceImpl struct {
+	delegate      rental.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ rental.Service = &RentalServiceImpl{}
+
+// NewRentalServiceImpl is a constructor
+func NewRentalServiceImpl(delegate rental.Service, tracerService TracerService) *RentalServiceImpl {
+	return &RentalServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// ReadOverdue traces rental.Service.ReadOverdue
+func (r *RentalServiceImpl) ReadOverdue(ctx context.Context) ([]rental.ViewVO, error) {
+	ctx, span := r.start(ctx, "ReadOverdue")
+	defer span.End()
+
+	vos, err := r.delegate.ReadOverdue(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+func (r *RentalServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return r.tracerService.Tracer().Start(ctx, "rental.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
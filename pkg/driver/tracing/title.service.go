@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// TitleServiceImpl decorates a title.Service so that
+// each call is recorded as a span.
+type TitleServiceImpl struct {
+	delegate      title.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ title.Service = &TitleServiceImpl{}
+
+// NewTitleServiceImpl is a constructor
+func NewTitleServiceImpl(delegate title.Service, tracerService TracerService) *TitleServiceImpl {
+	return &TitleServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Create traces title.Service.Create
+func (t *TitleServiceImpl) Create(ctx context.Context, vo *title.CreateTitleVO) (entity.ID, error) {
+	ctx, span := t.start(ctx, "Create")
+	defer span.End()
+
+	id, err := t.delegate.Create(ctx, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadDetails traces title.Service.ReadDetails
+func (t *TitleServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*title.ViewVO, error) {
+	ctx, span := t.start(ctx, "ReadDetails", idAttribute(id))
+	defer span.End()
+
+	vo, err := t.delegate.ReadDetails(ctx, id)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadManyDetails traces title.Service.ReadManyDetails
+func (t *TitleServiceImpl) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]title.ViewVO, error) {
+	ctx, span := t.start(ctx, "ReadManyDetails", idsAttribute(ids))
+	defer span.End()
+
+	vos, err := t.delegate.ReadManyDetails(ctx, ids)
+	recordError(span, err)
+	return vos, err
+}
+
+// ReadAll traces title.Service.ReadAll
+func (t *TitleServiceImpl) ReadAll(ctx context.Context) ([]title.ThinViewVO, error) {
+	ctx, span := t.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := t.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Update traces title.Service.Update
+func (t *TitleServiceImpl) Update(ctx context.Context, id entity.ID, vo *title.UpdateTitleVO) error {
+	ctx, span := t.start(ctx, "Update", idAttribute(id))
+	defer span.End()
+
+	err := t.delegate.Update(ctx, id, vo)
+	recordError(span, err)
+	return err
+}
+
+// Delete traces title.Service.Delete
+func (t *TitleServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	ctx, span := t.start(ctx, "Delete", idAttribute(id))
+	defer span.End()
+
+	err := t.delegate.Delete(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+func (t *TitleServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracerService.Tracer().Start(ctx, "title.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+const instrumentationName = "github.com/liampulles/matchstick-video"
+
+// TracerService provides what is needed to create spans and
+// propagate them across process boundaries.
+type TracerService interface {
+	Tracer() trace.Tracer
+	Provider() trace.TracerProvider
+	Propagator() propagation.TextMapPropagator
+	Shutdown(ctx context.Context) error
+}
+
+// TracerServiceImpl implements TracerService with OpenTelemetry
+type TracerServiceImpl struct {
+	tracer     trace.Tracer
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+	shutdown   domain.Shutdown
+}
+
+// Check we implement the interface
+var _ TracerService = &TracerServiceImpl{}
+
+// NewTracerServiceImpl is a constructor
+func NewTracerServiceImpl(configStore config.Store) (*TracerServiceImpl, error) {
+	provider, shutdown, err := newTracerProvider(configStore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracerServiceImpl{
+		tracer:   provider.Tracer(instrumentationName),
+		provider: provider,
+		// W3C traceparent/tracestate headers
+		propagator: propagation.TraceContext{},
+		shutdown:   shutdown,
+	}, nil
+}
+
+// Tracer returns a tracer for starting spans.
+func (t *TracerServiceImpl) Tracer() trace.Tracer {
+	return t.tracer
+}
+
+// Provider returns the provider of the tracer, for instrumentation
+// which creates its own tracers.
+func (t *TracerServiceImpl) Provider() trace.TracerProvider {
+	return t.provider
+}
+
+// Propagator returns the propagator used to extract and inject
+// span context from/into carriers (e.g. HTTP headers).
+func (t *TracerServiceImpl) Propagator() propagation.TextMapPropagator {
+	return t.propagator
+}
+
+// Shutdown exports any spans which have not been yet, and stops the
+// tracer. Spans started afterwards are not exported.
+func (t *TracerServiceImpl) Shutdown(ctx context.Context) error {
+	if err := t.shutdown(ctx); err != nil {
+		return fmt.Errorf("could not shutdown tracer - %w", err)
+	}
+	return nil
+}
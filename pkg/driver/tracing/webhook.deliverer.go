@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// WebhookDelivererImpl decorates a webhook.Deliverer so that
+// each call is recorded as a span.
+type WebhookDelivererImpl struct {
+	delegate      webhook.Deliverer
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ webhook.Deliverer = &WebhookDelivererImpl{}
+
+// NewWebhookDelivererImpl is a constructor
+func NewWebhookDelivererImpl(delegate webhook.Deliverer, tracerService TracerService) *WebhookDelivererImpl {
+	return &WebhookDelivererImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// DeliverDue traces webhook.Deliverer.DeliverDue
+func (w *WebhookDelivererImpl) DeliverDue(ctx context.Context) (int, error) {
+	ctx, span := w.tracerService.Tracer().Start(ctx, "webhook.Deliverer/DeliverDue")
+	defer span.End()
+
+	attempted, err := w.delegate.DeliverDue(ctx)
+	span.SetAttributes(attribute.Int("matchstick.webhook.attempted", attempted))
+	recordError(span, err)
+	return attempted, err
+}
@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// WebhookSenderImpl decorates a webhook.Sender so that
+// each attempt is recorded as a span.
+type WebhookSenderImpl struct {
+	delegate      webhook.Sender
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ webhook.Sender = &WebhookSenderImpl{}
+
+// NewWebhookSenderImpl is a constructor
+func NewWebhookSenderImpl(delegate webhook.Sender, tracerService TracerService) *WebhookSenderImpl {
+	return &WebhookSenderImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Send traces webhook.Sender.Send
+func (w *WebhookSenderImpl) Send(ctx context.Context, subscription entity.WebhookSubscription, delivery entity.WebhookDelivery) (int, error) {
+	ctx, span := w.tracerService.Tracer().Start(ctx, "webhook.Sender/Send",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			subscriptionAttribute(subscription.ID()),
+			idAttribute(delivery.ID()),
+			semconv.HTTPMethodKey.String("POST"),
+		),
+	)
+	defer span.End()
+
+	statusCode, err := w.delegate.Send(ctx, subscription, delivery)
+	if statusCode != 0 {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(statusCode))
+	}
+	recordError(span, err)
+	return statusCode, err
+}
@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// WebhookServiceImpl decorates a webhook.Service so that
+// each call is recorded as a span.
+type WebhookServiceImpl struct {
+	delegate      webhook.Service
+	tracerService TracerService
+}
+
+// Check we implement the interface
+var _ webhook.Service = &WebhookServiceImpl{}
+
+// NewWebhookServiceImpl is a constructor
+func NewWebhookServiceImpl(delegate webhook.Service, tracerService TracerService) *WebhookServiceImpl {
+	return &WebhookServiceImpl{
+		delegate:      delegate,
+		tracerService: tracerService,
+	}
+}
+
+// Create traces webhook.Service.Create
+func (w *WebhookServiceImpl) Create(ctx context.Context, vo *webhook.CreateSubscriptionVO) (entity.ID, error) {
+	ctx, span := w.start(ctx, "Create")
+	defer span.End()
+
+	id, err := w.delegate.Create(ctx, vo)
+	recordError(span, err)
+	return id, err
+}
+
+// ReadDetails traces webhook.Service.ReadDetails
+func (w *WebhookServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*webhook.SubscriptionViewVO, error) {
+	ctx, span := w.start(ctx, "ReadDetails", idAttribute(id))
+	defer span.End()
+
+	vo, err := w.delegate.ReadDetails(ctx, id)
+	recordError(span, err)
+	return vo, err
+}
+
+// ReadAll traces webhook.Service.ReadAll
+func (w *WebhookServiceImpl) ReadAll(ctx context.Context) ([]webhook.SubscriptionViewVO, error) {
+	ctx, span := w.start(ctx, "ReadAll")
+	defer span.End()
+
+	vos, err := w.delegate.ReadAll(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Delete traces webhook.Service.Delete
+func (w *WebhookServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	ctx, span := w.start(ctx, "Delete", idAttribute(id))
+	defer span.End()
+
+	err := w.delegate.Delete(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+// ReadDeliveries traces webhook.Service.ReadDeliveries
+func (w *WebhookServiceImpl) ReadDeliveries(ctx context.Context, subscriptionID entity.ID) ([]webhook.DeliveryViewVO, error) {
+	ctx, span := w.start(ctx, "ReadDeliveries", subscriptionAttribute(subscriptionID))
+	defer span.End()
+
+	vos, err := w.delegate.ReadDeliveries(ctx, subscriptionID)
+	recordError(span, err)
+	return vos, err
+}
+
+// ReadDeadLetters traces webhook.Service.ReadDeadLetters
+func (w *WebhookServiceImpl) ReadDeadLetters(ctx context.Context) ([]webhook.DeliveryViewVO, error) {
+	ctx, span := w.start(ctx, "ReadDeadLetters")
+	defer span.End()
+
+	vos, err := w.delegate.ReadDeadLetters(ctx)
+	recordError(span, err)
+	return vos, err
+}
+
+// Redeliver traces webhook.Service.Redeliver
+func (w *WebhookServiceImpl) Redeliver(ctx context.Context, id entity.ID) error {
+	ctx, span := w.start(ctx, "Redeliver", idAttribute(id))
+	defer span.End()
+
+	err := w.delegate.Redeliver(ctx, id)
+	recordError(span, err)
+	return err
+}
+
+func (w *WebhookServiceImpl) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return w.tracerService.Tracer().Start(ctx, "webhook.Service/"+method,
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func subscriptionAttribute(id entity.ID) attribute.KeyValue {
+	return attribute.Int64("matchstick.webhook.subscription.id", int64(id))
+}
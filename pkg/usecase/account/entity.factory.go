@@ -0,0 +1,32 @@
+package account
+
+import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+
+// EntityFactory defines methods for creating
+// an entity.Account from VOs
+type EntityFactory interface {
+	CreateFromVO(*CreateAccountVO) (entity.Account, error)
+}
+
+// EntityFactoryImpl implements EntityFactory
+type EntityFactoryImpl struct {
+	constructor entity.AccountConstructor
+}
+
+// Check we implement the interface
+var _ EntityFactory = &EntityFactoryImpl{}
+
+// NewEntityFactoryImpl is a constructor
+func NewEntityFactoryImpl(constructor entity.AccountConstructor) *EntityFactoryImpl {
+	return &EntityFactoryImpl{
+		constructor: constructor,
+	}
+}
+
+// CreateFromVO creates a new entity from a vo
+func (e *EntityFactoryImpl) CreateFromVO(vo *CreateAccountVO) (entity.Account, error) {
+	return e.constructor.New(
+		vo.Name,
+		vo.DateOfBirth,
+	)
+}
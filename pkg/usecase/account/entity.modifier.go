@@ -0,0 +1,34 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// EntityModifier encapsulates methods which make mass
+// updates to an entity
+type EntityModifier interface {
+	ModifyWithUpdateAccountVO(entity.Account, *UpdateAccountVO) error
+}
+
+// EntityModifierImpl implements EntityModifier
+type EntityModifierImpl struct{}
+
+var _ EntityModifier = &EntityModifierImpl{}
+
+// NewEntityModifierImpl is a constructor
+func NewEntityModifierImpl() *EntityModifierImpl {
+	return &EntityModifierImpl{}
+}
+
+// ModifyWithUpdateAccountVO modifies an existing entity as directed by an update vo
+func (e *EntityModifierImpl) ModifyWithUpdateAccountVO(ent entity.Account, vo *UpdateAccountVO) error {
+	if err := ent.ChangeName(vo.Name); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity name change error: %w", err)
+	}
+	if err := ent.ChangeDateOfBirth(vo.DateOfBirth); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity date of birth change error: %w", err)
+	}
+	return nil
+}
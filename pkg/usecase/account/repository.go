@@ -0,0 +1,17 @@
+package account
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting account entities
+// and retrieving persisted entities
+type Repository interface {
+	Create(context.Context, entity.Account) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.Account, error)
+	FindAll(context.Context) ([]entity.Account, error)
+	Update(context.Context, entity.Account) error
+	DeleteByID(context.Context, entity.ID) error
+}
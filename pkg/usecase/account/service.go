@@ -0,0 +1,137 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// Service performs operations on accounts.
+type Service interface {
+	Create(context.Context, *CreateAccountVO) (entity.ID, error)
+	ReadDetails(context.Context, entity.ID) (*ViewVO, error)
+	ReadAll(context.Context) ([]ThinViewVO, error)
+	Update(context.Context, entity.ID, *UpdateAccountVO) error
+	Delete(context.Context, entity.ID) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	accountRepository Repository
+	rentalRepository  rental.Repository
+	entityFactory     EntityFactory
+	entityModifier    EntityModifier
+	voFactory         VOFactory
+	rentalVOFactory   rental.VOFactory
+	clock             domain.Clock
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	accountRepository Repository,
+	rentalRepository rental.Repository,
+	entityFactory EntityFactory,
+	entityModifier EntityModifier,
+	voFactory VOFactory,
+	rentalVOFactory rental.VOFactory,
+	clock domain.Clock) *ServiceImpl {
+	return &ServiceImpl{
+		accountRepository: accountRepository,
+		rentalRepository:  rentalRepository,
+		entityFactory:     entityFactory,
+		entityModifier:    entityModifier,
+		voFactory:         voFactory,
+		rentalVOFactory:   rentalVOFactory,
+		clock:             clock,
+	}
+}
+
+// Create creates a new entity from a request vo, and persists it.
+func (s *ServiceImpl) Create(ctx context.Context, vo *CreateAccountVO) (entity.ID, error) {
+	// Create new entity
+	e, err := s.entityFactory.CreateFromVO(vo)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create account - factory error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.accountRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create account - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// ReadDetails retrieves an entity and its outstanding rentals, and
+// returns a view of it.
+func (s *ServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*ViewVO, error) {
+	// Retrieve entity
+	found, err := s.accountRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read account - repository find error: %w", err)
+	}
+
+	// Retrieve rentals
+	rentals, err := s.rentalRepository.FindActiveByAccountID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read account - rental repository find error: %w", err)
+	}
+
+	// Create VO
+	rentalVOs := s.rentalVOFactory.CreateViewVOsFromEntities(rentals, s.clock.Now())
+	vo := s.voFactory.CreateViewVOFromEntity(found, rentalVOs)
+
+	return vo, nil
+}
+
+// ReadAll retrieves all entities and returns views of them.
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]ThinViewVO, error) {
+	// Retrieve entities
+	found, err := s.accountRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read accounts - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateThinViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// Update modifies an existing entity as directed by a vo, and
+// persists the changes.
+func (s *ServiceImpl) Update(ctx context.Context, id entity.ID, vo *UpdateAccountVO) error {
+	// Retrieve entity
+	found, err := s.accountRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not update account - repository find error: %w", err)
+	}
+
+	// Modify it
+	if err := s.entityModifier.ModifyWithUpdateAccountVO(found, vo); err != nil {
+		return fmt.Errorf("could not update account - modifier error: %w", err)
+	}
+
+	// Persist it
+	err = s.accountRepository.Update(ctx, found)
+	if err != nil {
+		return fmt.Errorf("could not update account - repository update error: %w", err)
+	}
+	return nil
+}
+
+// Delete wipes the entity from storage. An account which has rented
+// items cannot be deleted.
+func (s *ServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	if err := s.accountRepository.DeleteByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete account - repository delete error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// VOFactory is used to create account VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.Account, []rental.ViewVO) *ViewVO
+	CreateThinViewVOsFromEntities([]entity.Account) []ThinViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity and its outstanding rentals
+// to a view vo. The account is overdue if any of its rentals are.
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.Account, rentals []rental.ViewVO) *ViewVO {
+	overdue := false
+	for _, r := range rentals {
+		if r.Overdue {
+			overdue = true
+		}
+	}
+	return &ViewVO{
+		ID:          e.ID(),
+		Name:        e.Name(),
+		DateOfBirth: e.DateOfBirth(),
+		Balance:     e.Balance(),
+		Rentals:     rentals,
+		Overdue:     overdue,
+	}
+}
+
+// CreateThinViewVOsFromEntities maps entities to thin view vos
+func (v *VOFactoryImpl) CreateThinViewVOsFromEntities(entities []entity.Account) []ThinViewVO {
+	var results []ThinViewVO
+	for _, e := range entities {
+		view := v.createThinViewVOFromEntity(e)
+		results = append(results, *view)
+	}
+	return results
+}
+
+func (v *VOFactoryImpl) createThinViewVOFromEntity(e entity.Account) *ThinViewVO {
+	return &ThinViewVO{
+		ID:   e.ID(),
+		Name: e.Name(),
+	}
+}
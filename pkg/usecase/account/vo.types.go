@@ -0,0 +1,38 @@
+package account
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// CreateAccountVO defines data needed to create an account.
+type CreateAccountVO struct {
+	Name        string
+	DateOfBirth *time.Time
+}
+
+// UpdateAccountVO defines data that may be used to update an account.
+type UpdateAccountVO struct {
+	Name        string
+	DateOfBirth *time.Time
+}
+
+// ViewVO describes an account in full, along with what it owes,
+// what it has rented out and whether any of it is overdue.
+type ViewVO struct {
+	ID          entity.ID
+	Name        string
+	DateOfBirth *time.Time
+	Balance     entity.Money
+	Rentals     []rental.ViewVO
+	Overdue     bool
+}
+
+// ThinViewVO outlines an account, so that the client
+// can then read the details of individual accounts.
+type ThinViewVO struct {
+	ID   entity.ID
+	Name string
+}
@@ -0,0 +1,64 @@
+package hold
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Queue puts copies which come free aside for the next
+// hold waiting on their title.
+type Queue interface {
+	// AssignNext puts the inventory item aside for the earliest waiting
+	// hold on the title, and returns it - or nil if no one is waiting.
+	AssignNext(ctx context.Context, titleID entity.ID, itemID entity.ID) (entity.Hold, error)
+}
+
+// QueueImpl implements Queue
+type QueueImpl struct {
+	holdRepository Repository
+	clock          domain.Clock
+	expiry         time.Duration
+}
+
+// Check we implement the interface
+var _ Queue = &QueueImpl{}
+
+// NewQueueImpl is a constructor. Copies are put aside for expiry,
+// after which they may be given to the next in the queue.
+func NewQueueImpl(
+	holdRepository Repository,
+	clock domain.Clock,
+	expiry time.Duration) *QueueImpl {
+	return &QueueImpl{
+		holdRepository: holdRepository,
+		clock:          clock,
+		expiry:         expiry,
+	}
+}
+
+// AssignNext implements the Queue interface
+func (q *QueueImpl) AssignNext(ctx context.Context, titleID entity.ID, itemID entity.ID) (entity.Hold, error) {
+	// Find who is next in the queue
+	next, err := q.holdRepository.FindNextWaitingByTitleID(ctx, titleID)
+	if err != nil {
+		return nil, fmt.Errorf("could not assign hold - repository find error: %w", err)
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	// Put the copy aside for them
+	if err := next.Assign(itemID, q.clock.Now().Add(q.expiry)); err != nil {
+		return nil, fmt.Errorf("could not assign hold - entity error: %w", err)
+	}
+
+	// Persist it
+	if err := q.holdRepository.Update(ctx, next); err != nil {
+		return nil, fmt.Errorf("could not assign hold - repository update error: %w", err)
+	}
+	return next, nil
+}
@@ -0,0 +1,31 @@
+package hold
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting hold entities
+// and retrieving persisted entities
+type Repository interface {
+	Create(context.Context, entity.Hold) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.Hold, error)
+	Update(context.Context, entity.Hold) error
+
+	// FindActiveByTitleID returns the queue of holds on a title
+	// which are waiting for, or holding, a copy - earliest first.
+	FindActiveByTitleID(context.Context, entity.ID) ([]entity.Hold, error)
+	// FindNextWaitingByTitleID returns the earliest waiting hold on
+	// a title, or nil if no one is waiting.
+	FindNextWaitingByTitleID(context.Context, entity.ID) (entity.Hold, error)
+	// FindReadyByItemID returns the hold which an inventory item is
+	// put aside for, or nil if it is not put aside.
+	FindReadyByItemID(context.Context, entity.ID) (entity.Hold, error)
+}
+
+// ItemRepository retrieves the inventory items which are put aside for
+// holds.
+type ItemRepository interface {
+	FindByID(context.Context, entity.ID) (entity.InventoryItem, error)
+}
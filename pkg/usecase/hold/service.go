@@ -0,0 +1,167 @@
+package hold
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// Service performs operations on holds.
+type Service interface {
+	Place(context.Context, entity.ID, *PlaceHoldVO) (entity.ID, error)
+	ReadQueue(context.Context, entity.ID) ([]ViewVO, error)
+	Cancel(context.Context, entity.ID) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	holdRepository  Repository
+	titleRepository title.Repository
+	itemRepository  ItemRepository
+	holdConstructor entity.HoldConstructor
+	queue           Queue
+	voFactory       VOFactory
+	outboxWriter    outbox.Writer
+	transactor      domain.Transactor
+	clock           domain.Clock
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	holdRepository Repository,
+	titleRepository title.Repository,
+	itemRepository ItemRepository,
+	holdConstructor entity.HoldConstructor,
+	queue Queue,
+	voFactory VOFactory,
+	outboxWriter outbox.Writer,
+	transactor domain.Transactor,
+	clock domain.Clock) *ServiceImpl {
+	return &ServiceImpl{
+		holdRepository:  holdRepository,
+		titleRepository: titleRepository,
+		itemRepository:  itemRepository,
+		holdConstructor: holdConstructor,
+		queue:           queue,
+		voFactory:       voFactory,
+		outboxWriter:    outboxWriter,
+		transactor:      transactor,
+		clock:           clock,
+	}
+}
+
+// Place queues an account for the next copy of a title to come back.
+// Holds may only be placed when every copy of the title is out.
+func (s *ServiceImpl) Place(ctx context.Context, titleID entity.ID, vo *PlaceHoldVO) (entity.ID, error) {
+	// Check the title exists
+	if _, err := s.titleRepository.FindByID(ctx, titleID); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not place hold - title repository find error: %w", err)
+	}
+
+	// Check every copy is out
+	stock, err := s.titleRepository.FindStockByID(ctx, titleID)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not place hold - title repository stock error: %w", err)
+	}
+	if stock.Copies == 0 {
+		return entity.InvalidID, fmt.Errorf("could not place hold - stock error: %w",
+			commonerror.NewValidation("titleId", "has no copies to hold"))
+	}
+	if stock.Available > 0 {
+		return entity.InvalidID, fmt.Errorf("could not place hold - stock error: %w",
+			commonerror.NewValidation("titleId", "has copies available - check one out instead"))
+	}
+
+	// Create new entity
+	e, err := s.holdConstructor.New(titleID, vo.AccountID, s.clock.Now())
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not place hold - entity error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.holdRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not place hold - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// ReadQueue retrieves the active holds on a title, earliest first,
+// and returns views of them.
+func (s *ServiceImpl) ReadQueue(ctx context.Context, titleID entity.ID) ([]ViewVO, error) {
+	// Retrieve entities
+	found, err := s.holdRepository.FindActiveByTitleID(ctx, titleID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read holds - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// Cancel withdraws a hold. If a copy was put aside for it, the copy
+// is passed on to the next hold in the queue. Everything is persisted
+// along with the copy's events, or nothing is.
+func (s *ServiceImpl) Cancel(ctx context.Context, id entity.ID) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.cancel(ctx, id)
+	})
+}
+
+func (s *ServiceImpl) cancel(ctx context.Context, id entity.ID) error {
+	// Retrieve entity
+	found, err := s.holdRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not cancel hold - repository find error: %w", err)
+	}
+	wasReady := found.Status() == entity.HoldReady
+
+	// Cancel it
+	if err := found.Cancel(); err != nil {
+		return fmt.Errorf("could not cancel hold - entity error: %w", err)
+	}
+
+	// Persist it
+	if err := s.holdRepository.Update(ctx, found); err != nil {
+		return fmt.Errorf("could not cancel hold - repository update error: %w", err)
+	}
+
+	// Pass on the copy
+	if wasReady {
+		if err := s.passOn(ctx, found); err != nil {
+			return fmt.Errorf("could not cancel hold - %w", err)
+		}
+	}
+	return nil
+}
+
+// passOn puts the copy which was put aside for the cancelled hold aside
+// for the next hold in the queue, if there is one, and records it.
+func (s *ServiceImpl) passOn(ctx context.Context, cancelled entity.Hold) error {
+	item, err := s.itemRepository.FindByID(ctx, cancelled.ItemID())
+	if err != nil {
+		return fmt.Errorf("item repository find error: %w", err)
+	}
+	next, err := s.queue.AssignNext(ctx, cancelled.TitleID(), item.ID())
+	if err != nil {
+		return fmt.Errorf("queue error: %w", err)
+	}
+	if next == nil {
+		return nil
+	}
+
+	// Record what happened
+	item.PutAside(next.ID(), next.AccountID())
+	return s.outboxWriter.Write(ctx, item.ID(), item)
+}
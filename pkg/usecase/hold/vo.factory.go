@@ -0,0 +1,45 @@
+package hold
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create hold VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.Hold) *ViewVO
+	CreateViewVOsFromEntities([]entity.Hold) []ViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity to a view vo.
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.Hold) *ViewVO {
+	return &ViewVO{
+		ID:        e.ID(),
+		TitleID:   e.TitleID(),
+		AccountID: e.AccountID(),
+		PlacedAt:  e.PlacedAt(),
+		Status:    e.Status(),
+		ItemID:    e.ItemID(),
+		ExpiresAt: e.ExpiresAt(),
+	}
+}
+
+// CreateViewVOsFromEntities maps entities to view vos.
+func (v *VOFactoryImpl) CreateViewVOsFromEntities(entities []entity.Hold) []ViewVO {
+	var results []ViewVO
+	for _, e := range entities {
+		view := v.CreateViewVOFromEntity(e)
+		results = append(results, *view)
+	}
+	return results
+}
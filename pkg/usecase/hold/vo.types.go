@@ -0,0 +1,23 @@
+package hold
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// PlaceHoldVO defines data needed to place a hold on a title.
+type PlaceHoldVO struct {
+	AccountID entity.ID
+}
+
+// ViewVO describes a hold, and the copy put aside for it (if any).
+type ViewVO struct {
+	ID        entity.ID
+	TitleID   entity.ID
+	AccountID entity.ID
+	PlacedAt  time.Time
+	Status    entity.HoldStatus
+	ItemID    entity.ID
+	ExpiresAt *time.Time
+}
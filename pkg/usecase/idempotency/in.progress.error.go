@@ -0,0 +1,23 @@
+package idempotency
+
+import "fmt"
+
+// InProgressError is returned when an idempotency key is given while
+// the request it was first given with is still being handled.
+type InProgressError struct {
+	Key string
+}
+
+// Check we implement the interface
+var _ error = &InProgressError{}
+
+// NewInProgressError is a constructor
+func NewInProgressError(key string) *InProgressError {
+	return &InProgressError{
+		Key: key,
+	}
+}
+
+func (i *InProgressError) Error() string {
+	return fmt.Sprintf("request with idempotency key is still being handled: key=[%s]", i.Key)
+}
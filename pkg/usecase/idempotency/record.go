@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"time"
+)
+
+// Record is what is kept of a request made with an idempotency key,
+// so that a retry of it can be recognised and answered the same way.
+// Keys belong to the client which sent them. Response is nil while the
+// request is being handled.
+type Record struct {
+	Client      string
+	Key         string
+	Fingerprint string
+	Response    *ResponseVO
+	CreatedAt   time.Time
+}
+
+// ResponseVO describes the response given to a request, so that it
+// can be given again.
+type ResponseVO struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
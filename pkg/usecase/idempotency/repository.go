@@ -0,0 +1,27 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Repository handles persisting idempotency records
+// and retrieving persisted records
+type Repository interface {
+	// Create persists the record unless one with the same client and
+	// key already exists, and returns whether it did.
+	Create(context.Context, *Record) (bool, error)
+	// FindByKey returns the record with the client and key, or nil if
+	// there is none.
+	FindByKey(ctx context.Context, client string, key string) (*Record, error)
+	// Finish stores the response of the record with the client and key.
+	Finish(ctx context.Context, client string, key string, response *ResponseVO) error
+	DeleteByKey(ctx context.Context, client string, key string) error
+	// DeleteUnfinishedCreatedBefore deletes the record with the client
+	// and key if it has no response and was created before the given
+	// time, and returns whether it did.
+	DeleteUnfinishedCreatedBefore(ctx context.Context, client string, key string, before time.Time) (bool, error)
+	// DeleteCreatedBefore deletes every record created before the
+	// given time.
+	DeleteCreatedBefore(context.Context, time.Time) error
+}
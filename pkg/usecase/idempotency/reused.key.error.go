@@ -0,0 +1,23 @@
+package idempotency
+
+import "fmt"
+
+// ReusedKeyError is returned when an idempotency key is given with a
+// request which differs from the one it was first given with.
+type ReusedKeyError struct {
+	Key string
+}
+
+// Check we implement the interface
+var _ error = &ReusedKeyError{}
+
+// NewReusedKeyError is a constructor
+func NewReusedKeyError(key string) *ReusedKeyError {
+	return &ReusedKeyError{
+		Key: key,
+	}
+}
+
+func (r *ReusedKeyError) Error() string {
+	return fmt.Sprintf("idempotency key was used with a different request: key=[%s]", r.Key)
+}
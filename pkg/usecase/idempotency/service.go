@@ -0,0 +1,134 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// Service lets a request be retried with the same idempotency key
+// without being handled more than once.
+type Service interface {
+	// Begin claims the client's key for a request with the fingerprint,
+	// and returns nil. If the same request already claimed the key, its
+	// response is returned instead, and the request should not be
+	// handled again.
+	Begin(ctx context.Context, client string, key string, fingerprint string) (*ResponseVO, error)
+	// Finish stores the response to the request which claimed the key.
+	Finish(ctx context.Context, client string, key string, response *ResponseVO) error
+	// Abandon releases the key, so that the request may be retried,
+	// e.g. because it failed unexpectedly.
+	Abandon(ctx context.Context, client string, key string) error
+}
+
+// maxClaimAttempts is how many times a key is claimed before giving up,
+// should it keep being released before it can be looked up.
+const maxClaimAttempts = 3
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	repository   Repository
+	clock        domain.Clock
+	expiry       time.Duration
+	claimTimeout time.Duration
+}
+
+// Check we implement the interface
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor. Keys may be used again once expiry
+// has passed since they were claimed. A request which has not finished
+// within claimTimeout (e.g. because the server crashed while handling
+// it) is taken to be abandoned.
+func NewServiceImpl(
+	repository Repository,
+	clock domain.Clock,
+	expiry time.Duration,
+	claimTimeout time.Duration,
+) *ServiceImpl {
+
+	return &ServiceImpl{
+		repository:   repository,
+		clock:        clock,
+		expiry:       expiry,
+		claimTimeout: claimTimeout,
+	}
+}
+
+// Begin clears out expired keys before claiming this one. If another
+// request claimed it first, the requests must match. A key which is
+// released (e.g. abandoned) after it could not be claimed, but before
+// it could be looked up, is claimed again - as is a key whose request
+// has run out of time without finishing.
+func (s *ServiceImpl) Begin(ctx context.Context, client string, key string, fingerprint string) (*ResponseVO, error) {
+	now := s.clock.Now()
+	if err := s.repository.DeleteCreatedBefore(ctx, now.Add(-s.expiry)); err != nil {
+		return nil, fmt.Errorf("could not begin idempotent request - repository delete error: %w", err)
+	}
+
+	for attempt := 0; attempt < maxClaimAttempts; attempt++ {
+		// Claim the key
+		created, err := s.repository.Create(ctx, &Record{
+			Client:      client,
+			Key:         key,
+			Fingerprint: fingerprint,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not begin idempotent request - repository create error: %w", err)
+		}
+		if created {
+			return nil, nil
+		}
+
+		// Otherwise, it is a retry
+		record, err := s.repository.FindByKey(ctx, client, key)
+		if err != nil {
+			return nil, fmt.Errorf("could not begin idempotent request - repository find error: %w", err)
+		}
+		if record == nil {
+			continue
+		}
+		if record.Response != nil || !record.CreatedAt.Before(now.Add(-s.claimTimeout)) {
+			return replay(record, fingerprint)
+		}
+
+		// Release it, unless another request got there first
+		if _, err := s.repository.DeleteUnfinishedCreatedBefore(ctx, client, key, now.Add(-s.claimTimeout)); err != nil {
+			return nil, fmt.Errorf("could not begin idempotent request - repository delete error: %w", err)
+		}
+	}
+
+	// Other requests keep taking and releasing the key
+	return nil, NewInProgressError(key)
+}
+
+// replay returns the response of the request which claimed the key,
+// if it is the same request and it has been handled.
+func replay(record *Record, fingerprint string) (*ResponseVO, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, NewReusedKeyError(record.Key)
+	}
+	if record.Response == nil {
+		return nil, NewInProgressError(record.Key)
+	}
+	return record.Response, nil
+}
+
+// Finish stores the response against the key.
+func (s *ServiceImpl) Finish(ctx context.Context, client string, key string, response *ResponseVO) error {
+	if err := s.repository.Finish(ctx, client, key, response); err != nil {
+		return fmt.Errorf("could not finish idempotent request - repository finish error: %w", err)
+	}
+	return nil
+}
+
+// Abandon deletes the key.
+func (s *ServiceImpl) Abandon(ctx context.Context, client string, key string) error {
+	if err := s.repository.DeleteByKey(ctx, client, key); err != nil {
+		return fmt.Errorf("could not abandon idempotent request - repository delete error: %w", err)
+	}
+	return nil
+}
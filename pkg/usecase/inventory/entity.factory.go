@@ -1,6 +1,11 @@
 package inventory
 
-import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
 
 // EntityFactory defines methods for creating
 // an entity.InventoryItem from VOs
@@ -10,20 +15,26 @@ type EntityFactory interface {
 
 // EntityFactoryImpl implements EntityFactory
 type EntityFactoryImpl struct {
-	constructor entity.InventoryItemConstructor
+	constructor    entity.InventoryItemConstructor
+	locationFormat domain.LocationFormat
 }
 
 // Check we implement the interface
 var _ EntityFactory = &EntityFactoryImpl{}
 
 // NewEntityFactoryImpl is a constructor
-func NewEntityFactoryImpl(constructor entity.InventoryItemConstructor) *EntityFactoryImpl {
+func NewEntityFactoryImpl(constructor entity.InventoryItemConstructor, locationFormat domain.LocationFormat) *EntityFactoryImpl {
 	return &EntityFactoryImpl{
-		constructor: constructor,
+		constructor:    constructor,
+		locationFormat: locationFormat,
 	}
 }
 
 // CreateFromVO creates a new entity from a vo
 func (e *EntityFactoryImpl) CreateFromVO(vo *CreateItemVO) (entity.InventoryItem, error) {
-	return e.constructor.NewAvailable(vo.Name, vo.Location)
+	location, err := e.locationFormat.Parse(vo.Location)
+	if err != nil {
+		return nil, fmt.Errorf("could not create entity from vo - location format error: %w", err)
+	}
+	return e.constructor.NewAvailable(vo.TitleID, vo.Format, vo.Barcode, location)
 }
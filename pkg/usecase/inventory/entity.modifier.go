@@ -3,6 +3,7 @@ package inventory
 import (
 	"fmt"
 
+	"github.com/liampulles/matchstick-video/pkg/domain"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
@@ -10,29 +11,59 @@ import (
 // updates to an entity
 type EntityModifier interface {
 	ModifyWithUpdateItemVO(entity.InventoryItem, *UpdateItemVO) error
+	ModifyWithMoveVO(entity.InventoryItem, *MoveVO) error
 }
 
 // EntityModifierImpl implements EntityModifier
-type EntityModifierImpl struct{}
+type EntityModifierImpl struct {
+	locationFormat domain.LocationFormat
+}
 
 var _ EntityModifier = &EntityModifierImpl{}
 
 // NewEntityModifierImpl is a constructor
-func NewEntityModifierImpl() *EntityModifierImpl {
-	return &EntityModifierImpl{}
+func NewEntityModifierImpl(locationFormat domain.LocationFormat) *EntityModifierImpl {
+	return &EntityModifierImpl{
+		locationFormat: locationFormat,
+	}
 }
 
 // ModifyWithUpdateItemVO modidies an existing entity as directed by an update vo
 func (e *EntityModifierImpl) ModifyWithUpdateItemVO(ent entity.InventoryItem, vo *UpdateItemVO) error {
-	err := ent.ChangeName(vo.Name)
+	err := ent.ChangeTitle(vo.TitleID)
+	if err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity title change error: %w", err)
+	}
+
+	err = ent.ChangeFormat(vo.Format)
+	if err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity format change error: %w", err)
+	}
+	err = ent.ChangeBarcode(vo.Barcode)
 	if err != nil {
-		return fmt.Errorf("could not modify entity with update vo - entity name change error: %w", err)
+		return fmt.Errorf("could not modify entity with update vo - entity barcode change error: %w", err)
 	}
 
-	err = ent.ChangeLocation(vo.Location)
+	location, err := e.locationFormat.Parse(vo.Location)
+	if err != nil {
+		return fmt.Errorf("could not modify entity with update vo - location format error: %w", err)
+	}
+	err = ent.ChangeLocation(location)
 	if err != nil {
 		return fmt.Errorf("could not modify entity with update vo - entity location change error: %w", err)
 	}
 
 	return nil
 }
+
+// ModifyWithMoveVO moves an existing entity to the location given by a move vo
+func (e *EntityModifierImpl) ModifyWithMoveVO(ent entity.InventoryItem, vo *MoveVO) error {
+	location, err := e.locationFormat.Parse(vo.Location)
+	if err != nil {
+		return fmt.Errorf("could not modify entity with move vo - location format error: %w", err)
+	}
+	if err := ent.ChangeLocation(location); err != nil {
+		return fmt.Errorf("could not modify entity with move vo - entity location change error: %w", err)
+	}
+	return nil
+}
@@ -1,15 +1,31 @@
 package inventory
 
 import (
+	"context"
+
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 // Repository handles persisting inventory entities
 // and retrieving persisted entities
 type Repository interface {
-	Create(entity.InventoryItem) (entity.ID, error)
-	FindByID(entity.ID) (entity.InventoryItem, error)
-	FindAll() ([]entity.InventoryItem, error)
-	Update(entity.InventoryItem) error
-	DeleteByID(entity.ID) error
+	Create(context.Context, entity.InventoryItem) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.InventoryItem, error)
+	// FindByIDs returns the inventory items with any of the given ids.
+	// Ids which match none are left out.
+	FindByIDs(context.Context, []entity.ID) ([]entity.InventoryItem, error)
+	FindByBarcode(context.Context, string) (entity.InventoryItem, error)
+	FindAll(context.Context) ([]entity.InventoryItem, error)
+	FindAllOfFormat(context.Context, entity.Format) ([]entity.InventoryItem, error)
+	FindAllOfTitles(context.Context, []entity.ID) ([]entity.InventoryItem, error)
+	FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.InventoryItem, error)
+	Update(context.Context, entity.InventoryItem) error
+	DeleteByID(context.Context, entity.ID) error
+}
+
+// MoveRepository handles persisting the moves of inventory
+// items between locations, and retrieving persisted moves
+type MoveRepository interface {
+	Create(context.Context, entity.LocationMove) (entity.ID, error)
+	FindByItemID(context.Context, entity.ID) ([]entity.LocationMove, error)
 }
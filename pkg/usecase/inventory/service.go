@@ -1,29 +1,71 @@
 package inventory
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
 )
 
 // Service performs operations on inventories.
 type Service interface {
-	Create(*CreateItemVO) (entity.ID, error)
-	ReadDetails(entity.ID) (*ViewVO, error)
-	ReadAll() ([]ThinViewVO, error)
-	Update(entity.ID, *UpdateItemVO) error
-	Delete(entity.ID) error
+	Create(context.Context, *CreateItemVO) (entity.ID, error)
+	ReadDetails(context.Context, entity.ID) (*ViewVO, error)
+	ReadManyDetails(context.Context, []entity.ID) ([]ViewVO, error)
+	FindIDByBarcode(context.Context, string) (entity.ID, error)
+	ReadAll(context.Context) ([]ThinViewVO, error)
+	ReadAllOfFormat(context.Context, entity.Format) ([]ThinViewVO, error)
+	ReadAllOfTitles(context.Context, []entity.ID) ([]ThinViewVO, error)
+	Update(context.Context, entity.ID, *UpdateItemVO) error
+	Delete(context.Context, entity.ID) error
 
-	Checkout(entity.ID) error
-	CheckIn(entity.ID) error
+	Checkout(context.Context, entity.ID, *CheckoutVO) error
+	CheckIn(context.Context, entity.ID) (*rental.ReceiptLineVO, error)
+	Renew(context.Context, entity.ID) (*rental.RenewalReceiptLineVO, error)
+	FulfilHold(context.Context, entity.ID) error
+
+	Move(context.Context, entity.ID, *MoveVO) error
+	ReadMoves(context.Context, entity.ID) ([]MoveViewVO, error)
+	ReadShelf(ctx context.Context, store, aisle, shelf string) (*ShelfViewVO, error)
+
+	ReadLabels(context.Context, []entity.ID) ([]LabelVO, error)
 }
 
 // ServiceImpl implements Service
 type ServiceImpl struct {
-	inventoryRepository Repository
-	entityFactory       EntityFactory
-	entityModifier      EntityModifier
-	voFactory           VOFactory
+	inventoryRepository    Repository
+	rentalRepository       rental.Repository
+	formatRepository       mediaformat.Repository
+	titleRepository        title.Repository
+	accountRepository      account.Repository
+	ledgerRepository       ledger.Repository
+	holdRepository         hold.Repository
+	locationRepository     location.Repository
+	moveRepository         MoveRepository
+	entityFactory          EntityFactory
+	entityModifier         EntityModifier
+	voFactory              VOFactory
+	rentalVOFactory        rental.VOFactory
+	rentalConstructor      entity.RentalConstructor
+	ledgerEntryConstructor entity.LedgerEntryConstructor
+	lateFeePolicy          domain.LateFeePolicy
+	ratingScheme           domain.RatingScheme
+	holdQueue              hold.Queue
+	outboxWriter           outbox.Writer
+	transactor             domain.Transactor
+	renewalLimit           int
+	creditLimit            entity.Money
+	clock                  domain.Clock
 }
 
 // Make sure ServiceImpl implements Service!
@@ -32,52 +74,192 @@ var _ Service = &ServiceImpl{}
 // NewServiceImpl is a constructor
 func NewServiceImpl(
 	inventoryRepository Repository,
+	rentalRepository rental.Repository,
+	formatRepository mediaformat.Repository,
+	titleRepository title.Repository,
+	accountRepository account.Repository,
+	ledgerRepository ledger.Repository,
+	holdRepository hold.Repository,
+	locationRepository location.Repository,
+	moveRepository MoveRepository,
 	entityFactory EntityFactory,
 	entityModifier EntityModifier,
-	voFactory VOFactory) *ServiceImpl {
+	voFactory VOFactory,
+	rentalVOFactory rental.VOFactory,
+	rentalConstructor entity.RentalConstructor,
+	ledgerEntryConstructor entity.LedgerEntryConstructor,
+	lateFeePolicy domain.LateFeePolicy,
+	ratingScheme domain.RatingScheme,
+	holdQueue hold.Queue,
+	outboxWriter outbox.Writer,
+	transactor domain.Transactor,
+	renewalLimit int,
+	creditLimit entity.Money,
+	clock domain.Clock) *ServiceImpl {
 	return &ServiceImpl{
-		inventoryRepository: inventoryRepository,
-		entityFactory:       entityFactory,
-		entityModifier:      entityModifier,
-		voFactory:           voFactory,
+		inventoryRepository:    inventoryRepository,
+		rentalRepository:       rentalRepository,
+		formatRepository:       formatRepository,
+		titleRepository:        titleRepository,
+		accountRepository:      accountRepository,
+		ledgerRepository:       ledgerRepository,
+		holdRepository:         holdRepository,
+		locationRepository:     locationRepository,
+		moveRepository:         moveRepository,
+		entityFactory:          entityFactory,
+		entityModifier:         entityModifier,
+		voFactory:              voFactory,
+		rentalVOFactory:        rentalVOFactory,
+		rentalConstructor:      rentalConstructor,
+		ledgerEntryConstructor: ledgerEntryConstructor,
+		lateFeePolicy:          lateFeePolicy,
+		ratingScheme:           ratingScheme,
+		holdQueue:              holdQueue,
+		outboxWriter:           outboxWriter,
+		transactor:             transactor,
+		renewalLimit:           renewalLimit,
+		creditLimit:            creditLimit,
+		clock:                  clock,
 	}
 }
 
-// Create creates a new entity from a request vo, and persists it.
-func (s *ServiceImpl) Create(vo *CreateItemVO) (entity.ID, error) {
+// Create creates a new entity from a request vo, and persists it
+// along with its events.
+func (s *ServiceImpl) Create(ctx context.Context, vo *CreateItemVO) (entity.ID, error) {
+	id := entity.InvalidID
+	err := s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.create(ctx, vo)
+		return err
+	})
+	return id, err
+}
+
+func (s *ServiceImpl) create(ctx context.Context, vo *CreateItemVO) (entity.ID, error) {
 	// Create new entity
 	e, err := s.entityFactory.CreateFromVO(vo)
 	if err != nil {
 		return entity.InvalidID, fmt.Errorf("could not create inventory item - factory error: %w", err)
 	}
 
+	// Make sure it is kept somewhere we know of
+	if err := s.checkLocation(ctx, e.Location()); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create inventory item - %w", err)
+	}
+
 	// Persist it
-	id, err := s.inventoryRepository.Create(e)
+	id, err := s.inventoryRepository.Create(ctx, e)
 	if err != nil {
 		return entity.InvalidID, fmt.Errorf("could not create inventory item - repository create error: %w", err)
 	}
 
+	// Record what happened
+	if err := s.outboxWriter.Write(ctx, id, e); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create inventory item - %w", err)
+	}
+
 	return id, nil
 }
 
-// ReadDetails retrieves an entity and returns a view of it.
-func (s *ServiceImpl) ReadDetails(id entity.ID) (*ViewVO, error) {
+// ReadDetails retrieves an entity and its outstanding rental (if any),
+// and returns a view of it.
+func (s *ServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*ViewVO, error) {
 	// Retrieve entity
-	found, err := s.inventoryRepository.FindByID(id)
+	found, err := s.inventoryRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("could not read inventory item - repository find error: %w", err)
 	}
 
+	// Retrieve rental
+	active, err := s.rentalRepository.FindActiveByItemID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory item - rental repository find error: %w", err)
+	}
+
 	// Create VO
-	vo := s.voFactory.CreateViewVOFromEntity(found)
+	vo := s.voFactory.CreateViewVOFromEntity(found, active, s.clock.Now())
 
 	return vo, nil
 }
 
+// ReadManyDetails retrieves the entities with any of the given ids and
+// their outstanding rentals, and returns views of them. Ids which match
+// no entity are left out.
+func (s *ServiceImpl) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]ViewVO, error) {
+	// Retrieve entities
+	found, err := s.inventoryRepository.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - repository find error: %w", err)
+	}
+
+	// Retrieve rentals
+	rentals, err := s.rentalRepository.FindActiveByItemIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - rental repository find error: %w", err)
+	}
+	active := make(map[entity.ID]entity.Rental)
+	for _, r := range rentals {
+		active[r.ItemID()] = r
+	}
+
+	// Create VOs
+	now := s.clock.Now()
+	vos := make([]ViewVO, len(found))
+	for i, e := range found {
+		vos[i] = *s.voFactory.CreateViewVOFromEntity(e, active[e.ID()], now)
+	}
+
+	return vos, nil
+}
+
+// FindIDByBarcode returns the id of the entity with the given barcode.
+func (s *ServiceImpl) FindIDByBarcode(ctx context.Context, barcode string) (entity.ID, error) {
+	found, err := s.inventoryRepository.FindByBarcode(ctx, barcode)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not find inventory item by barcode - repository find error: %w", err)
+	}
+	return found.ID(), nil
+}
+
 // ReadAll retrieves all entities and returns views of them.
-func (s *ServiceImpl) ReadAll() ([]ThinViewVO, error) {
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]ThinViewVO, error) {
 	// Retrieve entity
-	found, err := s.inventoryRepository.FindAll()
+	found, err := s.inventoryRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateThinViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// ReadAllOfFormat retrieves all entities of the given format and
+// returns views of them.
+func (s *ServiceImpl) ReadAllOfFormat(ctx context.Context, format entity.Format) ([]ThinViewVO, error) {
+	// Check the format is one we stock
+	if err := format.Validate(); err != nil {
+		return nil, fmt.Errorf("could not read inventory items - format error: %w", err)
+	}
+
+	// Retrieve entities
+	found, err := s.inventoryRepository.FindAllOfFormat(ctx, format)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory items - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateThinViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// ReadAllOfTitles retrieves all entities of any of the given titles
+// and returns views of them.
+func (s *ServiceImpl) ReadAllOfTitles(ctx context.Context, titleIDs []entity.ID) ([]ThinViewVO, error) {
+	// Retrieve entities
+	found, err := s.inventoryRepository.FindAllOfTitles(ctx, titleIDs)
 	if err != nil {
 		return nil, fmt.Errorf("could not read inventory items - repository find error: %w", err)
 	}
@@ -89,13 +271,21 @@ func (s *ServiceImpl) ReadAll() ([]ThinViewVO, error) {
 }
 
 // Update modifies an existing entity as directed by a vo, and
-// persists the changes.
-func (s *ServiceImpl) Update(id entity.ID, vo *UpdateItemVO) error {
+// persists the changes along with its events. A change of location
+// is recorded as a move.
+func (s *ServiceImpl) Update(ctx context.Context, id entity.ID, vo *UpdateItemVO) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.update(ctx, id, vo)
+	})
+}
+
+func (s *ServiceImpl) update(ctx context.Context, id entity.ID, vo *UpdateItemVO) error {
 	// Retrieve entity
-	found, err := s.inventoryRepository.FindByID(id)
+	found, err := s.inventoryRepository.FindByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("could not update inventory item - repository find error: %w", err)
 	}
+	from := found.Location()
 
 	// Modify it
 	if err := s.entityModifier.ModifyWithUpdateItemVO(found, vo); err != nil {
@@ -103,61 +293,553 @@ func (s *ServiceImpl) Update(id entity.ID, vo *UpdateItemVO) error {
 	}
 
 	// Persist it
-	err = s.inventoryRepository.Update(found)
+	if err := s.save(ctx, id, found, from); err != nil {
+		return fmt.Errorf("could not update inventory item - %w", err)
+	}
+	return nil
+}
+
+// Move moves an existing entity to the location given by a vo,
+// and persists the change along with a record of the move and
+// its events.
+func (s *ServiceImpl) Move(ctx context.Context, id entity.ID, vo *MoveVO) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.move(ctx, id, vo)
+	})
+}
+
+func (s *ServiceImpl) move(ctx context.Context, id entity.ID, vo *MoveVO) error {
+	// Retrieve entity
+	found, err := s.inventoryRepository.FindByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not update inventory item - repository update error: %w", err)
+		return fmt.Errorf("could not move inventory item - repository find error: %w", err)
+	}
+	from := found.Location()
+
+	// Move it
+	if err := s.entityModifier.ModifyWithMoveVO(found, vo); err != nil {
+		return fmt.Errorf("could not move inventory item - modifier error: %w", err)
+	}
+
+	// Persist it
+	if err := s.save(ctx, id, found, from); err != nil {
+		return fmt.Errorf("could not move inventory item - %w", err)
+	}
+	return nil
+}
+
+// ReadMoves retrieves the moves of an entity between locations,
+// earliest first, and returns views of them.
+func (s *ServiceImpl) ReadMoves(ctx context.Context, id entity.ID) ([]MoveViewVO, error) {
+	// Make sure the entity exists
+	if _, err := s.inventoryRepository.FindByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("could not read inventory item moves - repository find error: %w", err)
+	}
+
+	// Retrieve moves
+	found, err := s.moveRepository.FindByItemID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read inventory item moves - move repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateMoveViewVOs(found)
+
+	return vos, nil
+}
+
+// ReadShelf retrieves the locations of a shelf and the entities kept
+// on it, and returns a view of them. A shelf with no locations has
+// no slots.
+func (s *ServiceImpl) ReadShelf(ctx context.Context, store, aisle, shelf string) (*ShelfViewVO, error) {
+	// Retrieve locations
+	locations, err := s.locationRepository.FindAllOnShelf(ctx, store, aisle, shelf)
+	if err != nil {
+		return nil, fmt.Errorf("could not read shelf - location repository find error: %w", err)
+	}
+
+	// Retrieve entities
+	found, err := s.inventoryRepository.FindAllOnShelf(ctx, store, aisle, shelf)
+	if err != nil {
+		return nil, fmt.Errorf("could not read shelf - repository find error: %w", err)
+	}
+
+	// Create VO
+	vo := s.voFactory.CreateShelfViewVO(store, aisle, shelf, locations, found)
+
+	return vo, nil
+}
+
+// ReadLabels returns what should be printed on the labels of
+// inventory items, in the order of ids.
+func (s *ServiceImpl) ReadLabels(ctx context.Context, ids []entity.ID) ([]LabelVO, error) {
+	var vos []LabelVO
+	for _, id := range ids {
+		// Retrieve entity
+		found, err := s.inventoryRepository.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not read inventory item labels - repository find error: %w", err)
+		}
+
+		// Retrieve title
+		t, err := s.titleRepository.FindByID(ctx, found.TitleID())
+		if err != nil {
+			return nil, fmt.Errorf("could not read inventory item labels - title repository find error: %w", err)
+		}
+
+		// Create VO
+		vos = append(vos, *s.voFactory.CreateLabelVO(found, t))
+	}
+
+	return vos, nil
+}
+
+// save persists a modified entity along with its events. If it has
+// moved from the given location, the new location must be known, and
+// the move is recorded.
+func (s *ServiceImpl) save(ctx context.Context, id entity.ID, e entity.InventoryItem, from entity.Location) error {
+	moved := e.Location() != from
+	if moved {
+		if err := s.checkLocation(ctx, e.Location()); err != nil {
+			return err
+		}
+	}
+
+	if err := s.inventoryRepository.Update(ctx, e); err != nil {
+		return fmt.Errorf("repository update error: %w", err)
+	}
+
+	if moved {
+		move := entity.LocationMove{
+			ItemID:  id,
+			From:    from,
+			To:      e.Location(),
+			MovedAt: s.clock.Now(),
+		}
+		if _, err := s.moveRepository.Create(ctx, move); err != nil {
+			return fmt.Errorf("move repository create error: %w", err)
+		}
+	}
+
+	// Record what happened
+	return s.outboxWriter.Write(ctx, id, e)
+}
+
+// checkLocation returns a validation error if the location is not
+// one we know of.
+func (s *ServiceImpl) checkLocation(ctx context.Context, l entity.Location) error {
+	exists, err := s.locationRepository.Exists(ctx, l)
+	if err != nil {
+		return fmt.Errorf("location repository find error: %w", err)
+	}
+	if !exists {
+		return commonerror.NewValidation("location", "must be a known location")
 	}
 	return nil
 }
 
-// Delete wipes the entity from storage.
-func (s *ServiceImpl) Delete(id entity.ID) error {
-	if err := s.inventoryRepository.DeleteByID(id); err != nil {
+// Delete wipes the entity from storage, and records its deletion.
+func (s *ServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.delete(ctx, id)
+	})
+}
+
+func (s *ServiceImpl) delete(ctx context.Context, id entity.ID) error {
+	// Retrieve entity
+	found, err := s.inventoryRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not delete inventory item - repository find error: %w", err)
+	}
+
+	// Delete it
+	found.Delete()
+	if err := s.inventoryRepository.DeleteByID(ctx, id); err != nil {
 		return fmt.Errorf("could not delete inventory item - repository delete error: %w", err)
 	}
+
+	// Record what happened
+	if err := s.outboxWriter.Write(ctx, id, found); err != nil {
+		return fmt.Errorf("could not delete inventory item - %w", err)
+	}
 	return nil
 }
 
-// Checkout marks an entity as unavailable, and persists that information.
-func (s *ServiceImpl) Checkout(id entity.ID) error {
+// Checkout marks an entity as unavailable and rents it to an account,
+// due back after the rental period of its format. Both are persisted.
+// A copy put aside for a hold may only be checked out to the account
+// which placed it, fulfilling the hold. Age restricted titles may only
+// be checked out to account holders who are old enough. Everything is
+// persisted along with the entity's events, or nothing is.
+func (s *ServiceImpl) Checkout(ctx context.Context, id entity.ID, vo *CheckoutVO) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.checkout(ctx, id, vo)
+	})
+}
+
+func (s *ServiceImpl) checkout(ctx context.Context, id entity.ID, vo *CheckoutVO) error {
 	// Retrieve entity
-	found, err := s.inventoryRepository.FindByID(id)
+	found, err := s.inventoryRepository.FindByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("could not checkout inventory item - repository find error: %w", err)
 	}
 
+	// Retrieve the rental period
+	format, err := s.formatRepository.FindByFormat(ctx, found.Format())
+	if err != nil {
+		return fmt.Errorf("could not checkout inventory item - format repository find error: %w", err)
+	}
+
+	// Respect any hold the copy is put aside for
+	held, err := s.findHold(ctx, found)
+	if err != nil {
+		return fmt.Errorf("could not checkout inventory item - %w", err)
+	}
+	if held != nil && held.AccountID() != vo.AccountID {
+		return fmt.Errorf("could not checkout inventory item - %w",
+			commonerror.NewConflict("inventory item", "it is held for another account"))
+	}
+
 	// Checkout the entity
-	err = found.Checkout()
+	err = found.Checkout(vo.AccountID)
 	if err != nil {
 		return fmt.Errorf("could not checkout inventory item - entity error: %w", err)
 	}
 
+	// Refuse accounts which owe too much
+	if err := s.checkCredit(ctx, vo.AccountID); err != nil {
+		return fmt.Errorf("could not checkout inventory item - %w", err)
+	}
+
+	// Refuse account holders who are too young
+	if err := s.checkAge(ctx, found, vo.AccountID); err != nil {
+		return fmt.Errorf("could not checkout inventory item - %w", err)
+	}
+
+	// Rent it out
+	r, err := s.rentalConstructor.New(id, vo.AccountID, s.clock.Now(), format.RentalPeriodDays())
+	if err != nil {
+		return fmt.Errorf("could not checkout inventory item - rental error: %w", err)
+	}
+
+	// Persist the rental first, so that an unknown account
+	// leaves the item untouched.
+	_, err = s.rentalRepository.Create(ctx, r)
+	if err != nil {
+		return fmt.Errorf("could not checkout inventory item - rental repository create error: %w", err)
+	}
+
+	// Fulfil the hold
+	if held != nil {
+		if err := held.Fulfil(); err != nil {
+			return fmt.Errorf("could not checkout inventory item - hold error: %w", err)
+		}
+		if err := s.holdRepository.Update(ctx, held); err != nil {
+			return fmt.Errorf("could not checkout inventory item - hold repository update error: %w", err)
+		}
+	}
+
 	// Persist the updated entity
-	err = s.inventoryRepository.Update(found)
+	err = s.inventoryRepository.Update(ctx, found)
 	if err != nil {
 		return fmt.Errorf("could not checkout inventory item - repository update error: %w", err)
 	}
+
+	// Record what happened
+	if err := s.outboxWriter.Write(ctx, id, found); err != nil {
+		return fmt.Errorf("could not checkout inventory item - %w", err)
+	}
 	return nil
 }
 
-// CheckIn marks an entity as available, and persists that information.
-func (s *ServiceImpl) CheckIn(id entity.ID) error {
+// CheckIn will check in the entity and close its rental, charging
+// the account for any lateness, and put it aside for the next hold on
+// its title. The receipt line of the rental is returned - or nil for
+// items checked out before rentals were recorded. Everything is
+// persisted along with the entity's events, or nothing is.
+func (s *ServiceImpl) CheckIn(ctx context.Context, id entity.ID) (*rental.ReceiptLineVO, error) {
+	var receipt *rental.ReceiptLineVO
+	err := s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		receipt, err = s.checkIn(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (s *ServiceImpl) checkIn(ctx context.Context, id entity.ID) (*rental.ReceiptLineVO, error) {
+	// Lock the rental, if there is one, first - so that a concurrent
+	// check in or renewal waits for this one, and sees what it did.
+	active, err := s.rentalRepository.FindActiveByItemIDForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - rental repository find error: %w", err)
+	}
+
 	// Retrieve the entity
-	found, err := s.inventoryRepository.FindByID(id)
+	found, err := s.inventoryRepository.FindByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not check in inventory item - repository find error: %w", err)
+		return nil, fmt.Errorf("could not check in inventory item - repository find error: %w", err)
 	}
 
 	// Check in the entity
 	err = found.CheckIn()
 	if err != nil {
-		return fmt.Errorf("could not check in inventory item - entity error: %w", err)
+		return nil, fmt.Errorf("could not check in inventory item - entity error: %w", err)
+	}
+
+	// Close the rental, if there is one
+	var receipt *rental.ReceiptLineVO
+	if active != nil {
+		receipt, err = s.closeRental(ctx, found, active)
+		if err != nil {
+			return nil, fmt.Errorf("could not check in inventory item - %w", err)
+		}
+	}
+
+	// Put it aside for whoever is next in the queue
+	if _, err := s.passOn(ctx, found); err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - %w", err)
 	}
 
 	// Persist the modified entity
-	err = s.inventoryRepository.Update(found)
+	err = s.inventoryRepository.Update(ctx, found)
+	if err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - repository update error: %w", err)
+	}
+
+	// Record what happened
+	if err := s.outboxWriter.Write(ctx, id, found); err != nil {
+		return nil, fmt.Errorf("could not check in inventory item - %w", err)
+	}
+	return receipt, nil
+}
+
+func (s *ServiceImpl) closeRental(ctx context.Context, item entity.InventoryItem, active entity.Rental) (*rental.ReceiptLineVO, error) {
+	// Work out the late fee, and return the rental
+	now := s.clock.Now()
+	fee := s.lateFeePolicy.Calculate(item.Format(), active.DueAt(), now)
+	if err := active.Return(now, fee.Amount); err != nil {
+		return nil, fmt.Errorf("rental error: %w", err)
+	}
+
+	// Charge the account
+	if fee.Amount > 0 {
+		if err := s.chargeAccount(ctx, active, fee.Amount, "late fee"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Persist the returned rental
+	if err := s.rentalRepository.Update(ctx, active); err != nil {
+		return nil, fmt.Errorf("rental repository update error: %w", err)
+	}
+
+	return s.rentalVOFactory.CreateReceiptLineVO(active, fee), nil
+}
+
+// Renew extends the outstanding rental of the entity by the rental
+// period of its format, and charges the account the format's rental
+// price for it. Renewal is refused if a hold is waiting on the title,
+// or the rental has been renewed the most times allowed. The receipt
+// line of the renewal is returned. Everything is persisted along with
+// the entity's events, or nothing is.
+func (s *ServiceImpl) Renew(ctx context.Context, id entity.ID) (*rental.RenewalReceiptLineVO, error) {
+	var receipt *rental.RenewalReceiptLineVO
+	err := s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		receipt, err = s.renew(ctx, id)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("could not check in inventory item - repository update error: %w", err)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (s *ServiceImpl) renew(ctx context.Context, id entity.ID) (*rental.RenewalReceiptLineVO, error) {
+	// Retrieve and lock the rental, so that a concurrent renewal or
+	// check in waits for this one, and sees what it did.
+	active, err := s.rentalRepository.FindActiveByItemIDForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - rental repository find error: %w", err)
+	}
+	if active == nil {
+		return nil, fmt.Errorf("could not renew inventory item - %w",
+			commonerror.NewConflict("inventory item", "it is not rented out"))
+	}
+	if active.Renewals() >= s.renewalLimit {
+		return nil, fmt.Errorf("could not renew inventory item - %w",
+			commonerror.NewConflict("inventory item", fmt.Sprintf("it has been renewed the most times allowed (%d)", s.renewalLimit)))
+	}
+
+	// Retrieve the entity
+	found, err := s.inventoryRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - repository find error: %w", err)
+	}
+
+	// Make sure no one is waiting for it
+	waiting, err := s.holdRepository.FindNextWaitingByTitleID(ctx, found.TitleID())
+	if err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - hold repository find error: %w", err)
+	}
+	if waiting != nil {
+		return nil, fmt.Errorf("could not renew inventory item - %w",
+			commonerror.NewConflict("inventory item", "a hold is waiting for its title"))
+	}
+
+	// Retrieve the rental period and price
+	format, err := s.formatRepository.FindByFormat(ctx, found.Format())
+	if err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - format repository find error: %w", err)
+	}
+
+	// Extend the rental
+	if err := active.Renew(format.RentalPeriodDays()); err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - rental error: %w", err)
+	}
+
+	// Charge the account
+	fee := format.RentalPrice()
+	if fee > 0 {
+		if err := s.chargeAccount(ctx, active, fee, "renewal fee"); err != nil {
+			return nil, fmt.Errorf("could not renew inventory item - %w", err)
+		}
+	}
+
+	// Persist the renewed rental
+	if err := s.rentalRepository.Update(ctx, active); err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - rental repository update error: %w", err)
+	}
+
+	// Record what happened
+	if err := found.Renew(active.AccountID(), active.DueAt()); err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - entity error: %w", err)
+	}
+	if err := s.outboxWriter.Write(ctx, id, found); err != nil {
+		return nil, fmt.Errorf("could not renew inventory item - %w", err)
+	}
+
+	return s.rentalVOFactory.CreateRenewalReceiptLineVO(active, fee), nil
+}
+
+// chargeAccount records a charge for the rental against the
+// account which rented it.
+func (s *ServiceImpl) chargeAccount(ctx context.Context, r entity.Rental, amount entity.Money, note string) error {
+	charge, err := s.ledgerEntryConstructor.NewCharge(r.AccountID(), r.ID(), amount, note, s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("ledger entry error: %w", err)
+	}
+	if _, err := s.ledgerRepository.Create(ctx, charge); err != nil {
+		return fmt.Errorf("ledger repository create error: %w", err)
+	}
+	return nil
+}
+
+// checkCredit returns an error if the account owes more than
+// the credit limit. A zero limit means there is no limit.
+func (s *ServiceImpl) checkCredit(ctx context.Context, accountID entity.ID) error {
+	if s.creditLimit == 0 {
+		return nil
+	}
+	balance, err := s.ledgerRepository.BalanceByAccountID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("ledger repository balance error: %w", err)
+	}
+	if balance > s.creditLimit {
+		return commonerror.NewCreditLimit(int64(balance), int64(s.creditLimit))
+	}
+	return nil
+}
+
+// checkAge returns an error if the account holder is not old enough
+// for the rating of the entity's title. The account is only looked
+// up for restricted titles.
+func (s *ServiceImpl) checkAge(ctx context.Context, e entity.InventoryItem, accountID entity.ID) error {
+	t, err := s.titleRepository.FindByID(ctx, e.TitleID())
+	if err != nil {
+		return fmt.Errorf("title repository find error: %w", err)
+	}
+	if s.ratingScheme.MinimumAge(t.Rating()) == 0 {
+		return nil
+	}
+	renter, err := s.accountRepository.FindByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("account repository find error: %w", err)
+	}
+	return s.ratingScheme.CheckAge(t.Rating(), renter, s.clock.Now())
+}
+
+// FulfilHold checks out the copy put aside for a hold to the account
+// which placed it. The hold is read in the same transaction as the
+// checkout, so that it is checked as it is when the copy goes out.
+func (s *ServiceImpl) FulfilHold(ctx context.Context, id entity.ID) error {
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		return s.fulfilHold(ctx, id)
+	})
+}
+
+func (s *ServiceImpl) fulfilHold(ctx context.Context, id entity.ID) error {
+	// Retrieve the hold
+	found, err := s.holdRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not fulfil hold - repository find error: %w", err)
+	}
+
+	// Check a copy is waiting for collection
+	if found.Status() != entity.HoldReady {
+		return fmt.Errorf("could not fulfil hold - %w",
+			commonerror.NewConflict("hold", fmt.Sprintf("it is %s", found.Status())))
+	}
+	if found.IsExpired(s.clock.Now()) {
+		return fmt.Errorf("could not fulfil hold - %w",
+			commonerror.NewConflict("hold", "it has expired"))
+	}
+
+	// Check it out
+	vo := &CheckoutVO{
+		AccountID: found.AccountID(),
+	}
+	if err := s.checkout(ctx, found.ItemID(), vo); err != nil {
+		return fmt.Errorf("could not fulfil hold - %w", err)
 	}
 	return nil
 }
+
+// findHold finds the hold the item is put aside for, if any. If the hold
+// was not collected in time, it is expired and the item passed on to the
+// next in the queue.
+func (s *ServiceImpl) findHold(ctx context.Context, item entity.InventoryItem) (entity.Hold, error) {
+	held, err := s.holdRepository.FindReadyByItemID(ctx, item.ID())
+	if err != nil {
+		return nil, fmt.Errorf("hold repository find error: %w", err)
+	}
+	if held == nil || !held.IsExpired(s.clock.Now()) {
+		return held, nil
+	}
+
+	// Pass it on
+	if err := held.Expire(); err != nil {
+		return nil, fmt.Errorf("hold error: %w", err)
+	}
+	if err := s.holdRepository.Update(ctx, held); err != nil {
+		return nil, fmt.Errorf("hold repository update error: %w", err)
+	}
+	return s.passOn(ctx, item)
+}
+
+// passOn puts the item aside for the next hold waiting on its title, if
+// there is one, and returns it. The item records that it was put aside.
+func (s *ServiceImpl) passOn(ctx context.Context, item entity.InventoryItem) (entity.Hold, error) {
+	next, err := s.holdQueue.AssignNext(ctx, item.TitleID(), item.ID())
+	if err != nil {
+		return nil, fmt.Errorf("hold queue error: %w", err)
+	}
+	if next != nil {
+		item.PutAside(next.ID(), next.AccountID())
+	}
+	return next, nil
+}
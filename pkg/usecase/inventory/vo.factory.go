@@ -1,34 +1,54 @@
 package inventory
 
 import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 // VOFactory is used to create inventory VOs
 type VOFactory interface {
-	CreateViewVOFromEntity(entity.InventoryItem) *ViewVO
+	CreateViewVOFromEntity(entity.InventoryItem, entity.Rental, time.Time) *ViewVO
 	CreateThinViewVOsFromEntities([]entity.InventoryItem) []ThinViewVO
+	CreateMoveViewVOs([]entity.LocationMove) []MoveViewVO
+	CreateShelfViewVO(store, aisle, shelf string, locations []entity.Location, items []entity.InventoryItem) *ShelfViewVO
+	CreateLabelVO(entity.InventoryItem, entity.Title) *LabelVO
 }
 
 // VOFactoryImpl implements VOFactory
-type VOFactoryImpl struct{}
+type VOFactoryImpl struct {
+	locationFormat domain.LocationFormat
+}
 
 // Check we implement the interface
 var _ VOFactory = &VOFactoryImpl{}
 
 // NewVOFactoryImpl is a constructor
-func NewVOFactoryImpl() *VOFactoryImpl {
-	return &VOFactoryImpl{}
+func NewVOFactoryImpl(locationFormat domain.LocationFormat) *VOFactoryImpl {
+	return &VOFactoryImpl{
+		locationFormat: locationFormat,
+	}
 }
 
-// CreateViewVOFromEntity maps an entity to a view vo
-func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.InventoryItem) *ViewVO {
-	return &ViewVO{
+// CreateViewVOFromEntity maps an entity and its outstanding rental
+// (which may be nil) to a view vo, judging whether it is overdue
+// as of now.
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.InventoryItem, r entity.Rental, now time.Time) *ViewVO {
+	result := &ViewVO{
 		ID:        e.ID(),
-		Name:      e.Name(),
-		Location:  e.Location(),
+		TitleID:   e.TitleID(),
+		Format:    e.Format(),
+		Barcode:   e.Barcode(),
+		Location:  v.locationFormat.Format(e.Location()),
 		Available: e.IsAvailable(),
 	}
+	if r != nil {
+		dueAt := r.DueAt()
+		result.DueAt = &dueAt
+		result.Overdue = r.IsOverdue(now)
+	}
+	return result
 }
 
 // CreateThinViewVOsFromEntities maps an entity to a view vo
@@ -41,9 +61,61 @@ func (v *VOFactoryImpl) CreateThinViewVOsFromEntities(entities []entity.Inventor
 	return results
 }
 
+// CreateMoveViewVOs maps moves to view vos
+func (v *VOFactoryImpl) CreateMoveViewVOs(moves []entity.LocationMove) []MoveViewVO {
+	var results []MoveViewVO
+	for _, m := range moves {
+		results = append(results, MoveViewVO{
+			ItemID:  m.ItemID,
+			From:    v.locationFormat.Format(m.From),
+			To:      v.locationFormat.Format(m.To),
+			MovedAt: m.MovedAt,
+		})
+	}
+	return results
+}
+
+// CreateShelfViewVO maps the locations of a shelf, and the entities
+// kept on it, to a view vo. Every location is given as a slot, even
+// if nothing is kept there.
+func (v *VOFactoryImpl) CreateShelfViewVO(store, aisle, shelf string, locations []entity.Location, items []entity.InventoryItem) *ShelfViewVO {
+	result := &ShelfViewVO{
+		Store: store,
+		Aisle: aisle,
+		Shelf: shelf,
+		Slots: []SlotViewVO{},
+	}
+	for _, l := range locations {
+		slot := SlotViewVO{
+			Slot:     l.Slot,
+			Location: v.locationFormat.Format(l),
+			Items:    []ThinViewVO{},
+		}
+		for _, e := range items {
+			if e.Location() == l {
+				slot.Items = append(slot.Items, *v.createThinViewVOFromEntity(e))
+			}
+		}
+		result.Slots = append(result.Slots, slot)
+	}
+	return result
+}
+
+// CreateLabelVO maps an entity and its title to a label vo
+func (v *VOFactoryImpl) CreateLabelVO(e entity.InventoryItem, t entity.Title) *LabelVO {
+	return &LabelVO{
+		ItemID:   e.ID(),
+		Barcode:  e.Barcode(),
+		Title:    t.Name(),
+		Location: v.locationFormat.Format(e.Location()),
+	}
+}
+
 func (v *VOFactoryImpl) createThinViewVOFromEntity(e entity.InventoryItem) *ThinViewVO {
 	return &ThinViewVO{
-		ID:   e.ID(),
-		Name: e.Name(),
+		ID:      e.ID(),
+		TitleID: e.TitleID(),
+		Format:  e.Format(),
+		Barcode: e.Barcode(),
 	}
 }
@@ -1,33 +1,93 @@
 package inventory
 
-import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
 
 // CreateItemVO defines data needed to create an inventory item.
 type CreateItemVO struct {
-	Name     string
+	TitleID  entity.ID
+	Format   entity.Format
+	Barcode  string
 	Location string
 }
 
 // UpdateItemVO defines data that may be used to update an inventory item.
 type UpdateItemVO struct {
-	Name     string
+	TitleID  entity.ID
+	Format   entity.Format
+	Barcode  string
+	Location string
+}
+
+// MoveVO defines data needed to move an inventory item to another
+// location. The location is written in the configured location format.
+type MoveVO struct {
 	Location string
 }
 
+// CheckoutVO defines data needed to check out an inventory item.
+type CheckoutVO struct {
+	AccountID entity.ID
+}
+
 // ViewVO describes an inventory item in full
 // (or at least, to the greatest degree we want users
-// to see them).
+// to see them). DueAt is nil unless the item is rented out.
 type ViewVO struct {
 	ID        entity.ID
-	Name      string
+	TitleID   entity.ID
+	Format    entity.Format
+	Barcode   string
 	Location  string
 	Available bool
+	DueAt     *time.Time
+	Overdue   bool
 }
 
 // ThinViewVO outlines an inventory item, so that
 // the client can then read the details of
 // individual items.
 type ThinViewVO struct {
-	ID   entity.ID
-	Name string
+	ID      entity.ID
+	TitleID entity.ID
+	Format  entity.Format
+	Barcode string
+}
+
+// MoveViewVO describes a move of an inventory item from one location
+// to another. Locations are written in the location format.
+type MoveViewVO struct {
+	ItemID  entity.ID
+	From    string
+	To      string
+	MovedAt time.Time
+}
+
+// ShelfViewVO describes the slots of a shelf, and the inventory
+// items kept in each.
+type ShelfViewVO struct {
+	Store string
+	Aisle string
+	Shelf string
+	Slots []SlotViewVO
+}
+
+// SlotViewVO describes a slot on a shelf, and the inventory items
+// kept in it.
+type SlotViewVO struct {
+	Slot     string
+	Location string
+	Items    []ThinViewVO
+}
+
+// LabelVO defines what is printed on the label of an inventory item.
+// The location is written in the location format.
+type LabelVO struct {
+	ItemID   entity.ID
+	Barcode  string
+	Title    string
+	Location string
 }
@@ -0,0 +1,20 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting ledger entries
+// and retrieving persisted entries
+type Repository interface {
+	Create(context.Context, entity.LedgerEntry) (entity.ID, error)
+
+	// FindByAccountID returns every entry recorded against an
+	// account, earliest first.
+	FindByAccountID(context.Context, entity.ID) ([]entity.LedgerEntry, error)
+	// BalanceByAccountID totals what an account owes. Accounts
+	// with no entries owe nothing.
+	BalanceByAccountID(context.Context, entity.ID) (entity.Money, error)
+}
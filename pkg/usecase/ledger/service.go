@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// Service performs operations on account ledgers.
+type Service interface {
+	RecordPayment(context.Context, entity.ID, *RecordPaymentVO) (entity.ID, error)
+	RecordAdjustment(context.Context, entity.ID, *RecordAdjustmentVO) (entity.ID, error)
+	ReadStatement(context.Context, entity.ID) (*StatementVO, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	ledgerRepository  Repository
+	accountRepository account.Repository
+	entryConstructor  entity.LedgerEntryConstructor
+	voFactory         VOFactory
+	clock             domain.Clock
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	ledgerRepository Repository,
+	accountRepository account.Repository,
+	entryConstructor entity.LedgerEntryConstructor,
+	voFactory VOFactory,
+	clock domain.Clock) *ServiceImpl {
+	return &ServiceImpl{
+		ledgerRepository:  ledgerRepository,
+		accountRepository: accountRepository,
+		entryConstructor:  entryConstructor,
+		voFactory:         voFactory,
+		clock:             clock,
+	}
+}
+
+// RecordPayment records a payment made by an account, reducing what
+// it owes. Payments beyond what is owed are kept as credit.
+func (s *ServiceImpl) RecordPayment(ctx context.Context, accountID entity.ID, vo *RecordPaymentVO) (entity.ID, error) {
+	// Check the account exists
+	if _, err := s.accountRepository.FindByID(ctx, accountID); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record payment - account repository find error: %w", err)
+	}
+
+	// Create new entity
+	e, err := s.entryConstructor.NewPayment(accountID, vo.Amount, vo.Method, s.clock.Now())
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record payment - entity error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.ledgerRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record payment - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// RecordAdjustment records a correction to what an account owes, e.g.
+// waiving a fee.
+func (s *ServiceImpl) RecordAdjustment(ctx context.Context, accountID entity.ID, vo *RecordAdjustmentVO) (entity.ID, error) {
+	// Check the account exists
+	if _, err := s.accountRepository.FindByID(ctx, accountID); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record adjustment - account repository find error: %w", err)
+	}
+
+	// Create new entity
+	e, err := s.entryConstructor.NewAdjustment(accountID, vo.Amount, vo.Note, s.clock.Now())
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record adjustment - entity error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.ledgerRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not record adjustment - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// ReadStatement retrieves every entry recorded against an account,
+// earliest first, and returns a statement of them.
+func (s *ServiceImpl) ReadStatement(ctx context.Context, accountID entity.ID) (*StatementVO, error) {
+	// Check the account exists
+	if _, err := s.accountRepository.FindByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("could not read ledger - account repository find error: %w", err)
+	}
+
+	// Retrieve entities
+	found, err := s.ledgerRepository.FindByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ledger - repository find error: %w", err)
+	}
+
+	// Create VO
+	vo := s.voFactory.CreateStatementVOFromEntities(accountID, found)
+
+	return vo, nil
+}
@@ -0,0 +1,53 @@
+package ledger
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create ledger VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.LedgerEntry) *ViewVO
+	CreateStatementVOFromEntities(entity.ID, []entity.LedgerEntry) *StatementVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity to a view vo.
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.LedgerEntry) *ViewVO {
+	return &ViewVO{
+		ID:         e.ID(),
+		AccountID:  e.AccountID(),
+		Kind:       e.Kind(),
+		Amount:     e.Amount(),
+		Method:     e.Method(),
+		RentalID:   e.RentalID(),
+		Note:       e.Note(),
+		RecordedAt: e.RecordedAt(),
+	}
+}
+
+// CreateStatementVOFromEntities maps the entries of an account to a
+// statement vo, totalling the balance from them.
+func (v *VOFactoryImpl) CreateStatementVOFromEntities(accountID entity.ID, entities []entity.LedgerEntry) *StatementVO {
+	var balance entity.Money
+	var entries []ViewVO
+	for _, e := range entities {
+		balance += e.BalanceEffect()
+		view := v.CreateViewVOFromEntity(e)
+		entries = append(entries, *view)
+	}
+	return &StatementVO{
+		AccountID: accountID,
+		Balance:   balance,
+		Entries:   entries,
+	}
+}
@@ -0,0 +1,39 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// RecordPaymentVO defines data needed to record a payment.
+type RecordPaymentVO struct {
+	Amount entity.Money
+	Method entity.PaymentMethod
+}
+
+// RecordAdjustmentVO defines data needed to record an adjustment.
+type RecordAdjustmentVO struct {
+	Amount entity.Money
+	Note   string
+}
+
+// ViewVO describes a ledger entry.
+type ViewVO struct {
+	ID         entity.ID
+	AccountID  entity.ID
+	Kind       entity.LedgerEntryKind
+	Amount     entity.Money
+	Method     entity.PaymentMethod
+	RentalID   entity.ID
+	Note       string
+	RecordedAt time.Time
+}
+
+// StatementVO describes the ledger of an account, and what
+// it comes to.
+type StatementVO struct {
+	AccountID entity.ID
+	Balance   entity.Money
+	Entries   []ViewVO
+}
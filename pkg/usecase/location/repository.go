@@ -0,0 +1,17 @@
+package location
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting the locations copies may be
+// kept at, and retrieving persisted locations
+type Repository interface {
+	Create(context.Context, entity.Location) error
+	Exists(context.Context, entity.Location) (bool, error)
+	FindAll(context.Context) ([]entity.Location, error)
+	FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.Location, error)
+	Delete(context.Context, entity.Location) error
+}
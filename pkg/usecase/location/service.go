@@ -0,0 +1,81 @@
+package location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Service performs operations on locations.
+type Service interface {
+	Create(context.Context, *CreateLocationVO) (*ViewVO, error)
+	ReadAll(context.Context) ([]ViewVO, error)
+	Delete(context.Context, entity.Location) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	locationRepository Repository
+	locationFormat     domain.LocationFormat
+	voFactory          VOFactory
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	locationRepository Repository,
+	locationFormat domain.LocationFormat,
+	voFactory VOFactory) *ServiceImpl {
+	return &ServiceImpl{
+		locationRepository: locationRepository,
+		locationFormat:     locationFormat,
+		voFactory:          voFactory,
+	}
+}
+
+// Create reads a location written in the location format, and
+// persists it.
+func (s *ServiceImpl) Create(ctx context.Context, vo *CreateLocationVO) (*ViewVO, error) {
+	// Read the location
+	l, err := s.locationFormat.Parse(vo.Location)
+	if err != nil {
+		return nil, fmt.Errorf("could not create location - location format error: %w", err)
+	}
+	if err := l.Validate(); err != nil {
+		return nil, fmt.Errorf("could not create location - location error: %w", err)
+	}
+
+	// Persist it
+	if err := s.locationRepository.Create(ctx, l); err != nil {
+		return nil, fmt.Errorf("could not create location - repository create error: %w", err)
+	}
+
+	return s.voFactory.CreateViewVO(l), nil
+}
+
+// ReadAll retrieves all locations and returns views of them.
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]ViewVO, error) {
+	// Retrieve locations
+	found, err := s.locationRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read locations - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateViewVOs(found)
+
+	return vos, nil
+}
+
+// Delete wipes the location from storage. Locations where copies
+// are kept may not be deleted.
+func (s *ServiceImpl) Delete(ctx context.Context, l entity.Location) error {
+	if err := s.locationRepository.Delete(ctx, l); err != nil {
+		return fmt.Errorf("could not delete location - repository delete error: %w", err)
+	}
+	return nil
+}
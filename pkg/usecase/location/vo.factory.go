@@ -0,0 +1,47 @@
+package location
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create location VOs
+type VOFactory interface {
+	CreateViewVO(entity.Location) *ViewVO
+	CreateViewVOs([]entity.Location) []ViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct {
+	locationFormat domain.LocationFormat
+}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl(locationFormat domain.LocationFormat) *VOFactoryImpl {
+	return &VOFactoryImpl{
+		locationFormat: locationFormat,
+	}
+}
+
+// CreateViewVO maps a location to a view vo
+func (v *VOFactoryImpl) CreateViewVO(l entity.Location) *ViewVO {
+	return &ViewVO{
+		Location: v.locationFormat.Format(l),
+		Store:    l.Store,
+		Aisle:    l.Aisle,
+		Shelf:    l.Shelf,
+		Slot:     l.Slot,
+	}
+}
+
+// CreateViewVOs maps locations to view vos
+func (v *VOFactoryImpl) CreateViewVOs(locations []entity.Location) []ViewVO {
+	var results []ViewVO
+	for _, l := range locations {
+		results = append(results, *v.CreateViewVO(l))
+	}
+	return results
+}
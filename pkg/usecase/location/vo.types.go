@@ -0,0 +1,17 @@
+package location
+
+// CreateLocationVO defines data needed to create a location. The
+// location is written in the configured location format.
+type CreateLocationVO struct {
+	Location string
+}
+
+// ViewVO describes a location, both written in the location format
+// and split into its parts.
+type ViewVO struct {
+	Location string
+	Store    string
+	Aisle    string
+	Shelf    string
+	Slot     string
+}
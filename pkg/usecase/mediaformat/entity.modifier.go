@@ -0,0 +1,37 @@
+package mediaformat
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// EntityModifier encapsulates methods which make mass
+// updates to an entity
+type EntityModifier interface {
+	ModifyWithUpdateFormatVO(entity.MediaFormat, *UpdateFormatVO) error
+}
+
+// EntityModifierImpl implements EntityModifier
+type EntityModifierImpl struct{}
+
+var _ EntityModifier = &EntityModifierImpl{}
+
+// NewEntityModifierImpl is a constructor
+func NewEntityModifierImpl() *EntityModifierImpl {
+	return &EntityModifierImpl{}
+}
+
+// ModifyWithUpdateFormatVO modifies an existing entity as directed by an update vo
+func (e *EntityModifierImpl) ModifyWithUpdateFormatVO(ent entity.MediaFormat, vo *UpdateFormatVO) error {
+	if err := ent.ChangeName(vo.Name); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity name change error: %w", err)
+	}
+	if err := ent.ChangeRentalPrice(vo.RentalPrice); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity rental price change error: %w", err)
+	}
+	if err := ent.ChangeRentalPeriodDays(vo.RentalPeriodDays); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity rental period change error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package mediaformat
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Stock counts the copies held of a format
+type Stock struct {
+	Copies    int
+	Available int
+}
+
+// Repository handles persisting the format catalogue
+// and retrieving persisted entries
+type Repository interface {
+	FindByFormat(context.Context, entity.Format) (entity.MediaFormat, error)
+	FindAll(context.Context) ([]entity.MediaFormat, error)
+	Update(context.Context, entity.MediaFormat) error
+
+	FindStockByFormat(context.Context, entity.Format) (Stock, error)
+	FindAllStock(context.Context) (map[entity.Format]Stock, error)
+}
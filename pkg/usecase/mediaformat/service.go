@@ -0,0 +1,100 @@
+package mediaformat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Service performs operations on the format catalogue.
+type Service interface {
+	ReadDetails(context.Context, entity.Format) (*ViewVO, error)
+	ReadAll(context.Context) ([]ViewVO, error)
+	Update(context.Context, entity.Format, *UpdateFormatVO) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	formatRepository Repository
+	entityModifier   EntityModifier
+	voFactory        VOFactory
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	formatRepository Repository,
+	entityModifier EntityModifier,
+	voFactory VOFactory) *ServiceImpl {
+	return &ServiceImpl{
+		formatRepository: formatRepository,
+		entityModifier:   entityModifier,
+		voFactory:        voFactory,
+	}
+}
+
+// ReadDetails retrieves the catalogue entry of a format and its
+// stock, and returns a view of it.
+func (s *ServiceImpl) ReadDetails(ctx context.Context, format entity.Format) (*ViewVO, error) {
+	// Retrieve entity
+	found, err := s.formatRepository.FindByFormat(ctx, format)
+	if err != nil {
+		return nil, fmt.Errorf("could not read format - repository find error: %w", err)
+	}
+
+	// Count copies
+	stock, err := s.formatRepository.FindStockByFormat(ctx, format)
+	if err != nil {
+		return nil, fmt.Errorf("could not read format - repository stock error: %w", err)
+	}
+
+	// Create VO
+	vo := s.voFactory.CreateViewVOFromEntity(found, stock)
+
+	return vo, nil
+}
+
+// ReadAll retrieves the whole catalogue and the stock of each
+// format, and returns views of them.
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]ViewVO, error) {
+	// Retrieve entities
+	found, err := s.formatRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read formats - repository find error: %w", err)
+	}
+
+	// Count copies
+	stock, err := s.formatRepository.FindAllStock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read formats - repository stock error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateViewVOsFromEntities(found, stock)
+
+	return vos, nil
+}
+
+// Update modifies the catalogue entry of a format as directed by
+// a vo, and persists the changes.
+func (s *ServiceImpl) Update(ctx context.Context, format entity.Format, vo *UpdateFormatVO) error {
+	// Retrieve entity
+	found, err := s.formatRepository.FindByFormat(ctx, format)
+	if err != nil {
+		return fmt.Errorf("could not update format - repository find error: %w", err)
+	}
+
+	// Modify it
+	if err := s.entityModifier.ModifyWithUpdateFormatVO(found, vo); err != nil {
+		return fmt.Errorf("could not update format - modifier error: %w", err)
+	}
+
+	// Persist it
+	if err := s.formatRepository.Update(ctx, found); err != nil {
+		return fmt.Errorf("could not update format - repository update error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mediaformat
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create format VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.MediaFormat, Stock) *ViewVO
+	CreateViewVOsFromEntities([]entity.MediaFormat, map[entity.Format]Stock) []ViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity and its stock to a view vo
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.MediaFormat, stock Stock) *ViewVO {
+	return &ViewVO{
+		Format:           e.Format(),
+		Name:             e.Name(),
+		RentalPrice:      e.RentalPrice(),
+		RentalPeriodDays: e.RentalPeriodDays(),
+		Copies:           stock.Copies,
+		AvailableCopies:  stock.Available,
+	}
+}
+
+// CreateViewVOsFromEntities maps entities and their stock to view
+// vos. Formats missing from stock are taken to have no copies.
+func (v *VOFactoryImpl) CreateViewVOsFromEntities(entities []entity.MediaFormat, stock map[entity.Format]Stock) []ViewVO {
+	var results []ViewVO
+	for _, e := range entities {
+		view := v.CreateViewVOFromEntity(e, stock[e.Format()])
+		results = append(results, *view)
+	}
+	return results
+}
@@ -0,0 +1,22 @@
+package mediaformat
+
+import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+
+// UpdateFormatVO defines data that may be used to update the
+// catalogue entry of a format.
+type UpdateFormatVO struct {
+	Name             string
+	RentalPrice      entity.Money
+	RentalPeriodDays int
+}
+
+// ViewVO describes the catalogue entry of a format, along
+// with how many copies of that format the store holds.
+type ViewVO struct {
+	Format           entity.Format
+	Name             string
+	RentalPrice      entity.Money
+	RentalPeriodDays int
+	Copies           int
+	AvailableCopies  int
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Feed follows the messages written to the outbox as they are written,
+// whether or not they have been published.
+type Feed interface {
+	// Latest returns the id of the latest message, so that only
+	// messages written from now on are followed.
+	Latest(context.Context) (entity.ID, error)
+	// Follow calls fn with the messages written after the one with the
+	// given id, earliest first, until ctx is done or fn fails. fn is
+	// called each time the feed looks for messages, even if there are
+	// none.
+	Follow(ctx context.Context, after entity.ID, fn func([]Message) error) error
+}
+
+// FeedImpl implements Feed
+type FeedImpl struct {
+	repository Repository
+	clock      domain.Clock
+	interval   time.Duration
+	gapTimeout time.Duration
+	batchSize  int
+}
+
+// Check we implement the interface
+var _ Feed = &FeedImpl{}
+
+// NewFeedImpl is a constructor
+func NewFeedImpl(
+	repository Repository,
+	clock domain.Clock,
+	interval time.Duration,
+	gapTimeout time.Duration,
+	batchSize int,
+) *FeedImpl {
+	return &FeedImpl{
+		repository: repository,
+		clock:      clock,
+		interval:   interval,
+		gapTimeout: gapTimeout,
+		batchSize:  batchSize,
+	}
+}
+
+// Latest returns the id of the latest message, or 0 if there are none.
+func (f *FeedImpl) Latest(ctx context.Context) (entity.ID, error) {
+	id, err := f.repository.FindLatestID(ctx)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not find latest outbox message - repository find error: %w", err)
+	}
+	return id, nil
+}
+
+// Follow looks for messages after the given id every interval, or at
+// once if a full batch was found. Ids are given out before the
+// transactions which write them commit, so a message may be found
+// before one with a smaller id. Messages are only passed on in order:
+// if an id is missing, the messages after it are held back until it
+// is found, or until it has been missing for the gap timeout (e.g.
+// because its transaction was rolled back).
+func (f *FeedImpl) Follow(ctx context.Context, after entity.ID, fn func([]Message) error) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	gap := entity.InvalidID
+	var gapSince time.Time
+	for {
+		// Look for new messages
+		found, err := f.repository.FindAfter(ctx, after, f.batchSize)
+		if err != nil {
+			return fmt.Errorf("could not follow outbox messages - repository find error: %w", err)
+		}
+
+		// Hold back anything after a gap, unless we have waited long enough
+		n := followOn(after, found)
+		if n < len(found) {
+			missing := after + 1
+			if n > 0 {
+				missing = found[n-1].ID + 1
+			}
+			now := f.clock.Now()
+			if missing != gap {
+				gap = missing
+				gapSince = now
+			}
+			if now.Sub(gapSince) >= f.gapTimeout {
+				n += followOn(found[n].ID-1, found[n:])
+			}
+		}
+		ready := found[:n]
+
+		// Pass them on
+		if err := fn(ready); err != nil {
+			return fmt.Errorf("could not follow outbox messages - %w", err)
+		}
+		if n > 0 {
+			after = ready[n-1].ID
+		}
+
+		// Catch up at once if there may be more, else wait
+		if n == f.batchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// followOn returns how many of the messages follow on from the given
+// id, one after the other.
+func followOn(after entity.ID, msgs []Message) int {
+	for i, msg := range msgs {
+		if msg.ID != after+1 {
+			return i
+		}
+		after = msg.ID
+	}
+	return len(msgs)
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Message is an event waiting in the outbox to be published.
+type Message struct {
+	ID         entity.ID
+	EntityID   entity.ID
+	Type       entity.EventType
+	Data       map[string]interface{}
+	OccurredAt time.Time
+}
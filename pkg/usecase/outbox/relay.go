@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// Relay publishes the messages waiting in the outbox.
+type Relay interface {
+	RelayPending(context.Context) (int, error)
+}
+
+// RelayImpl implements Relay
+type RelayImpl struct {
+	repository Repository
+	sinks      []Sink
+	transactor domain.Transactor
+	clock      domain.Clock
+	batchSize  int
+}
+
+// Check we implement the interface
+var _ Relay = &RelayImpl{}
+
+// NewRelayImpl is a constructor
+func NewRelayImpl(
+	repository Repository,
+	sinks []Sink,
+	transactor domain.Transactor,
+	clock domain.Clock,
+	batchSize int,
+) *RelayImpl {
+	return &RelayImpl{
+		repository: repository,
+		sinks:      sinks,
+		transactor: transactor,
+		clock:      clock,
+		batchSize:  batchSize,
+	}
+}
+
+// RelayPending publishes a batch of unpublished messages to every sink,
+// earliest first, and returns how many were published. If a sink fails,
+// the messages before are still marked as published and the rest are
+// left for next time. Messages are published at least once: a message
+// may be published again if marking it fails, or if another sink fails
+// to publish it.
+func (r *RelayImpl) RelayPending(ctx context.Context) (int, error) {
+	relayed := 0
+	var sinkErr error
+	err := r.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		// Retrieve and lock the batch
+		pending, err := r.repository.FindUnpublished(ctx, r.batchSize)
+		if err != nil {
+			return fmt.Errorf("repository find error: %w", err)
+		}
+
+		for _, msg := range pending {
+			// Publish it, keeping what was published so far if we can't
+			if sinkErr = r.publish(ctx, msg); sinkErr != nil {
+				return nil
+			}
+
+			// Mark it
+			if err := r.repository.MarkPublished(ctx, msg.ID, r.clock.Now()); err != nil {
+				return fmt.Errorf("repository mark error: %w", err)
+			}
+			relayed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not relay outbox messages - %w", err)
+	}
+	if sinkErr != nil {
+		return relayed, fmt.Errorf("could not relay outbox messages - %w", sinkErr)
+	}
+	return relayed, nil
+}
+
+func (r *RelayImpl) publish(ctx context.Context, msg Message) error {
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, msg); err != nil {
+			return fmt.Errorf("sink publish error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting outbox messages
+// and retrieving persisted messages
+type Repository interface {
+	Create(context.Context, Message) (entity.ID, error)
+
+	// FindUnpublished returns at most limit messages which have not
+	// been published, earliest first. Messages found in a transaction
+	// are locked until it ends, and are skipped by other transactions.
+	FindUnpublished(ctx context.Context, limit int) ([]Message, error)
+	// MarkPublished records that a message has been published.
+	MarkPublished(ctx context.Context, id entity.ID, at time.Time) error
+
+	// FindAfter returns at most limit messages with an id greater than
+	// the given one, whether published or not, earliest first.
+	FindAfter(ctx context.Context, after entity.ID, limit int) ([]Message, error)
+	// FindLatestID returns the id of the latest message, or 0 if there
+	// are none.
+	FindLatestID(context.Context) (entity.ID, error)
+}
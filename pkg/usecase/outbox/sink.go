@@ -0,0 +1,10 @@
+package outbox
+
+import "context"
+
+// Sink is somewhere outbox messages are published to, so that other
+// systems can react to them. Implementations can be found in the
+// adapter layer.
+type Sink interface {
+	Publish(context.Context, Message) error
+}
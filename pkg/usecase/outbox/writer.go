@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Writer puts the events recorded by entities in the outbox.
+type Writer interface {
+	Write(ctx context.Context, entityID entity.ID, recorder entity.EventRecorder) error
+}
+
+// WriterImpl implements Writer
+type WriterImpl struct {
+	repository Repository
+	clock      domain.Clock
+}
+
+// Check we implement the interface
+var _ Writer = &WriterImpl{}
+
+// NewWriterImpl is a constructor
+func NewWriterImpl(repository Repository, clock domain.Clock) *WriterImpl {
+	return &WriterImpl{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+// Write pulls the events recorded by the entity with the given id, and
+// persists a message for each. To be published only if the entity's
+// changes are kept, it should be called in the same transaction as they
+// are persisted.
+func (w *WriterImpl) Write(ctx context.Context, entityID entity.ID, recorder entity.EventRecorder) error {
+	now := w.clock.Now()
+	for _, event := range recorder.PullEvents() {
+		msg := Message{
+			EntityID:   entityID,
+			Type:       event.Type,
+			Data:       event.Data,
+			OccurredAt: now,
+		}
+		if _, err := w.repository.Create(ctx, msg); err != nil {
+			return fmt.Errorf("could not write outbox message - repository create error: %w", err)
+		}
+	}
+	return nil
+}
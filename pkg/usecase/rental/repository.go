@@ -0,0 +1,29 @@
+package rental
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Repository handles persisting rental entities
+// and retrieving persisted entities
+type Repository interface {
+	Create(context.Context, entity.Rental) (entity.ID, error)
+	Update(context.Context, entity.Rental) error
+
+	// FindActiveByItemID returns the outstanding rental of an
+	// inventory item, or nil if the item is not rented out.
+	FindActiveByItemID(context.Context, entity.ID) (entity.Rental, error)
+	// FindActiveByItemIDForUpdate is like FindActiveByItemID, but
+	// the rental is locked until the transaction ends.
+	FindActiveByItemIDForUpdate(context.Context, entity.ID) (entity.Rental, error)
+	FindActiveByAccountID(context.Context, entity.ID) ([]entity.Rental, error)
+	// FindActiveByItemIDs returns the outstanding rentals of any of the
+	// given inventory items.
+	FindActiveByItemIDs(context.Context, []entity.ID) ([]entity.Rental, error)
+	// FindOverdue returns outstanding rentals which were due before
+	// the given time, earliest due first.
+	FindOverdue(context.Context, time.Time) ([]entity.Rental, error)
+}
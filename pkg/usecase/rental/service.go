@@ -0,0 +1,52 @@
+package rental
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// Service performs operations on rentals.
+type Service interface {
+	ReadOverdue(context.Context) ([]ViewVO, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	rentalRepository Repository
+	voFactory        VOFactory
+	clock            domain.Clock
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	rentalRepository Repository,
+	voFactory VOFactory,
+	clock domain.Clock) *ServiceImpl {
+	return &ServiceImpl{
+		rentalRepository: rentalRepository,
+		voFactory:        voFactory,
+		clock:            clock,
+	}
+}
+
+// ReadOverdue retrieves all outstanding rentals which are past
+// their due date, and returns views of them.
+func (s *ServiceImpl) ReadOverdue(ctx context.Context) ([]ViewVO, error) {
+	now := s.clock.Now()
+
+	// Retrieve entities
+	found, err := s.rentalRepository.FindOverdue(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("could not read overdue rentals - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateViewVOsFromEntities(found, now)
+
+	return vos, nil
+}
@@ -0,0 +1,82 @@
+package rental
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create rental VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.Rental, time.Time) *ViewVO
+	CreateViewVOsFromEntities([]entity.Rental, time.Time) []ViewVO
+	CreateReceiptLineVO(entity.Rental, domain.LateFee) *ReceiptLineVO
+	CreateRenewalReceiptLineVO(entity.Rental, entity.Money) *RenewalReceiptLineVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity to a view vo, judging
+// whether it is overdue as of now.
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.Rental, now time.Time) *ViewVO {
+	return &ViewVO{
+		ID:           e.ID(),
+		ItemID:       e.ItemID(),
+		AccountID:    e.AccountID(),
+		CheckedOutAt: e.CheckedOutAt(),
+		DueAt:        e.DueAt(),
+		Overdue:      e.IsOverdue(now),
+	}
+}
+
+// CreateViewVOsFromEntities maps entities to view vos, judging
+// whether they are overdue as of now.
+func (v *VOFactoryImpl) CreateViewVOsFromEntities(entities []entity.Rental, now time.Time) []ViewVO {
+	var results []ViewVO
+	for _, e := range entities {
+		view := v.CreateViewVOFromEntity(e, now)
+		results = append(results, *view)
+	}
+	return results
+}
+
+// CreateReceiptLineVO maps a returned entity, and the fee charged
+// for its lateness, to a receipt line vo.
+func (v *VOFactoryImpl) CreateReceiptLineVO(e entity.Rental, fee domain.LateFee) *ReceiptLineVO {
+	var returnedAt time.Time
+	if e.ReturnedAt() != nil {
+		returnedAt = *e.ReturnedAt()
+	}
+	return &ReceiptLineVO{
+		RentalID:   e.ID(),
+		ItemID:     e.ItemID(),
+		AccountID:  e.AccountID(),
+		DueAt:      e.DueAt(),
+		ReturnedAt: returnedAt,
+		DaysLate:   fee.DaysLate,
+		LateFee:    fee.Amount,
+	}
+}
+
+// CreateRenewalReceiptLineVO maps a renewed entity, and the fee charged
+// for renewing it, to a renewal receipt line vo.
+func (v *VOFactoryImpl) CreateRenewalReceiptLineVO(e entity.Rental, fee entity.Money) *RenewalReceiptLineVO {
+	return &RenewalReceiptLineVO{
+		RentalID:   e.ID(),
+		ItemID:     e.ItemID(),
+		AccountID:  e.AccountID(),
+		DueAt:      e.DueAt(),
+		Renewals:   e.Renewals(),
+		RenewalFee: fee,
+	}
+}
@@ -0,0 +1,41 @@
+package rental
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// ViewVO describes an outstanding rental, and whether
+// it is overdue.
+type ViewVO struct {
+	ID           entity.ID
+	ItemID       entity.ID
+	AccountID    entity.ID
+	CheckedOutAt time.Time
+	DueAt        time.Time
+	Overdue      bool
+}
+
+// ReceiptLineVO describes the return of a rented item, and
+// what was charged for it.
+type ReceiptLineVO struct {
+	RentalID   entity.ID
+	ItemID     entity.ID
+	AccountID  entity.ID
+	DueAt      time.Time
+	ReturnedAt time.Time
+	DaysLate   int
+	LateFee    entity.Money
+}
+
+// RenewalReceiptLineVO describes the renewal of a rented item, and
+// what was charged for it.
+type RenewalReceiptLineVO struct {
+	RentalID   entity.ID
+	ItemID     entity.ID
+	AccountID  entity.ID
+	DueAt      time.Time
+	Renewals   int
+	RenewalFee entity.Money
+}
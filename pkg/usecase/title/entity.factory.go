@@ -0,0 +1,37 @@
+package title
+
+import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+
+// EntityFactory defines methods for creating
+// an entity.Title from VOs
+type EntityFactory interface {
+	CreateFromVO(*CreateTitleVO) (entity.Title, error)
+}
+
+// EntityFactoryImpl implements EntityFactory
+type EntityFactoryImpl struct {
+	constructor entity.TitleConstructor
+}
+
+// Check we implement the interface
+var _ EntityFactory = &EntityFactoryImpl{}
+
+// NewEntityFactoryImpl is a constructor
+func NewEntityFactoryImpl(constructor entity.TitleConstructor) *EntityFactoryImpl {
+	return &EntityFactoryImpl{
+		constructor: constructor,
+	}
+}
+
+// CreateFromVO creates a new entity from a vo
+func (e *EntityFactoryImpl) CreateFromVO(vo *CreateTitleVO) (entity.Title, error) {
+	return e.constructor.New(
+		vo.Name,
+		vo.Year,
+		vo.Runtime,
+		vo.Synopsis,
+		vo.Genres,
+		vo.Cast,
+		vo.Rating,
+	)
+}
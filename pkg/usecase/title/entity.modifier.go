@@ -0,0 +1,49 @@
+package title
+
+import (
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// EntityModifier encapsulates methods which make mass
+// updates to an entity
+type EntityModifier interface {
+	ModifyWithUpdateTitleVO(entity.Title, *UpdateTitleVO) error
+}
+
+// EntityModifierImpl implements EntityModifier
+type EntityModifierImpl struct{}
+
+var _ EntityModifier = &EntityModifierImpl{}
+
+// NewEntityModifierImpl is a constructor
+func NewEntityModifierImpl() *EntityModifierImpl {
+	return &EntityModifierImpl{}
+}
+
+// ModifyWithUpdateTitleVO modifies an existing entity as directed by an update vo
+func (e *EntityModifierImpl) ModifyWithUpdateTitleVO(ent entity.Title, vo *UpdateTitleVO) error {
+	if err := ent.ChangeName(vo.Name); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity name change error: %w", err)
+	}
+	if err := ent.ChangeYear(vo.Year); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity year change error: %w", err)
+	}
+	if err := ent.ChangeRuntime(vo.Runtime); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity runtime change error: %w", err)
+	}
+	if err := ent.ChangeSynopsis(vo.Synopsis); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity synopsis change error: %w", err)
+	}
+	if err := ent.ChangeGenres(vo.Genres); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity genres change error: %w", err)
+	}
+	if err := ent.ChangeCast(vo.Cast); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity cast change error: %w", err)
+	}
+	if err := ent.ChangeRating(vo.Rating); err != nil {
+		return fmt.Errorf("could not modify entity with update vo - entity rating change error: %w", err)
+	}
+	return nil
+}
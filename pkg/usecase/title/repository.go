@@ -0,0 +1,32 @@
+package title
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Stock counts the copies held of a title
+type Stock struct {
+	Copies    int
+	Available int
+}
+
+// Repository handles persisting title entities
+// and retrieving persisted entities
+type Repository interface {
+	Create(context.Context, entity.Title) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.Title, error)
+	// FindByIDs returns the titles with any of the given ids. Ids
+	// which match none are left out.
+	FindByIDs(context.Context, []entity.ID) ([]entity.Title, error)
+	FindAll(context.Context) ([]entity.Title, error)
+	Update(context.Context, entity.Title) error
+	DeleteByID(context.Context, entity.ID) error
+
+	FindStockByID(context.Context, entity.ID) (Stock, error)
+	FindAllStock(context.Context) (map[entity.ID]Stock, error)
+	// FindStockByIDs counts the copies of the titles with any of the
+	// given ids. Titles without copies are left out.
+	FindStockByIDs(context.Context, []entity.ID) (map[entity.ID]Stock, error)
+}
@@ -0,0 +1,170 @@
+package title
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Service performs operations on the title catalogue.
+type Service interface {
+	Create(context.Context, *CreateTitleVO) (entity.ID, error)
+	ReadDetails(context.Context, entity.ID) (*ViewVO, error)
+	ReadManyDetails(context.Context, []entity.ID) ([]ViewVO, error)
+	ReadAll(context.Context) ([]ThinViewVO, error)
+	Update(context.Context, entity.ID, *UpdateTitleVO) error
+	Delete(context.Context, entity.ID) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	titleRepository Repository
+	entityFactory   EntityFactory
+	entityModifier  EntityModifier
+	voFactory       VOFactory
+	ratingScheme    domain.RatingScheme
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	titleRepository Repository,
+	entityFactory EntityFactory,
+	entityModifier EntityModifier,
+	voFactory VOFactory,
+	ratingScheme domain.RatingScheme) *ServiceImpl {
+	return &ServiceImpl{
+		titleRepository: titleRepository,
+		entityFactory:   entityFactory,
+		entityModifier:  entityModifier,
+		voFactory:       voFactory,
+		ratingScheme:    ratingScheme,
+	}
+}
+
+// Create creates a new entity from a request vo, and persists it.
+func (s *ServiceImpl) Create(ctx context.Context, vo *CreateTitleVO) (entity.ID, error) {
+	// Create new entity
+	e, err := s.entityFactory.CreateFromVO(vo)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create title - factory error: %w", err)
+	}
+	if err := s.ratingScheme.Validate(e.Rating()); err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create title - rating error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.titleRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create title - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// ReadDetails retrieves an entity and its stock, and returns a view of it.
+func (s *ServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*ViewVO, error) {
+	// Retrieve entity
+	found, err := s.titleRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read title - repository find error: %w", err)
+	}
+
+	// Count copies
+	stock, err := s.titleRepository.FindStockByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read title - repository stock error: %w", err)
+	}
+
+	// Create VO
+	vo := s.voFactory.CreateViewVOFromEntity(found, stock)
+
+	return vo, nil
+}
+
+// ReadManyDetails retrieves the entities with any of the given ids and
+// their stock, and returns views of them. Ids which match no entity
+// are left out.
+func (s *ServiceImpl) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]ViewVO, error) {
+	// Retrieve entities
+	found, err := s.titleRepository.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not read titles - repository find error: %w", err)
+	}
+
+	// Count copies
+	stock, err := s.titleRepository.FindStockByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not read titles - repository stock error: %w", err)
+	}
+
+	// Create VOs
+	vos := make([]ViewVO, len(found))
+	for i, e := range found {
+		vos[i] = *s.voFactory.CreateViewVOFromEntity(e, stock[e.ID()])
+	}
+
+	return vos, nil
+}
+
+// ReadAll retrieves all entities and their stock, and returns views of them.
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]ThinViewVO, error) {
+	// Retrieve entities
+	found, err := s.titleRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read titles - repository find error: %w", err)
+	}
+
+	// Count copies
+	stock, err := s.titleRepository.FindAllStock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read titles - repository stock error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateThinViewVOsFromEntities(found, stock)
+
+	return vos, nil
+}
+
+// Update modifies an existing entity as directed by a vo, and
+// persists the changes.
+func (s *ServiceImpl) Update(ctx context.Context, id entity.ID, vo *UpdateTitleVO) error {
+	// Retrieve entity
+	found, err := s.titleRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not update title - repository find error: %w", err)
+	}
+
+	// Modify it. Ratings given before the rating scheme was in
+	// place are kept as they are, unless changed.
+	previousRating := found.Rating()
+	if err := s.entityModifier.ModifyWithUpdateTitleVO(found, vo); err != nil {
+		return fmt.Errorf("could not update title - modifier error: %w", err)
+	}
+	if found.Rating() != previousRating {
+		if err := s.ratingScheme.Validate(found.Rating()); err != nil {
+			return fmt.Errorf("could not update title - rating error: %w", err)
+		}
+	}
+
+	// Persist it
+	err = s.titleRepository.Update(ctx, found)
+	if err != nil {
+		return fmt.Errorf("could not update title - repository update error: %w", err)
+	}
+	return nil
+}
+
+// Delete wipes the entity from storage. A title which still has copies
+// cannot be deleted.
+func (s *ServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	if err := s.titleRepository.DeleteByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete title - repository delete error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package title
+
+import (
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create title VOs
+type VOFactory interface {
+	CreateViewVOFromEntity(entity.Title, Stock) *ViewVO
+	CreateThinViewVOsFromEntities([]entity.Title, map[entity.ID]Stock) []ThinViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateViewVOFromEntity maps an entity and its stock to a view vo
+func (v *VOFactoryImpl) CreateViewVOFromEntity(e entity.Title, stock Stock) *ViewVO {
+	return &ViewVO{
+		ID:              e.ID(),
+		Name:            e.Name(),
+		Year:            e.Year(),
+		Runtime:         e.Runtime(),
+		Synopsis:        e.Synopsis(),
+		Genres:          e.Genres(),
+		Cast:            e.Cast(),
+		Rating:          e.Rating(),
+		Copies:          stock.Copies,
+		AvailableCopies: stock.Available,
+	}
+}
+
+// CreateThinViewVOsFromEntities maps entities and their stock to thin
+// view vos. Titles missing from stock are taken to have no copies.
+func (v *VOFactoryImpl) CreateThinViewVOsFromEntities(entities []entity.Title, stock map[entity.ID]Stock) []ThinViewVO {
+	var results []ThinViewVO
+	for _, e := range entities {
+		view := v.createThinViewVOFromEntity(e, stock[e.ID()])
+		results = append(results, *view)
+	}
+	return results
+}
+
+func (v *VOFactoryImpl) createThinViewVOFromEntity(e entity.Title, stock Stock) *ThinViewVO {
+	return &ThinViewVO{
+		ID:              e.ID(),
+		Name:            e.Name(),
+		Year:            e.Year(),
+		Copies:          stock.Copies,
+		AvailableCopies: stock.Available,
+	}
+}
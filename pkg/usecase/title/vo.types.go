@@ -0,0 +1,51 @@
+package title
+
+import "github.com/liampulles/matchstick-video/pkg/domain/entity"
+
+// CreateTitleVO defines data needed to create a title.
+type CreateTitleVO struct {
+	Name     string
+	Year     int
+	Runtime  int
+	Synopsis string
+	Genres   []string
+	Cast     []string
+	Rating   string
+}
+
+// UpdateTitleVO defines data that may be used to update a title.
+type UpdateTitleVO struct {
+	Name     string
+	Year     int
+	Runtime  int
+	Synopsis string
+	Genres   []string
+	Cast     []string
+	Rating   string
+}
+
+// ViewVO describes a title in full, along with
+// how many copies the store holds.
+type ViewVO struct {
+	ID              entity.ID
+	Name            string
+	Year            int
+	Runtime         int
+	Synopsis        string
+	Genres          []string
+	Cast            []string
+	Rating          string
+	Copies          int
+	AvailableCopies int
+}
+
+// ThinViewVO outlines a title and how many copies
+// the store holds, so that the client can then read
+// the details of individual titles.
+type ThinViewVO struct {
+	ID              entity.ID
+	Name            string
+	Year            int
+	Copies          int
+	AvailableCopies int
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Deliverer makes the webhook deliveries which are due.
+type Deliverer interface {
+	DeliverDue(context.Context) (int, error)
+}
+
+// DelivererImpl implements Deliverer
+type DelivererImpl struct {
+	subscriptionRepository SubscriptionRepository
+	deliveryRepository     DeliveryRepository
+	sender                 Sender
+	transactor             domain.Transactor
+	backoffPolicy          domain.BackoffPolicy
+	clock                  domain.Clock
+	batchSize              int
+}
+
+// Check we implement the interface
+var _ Deliverer = &DelivererImpl{}
+
+// NewDelivererImpl is a constructor
+func NewDelivererImpl(
+	subscriptionRepository SubscriptionRepository,
+	deliveryRepository DeliveryRepository,
+	sender Sender,
+	transactor domain.Transactor,
+	backoffPolicy domain.BackoffPolicy,
+	clock domain.Clock,
+	batchSize int,
+) *DelivererImpl {
+	return &DelivererImpl{
+		subscriptionRepository: subscriptionRepository,
+		deliveryRepository:     deliveryRepository,
+		sender:                 sender,
+		transactor:             transactor,
+		backoffPolicy:          backoffPolicy,
+		clock:                  clock,
+		batchSize:              batchSize,
+	}
+}
+
+// DeliverDue attempts a batch of due deliveries, earliest first, and
+// returns how many were attempted. Failed attempts are scheduled to be
+// retried according to the backoff policy, and once it gives up the
+// delivery is dead. Events are delivered at least once: an event may
+// be posted again if recording the outcome of the attempt fails.
+func (d *DelivererImpl) DeliverDue(ctx context.Context) (int, error) {
+	attempted := 0
+	err := d.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		// Retrieve and lock the batch
+		due, err := d.deliveryRepository.FindDue(ctx, d.clock.Now(), d.batchSize)
+		if err != nil {
+			return fmt.Errorf("delivery repository find error: %w", err)
+		}
+
+		for _, delivery := range due {
+			// Find where it goes
+			subscription, err := d.subscriptionRepository.FindByID(ctx, delivery.SubscriptionID())
+			if err != nil {
+				return fmt.Errorf("subscription repository find error: %w", err)
+			}
+
+			// Attempt it
+			if err := d.attempt(ctx, subscription, delivery); err != nil {
+				return fmt.Errorf("entity error: %w", err)
+			}
+
+			// Persist the outcome
+			if err := d.deliveryRepository.Update(ctx, delivery); err != nil {
+				return fmt.Errorf("delivery repository update error: %w", err)
+			}
+			attempted++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not deliver webhooks - %w", err)
+	}
+	return attempted, nil
+}
+
+func (d *DelivererImpl) attempt(ctx context.Context, subscription entity.WebhookSubscription, delivery entity.WebhookDelivery) error {
+	statusCode, sendErr := d.sender.Send(ctx, subscription, delivery)
+	now := d.clock.Now()
+	if sendErr == nil {
+		return delivery.Succeed(now, statusCode)
+	}
+	if next, ok := d.backoffPolicy.Next(delivery.Attempts()+1, now); ok {
+		return delivery.Retry(now, statusCode, sendErr.Error(), next)
+	}
+	return delivery.GiveUp(now, statusCode, sendErr.Error())
+}
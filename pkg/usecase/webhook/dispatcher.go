@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// DispatcherImpl implements outbox.Sink by queueing a delivery of
+// each message to every subscription which asks for it. The
+// deliveries are then made by a Deliverer.
+type DispatcherImpl struct {
+	subscriptionRepository SubscriptionRepository
+	deliveryRepository     DeliveryRepository
+	deliveryConstructor    entity.WebhookDeliveryConstructor
+	clock                  domain.Clock
+}
+
+// Check we implement the interface
+var _ outbox.Sink = &DispatcherImpl{}
+
+// NewDispatcherImpl is a constructor
+func NewDispatcherImpl(
+	subscriptionRepository SubscriptionRepository,
+	deliveryRepository DeliveryRepository,
+	deliveryConstructor entity.WebhookDeliveryConstructor,
+	clock domain.Clock,
+) *DispatcherImpl {
+	return &DispatcherImpl{
+		subscriptionRepository: subscriptionRepository,
+		deliveryRepository:     deliveryRepository,
+		deliveryConstructor:    deliveryConstructor,
+		clock:                  clock,
+	}
+}
+
+// Publish queues a delivery of the message to each subscription for
+// its type, due now. Publishing a message again does not queue it
+// again for the same subscription.
+func (d *DispatcherImpl) Publish(ctx context.Context, msg outbox.Message) error {
+	// Find who wants it
+	subscriptions, err := d.subscriptionRepository.FindByEventType(ctx, msg.Type)
+	if err != nil {
+		return fmt.Errorf("could not dispatch outbox message - subscription repository find error: %w", err)
+	}
+
+	for _, subscription := range subscriptions {
+		// Create new entity
+		delivery, err := d.deliveryConstructor.New(subscription.ID(), msg.ID, msg.Type, msg.EntityID, msg.Data, msg.OccurredAt, d.clock.Now())
+		if err != nil {
+			return fmt.Errorf("could not dispatch outbox message - entity error: %w", err)
+		}
+
+		// Persist it
+		if _, err := d.deliveryRepository.Create(ctx, delivery); err != nil {
+			return fmt.Errorf("could not dispatch outbox message - delivery repository create error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// SubscriptionRepository handles persisting webhook subscription
+// entities and retrieving persisted entities
+type SubscriptionRepository interface {
+	Create(context.Context, entity.WebhookSubscription) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.WebhookSubscription, error)
+	FindAll(context.Context) ([]entity.WebhookSubscription, error)
+	DeleteByID(context.Context, entity.ID) error
+
+	// FindByEventType returns the subscriptions which ask for events
+	// of the given type.
+	FindByEventType(context.Context, entity.EventType) ([]entity.WebhookSubscription, error)
+}
+
+// DeliveryRepository handles persisting webhook delivery entities
+// and retrieving persisted entities
+type DeliveryRepository interface {
+	// Create persists a new delivery. An event is only delivered once
+	// to each subscription, so if there is already a delivery of the
+	// event to the subscription then its id is returned instead.
+	Create(context.Context, entity.WebhookDelivery) (entity.ID, error)
+	FindByID(context.Context, entity.ID) (entity.WebhookDelivery, error)
+	Update(context.Context, entity.WebhookDelivery) error
+
+	// FindBySubscriptionID returns the deliveries to a subscription,
+	// latest first.
+	FindBySubscriptionID(context.Context, entity.ID) ([]entity.WebhookDelivery, error)
+	// FindDead returns the deliveries which were given up on, latest
+	// first.
+	FindDead(context.Context) ([]entity.WebhookDelivery, error)
+	// FindDue returns at most limit pending deliveries which are due
+	// to be attempted at now, earliest first. Deliveries found in a
+	// transaction are locked until it ends, and are skipped by other
+	// transactions.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]entity.WebhookDelivery, error)
+}
@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Sender posts the events of deliveries to subscribers.
+// Implementations can be found in the adapter layer.
+type Sender interface {
+	// Send posts the event of the delivery to the subscription, and
+	// returns the status code of the response (or 0 if there was no
+	// response). An error is returned if the event was not accepted.
+	Send(context.Context, entity.WebhookSubscription, entity.WebhookDelivery) (int, error)
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// Service performs operations on webhook subscriptions and
+// their deliveries.
+type Service interface {
+	Create(context.Context, *CreateSubscriptionVO) (entity.ID, error)
+	ReadDetails(context.Context, entity.ID) (*SubscriptionViewVO, error)
+	ReadAll(context.Context) ([]SubscriptionViewVO, error)
+	Delete(context.Context, entity.ID) error
+	ReadDeliveries(context.Context, entity.ID) ([]DeliveryViewVO, error)
+	ReadDeadLetters(context.Context) ([]DeliveryViewVO, error)
+	Redeliver(context.Context, entity.ID) error
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	subscriptionRepository  SubscriptionRepository
+	deliveryRepository      DeliveryRepository
+	subscriptionConstructor entity.WebhookSubscriptionConstructor
+	voFactory               VOFactory
+	clock                   domain.Clock
+}
+
+// Make sure ServiceImpl implements Service!
+var _ Service = &ServiceImpl{}
+
+// NewServiceImpl is a constructor
+func NewServiceImpl(
+	subscriptionRepository SubscriptionRepository,
+	deliveryRepository DeliveryRepository,
+	subscriptionConstructor entity.WebhookSubscriptionConstructor,
+	voFactory VOFactory,
+	clock domain.Clock) *ServiceImpl {
+	return &ServiceImpl{
+		subscriptionRepository:  subscriptionRepository,
+		deliveryRepository:      deliveryRepository,
+		subscriptionConstructor: subscriptionConstructor,
+		voFactory:               voFactory,
+		clock:                   clock,
+	}
+}
+
+// Create subscribes a URL to events of the given types.
+func (s *ServiceImpl) Create(ctx context.Context, vo *CreateSubscriptionVO) (entity.ID, error) {
+	// Create new entity
+	e, err := s.subscriptionConstructor.New(vo.URL, vo.EventTypes, vo.Secret, s.clock.Now())
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create webhook subscription - entity error: %w", err)
+	}
+
+	// Persist it
+	id, err := s.subscriptionRepository.Create(ctx, e)
+	if err != nil {
+		return entity.InvalidID, fmt.Errorf("could not create webhook subscription - repository create error: %w", err)
+	}
+
+	return id, nil
+}
+
+// ReadDetails retrieves a subscription and returns a view of it.
+func (s *ServiceImpl) ReadDetails(ctx context.Context, id entity.ID) (*SubscriptionViewVO, error) {
+	// Retrieve entity
+	found, err := s.subscriptionRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read webhook subscription - repository find error: %w", err)
+	}
+
+	// Create VO
+	vo := s.voFactory.CreateSubscriptionViewVOFromEntity(found)
+
+	return vo, nil
+}
+
+// ReadAll retrieves every subscription and returns views of them.
+func (s *ServiceImpl) ReadAll(ctx context.Context) ([]SubscriptionViewVO, error) {
+	// Retrieve entities
+	found, err := s.subscriptionRepository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read webhook subscriptions - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateSubscriptionViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// Delete unsubscribes, and forgets the deliveries made to the
+// subscription.
+func (s *ServiceImpl) Delete(ctx context.Context, id entity.ID) error {
+	if err := s.subscriptionRepository.DeleteByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete webhook subscription - repository delete error: %w", err)
+	}
+	return nil
+}
+
+// ReadDeliveries retrieves the deliveries to a subscription, latest
+// first, and returns views of them.
+func (s *ServiceImpl) ReadDeliveries(ctx context.Context, subscriptionID entity.ID) ([]DeliveryViewVO, error) {
+	// Check the subscription exists
+	if _, err := s.subscriptionRepository.FindByID(ctx, subscriptionID); err != nil {
+		return nil, fmt.Errorf("could not read webhook deliveries - subscription repository find error: %w", err)
+	}
+
+	// Retrieve entities
+	found, err := s.deliveryRepository.FindBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read webhook deliveries - delivery repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateDeliveryViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// ReadDeadLetters retrieves the deliveries which were given up on,
+// latest first, and returns views of them.
+func (s *ServiceImpl) ReadDeadLetters(ctx context.Context) ([]DeliveryViewVO, error) {
+	// Retrieve entities
+	found, err := s.deliveryRepository.FindDead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read webhook dead letters - repository find error: %w", err)
+	}
+
+	// Create VO
+	vos := s.voFactory.CreateDeliveryViewVOsFromEntities(found)
+
+	return vos, nil
+}
+
+// Redeliver makes a dead delivery pending again, so that it is
+// attempted on the next poll.
+func (s *ServiceImpl) Redeliver(ctx context.Context, id entity.ID) error {
+	// Retrieve entity
+	found, err := s.deliveryRepository.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not redeliver webhook delivery - repository find error: %w", err)
+	}
+
+	// Revive it
+	if err := found.Redeliver(s.clock.Now()); err != nil {
+		return fmt.Errorf("could not redeliver webhook delivery - entity error: %w", err)
+	}
+
+	// Persist it
+	if err := s.deliveryRepository.Update(ctx, found); err != nil {
+		return fmt.Errorf("could not redeliver webhook delivery - repository update error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// VOFactory is used to create webhook VOs
+type VOFactory interface {
+	CreateSubscriptionViewVOFromEntity(entity.WebhookSubscription) *SubscriptionViewVO
+	CreateSubscriptionViewVOsFromEntities([]entity.WebhookSubscription) []SubscriptionViewVO
+	CreateDeliveryViewVOsFromEntities([]entity.WebhookDelivery) []DeliveryViewVO
+}
+
+// VOFactoryImpl implements VOFactory
+type VOFactoryImpl struct{}
+
+// Check we implement the interface
+var _ VOFactory = &VOFactoryImpl{}
+
+// NewVOFactoryImpl is a constructor
+func NewVOFactoryImpl() *VOFactoryImpl {
+	return &VOFactoryImpl{}
+}
+
+// CreateSubscriptionViewVOFromEntity maps an entity to a view vo.
+func (v *VOFactoryImpl) CreateSubscriptionViewVOFromEntity(e entity.WebhookSubscription) *SubscriptionViewVO {
+	return &SubscriptionViewVO{
+		ID:         e.ID(),
+		URL:        e.URL(),
+		EventTypes: e.EventTypes(),
+		CreatedAt:  e.CreatedAt(),
+	}
+}
+
+// CreateSubscriptionViewVOsFromEntities maps entities to view vos.
+func (v *VOFactoryImpl) CreateSubscriptionViewVOsFromEntities(entities []entity.WebhookSubscription) []SubscriptionViewVO {
+	var results []SubscriptionViewVO
+	for _, e := range entities {
+		view := v.CreateSubscriptionViewVOFromEntity(e)
+		results = append(results, *view)
+	}
+	return results
+}
+
+// CreateDeliveryViewVOsFromEntities maps entities to view vos. The next
+// attempt is only given for pending deliveries.
+func (v *VOFactoryImpl) CreateDeliveryViewVOsFromEntities(entities []entity.WebhookDelivery) []DeliveryViewVO {
+	var results []DeliveryViewVO
+	for _, e := range entities {
+		var nextAttemptAt *time.Time
+		if e.Status() == entity.DeliveryPending {
+			next := e.NextAttemptAt()
+			nextAttemptAt = &next
+		}
+		results = append(results, DeliveryViewVO{
+			ID:             e.ID(),
+			SubscriptionID: e.SubscriptionID(),
+			EventID:        e.EventID(),
+			EventType:      e.EventType(),
+			EntityID:       e.EntityID(),
+			Status:         e.Status(),
+			Attempts:       e.Attempts(),
+			NextAttemptAt:  nextAttemptAt,
+			LastAttemptAt:  e.LastAttemptAt(),
+			LastStatusCode: e.LastStatusCode(),
+			LastError:      e.LastError(),
+		})
+	}
+	return results
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// CreateSubscriptionVO defines data needed to subscribe a URL
+// to events.
+type CreateSubscriptionVO struct {
+	URL        string
+	EventTypes []entity.EventType
+	Secret     string
+}
+
+// SubscriptionViewVO describes a subscription. The secret is left
+// out, so that only the subscriber knows it.
+type SubscriptionViewVO struct {
+	ID         entity.ID
+	URL        string
+	EventTypes []entity.EventType
+	CreatedAt  time.Time
+}
+
+// DeliveryViewVO describes a delivery of an event to a subscription,
+// and the outcome of its latest attempt.
+type DeliveryViewVO struct {
+	ID             entity.ID
+	SubscriptionID entity.ID
+	EventID        entity.ID
+	EventType      entity.EventType
+	EntityID       entity.ID
+	Status         entity.DeliveryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastAttemptAt  *time.Time
+	LastStatusCode int
+	LastError      string
+}
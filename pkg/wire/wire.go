@@ -1,54 +1,226 @@
 package wire
 
 import (
+	"context"
+	"fmt"
+	netHttp "net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
 	goConfig "github.com/liampulles/go-config"
+	goGrpc "google.golang.org/grpc"
 
+	"github.com/liampulles/matchstick-video/pkg/adapter/client"
 	"github.com/liampulles/matchstick-video/pkg/adapter/config"
 	adapterDb "github.com/liampulles/matchstick-video/pkg/adapter/db"
 	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+	"github.com/liampulles/matchstick-video/pkg/adapter/sink"
 	"github.com/liampulles/matchstick-video/pkg/domain"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/cli"
 	"github.com/liampulles/matchstick-video/pkg/driver/db"
+	driverGrpc "github.com/liampulles/matchstick-video/pkg/driver/grpc"
 	"github.com/liampulles/matchstick-video/pkg/driver/http/mux"
+	driverOutbox "github.com/liampulles/matchstick-video/pkg/driver/outbox"
+	"github.com/liampulles/matchstick-video/pkg/driver/ratelimit"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 // CreateApp creates a runnable for the entrypoint of the
-// application
-func CreateApp(source goConfig.Source) domain.Runnable {
-	factory, err := CreateServerFactory(source)
+// application, and a Shutdown to call once it has finished. args are
+// the command-line arguments (excluding the program name), which
+// select a command and may set config.
+func CreateApp(args []string, env goConfig.Source) (domain.Runnable, domain.Shutdown) {
+	source, command, err := config.NewCommandLineSource(args, env)
 	if err != nil {
+		return failed(err), domain.NothingToShutdown
+	}
+
+	name := strings.Join(command, " ")
+	switch {
+	case name == "" || name == "serve":
+		factory, shutdown, err := CreateServerFactory(source)
+		if err != nil {
+			return failed(err), domain.NothingToShutdown
+		}
+		return untilInterrupted(factory.Create()), shutdown
+
+	case name == "config print":
+		printer, err := CreateConfigPrinter(source)
+		if err != nil {
+			return failed(err), domain.NothingToShutdown
+		}
+		return printer.Print, domain.NothingToShutdown
+
+	case command[0] == "migrate":
+		migrateCommand, shutdown, err := CreateMigrateCommand(source)
+		if err != nil {
+			return failed(err), domain.NothingToShutdown
+		}
 		return func() error {
-			return err
+			return migrateCommand.Run(command[1:])
+		}, shutdown
+
+	case command[0] == "inventory":
+		inventoryCommand, err := CreateInventoryCommand(source)
+		if err != nil {
+			return failed(err), domain.NothingToShutdown
 		}
+		return func() error {
+			return inventoryCommand.Run(command[1:])
+		}, domain.NothingToShutdown
+
+	default:
+		return failed(fmt.Errorf("unknown command: %s", name)), domain.NothingToShutdown
 	}
-	return factory.Create()
 }
 
-// CreateServerFactory injects all the dependencies needed to create
-// http.ServerFactory
-func CreateServerFactory(source goConfig.Source) (http.ServerFactory, error) {
-	// Each "tap" below indicates a level of dependency
+// CreateConfigPrinter injects all the dependencies needed to create
+// cli.ConfigPrinter
+func CreateConfigPrinter(source goConfig.Source) (cli.ConfigPrinter, error) {
 	configStore, err := config.NewStoreImpl(
 		source,
 	)
 	if err != nil {
 		return nil, err
 	}
-	errorParser := adapterDb.NewErrorParserImpl()
 
 	// --- NEXT TAP ---
-	helperService := sql.NewHelperServiceImpl(errorParser)
-	databaseService, err := db.NewDatabaseServiceImpl(
+	return cli.NewConfigPrinterImpl(
+		configStore.Settings(),
+		os.Stdout,
+	), nil
+}
+
+// CreateMigrateCommand injects all the dependencies needed to create
+// cli.MigrateCommand, along with a Shutdown for once it has run.
+func CreateMigrateCommand(source goConfig.Source) (cli.MigrateCommand, domain.Shutdown, error) {
+	configStore, err := config.NewStoreImpl(
+		source,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// --- NEXT TAP ---
+	migrator, err := db.NewMigratorImpl(
 		configStore,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shutdown := func(context.Context) error {
+		return migrator.Close()
+	}
+
+	// --- NEXT TAP ---
+	return cli.NewMigrateCommandImpl(
+		migrator,
+		os.Stdout,
+	), shutdown, nil
+}
+
+// CreateInventoryCommand injects all the dependencies needed to create
+// cli.InventoryCommand. If a server URL is configured, the command
+// calls that server - otherwise it uses the DB directly.
+func CreateInventoryCommand(source goConfig.Source) (cli.InventoryCommand, error) {
+	configStore, err := config.NewStoreImpl(
+		source,
+	)
 	if err != nil {
 		return nil, err
 	}
+	decoderService := json.NewDecoderServiceImpl()
+	encoderService := json.NewEncoderServiceImpl()
+
+	// --- NEXT TAP ---
+	var inventoryService inventory.Service
+	if configStore.GetCliServerURL() != "" {
+		inventoryService = client.NewInventoryServiceImpl(
+			configStore.GetCliServerURL(),
+			&netHttp.Client{Timeout: configStore.GetRequestTimeout()},
+			encoderService,
+			decoderService,
+		)
+	} else {
+		// Leave migrating to the server and the migrate command
+		databaseService, err := db.NewDatabaseServiceImpl(
+			configStore,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		inventoryService, err = newInventoryService(
+			configStore,
+			databaseService,
+			sql.NewHelperServiceImpl(adapterDb.NewErrorParserImpl()),
+			domain.NewClockImpl(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// --- NEXT TAP ---
+	return cli.NewInventoryCommandImpl(
+		inventoryService,
+		encoderService,
+		os.Stdout,
+		configStore.GetCliOutput(),
+	), nil
+}
+
+// newInventoryService injects all the dependencies needed to create
+// inventory.Service on top of the given DB, so that the server and the
+// CLI handle inventory in the same way.
+func newInventoryService(
+	configStore config.Store,
+	databaseService sql.DatabaseService,
+	helperService sql.HelperService,
+	clock domain.Clock,
+) (inventory.Service, error) {
+
 	inventoryItemConstructor := entity.NewInventoryItemConstructorImpl()
-	muxWrapper := mux.NewWrapperImpl()
+	titleConstructor := entity.NewTitleConstructorImpl()
+	mediaFormatConstructor := entity.NewMediaFormatConstructorImpl()
+	accountConstructor := entity.NewAccountConstructorImpl()
+	rentalConstructor := entity.NewRentalConstructorImpl()
+	holdConstructor := entity.NewHoldConstructorImpl()
+	ledgerEntryConstructor := entity.NewLedgerEntryConstructorImpl()
+	lateFeePolicy := domain.NewLateFeePolicyImpl(
+		configStore.GetLateFeeRule(),
+		configStore.GetLateFeeFormatRules(),
+	)
+	ratingScheme := domain.NewRatingSchemeImpl(
+		domain.RatingSchemes[configStore.GetRatingScheme()],
+		configStore.GetRatingMinimumAges(),
+	)
+	locationFormat, err := domain.NewLocationFormatImpl(
+		configStore.GetLocationFormat(),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	// --- NEXT TAP ---
 	inventoryRepository := sql.NewInventoryRepositoryImpl(
@@ -56,47 +228,668 @@ func CreateServerFactory(source goConfig.Source) (http.ServerFactory, error) {
 		helperService,
 		inventoryItemConstructor,
 	)
+	titleRepository := sql.NewTitleRepositoryImpl(
+		databaseService,
+		helperService,
+		titleConstructor,
+	)
+	mediaFormatRepository := sql.NewMediaFormatRepositoryImpl(
+		databaseService,
+		helperService,
+		mediaFormatConstructor,
+	)
+	accountRepository := sql.NewAccountRepositoryImpl(
+		databaseService,
+		helperService,
+		accountConstructor,
+	)
+	rentalRepository := sql.NewRentalRepositoryImpl(
+		databaseService,
+		helperService,
+		rentalConstructor,
+	)
+	holdRepository := sql.NewHoldRepositoryImpl(
+		databaseService,
+		helperService,
+		holdConstructor,
+	)
+	ledgerRepository := sql.NewLedgerRepositoryImpl(
+		databaseService,
+		helperService,
+		ledgerEntryConstructor,
+	)
+	locationRepository := sql.NewLocationRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	locationMoveRepository := sql.NewLocationMoveRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	outboxRepository := sql.NewOutboxRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	transactor := sql.NewTransactorImpl(
+		databaseService,
+	)
 	entityFactory := inventory.NewEntityFactoryImpl(
 		inventoryItemConstructor,
+		locationFormat,
 	)
-	entityModifier := inventory.NewEntityModifierImpl()
-	voFactory := inventory.NewVOFactoryImpl()
-	ioMapper := mux.NewIOMapperImpl(
-		muxWrapper,
+	entityModifier := inventory.NewEntityModifierImpl(
+		locationFormat,
 	)
+	voFactory := inventory.NewVOFactoryImpl(
+		locationFormat,
+	)
+	rentalVOFactory := rental.NewVOFactoryImpl()
 
 	// --- NEXT TAP ---
-	inventoryService := inventory.NewServiceImpl(
+	holdQueue := hold.NewQueueImpl(
+		holdRepository,
+		clock,
+		configStore.GetHoldExpiry(),
+	)
+	outboxWriter := outbox.NewWriterImpl(
+		outboxRepository,
+		clock,
+	)
+
+	// --- NEXT TAP ---
+	return inventory.NewServiceImpl(
 		inventoryRepository,
+		rentalRepository,
+		mediaFormatRepository,
+		titleRepository,
+		accountRepository,
+		ledgerRepository,
+		holdRepository,
+		locationRepository,
+		locationMoveRepository,
 		entityFactory,
 		entityModifier,
 		voFactory,
+		rentalVOFactory,
+		rentalConstructor,
+		ledgerEntryConstructor,
+		lateFeePolicy,
+		ratingScheme,
+		holdQueue,
+		outboxWriter,
+		transactor,
+		configStore.GetRenewalLimit(),
+		configStore.GetCreditLimit(),
+		clock,
+	), nil
+}
+
+// CreateServerFactory injects all the dependencies needed to create
+// http.ServerFactory, along with a Shutdown for once its server has
+// finished.
+func CreateServerFactory(source goConfig.Source) (http.ServerFactory, domain.Shutdown, error) {
+	// Each "tap" below indicates a level of dependency
+	configStore, err := config.NewStoreImpl(
+		source,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	errorParser := adapterDb.NewErrorParserImpl()
+
+	// --- NEXT TAP ---
+	tracerService, err := tracing.NewTracerServiceImpl(
+		configStore,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	helperService := tracing.NewHelperServiceImpl(
+		sql.NewHelperServiceImpl(errorParser),
+		tracerService,
+	)
+	databaseService, err := db.NewDatabaseServiceImpl(
+		configStore,
+		configStore.GetAutoMigrate(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	inventoryItemConstructor := entity.NewInventoryItemConstructorImpl()
+	titleConstructor := entity.NewTitleConstructorImpl()
+	mediaFormatConstructor := entity.NewMediaFormatConstructorImpl()
+	accountConstructor := entity.NewAccountConstructorImpl()
+	rentalConstructor := entity.NewRentalConstructorImpl()
+	holdConstructor := entity.NewHoldConstructorImpl()
+	ledgerEntryConstructor := entity.NewLedgerEntryConstructorImpl()
+	webhookSubscriptionConstructor := entity.NewWebhookSubscriptionConstructorImpl()
+	webhookDeliveryConstructor := entity.NewWebhookDeliveryConstructorImpl()
+	clock := domain.NewClockImpl()
+	ratingScheme := domain.NewRatingSchemeImpl(
+		domain.RatingSchemes[configStore.GetRatingScheme()],
+		configStore.GetRatingMinimumAges(),
+	)
+	locationFormat, err := domain.NewLocationFormatImpl(
+		configStore.GetLocationFormat(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	backoffPolicy := domain.NewBackoffPolicyImpl(
+		configStore.GetWebhookBackoff(),
+		configStore.GetWebhookMaxBackoff(),
+		configStore.GetWebhookMaxAttempts(),
+	)
+	muxWrapper := mux.NewWrapperImpl()
+	webhookSender := tracing.NewWebhookSenderImpl(
+		sink.NewWebhookSenderImpl(
+			&netHttp.Client{Timeout: configStore.GetWebhookTimeout()},
+		),
+		tracerService,
+	)
+
+	// --- NEXT TAP ---
+	inventoryRepository := sql.NewInventoryRepositoryImpl(
+		databaseService,
+		helperService,
+		inventoryItemConstructor,
+	)
+	titleRepository := sql.NewTitleRepositoryImpl(
+		databaseService,
+		helperService,
+		titleConstructor,
+	)
+	mediaFormatRepository := sql.NewMediaFormatRepositoryImpl(
+		databaseService,
+		helperService,
+		mediaFormatConstructor,
+	)
+	accountRepository := sql.NewAccountRepositoryImpl(
+		databaseService,
+		helperService,
+		accountConstructor,
+	)
+	rentalRepository := sql.NewRentalRepositoryImpl(
+		databaseService,
+		helperService,
+		rentalConstructor,
+	)
+	holdRepository := sql.NewHoldRepositoryImpl(
+		databaseService,
+		helperService,
+		holdConstructor,
+	)
+	ledgerRepository := sql.NewLedgerRepositoryImpl(
+		databaseService,
+		helperService,
+		ledgerEntryConstructor,
+	)
+	locationRepository := sql.NewLocationRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	outboxRepository := sql.NewOutboxRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	webhookSubscriptionRepository := sql.NewWebhookSubscriptionRepositoryImpl(
+		databaseService,
+		helperService,
+		webhookSubscriptionConstructor,
+	)
+	webhookDeliveryRepository := sql.NewWebhookDeliveryRepositoryImpl(
+		databaseService,
+		helperService,
+		webhookDeliveryConstructor,
+	)
+	idempotencyRepository := sql.NewIdempotencyRepositoryImpl(
+		databaseService,
+		helperService,
+	)
+	transactor := sql.NewTransactorImpl(
+		databaseService,
+	)
+	titleEntityFactory := title.NewEntityFactoryImpl(
+		titleConstructor,
+	)
+	titleEntityModifier := title.NewEntityModifierImpl()
+	titleVOFactory := title.NewVOFactoryImpl()
+	mediaFormatEntityModifier := mediaformat.NewEntityModifierImpl()
+	mediaFormatVOFactory := mediaformat.NewVOFactoryImpl()
+	accountEntityFactory := account.NewEntityFactoryImpl(
+		accountConstructor,
+	)
+	accountEntityModifier := account.NewEntityModifierImpl()
+	accountVOFactory := account.NewVOFactoryImpl()
+	rentalVOFactory := rental.NewVOFactoryImpl()
+	holdVOFactory := hold.NewVOFactoryImpl()
+	ledgerVOFactory := ledger.NewVOFactoryImpl()
+	webhookVOFactory := webhook.NewVOFactoryImpl()
+	locationVOFactory := location.NewVOFactoryImpl(
+		locationFormat,
+	)
+	ioMapper := mux.NewIOMapperImpl(
+		muxWrapper,
+	)
+	tracingMiddleware := tracing.NewHTTPMiddlewareImpl(
+		tracerService,
+		muxWrapper,
+	)
+	cors := mux.NewCORSImpl(
+		configStore,
+	)
+	clientIdentifier := http.NewClientIdentifierImpl(
+		configStore,
+	)
+	rateLimitMiddleware := ratelimit.NewHTTPMiddlewareImpl(
+		configStore,
+		muxWrapper,
+		clientIdentifier,
+		ratelimit.NewMemoryBucketStoreImpl(clock),
+	)
+
+	// --- NEXT TAP ---
+	holdQueue := hold.NewQueueImpl(
+		holdRepository,
+		clock,
+		configStore.GetHoldExpiry(),
+	)
+	outboxWriter := outbox.NewWriterImpl(
+		outboxRepository,
+		clock,
+	)
+	outboxFeed := outbox.NewFeedImpl(
+		outboxRepository,
+		clock,
+		configStore.GetEventStreamPollInterval(),
+		configStore.GetEventStreamGapTimeout(),
+		configStore.GetOutboxBatchSize(),
+	)
+	webhookDispatcher := webhook.NewDispatcherImpl(
+		webhookSubscriptionRepository,
+		webhookDeliveryRepository,
+		webhookDeliveryConstructor,
+		clock,
+	)
+	outboxSinks, err := createOutboxSinks(
+		configStore.GetOutboxSinks(),
+		webhookDispatcher,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	outboxRelay := tracing.NewOutboxRelayImpl(
+		outbox.NewRelayImpl(
+			outboxRepository,
+			outboxSinks,
+			transactor,
+			clock,
+			configStore.GetOutboxBatchSize(),
+		),
+		tracerService,
+	)
+	webhookDeliverer := tracing.NewWebhookDelivererImpl(
+		webhook.NewDelivererImpl(
+			webhookSubscriptionRepository,
+			webhookDeliveryRepository,
+			webhookSender,
+			transactor,
+			backoffPolicy,
+			clock,
+			configStore.GetOutboxBatchSize(),
+		),
+		tracerService,
+	)
+
+	// --- NEXT TAP ---
+	delegateInventoryService, err := newInventoryService(
+		configStore,
+		databaseService,
+		helperService,
+		clock,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	inventoryService := tracing.NewInventoryServiceImpl(
+		delegateInventoryService,
+		tracerService,
+	)
+	titleService := tracing.NewTitleServiceImpl(
+		title.NewServiceImpl(
+			titleRepository,
+			titleEntityFactory,
+			titleEntityModifier,
+			titleVOFactory,
+			ratingScheme,
+		),
+		tracerService,
+	)
+	mediaFormatService := tracing.NewMediaFormatServiceImpl(
+		mediaformat.NewServiceImpl(
+			mediaFormatRepository,
+			mediaFormatEntityModifier,
+			mediaFormatVOFactory,
+		),
+		tracerService,
+	)
+	accountService := tracing.NewAccountServiceImpl(
+		account.NewServiceImpl(
+			accountRepository,
+			rentalRepository,
+			accountEntityFactory,
+			accountEntityModifier,
+			accountVOFactory,
+			rentalVOFactory,
+			clock,
+		),
+		tracerService,
+	)
+	rentalService := tracing.NewRentalServiceImpl(
+		rental.NewServiceImpl(
+			rentalRepository,
+			rentalVOFactory,
+			clock,
+		),
+		tracerService,
+	)
+	holdService := tracing.NewHoldServiceImpl(
+		hold.NewServiceImpl(
+			holdRepository,
+			titleRepository,
+			inventoryRepository,
+			holdConstructor,
+			holdQueue,
+			holdVOFactory,
+			outboxWriter,
+			transactor,
+			clock,
+		),
+		tracerService,
+	)
+	ledgerService := tracing.NewLedgerServiceImpl(
+		ledger.NewServiceImpl(
+			ledgerRepository,
+			accountRepository,
+			ledgerEntryConstructor,
+			ledgerVOFactory,
+			clock,
+		),
+		tracerService,
+	)
+	locationService := tracing.NewLocationServiceImpl(
+		location.NewServiceImpl(
+			locationRepository,
+			locationFormat,
+			locationVOFactory,
+		),
+		tracerService,
+	)
+	webhookService := tracing.NewWebhookServiceImpl(
+		webhook.NewServiceImpl(
+			webhookSubscriptionRepository,
+			webhookDeliveryRepository,
+			webhookSubscriptionConstructor,
+			webhookVOFactory,
+			clock,
+		),
+		tracerService,
+	)
+	idempotencyService := tracing.NewIdempotencyServiceImpl(
+		idempotency.NewServiceImpl(
+			idempotencyRepository,
+			clock,
+			configStore.GetIdempotencyKeyExpiry(),
+			longestRequestTimeout(configStore),
+		),
+		tracerService,
 	)
 	decoderService := json.NewDecoderServiceImpl()
 	encoderService := json.NewEncoderServiceImpl()
+	labelService := label.NewEncoderServiceImpl()
 	responseFactory := http.NewResponseFactoryImpl()
 	parameterConverter := http.NewParameterConverterImpl()
+	idempotencyWrapper := http.NewIdempotencyWrapperImpl(
+		idempotencyService,
+		clientIdentifier,
+		responseFactory,
+	)
 	handlerMapper := mux.NewHandlerMapperImpl(
 		ioMapper,
 	)
+	grpcErrorMapper := driverGrpc.NewErrorMapperImpl()
+	graphqlService, err := graphql.NewServiceImpl(
+		titleService,
+		inventoryService,
+		accountService,
+		configStore.GetGraphQLMaxDepth(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// --- NEXT TAP ---
 	inventoryController := http.NewInventoryControllerImpl(
 		inventoryService,
 		decoderService,
 		encoderService,
+		labelService,
+		responseFactory,
+		parameterConverter,
+	)
+	inventoryEventController := http.NewInventoryEventControllerImpl(
+		outboxFeed,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	titleController := http.NewTitleControllerImpl(
+		titleService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	mediaFormatController := http.NewMediaFormatControllerImpl(
+		mediaFormatService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	accountController := http.NewAccountControllerImpl(
+		accountService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	rentalController := http.NewRentalControllerImpl(
+		rentalService,
+		encoderService,
+		responseFactory,
+	)
+	holdController := http.NewHoldControllerImpl(
+		holdService,
+		inventoryService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	ledgerController := http.NewLedgerControllerImpl(
+		ledgerService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	locationController := http.NewLocationControllerImpl(
+		locationService,
+		inventoryService,
+		decoderService,
+		encoderService,
+		responseFactory,
+		parameterConverter,
+	)
+	webhookController := http.NewWebhookControllerImpl(
+		webhookService,
+		decoderService,
+		encoderService,
 		responseFactory,
 		parameterConverter,
 	)
+	graphqlController := http.NewGraphQLControllerImpl(
+		graphqlService,
+		decoderService,
+		responseFactory,
+	)
+	grpcServer := driverGrpc.NewServerImpl(
+		configStore,
+		driverGrpc.NewInventoryServerImpl(
+			inventoryService,
+			grpcErrorMapper,
+		),
+		[]goGrpc.UnaryServerInterceptor{
+			tracing.UnaryServerInterceptor(tracerService),
+		},
+		[]goGrpc.StreamServerInterceptor{
+			tracing.StreamServerInterceptor(tracerService),
+		},
+	)
+	workers := []domain.Runnable{
+		grpcServer.Run,
+	}
+	pollerCtx, stopPollers := context.WithCancel(context.Background())
+	if len(outboxSinks) > 0 {
+		poller := driverOutbox.NewPollerImpl(
+			pollerCtx,
+			outboxRelay.RelayPending,
+			configStore.GetOutboxPollInterval(),
+		)
+		workers = append(workers, poller.Run)
+	}
+	if hasOutboxSink(configStore.GetOutboxSinks(), "webhook") {
+		poller := driverOutbox.NewPollerImpl(
+			pollerCtx,
+			webhookDeliverer.DeliverDue,
+			configStore.GetOutboxPollInterval(),
+		)
+		workers = append(workers, poller.Run)
+	}
 	serverConfiguration := mux.NewServerConfigurationImpl(
 		configStore,
 		handlerMapper,
 		muxWrapper,
+		cors,
+		[]mux.Middleware{
+			tracingMiddleware.Wrap,
+			cors.Wrap,
+			rateLimitMiddleware.Wrap,
+		},
 	)
 
 	// --- NEXT TAP ---
 	return http.NewServerFactoryImpl(
-		inventoryController,
+		[]http.Controller{
+			inventoryController,
+			inventoryEventController,
+			titleController,
+			mediaFormatController,
+			accountController,
+			rentalController,
+			holdController,
+			ledgerController,
+			locationController,
+			webhookController,
+			graphqlController,
+		},
+		idempotencyWrapper,
 		serverConfiguration,
-	), nil
+		workers,
+	), shutdownServer(stopPollers, grpcServer, tracerService), nil
+}
+
+// shutdownServer returns a Shutdown which stops the workers, and then
+// flushes any spans they leave behind.
+func shutdownServer(
+	stopPollers context.CancelFunc,
+	grpcServer driverGrpc.Server,
+	tracerService tracing.TracerService,
+) domain.Shutdown {
+
+	return func(ctx context.Context) error {
+		stopPollers()
+		grpcErr := grpcServer.Stop(ctx)
+		if err := tracerService.Shutdown(ctx); err != nil {
+			return err
+		}
+		return grpcErr
+	}
+}
+
+// createOutboxSinks creates the named sinks to publish domain
+// events to.
+func createOutboxSinks(names []string, webhookDispatcher outbox.Sink) ([]outbox.Sink, error) {
+	var sinks []outbox.Sink
+	for _, name := range names {
+		switch name {
+		case "stdout":
+			sinks = append(sinks, sink.NewWriterSinkImpl(os.Stdout))
+		case "webhook":
+			sinks = append(sinks, webhookDispatcher)
+		default:
+			return nil, fmt.Errorf("unknown outbox sink: %s", name)
+		}
+	}
+	return sinks, nil
+}
+
+// hasOutboxSink returns true if name is one of the named sinks.
+func hasOutboxSink(names []string, name string) bool {
+	for _, candidate := range names {
+		if candidate == name {
+			return true
+		}
+	}
+	return false
+}
+
+// longestRequestTimeout returns the longest any request may run for,
+// including those with route specific timeouts.
+func longestRequestTimeout(configStore config.Store) time.Duration {
+	longest := configStore.GetRequestTimeout()
+	for _, timeout := range configStore.GetRouteTimeouts() {
+		if timeout > longest {
+			longest = timeout
+		}
+	}
+	return longest
+}
+
+// untilInterrupted returns a Runnable which runs main until it returns,
+// or the process is asked to stop.
+func untilInterrupted(main domain.Runnable) domain.Runnable {
+	return func() error {
+		interrupted := make(chan os.Signal, 1)
+		signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(interrupted)
+
+		finished := make(chan error, 1)
+		go func() {
+			finished <- main()
+		}()
+		select {
+		case err := <-finished:
+			return err
+		case <-interrupted:
+			return nil
+		}
+	}
+}
+
+func failed(err error) domain.Runnable {
+	return func() error {
+		return err
+	}
 }
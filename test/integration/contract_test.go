@@ -3,19 +3,33 @@
 package integration
 
 import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc/inventorypb"
 )
 
 const baseURL = "http://localhost:9010"
+const grpcAddress = "localhost:9011"
 
 func TestMain(m *testing.M) {
 	cmd := setup()
@@ -27,11 +41,78 @@ func TestMain(m *testing.M) {
 	os.Exit(result)
 }
 
+func TestTitleLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T) {
+	// Test read on a non-existant title
+	resp := get(t, "/titles/999")
+	assertNotFound(t, resp)
+	body := extractString(t, resp)
+	expected := fmt.Sprintf(`could not read title - repository find error: cannot execute query - db scan error: entity not found: type=[title]`)
+	assert.Equal(t, expected, body)
+
+	// Test create with invalid year
+	resp = postJSON(t, "/titles", `{
+		"title": "Hackers",
+		"year": 1066
+	}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create title - factory error: validation error: field=[year], problem=[must be between 1888 and 9999]`)
+	assert.Equal(t, expected, body)
+
+	// Test create
+	resp = postJSON(t, "/titles", `{
+		"title": "Hackers",
+		"year": 1995,
+		"runtime": 105,
+		"genres": ["Crime", "Thriller"],
+		"cast": ["Jonny Lee Miller", "Angelina Jolie"],
+		"rating": "PG-13"
+	}`)
+	assertCreated(t, resp)
+
+	// Test read
+	id := extractString(t, resp)
+	resp = get(t, "/titles/"+id)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`{"id":%s,"title":"Hackers","year":1995,"runtime":105,"synopsis":"","genres":["Crime","Thriller"],"cast":["Jonny Lee Miller","Angelina Jolie"],"rating":"PG-13","copies":0,"availableCopies":0}`, id)
+	assert.Equal(t, expected, body)
+
+	// Test update
+	resp = putJSON(t, "/titles/"+id, `{
+		"title": "Hackers",
+		"year": 1995,
+		"runtime": 107,
+		"synopsis": "Hack the planet!",
+		"genres": ["Crime"],
+		"cast": [],
+		"rating": "PG-13"
+	}`)
+	assertNoContent(t, resp)
+
+	// Test read... for update
+	resp = get(t, "/titles/"+id)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`{"id":%s,"title":"Hackers","year":1995,"runtime":107,"synopsis":"Hack the planet!","genres":["Crime"],"cast":[],"rating":"PG-13","copies":0,"availableCopies":0}`, id)
+	assert.Equal(t, expected, body)
+
+	// Test delete
+	resp = delete(t, "/titles/"+id)
+	assertNoContent(t, resp)
+
+	// Test read... for delete
+	resp = get(t, "/titles/"+id)
+	assertNotFound(t, resp)
+}
+
 func TestInventoryItemLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T) {
 	// Test update on a non-existant item
 	resp := putJSON(t, "/inventory/999", `{
-		"Name": "Cool Runnings (1993) UPDATED",
-		"Location": "AD12 UPDATED"
+		"titleId": 1,
+		"format": "dvd",
+		"barcode": "MV00000999",
+		"location": "main-A-1-13"
 	}`)
 	assertNotFound(t, resp)
 	body := extractString(t, resp)
@@ -42,11 +123,11 @@ func TestInventoryItemLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T
 	resp = delete(t, "/inventory/999")
 	assertNotFound(t, resp)
 	body = extractString(t, resp)
-	expected = fmt.Sprintf(`could not delete inventory item - repository delete error: entity not found: type=[inventory item]`)
+	expected = fmt.Sprintf(`could not delete inventory item - repository find error: cannot execute query - db scan error: entity not found: type=[inventory item]`)
 	assert.Equal(t, expected, body)
 
 	// Test checkout on a non-existant item
-	resp = putJSON(t, "/inventory/999/checkout", "")
+	resp = putJSON(t, "/inventory/999/checkout", `{"accountId": 999}`)
 	assertNotFound(t, resp)
 	body = extractString(t, resp)
 	expected = fmt.Sprintf(`could not checkout inventory item - repository find error: cannot execute query - db scan error: entity not found: type=[inventory item]`)
@@ -59,11 +140,61 @@ func TestInventoryItemLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T
 	expected = fmt.Sprintf(`could not check in inventory item - repository find error: cannot execute query - db scan error: entity not found: type=[inventory item]`)
 	assert.Equal(t, expected, body)
 
-	// Test create
+	// Test create location which does not fit the format
+	resp = postJSON(t, "/locations", `{"location": "AD12"}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create location - location format error: validation error: field=[location], problem=[must look like {store}-{aisle}-{shelf}-{slot}]`)
+	assert.Equal(t, expected, body)
+
+	// Create locations to keep copies at
+	resp = postJSON(t, "/locations", `{"location": "main-A-1-12"}`)
+	assertCreated(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `{"location":"main-A-1-12","store":"main","aisle":"A","shelf":"1","slot":"12"}`, body)
+	resp = postJSON(t, "/locations", `{"location": "main-A-1-13"}`)
+	assertCreated(t, resp)
+
+	// Test create location again.. should be constraint violation
+	resp = postJSON(t, "/locations", `{"location": "main-A-1-12"}`)
+	assertBadRequest(t, resp)
+
+	// Create a title to hold copies
+	resp = postJSON(t, "/titles", `{
+		"title": "Cool Runnings",
+		"year": 1993
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+
+	// Test create at an unknown location
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000001",
+		"location": "main-A-1-99"
+	}`, titleID))
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create inventory item - validation error: field=[location], problem=[must be a known location]`)
+	assert.Equal(t, expected, body)
+
+	// Test create for a title which does not exist
 	resp = postJSON(t, "/inventory", `{
-		"Name": "Cool Runnings (1993)",
-		"Location": "AD12"
+		"titleId": 999,
+		"format": "dvd",
+		"barcode": "MV00000001",
+		"location": "main-A-1-12"
 	}`)
+	assertBadRequest(t, resp)
+
+	// Test create
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000001",
+		"location": "main-A-1-12"
+	}`, titleID))
 	assertCreated(t, resp)
 
 	// Test read
@@ -71,75 +202,227 @@ func TestInventoryItemLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T
 	resp = get(t, "/inventory/"+id)
 	assertOk(t, resp)
 	body = extractString(t, resp)
-	expected = fmt.Sprintf(`{"id":%s,"name":"Cool Runnings (1993)","location":"AD12","available":true}`, id)
+	expected = fmt.Sprintf(`{"id":%s,"titleId":%s,"format":"dvd","barcode":"MV00000001","location":"main-A-1-12","available":true,"dueAt":null,"overdue":false}`, id, titleID)
 	assert.Equal(t, expected, body)
 
-	// Test create with same name.. should be constraint violation
-	resp = postJSON(t, "/inventory", `{
-		"Name": "Cool Runnings (1993)",
-		"Location": "CD12"
-	}`)
+	// Test read by barcode
+	resp = get(t, "/inventory/by-barcode/MV00000001")
+	assertOk(t, resp)
+	assert.Equal(t, expected, extractString(t, resp))
+
+	// Test read by a barcode with a wrong check digit
+	resp = get(t, "/inventory/by-barcode/4006381333932")
 	assertBadRequest(t, resp)
 	body = extractString(t, resp)
-	expected = fmt.Sprintf(`could not create inventory item - repository create error: cannot execute query - db scan error: uniqueness constraint error: ERROR: duplicate key value violates unique constraint "inventory_item_name_key" (SQLSTATE 23505)`)
+	expected = fmt.Sprintf(`could not convert parameters to barcode - validation error: field=[barcode], problem=[must end with a valid EAN-13 check digit]`)
 	assert.Equal(t, expected, body)
 
-	// Test create with invalid name
-	resp = postJSON(t, "/inventory", `{
-		"Name": "",
-		"Location": "AD70"
-	}`)
+	// Test read by an unknown barcode
+	resp = get(t, "/inventory/by-barcode/4006381333931")
+	assertNotFound(t, resp)
+
+	// Test a second copy on the same shelf
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "vhs",
+		"barcode": "MV00000002",
+		"location": "main-A-1-12"
+	}`, titleID))
+	assertCreated(t, resp)
+	secondID := extractString(t, resp)
+
+	// Test label for thermal printers
+	resp = get(t, "/inventory/"+id+"/label?format=zpl")
+	assertOk(t, resp)
+	assert.Equal(t, "application/zpl", resp.Header.Get("Content-Type"))
+	assert.Contains(t, extractString(t, resp), "^FD>:MV00000001^FS")
+
+	// Test a sheet of labels
+	resp = get(t, "/labels?id="+id+"&id="+secondID+"&format=pdf")
+	assertOk(t, resp)
+	assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(extractString(t, resp), "%PDF-"))
+
+	// Test a sheet of labels with an unknown format
+	resp = get(t, "/labels?id="+id+"&format=gif")
 	assertBadRequest(t, resp)
 	body = extractString(t, resp)
-	expected = fmt.Sprintf(`could not create inventory item - factory error: validation error: field=[name], problem=[must not be blank]`)
+	expected = fmt.Sprintf(`could not convert parameters to label format - validation error: field=[format], problem=[must be one of png, pdf or zpl]`)
+	assert.Equal(t, expected, body)
+
+	// Test a sheet of labels for no items
+	resp = get(t, "/labels?format=pdf")
+	assertBadRequest(t, resp)
+
+	// Test create with same barcode.. should be constraint violation
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000001",
+		"location": "main-A-1-13"
+	}`, titleID))
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create inventory item - repository create error: cannot execute query - db scan error: uniqueness constraint error: ERROR: duplicate key value violates unique constraint "inventory_item_barcode_key" (SQLSTATE 23505)`)
+	assert.Equal(t, expected, body)
+
+	// Test create with invalid barcode
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"barcode": "",
+		"location": "main-A-1-70"
+	}`, titleID))
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create inventory item - factory error: validation error: field=[barcode], problem=[must not be blank]`)
+	assert.Equal(t, expected, body)
+
+	// Test create with a UPC-A barcode with a wrong check digit
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "036000291453",
+		"location": "main-A-1-12"
+	}`, titleID))
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create inventory item - factory error: validation error: field=[barcode], problem=[must end with a valid UPC-A check digit]`)
 	assert.Equal(t, expected, body)
 
 	// Test read all
 	resp = get(t, "/inventory")
 	assertOk(t, resp)
 	body = extractString(t, resp)
-	expected = fmt.Sprintf(`[{"id":%s,"name":"Cool Runnings (1993)"}]`, id)
+	expected = fmt.Sprintf(`[{"id":%s,"titleId":%s,"format":"dvd","barcode":"MV00000001"},{"id":%s,"titleId":%s,"format":"vhs","barcode":"MV00000002"}]`, id, titleID, secondID, titleID)
+	assert.Equal(t, expected, body)
+
+	// Test read all of a format
+	resp = get(t, "/inventory?format=vhs")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`[{"id":%s,"titleId":%s,"format":"vhs","barcode":"MV00000002"}]`, secondID, titleID)
 	assert.Equal(t, expected, body)
 
+	// Test read all of an unknown format
+	resp = get(t, "/inventory?format=laserdisc")
+	assertBadRequest(t, resp)
+
+	// Test format stock
+	resp = get(t, "/formats/vhs")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `{"format":"vhs","name":"VHS","rentalPriceCents":150,"rentalPeriodDays":7,"copies":1,"availableCopies":1}`, body)
+
 	// Test update
-	resp = putJSON(t, "/inventory/"+id, `{
-		"Name": "Cool Runnings (1993) UPDATED",
-		"Location": "AD12 UPDATED"
-	}`)
+	resp = putJSON(t, "/inventory/"+id, fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000001",
+		"location": "main-A-1-13"
+	}`, titleID))
 	assertNoContent(t, resp)
 
 	// Test read... for update
 	resp = get(t, "/inventory/"+id)
 	body = extractString(t, resp)
 	assertOk(t, resp)
-	expected = fmt.Sprintf(`{"id":%s,"name":"Cool Runnings (1993) UPDATED","location":"AD12 UPDATED","available":true}`, id)
+	expected = fmt.Sprintf(`{"id":%s,"titleId":%s,"format":"dvd","barcode":"MV00000001","location":"main-A-1-13","available":true,"dueAt":null,"overdue":false}`, id, titleID)
 	assert.Equal(t, expected, body)
 
+	// Test read moves... for update
+	resp = get(t, "/inventory/"+id+"/moves")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`[{"itemId":%s,"from":"main-A-1-12","to":"main-A-1-13","movedAt":"`, id))
+
+	// Test move to an unknown location
+	resp = putJSON(t, "/inventory/"+secondID+"/move", `{"location": "main-A-1-99"}`)
+	assertBadRequest(t, resp)
+
+	// Test move
+	resp = putJSON(t, "/inventory/"+secondID+"/move", `{"location": "main-A-1-13"}`)
+	assertNoContent(t, resp)
+
+	// Test read shelf
+	resp = get(t, "/stores/main/aisles/A/shelves/1")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`{"store":"main","aisle":"A","shelf":"1","slots":[{"slot":"12","location":"main-A-1-12","items":[]},`+
+		`{"slot":"13","location":"main-A-1-13","items":[{"id":%s,"titleId":%s,"format":"dvd","barcode":"MV00000001"},{"id":%s,"titleId":%s,"format":"vhs","barcode":"MV00000002"}]}]}`,
+		id, titleID, secondID, titleID)
+	assert.Equal(t, expected, body)
+
+	// Test location delete while copies are kept there.. should be constraint violation
+	resp = delete(t, "/stores/main/aisles/A/shelves/1/slots/13")
+	assertBadRequest(t, resp)
+
+	// Open an account to rent to
+	resp = postJSON(t, "/accounts", `{"name": "Derice Bannock"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+
+	// Test checkout for an account which does not exist
+	resp = putJSON(t, "/inventory/"+id+"/checkout", `{"accountId": 999}`)
+	assertBadRequest(t, resp)
+
 	// Test checkout
-	resp = putJSON(t, "/inventory/"+id+"/checkout", "")
+	resp = putJSON(t, "/inventory/"+id+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
 	assertNoContent(t, resp)
 
+	// Test checkout again.. should fail, as it is rented out
+	resp = putJSON(t, "/inventory/"+id+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertBadRequest(t, resp)
+
 	// Test read... for checkout
 	resp = get(t, "/inventory/"+id)
 	body = extractString(t, resp)
 	assertOk(t, resp)
-	expected = fmt.Sprintf(`{"id":%s,"name":"Cool Runnings (1993) UPDATED","location":"AD12 UPDATED","available":false}`, id)
-	assert.Equal(t, expected, body)
+	assert.Contains(t, body, `"location":"main-A-1-13","available":false,"dueAt":"`)
+	assert.Contains(t, body, `"overdue":false}`)
 
-	// Test check in
-	resp = putJSON(t, "/inventory/"+id+"/checkin", "")
-	assertNoContent(t, resp)
+	// Test account read... for checkout
+	resp = get(t, "/accounts/"+accountID)
+	body = extractString(t, resp)
+	assertOk(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"rentals":[{"id":`))
+	assert.Contains(t, body, fmt.Sprintf(`"itemId":%s,"accountId":%s,`, id, accountID))
+
+	// Test title stock... for checkout
+	resp = get(t, "/titles/"+titleID)
+	body = extractString(t, resp)
+	assertOk(t, resp)
+	assert.Contains(t, body, `"copies":2,"availableCopies":1`)
+
+	// Test check in by barcode
+	resp = putJSON(t, "/inventory/by-barcode/MV00000001/checkin", "")
+	body = extractString(t, resp)
+	assertOk(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"itemId":%s,"accountId":%s,`, id, accountID))
+	assert.Contains(t, body, `"daysLate":0,"lateFeeCents":0}`)
 
 	// Test read... for check in
 	resp = get(t, "/inventory/"+id)
 	body = extractString(t, resp)
 	assertOk(t, resp)
-	expected = fmt.Sprintf(`{"id":%s,"name":"Cool Runnings (1993) UPDATED","location":"AD12 UPDATED","available":true}`, id)
+	expected = fmt.Sprintf(`{"id":%s,"titleId":%s,"format":"dvd","barcode":"MV00000001","location":"main-A-1-13","available":true,"dueAt":null,"overdue":false}`, id, titleID)
 	assert.Equal(t, expected, body)
 
+	// Test account read... for check in
+	resp = get(t, "/accounts/"+accountID)
+	body = extractString(t, resp)
+	assertOk(t, resp)
+	expected = fmt.Sprintf(`{"id":%s,"name":"Derice Bannock","dateOfBirth":null,"balanceCents":0,"rentals":[],"overdue":false}`, accountID)
+	assert.Equal(t, expected, body)
+
+	// Test title delete while copies exist.. should be constraint violation
+	resp = delete(t, "/titles/"+titleID)
+	assertBadRequest(t, resp)
+
 	// Test delete
 	resp = delete(t, "/inventory/"+id)
 	assertNoContent(t, resp)
+	resp = delete(t, "/inventory/"+secondID)
+	assertNoContent(t, resp)
 
 	// Test read... for delete
 	resp = get(t, "/inventory/"+id)
@@ -147,6 +430,780 @@ func TestInventoryItemLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T
 	assertNotFound(t, resp)
 	expected = fmt.Sprintf(`could not read inventory item - repository find error: cannot execute query - db scan error: entity not found: type=[inventory item]`)
 	assert.Equal(t, expected, body)
+
+	// Test title delete, now that it has no copies
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+
+	// Test location delete, now that nothing is kept there
+	resp = delete(t, "/stores/main/aisles/A/shelves/1/slots/12")
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/main/aisles/A/shelves/1/slots/13")
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/main/aisles/A/shelves/1/slots/13")
+	assertNotFound(t, resp)
+}
+
+func TestAccountLifecycle_ShouldCreateRetrieveUpdateAndDelete(t *testing.T) {
+	// Test read on a non-existant account
+	resp := get(t, "/accounts/999")
+	assertNotFound(t, resp)
+	body := extractString(t, resp)
+	expected := fmt.Sprintf(`could not read account - repository find error: cannot execute query - db scan error: entity not found: type=[account]`)
+	assert.Equal(t, expected, body)
+
+	// Test create with a blank name
+	resp = postJSON(t, "/accounts", `{"name": ""}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not create account - factory error: validation error: field=[name], problem=[must not be blank]`)
+	assert.Equal(t, expected, body)
+
+	// Test create with a malformed date of birth
+	resp = postJSON(t, "/accounts", `{"name": "Irv Blitzer", "dateOfBirth": "06/01/1936"}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`could not unmarshal to account create account vo: validation error: field=[dateOfBirth], problem=[must be a date like 2006-01-02]`)
+	assert.Equal(t, expected, body)
+
+	// Test create
+	resp = postJSON(t, "/accounts", `{"name": "Irv Blitzer"}`)
+	assertCreated(t, resp)
+
+	// Test read
+	id := extractString(t, resp)
+	resp = get(t, "/accounts/"+id)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`{"id":%s,"name":"Irv Blitzer","dateOfBirth":null,"balanceCents":0,"rentals":[],"overdue":false}`, id)
+	assert.Equal(t, expected, body)
+
+	// Test update
+	resp = putJSON(t, "/accounts/"+id, `{"name": "Irving Blitzer", "dateOfBirth": "1936-06-01"}`)
+	assertNoContent(t, resp)
+
+	// Test read... for update
+	resp = get(t, "/accounts/"+id)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	expected = fmt.Sprintf(`{"id":%s,"name":"Irving Blitzer","dateOfBirth":"1936-06-01","balanceCents":0,"rentals":[],"overdue":false}`, id)
+	assert.Equal(t, expected, body)
+
+	// Test read all... for update
+	resp = get(t, "/accounts")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`{"id":%s,"name":"Irving Blitzer"}`, id))
+
+	// Test overdue rentals
+	resp = get(t, "/rentals/overdue")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `[]`, body)
+
+	// Test delete
+	resp = delete(t, "/accounts/"+id)
+	assertNoContent(t, resp)
+
+	// Test read... for delete
+	resp = get(t, "/accounts/"+id)
+	assertNotFound(t, resp)
+}
+
+func TestMediaFormats_ShouldListAndUpdatePricing(t *testing.T) {
+	// Test read all
+	resp := get(t, "/formats")
+	assertOk(t, resp)
+	body := extractString(t, resp)
+	assert.Contains(t, body, `{"format":"vhs","name":"VHS","rentalPriceCents":150,"rentalPeriodDays":7,`)
+	assert.Contains(t, body, `{"format":"4k","name":"4K Ultra HD","rentalPriceCents":500,"rentalPeriodDays":2,`)
+
+	// Test read of an unknown format
+	resp = get(t, "/formats/laserdisc")
+	assertBadRequest(t, resp)
+
+	// Test update with an invalid period
+	resp = putJSON(t, "/formats/bluray", `{
+		"name": "Blu-ray",
+		"rentalPriceCents": 450,
+		"rentalPeriodDays": 0
+	}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not update format - modifier error: could not modify entity with update vo - entity rental period change error: validation error: field=[rentalPeriodDays], problem=[must be between 1 and 365]`, body)
+
+	// Test update
+	resp = putJSON(t, "/formats/bluray", `{
+		"name": "Blu-ray",
+		"rentalPriceCents": 450,
+		"rentalPeriodDays": 2
+	}`)
+	assertNoContent(t, resp)
+
+	// Test read... for update
+	resp = get(t, "/formats/bluray")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, `{"format":"bluray","name":"Blu-ray","rentalPriceCents":450,"rentalPeriodDays":2,`)
+
+	// Restore the seeded pricing
+	resp = putJSON(t, "/formats/bluray", `{
+		"name": "Blu-ray",
+		"rentalPriceCents": 400,
+		"rentalPeriodDays": 3
+	}`)
+	assertNoContent(t, resp)
+}
+
+func TestHolds_ShouldQueueForAndAssignReturnedCopies(t *testing.T) {
+	// Create a title with a single copy
+	resp := postJSON(t, "/titles", `{
+		"title": "Hot Shots!",
+		"year": 1991
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "holds-A-1-1"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000101",
+		"location": "holds-A-1-1"
+	}`, titleID))
+	assertCreated(t, resp)
+	itemID := extractString(t, resp)
+
+	// Open accounts to rent to, and hold for
+	resp = postJSON(t, "/accounts", `{"name": "Topper Harley"}`)
+	assertCreated(t, resp)
+	renterID := extractString(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Ramada Thompson"}`)
+	assertCreated(t, resp)
+	holderID := extractString(t, resp)
+
+	// Test place while a copy is available.. should fail
+	resp = postJSON(t, "/titles/"+titleID+"/holds", fmt.Sprintf(`{"accountId": %s}`, holderID))
+	assertBadRequest(t, resp)
+
+	// Rent out the only copy
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, renterID))
+	assertNoContent(t, resp)
+
+	// Test renew while no one is waiting
+	resp = putJSON(t, "/inventory/"+itemID+"/renew", "")
+	assertOk(t, resp)
+	body := extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"itemId":%s,"accountId":%s,`, itemID, renterID))
+	assert.Contains(t, body, `"renewals":1,"renewalFeeCents":300}`)
+
+	// Test account read... for renew
+	resp = get(t, "/accounts/"+renterID)
+	assertOk(t, resp)
+	assert.Contains(t, extractString(t, resp), `"balanceCents":300,`)
+
+	// Test place
+	resp = postJSON(t, "/titles/"+titleID+"/holds", fmt.Sprintf(`{"accountId": %s}`, holderID))
+	assertCreated(t, resp)
+	holdID := extractString(t, resp)
+
+	// Test renew while the hold is waiting.. should fail
+	resp = putJSON(t, "/inventory/"+itemID+"/renew", "")
+	assertConflict(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not renew inventory item - conflict error: type=[inventory item], problem=[a hold is waiting for its title]`, body)
+
+	// Test place again for the same account.. should be constraint violation
+	resp = postJSON(t, "/titles/"+titleID+"/holds", fmt.Sprintf(`{"accountId": %s}`, holderID))
+	assertBadRequest(t, resp)
+
+	// Test read queue
+	resp = get(t, "/titles/"+titleID+"/holds")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"titleId":%s,"accountId":%s,`, titleID, holderID))
+	assert.Contains(t, body, `"status":"waiting","itemId":null,"expiresAt":null}]`)
+
+	// Test fulfil while waiting.. should fail
+	resp = putJSON(t, "/holds/"+holdID+"/fulfil", "")
+	assertConflict(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not fulfil hold - conflict error: type=[hold], problem=[it is waiting]`, body)
+
+	// Check the copy in, which puts it aside for the hold
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+
+	// Test read queue... for check in
+	resp = get(t, "/titles/"+titleID+"/holds")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"status":"ready","itemId":%s,"expiresAt":"`, itemID))
+
+	// Test title stock... for check in
+	resp = get(t, "/titles/"+titleID)
+	body = extractString(t, resp)
+	assertOk(t, resp)
+	assert.Contains(t, body, `"copies":1,"availableCopies":0`)
+
+	// Test checkout to another account.. should fail, as it is held
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, renterID))
+	assertConflict(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not checkout inventory item - conflict error: type=[inventory item], problem=[it is held for another account]`, body)
+
+	// Test fulfil
+	resp = putJSON(t, "/holds/"+holdID+"/fulfil", "")
+	assertNoContent(t, resp)
+
+	// Test read queue... for fulfil
+	resp = get(t, "/titles/"+titleID+"/holds")
+	assertOk(t, resp)
+	assert.Equal(t, `[]`, extractString(t, resp))
+
+	// Test cancel once fulfilled.. should fail
+	resp = putJSON(t, "/holds/"+holdID+"/cancel", "")
+	assertConflict(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not cancel hold - entity error: cannot cancel hold - conflict error: type=[hold], problem=[it is fulfilled]`, body)
+
+	// Return the copy and clean up
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+	resp = delete(t, "/inventory/"+itemID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/holds/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func TestLedger_ShouldRecordPaymentsAndBlockCheckoutOverCreditLimit(t *testing.T) {
+	// Test read on a non-existant account
+	resp := get(t, "/accounts/999/ledger")
+	assertNotFound(t, resp)
+
+	// Create a copy to rent, and an account to rent it to
+	resp = postJSON(t, "/titles", `{
+		"title": "Major League",
+		"year": 1989
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "ledger-A-1-1"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000201",
+		"location": "ledger-A-1-1"
+	}`, titleID))
+	assertCreated(t, resp)
+	itemID := extractString(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Rick Vaughn"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+
+	// Test read of an empty ledger
+	resp = get(t, "/accounts/"+accountID+"/ledger")
+	assertOk(t, resp)
+	body := extractString(t, resp)
+	assert.Equal(t, fmt.Sprintf(`{"accountId":%s,"balanceCents":0,"entries":[]}`, accountID), body)
+
+	// Test adjustment
+	resp = postJSON(t, "/accounts/"+accountID+"/adjustments", `{"amountCents": 1500, "note": "Damaged case"}`)
+	assertCreated(t, resp)
+
+	// Test checkout over the credit limit.. should fail
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertPaymentRequired(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not checkout inventory item - credit limit error: balanceCents=[1500], limitCents=[1000]`, body)
+
+	// Test payment with an unknown method.. should fail
+	resp = postJSON(t, "/accounts/"+accountID+"/payments", `{"amountCents": 600, "method": "cheque"}`)
+	assertBadRequest(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not record payment - entity error: validation error: field=[method], problem=[must be one of cash, card]`, body)
+
+	// Test payment
+	resp = postJSON(t, "/accounts/"+accountID+"/payments", `{"amountCents": 600, "method": "cash"}`)
+	assertCreated(t, resp)
+
+	// Test read... for payment
+	resp = get(t, "/accounts/"+accountID+"/ledger")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, `"balanceCents":900,`)
+	assert.Contains(t, body, `"kind":"adjustment","amountCents":1500,"method":null,"rentalId":null,"note":"Damaged case",`)
+	assert.Contains(t, body, `"kind":"payment","amountCents":600,"method":"cash","rentalId":null,"note":"",`)
+
+	// Test account read... for payment
+	resp = get(t, "/accounts/"+accountID)
+	assertOk(t, resp)
+	assert.Contains(t, extractString(t, resp), `"balanceCents":900,`)
+
+	// Test checkout within the credit limit
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertNoContent(t, resp)
+
+	// Return the copy and clean up
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+	resp = delete(t, "/inventory/"+itemID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/ledger/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func TestAgeRatings_ShouldRefuseUnderageCheckout(t *testing.T) {
+	// Test create with a rating outside the scheme.. should fail
+	resp := postJSON(t, "/titles", `{
+		"title": "Cool Runnings",
+		"year": 1993,
+		"rating": "15"
+	}`)
+	assertBadRequest(t, resp)
+	body := extractString(t, resp)
+	assert.Equal(t, `could not create title - rating error: validation error: field=[rating], problem=[must be one of G, PG, PG-13, R, NC-17]`, body)
+
+	// Create a restricted copy, and accounts for a minor, an adult and
+	// someone whose age we don't know
+	resp = postJSON(t, "/titles", `{
+		"title": "Cool Runnings",
+		"year": 1993,
+		"rating": "R"
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "ratings-A-1-1"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000301",
+		"location": "ratings-A-1-1"
+	}`, titleID))
+	assertCreated(t, resp)
+	itemID := extractString(t, resp)
+	minorBorn := time.Now().AddDate(-15, 0, 0).Format("2006-01-02")
+	resp = postJSON(t, "/accounts", fmt.Sprintf(`{"name": "Junior Bevil", "dateOfBirth": "%s"}`, minorBorn))
+	assertCreated(t, resp)
+	minorID := extractString(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Sanka Coffie", "dateOfBirth": "1965-02-14"}`)
+	assertCreated(t, resp)
+	adultID := extractString(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Yul Brenner"}`)
+	assertCreated(t, resp)
+	unknownID := extractString(t, resp)
+
+	// Test checkout to a minor.. should be forbidden
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, minorID))
+	assertForbidden(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not checkout inventory item - age restriction error: rating=[R], minimumAge=[17], problem=[account holder is 15]`, body)
+
+	// Test checkout to an account without a date of birth.. should be forbidden
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, unknownID))
+	assertForbidden(t, resp)
+	body = extractString(t, resp)
+	assert.Equal(t, `could not checkout inventory item - age restriction error: rating=[R], minimumAge=[17], problem=[account has no date of birth]`, body)
+
+	// Test checkout to an adult, by barcode
+	resp = putJSON(t, "/inventory/by-barcode/MV00000301/checkout", fmt.Sprintf(`{"accountId": %s}`, adultID))
+	assertNoContent(t, resp)
+
+	// Return the copy and clean up
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+	resp = delete(t, "/inventory/"+itemID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/ratings/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func TestWebhooks_ShouldDeliverSignedEventsAndRetry(t *testing.T) {
+	// Start a receiver, which refuses events until told otherwise
+	secret := "integration-webhook-secret"
+	receiver := &webhookReceiver{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// Test subscribe with a short secret.. should fail
+	resp := postJSON(t, "/webhooks", fmt.Sprintf(`{
+		"url": "%s",
+		"eventTypes": ["inventory.item.checked-in"],
+		"secret": "short"
+	}`, server.URL))
+	assertBadRequest(t, resp)
+	body := extractString(t, resp)
+	assert.Equal(t, `could not create webhook subscription - entity error: validation error: field=[secret], problem=[must be at least 16 characters]`, body)
+
+	// Test subscribe
+	resp = postJSON(t, "/webhooks", fmt.Sprintf(`{
+		"url": "%s",
+		"eventTypes": ["inventory.item.checked-out", "inventory.item.checked-in"],
+		"secret": "%s"
+	}`, server.URL, secret))
+	assertCreated(t, resp)
+	subscriptionID := extractString(t, resp)
+
+	// Test read... should not give the secret
+	resp = get(t, "/webhooks/"+subscriptionID)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, fmt.Sprintf(`"url":"%s","eventTypes":["inventory.item.checked-out","inventory.item.checked-in"],`, server.URL))
+	assert.NotContains(t, body, secret)
+
+	// Create a copy, and rent it out while the receiver is refusing
+	resp = postJSON(t, "/titles", `{
+		"title": "Airplane!",
+		"year": 1980
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "webhooks-A-1-1"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "vhs",
+		"barcode": "MV00000401",
+		"location": "webhooks-A-1-1"
+	}`, titleID))
+	assertCreated(t, resp)
+	itemID := extractString(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Ted Striker"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertNoContent(t, resp)
+
+	// Test dead letters... once every attempt was refused
+	checkedOut := fmt.Sprintf(`"type":"inventory.item.checked-out","entityId":%s,`, itemID)
+	waitFor(t, func() bool {
+		resp := get(t, "/webhook-deliveries/dead")
+		return strings.Contains(extractString(t, resp), `"eventType":"inventory.item.checked-out","entityId":`+itemID+`,"status":"dead","attempts":3,`)
+	})
+	assert.Equal(t, 3, receiver.count(checkedOut))
+	resp = get(t, "/webhooks/"+subscriptionID+"/deliveries")
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, `"lastStatusCode":503,"lastError":"could not send webhook - receiver responded with status 503"`)
+
+	// Test redeliver... once the receiver accepts
+	receiver.accept()
+	deliveryID := extractDeliveryID(t, body, itemID)
+	resp = putJSON(t, "/webhook-deliveries/"+deliveryID+"/redeliver", "")
+	assertNoContent(t, resp)
+	waitFor(t, func() bool {
+		resp := get(t, "/webhooks/"+subscriptionID+"/deliveries")
+		return strings.Contains(extractString(t, resp), `"eventType":"inventory.item.checked-out","entityId":`+itemID+`,"status":"delivered","attempts":1,`)
+	})
+	assert.Equal(t, 4, receiver.count(checkedOut))
+
+	// Test redeliver of a delivered event.. should fail
+	resp = putJSON(t, "/webhook-deliveries/"+deliveryID+"/redeliver", "")
+	assertConflict(t, resp)
+
+	// Test check in... should be delivered first time, signed, with the title
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+	checkedIn := fmt.Sprintf(`"type":"inventory.item.checked-in","entityId":%s,`, itemID)
+	waitFor(t, func() bool { return receiver.count(checkedIn) == 1 })
+	received := receiver.find(checkedIn)
+	assert.Contains(t, received.body, fmt.Sprintf(`"data":{"titleId":%s}`, titleID))
+	assert.Equal(t, "inventory.item.checked-in", received.header.Get("X-Matchstick-Event"))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(received.body))
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), received.header.Get("X-Matchstick-Signature"))
+
+	// Test unsubscribe
+	resp = delete(t, "/webhooks/"+subscriptionID)
+	assertNoContent(t, resp)
+	resp = get(t, "/webhooks/"+subscriptionID)
+	assertNotFound(t, resp)
+	resp = get(t, "/webhooks/"+subscriptionID+"/deliveries")
+	assertNotFound(t, resp)
+
+	// Clean up
+	resp = delete(t, "/inventory/"+itemID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/webhooks/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func TestInventoryEvents_ShouldStreamChangesAndResume(t *testing.T) {
+	// Open a stream... before anything happens
+	stream := openEventStream(t, "")
+	defer stream.close()
+
+	// Create a copy, move it and rent it out
+	resp := postJSON(t, "/titles", `{
+		"title": "Clue",
+		"year": 1985
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "events-A-1-1"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "events-B-2-2"}`)
+	assertCreated(t, resp)
+	resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+		"titleId": %s,
+		"format": "dvd",
+		"barcode": "MV00000501",
+		"location": "events-A-1-1"
+	}`, titleID))
+	assertCreated(t, resp)
+	itemID := extractString(t, resp)
+	resp = putJSON(t, "/inventory/"+itemID+"/move", `{"location": "events-B-2-2"}`)
+	assertNoContent(t, resp)
+	resp = postJSON(t, "/accounts", `{"name": "Wadsworth"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+	resp = putJSON(t, "/inventory/"+itemID+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertNoContent(t, resp)
+
+	// Test stream... should have each change, in order
+	entity := fmt.Sprintf(`"entityId":%s,`, itemID)
+	waitFor(t, func() bool { return len(stream.find(entity)) == 3 })
+	events := stream.find(entity)
+	assert.Equal(t, "inventory.item.created", events[0].eventType)
+	assert.Equal(t, "inventory.item.moved", events[1].eventType)
+	assert.Contains(t, events[1].data, `"from":{"aisle":"A","shelf":"1","slot":"1","store":"events"}`)
+	assert.Contains(t, events[1].data, `"to":{"aisle":"B","shelf":"2","slot":"2","store":"events"}`)
+	assert.Equal(t, "inventory.item.checked-out", events[2].eventType)
+	assert.Contains(t, events[2].data, fmt.Sprintf(`"id":%s,`, events[2].id))
+
+	// Test resume... should have everything after the last event seen
+	resumed := openEventStream(t, events[0].id)
+	defer resumed.close()
+	waitFor(t, func() bool { return len(resumed.find(entity)) == 2 })
+	assert.Equal(t, events[1:], resumed.find(entity))
+
+	// Test resume with a bad id.. should fail
+	req, _ := http.NewRequest(http.MethodGet, baseURL+"/inventory/events", nil)
+	req.Header.Set("Last-Event-ID", "not-an-id")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assertBadRequest(t, resp)
+
+	// Clean up
+	resp = putJSON(t, "/inventory/"+itemID+"/checkin", "")
+	assertOk(t, resp)
+	resp = delete(t, "/inventory/"+itemID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/events/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/events/aisles/B/shelves/2/slots/2")
+	assertNoContent(t, resp)
+}
+
+func TestInventoryGRPC_ShouldCreateListCheckOutAndDelete(t *testing.T) {
+	client, closeClient := dialInventoryGRPC(t)
+	defer closeClient()
+	ctx := context.Background()
+
+	// Test read on a non-existant item
+	_, err := client.Read(ctx, &inventorypb.ReadItemRequest{Id: 999})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, `could not read inventory item - repository find error: cannot execute query - db scan error: entity not found: type=[inventory item]`, status.Convert(err).Message())
+
+	// Test create with an invalid format
+	_, err = client.Create(ctx, &inventorypb.CreateItemRequest{
+		TitleId: 1,
+		Format:  "betamax",
+		Barcode: "MV00000601",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Create a copy
+	resp := postJSON(t, "/titles", `{
+		"title": "Brazil",
+		"year": 1985
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "grpc-A-1-1"}`)
+	assertCreated(t, resp)
+	var titleIDNum int64
+	fmt.Sscan(titleID, &titleIDNum)
+	created, err := client.Create(ctx, &inventorypb.CreateItemRequest{
+		TitleId:  titleIDNum,
+		Format:   "vhs",
+		Barcode:  "MV00000601",
+		Location: "grpc-A-1-1",
+	})
+	assert.NoError(t, err)
+	itemID := created.GetId()
+
+	// Test create with the same barcode... should already exist
+	_, err = client.Create(ctx, &inventorypb.CreateItemRequest{
+		TitleId:  titleIDNum,
+		Format:   "vhs",
+		Barcode:  "MV00000601",
+		Location: "grpc-A-1-1",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Test read
+	item, err := client.Read(ctx, &inventorypb.ReadItemRequest{Id: itemID})
+	assert.NoError(t, err)
+	assert.Equal(t, "MV00000601", item.GetBarcode())
+	assert.Equal(t, "grpc-A-1-1", item.GetLocation())
+	assert.True(t, item.GetAvailable())
+
+	// Test list of the format... should stream the copy
+	stream, err := client.List(ctx, &inventorypb.ListItemsRequest{Format: "vhs"})
+	assert.NoError(t, err)
+	found := false
+	for {
+		summary, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		if summary.GetId() == itemID {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	// Test check out and check in
+	resp = postJSON(t, "/accounts", `{"name": "Sam Lowry"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+	var accountIDNum int64
+	fmt.Sscan(accountID, &accountIDNum)
+	_, err = client.Checkout(ctx, &inventorypb.CheckoutRequest{Id: itemID, AccountId: accountIDNum})
+	assert.NoError(t, err)
+	item, err = client.Read(ctx, &inventorypb.ReadItemRequest{Id: itemID})
+	assert.NoError(t, err)
+	assert.False(t, item.GetAvailable())
+	assert.NotNil(t, item.GetDueAt())
+	receipt, err := client.CheckIn(ctx, &inventorypb.CheckInRequest{Id: itemID})
+	assert.NoError(t, err)
+	assert.Equal(t, itemID, receipt.GetItemId())
+	assert.Equal(t, accountIDNum, receipt.GetAccountId())
+
+	// Test update
+	_, err = client.Update(ctx, &inventorypb.UpdateItemRequest{
+		Id:       itemID,
+		TitleId:  titleIDNum,
+		Format:   "vhs",
+		Barcode:  "MV00000602",
+		Location: "grpc-A-1-1",
+	})
+	assert.NoError(t, err)
+	item, err = client.Read(ctx, &inventorypb.ReadItemRequest{Id: itemID})
+	assert.NoError(t, err)
+	assert.Equal(t, "MV00000602", item.GetBarcode())
+
+	// Test delete
+	_, err = client.Delete(ctx, &inventorypb.DeleteItemRequest{Id: itemID})
+	assert.NoError(t, err)
+	_, err = client.Read(ctx, &inventorypb.ReadItemRequest{Id: itemID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Clean up
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/grpc/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func TestGraphQL_ShouldReadTitlesCopiesAndRentalsInOneRequest(t *testing.T) {
+	// Create a title with two copies, one rented out
+	resp := postJSON(t, "/titles", `{
+		"title": "Time Bandits",
+		"year": 1981,
+		"rating": "PG"
+	}`)
+	assertCreated(t, resp)
+	titleID := extractString(t, resp)
+	resp = postJSON(t, "/locations", `{"location": "graphql-A-1-1"}`)
+	assertCreated(t, resp)
+	var itemIDs []string
+	for _, barcode := range []string{"MV00000701", "MV00000702"} {
+		resp = postJSON(t, "/inventory", fmt.Sprintf(`{
+			"titleId": %s,
+			"format": "vhs",
+			"barcode": "%s",
+			"location": "graphql-A-1-1"
+		}`, titleID, barcode))
+		assertCreated(t, resp)
+		itemIDs = append(itemIDs, extractString(t, resp))
+	}
+	resp = postJSON(t, "/accounts", `{"name": "Kevin", "dateOfBirth": "1970-01-01"}`)
+	assertCreated(t, resp)
+	accountID := extractString(t, resp)
+	resp = putJSON(t, "/inventory/"+itemIDs[0]+"/checkout", fmt.Sprintf(`{"accountId": %s}`, accountID))
+	assertNoContent(t, resp)
+
+	// Test query of the title and account
+	resp = postJSON(t, "/graphql", fmt.Sprintf(`{
+		"query": "query Kiosk($title: ID!, $account: ID!) { title(id: $title) { name copies availableCopies items { barcode available } } account(id: $account) { name rentals { overdue item { barcode title { name } } } } }",
+		"variables": {"title": "%s", "account": "%s"}
+	}`, titleID, accountID))
+	assertOk(t, resp)
+	body := extractString(t, resp)
+	assert.JSONEq(t, `{"data": {
+		"title": {
+			"name": "Time Bandits",
+			"copies": 2,
+			"availableCopies": 1,
+			"items": [
+				{"barcode": "MV00000701", "available": false},
+				{"barcode": "MV00000702", "available": true}
+			]
+		},
+		"account": {
+			"name": "Kevin",
+			"rentals": [
+				{"overdue": false, "item": {"barcode": "MV00000701", "title": {"name": "Time Bandits"}}}
+			]
+		}
+	}}`, body)
+
+	// Test query of a missing item... should be null
+	resp = postJSON(t, "/graphql", `{"query": "{ item(id: \"999\") { barcode } }"}`)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.JSONEq(t, `{"data": {"item": null}}`, body)
+
+	// Test query which is too deep... should be refused
+	resp = postJSON(t, "/graphql", `{"query": "{ titles { items { title { items { title { name } } } } } }"}`)
+	assertOk(t, resp)
+	body = extractString(t, resp)
+	assert.Contains(t, body, `exceeds max depth 5`)
+	assert.NotContains(t, body, `"data"`)
+
+	// Clean up
+	resp = putJSON(t, "/inventory/"+itemIDs[0]+"/checkin", "")
+	assertOk(t, resp)
+	for _, itemID := range itemIDs {
+		resp = delete(t, "/inventory/"+itemID)
+		assertNoContent(t, resp)
+	}
+	resp = delete(t, "/titles/"+titleID)
+	assertNoContent(t, resp)
+	resp = delete(t, "/stores/graphql/aisles/A/shelves/1/slots/1")
+	assertNoContent(t, resp)
+}
+
+func dialInventoryGRPC(t *testing.T) (inventorypb.InventoryServiceClient, func()) {
+	conn, err := grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return inventorypb.NewInventoryServiceClient(conn), func() { conn.Close() }
 }
 
 func delete(t *testing.T, path string) *http.Response {
@@ -198,11 +1255,17 @@ func setup() *exec.Cmd {
 	cmd := exec.Command("matchstick-video")
 	cmd.Env = []string{
 		"PORT=9010",
+		"GRPC_PORT=9011",
 		"MIGRATION_SOURCE=file://../../migrations",
 		"DB_USER=integration",
 		"DB_PASSWORD=integration",
 		"DB_NAME=integration",
 		"DB_PORT=5050",
+		"OUTBOX_SINKS=webhook",
+		"OUTBOX_POLL_INTERVAL=100ms",
+		"WEBHOOK_BACKOFF=100ms",
+		"WEBHOOK_MAX_ATTEMPTS=3",
+		"EVENT_STREAM_POLL_INTERVAL=100ms",
 	}
 
 	if err := cmd.Start(); err != nil {
@@ -233,6 +1296,14 @@ func assertNoContent(t *testing.T, resp *http.Response) {
 	assert.Equal(t, 204, resp.StatusCode, "expected No Content")
 }
 
+func assertPaymentRequired(t *testing.T, resp *http.Response) {
+	assert.Equal(t, 402, resp.StatusCode, "expected Payment Required")
+}
+
+func assertForbidden(t *testing.T, resp *http.Response) {
+	assert.Equal(t, 403, resp.StatusCode, "expected Forbidden")
+}
+
 func assertNotFound(t *testing.T, resp *http.Response) {
 	assert.Equal(t, 404, resp.StatusCode, "expected Not Found")
 }
@@ -241,8 +1312,159 @@ func assertBadRequest(t *testing.T, resp *http.Response) {
 	assert.Equal(t, 400, resp.StatusCode, "expected Bad Request")
 }
 
+func assertConflict(t *testing.T, resp *http.Response) {
+	assert.Equal(t, 409, resp.StatusCode, "expected Conflict")
+}
+
+func assertInternalServerError(t *testing.T, resp *http.Response) {
+	assert.Equal(t, 500, resp.StatusCode, "expected Internal Server Error")
+}
+
 func extractString(t *testing.T, resp *http.Response) string {
 	bytes, err := ioutil.ReadAll(resp.Body)
 	assert.NoError(t, err)
 	return string(bytes)
 }
+
+// webhookReceiver records the webhooks posted to it, and responds
+// with its status.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	received []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   string
+}
+
+func (w *webhookReceiver) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.received = append(w.received, receivedWebhook{header: req.Header, body: string(body)})
+	res.WriteHeader(w.status)
+}
+
+func (w *webhookReceiver) accept() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.status = http.StatusNoContent
+}
+
+func (w *webhookReceiver) count(fragment string) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	count := 0
+	for _, received := range w.received {
+		if strings.Contains(received.body, fragment) {
+			count++
+		}
+	}
+	return count
+}
+
+func (w *webhookReceiver) find(fragment string) receivedWebhook {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, received := range w.received {
+		if strings.Contains(received.body, fragment) {
+			return received
+		}
+	}
+	return receivedWebhook{header: http.Header{}}
+}
+
+// extractDeliveryID finds the id of the check out delivery of the item
+// in a list of deliveries.
+func extractDeliveryID(t *testing.T, body string, itemID string) string {
+	for _, delivery := range strings.Split(body, "},{") {
+		if strings.Contains(delivery, `"eventType":"inventory.item.checked-out","entityId":`+itemID+`,`) {
+			fields := strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(delivery, "["), "{"), ",", 2)
+			return strings.TrimPrefix(fields[0], `"id":`)
+		}
+	}
+	assert.Fail(t, "no delivery for item", itemID)
+	return ""
+}
+
+// waitFor polls until the condition holds, failing after five seconds.
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			assert.Fail(t, "timed out waiting for condition")
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// eventStream records the server-sent events it is sent.
+type eventStream struct {
+	mu     sync.Mutex
+	resp   *http.Response
+	events []streamedEvent
+}
+
+type streamedEvent struct {
+	id        string
+	eventType string
+	data      string
+}
+
+// openEventStream follows /inventory/events, resuming after
+// lastEventID if it is given.
+func openEventStream(t *testing.T, lastEventID string) *eventStream {
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/inventory/events", nil)
+	assert.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assertOk(t, resp)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	stream := &eventStream{resp: resp}
+	go stream.read()
+	return stream
+}
+
+func (e *eventStream) read() {
+	scanner := bufio.NewScanner(e.resp.Body)
+	var event streamedEvent
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.eventType = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		case line == "" && event.id != "":
+			e.mu.Lock()
+			e.events = append(e.events, event)
+			e.mu.Unlock()
+			event = streamedEvent{}
+		}
+	}
+}
+
+func (e *eventStream) find(fragment string) []streamedEvent {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var found []streamedEvent
+	for _, event := range e.events {
+		if strings.Contains(event.data, fragment) {
+			found = append(found, event)
+		}
+	}
+	return found
+}
+
+func (e *eventStream) close() {
+	e.resp.Body.Close()
+}
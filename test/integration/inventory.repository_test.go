@@ -3,6 +3,7 @@
 package integration_test
 
 import (
+	"context"
 	"testing"
 
 	goConfig "github.com/liampulles/go-config"
@@ -18,14 +19,16 @@ import (
 
 type InventoryRepositoryTestSuite struct {
 	suite.Suite
-	sut *sql.InventoryRepositoryImpl
+	titleID  entity.ID
+	location entity.Location
+	sut      *sql.InventoryRepositoryImpl
 }
 
 func TestInventoryRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(InventoryRepositoryTestSuite))
 }
 
-func (suite *InventoryRepositoryTestSuite) SetupTest() {
+func (suite *InventoryRepositoryTestSuite) SetupSuite() {
 
 	source := goConfig.MapSource(map[string]string{
 		"PORT":             "9010",
@@ -42,7 +45,7 @@ func (suite *InventoryRepositoryTestSuite) SetupTest() {
 	}
 	errorParser := adapterDb.NewErrorParserImpl()
 
-	dbService, err := db.NewDatabaseServiceImpl(configStore)
+	dbService, err := db.NewDatabaseServiceImpl(configStore, configStore.GetAutoMigrate())
 	if err != nil {
 		panic(err)
 	}
@@ -52,26 +55,88 @@ func (suite *InventoryRepositoryTestSuite) SetupTest() {
 	suite.sut = sql.NewInventoryRepositoryImpl(
 		dbService, helperService, constructor,
 	)
+
+	// Every copy needs a title to belong to
+	titleRepository := sql.NewTitleRepositoryImpl(
+		dbService, helperService, entity.NewTitleConstructorImpl(),
+	)
+	suite.titleID, err = titleRepository.Create(context.Background(), entity.TestTitleImplConstructor(
+		entity.InvalidID, "some.repository.title", 2000, 0, "", nil, nil, "",
+	))
+	if err != nil {
+		panic(err)
+	}
+
+	// ...and a known location to be kept at
+	locationRepository := sql.NewLocationRepositoryImpl(dbService, helperService)
+	suite.location = entity.Location{Store: "repository", Aisle: "A", Shelf: "1", Slot: "1"}
+	exists, err := locationRepository.Exists(context.Background(), suite.location)
+	if err != nil {
+		panic(err)
+	}
+	if !exists {
+		if err := locationRepository.Create(context.Background(), suite.location); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (suite *InventoryRepositoryTestSuite) TestFindByID_WhenDoesExist_ShouldPass() {
 	// Setup fixture
 	e := entity.TestInventoryItemImplConstructor(
-		entity.InvalidID, "some.find.name", "some.find.location", true,
+		entity.InvalidID, suite.titleID, entity.FormatDVD, "some.find.barcode", suite.location, true,
 	)
-	id, err := suite.sut.Create(e)
+	id, err := suite.sut.Create(context.Background(), e)
 	suite.NoError(err)
 
 	// Exercise SUT
-	_, err = suite.sut.FindByID(id)
+	_, err = suite.sut.FindByID(context.Background(), id)
 
 	// Verify results
 	suite.NoError(err)
 }
 
+func (suite *InventoryRepositoryTestSuite) TestFindByIDs_ShouldFindEachExisting() {
+	// Setup fixture
+	e := entity.TestInventoryItemImplConstructor(
+		entity.InvalidID, suite.titleID, entity.FormatDVD, "some.find.many.barcode", suite.location, true,
+	)
+	id, err := suite.sut.Create(context.Background(), e)
+	suite.NoError(err)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByIDs(context.Background(), []entity.ID{id, 999999})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Len(actual, 1)
+	suite.Equal(id, actual[0].ID())
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindAllOfTitles_ShouldFindCopiesOfTitles() {
+	// Setup fixture
+	e := entity.TestInventoryItemImplConstructor(
+		entity.InvalidID, suite.titleID, entity.FormatDVD, "some.of.titles.barcode", suite.location, true,
+	)
+	id, err := suite.sut.Create(context.Background(), e)
+	suite.NoError(err)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAllOfTitles(context.Background(), []entity.ID{suite.titleID})
+
+	// Verify results
+	suite.NoError(err)
+	var ids []entity.ID
+	for _, item := range actual {
+		suite.Equal(suite.titleID, item.TitleID())
+		ids = append(ids, item.ID())
+	}
+	suite.Contains(ids, id)
+}
+
 func (suite *InventoryRepositoryTestSuite) TestFindAll_ShouldPass() {
 	// Exercise SUT
-	_, err := suite.sut.FindAll()
+	_, err := suite.sut.FindAll(context.Background())
 
 	// Verify results
 	suite.NoError(err)
@@ -80,11 +145,11 @@ func (suite *InventoryRepositoryTestSuite) TestFindAll_ShouldPass() {
 func (suite *InventoryRepositoryTestSuite) TestCreate_ShouldPass() {
 	// Setup fixture
 	e := entity.TestInventoryItemImplConstructor(
-		entity.InvalidID, "some.create.name", "some.create.location", true,
+		entity.InvalidID, suite.titleID, entity.FormatDVD, "some.create.barcode", suite.location, true,
 	)
 
 	// Exercise SUT
-	_, err := suite.sut.Create(e)
+	_, err := suite.sut.Create(context.Background(), e)
 
 	// Verify results
 	suite.NoError(err)
@@ -93,13 +158,13 @@ func (suite *InventoryRepositoryTestSuite) TestCreate_ShouldPass() {
 func (suite *InventoryRepositoryTestSuite) TestDeleteById_WhenDoesExist_ShouldPass() {
 	// Setup fixture
 	e := entity.TestInventoryItemImplConstructor(
-		entity.InvalidID, "some.delete.name", "some.delete.location", true,
+		entity.InvalidID, suite.titleID, entity.FormatDVD, "some.delete.barcode", suite.location, true,
 	)
-	id, err := suite.sut.Create(e)
+	id, err := suite.sut.Create(context.Background(), e)
 	suite.NoError(err)
 
 	// Exercise SUT
-	err = suite.sut.DeleteByID(id)
+	err = suite.sut.DeleteByID(context.Background(), id)
 
 	// Verify results
 	suite.NoError(err)
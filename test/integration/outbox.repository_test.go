@@ -0,0 +1,119 @@
+// +build integration
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	goConfig "github.com/liampulles/go-config"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	adapterDb "github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/db"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+	transactor *sql.TransactorImpl
+	sut        *sql.OutboxRepositoryImpl
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
+
+func (suite *OutboxRepositoryTestSuite) SetupSuite() {
+
+	source := goConfig.MapSource(map[string]string{
+		"PORT":             "9010",
+		"MIGRATION_SOURCE": "file://../../migrations",
+		"DB_USER":          "integration",
+		"DB_PASSWORD":      "integration",
+		"DB_NAME":          "integration",
+		"DB_PORT":          "5050",
+	})
+
+	configStore, err := config.NewStoreImpl(source)
+	if err != nil {
+		panic(err)
+	}
+	errorParser := adapterDb.NewErrorParserImpl()
+
+	dbService, err := db.NewDatabaseServiceImpl(configStore, configStore.GetAutoMigrate())
+	if err != nil {
+		panic(err)
+	}
+	helperService := sql.NewHelperServiceImpl(errorParser)
+
+	suite.transactor = sql.NewTransactorImpl(dbService)
+	suite.sut = sql.NewOutboxRepositoryImpl(
+		dbService, helperService,
+	)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestCreate_WhenTransactionRollsBack_ShouldNotKeepMessage() {
+	// Setup fixture
+	ctx := context.Background()
+	msg := outbox.Message{
+		EntityID:   entity.ID(987654),
+		Type:       entity.EventItemDeleted,
+		Data:       map[string]interface{}{"barcode": "some.rolled.back.barcode"},
+		OccurredAt: time.Now().UTC(),
+	}
+
+	// Exercise SUT
+	err := suite.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if _, err := suite.sut.Create(ctx, msg); err != nil {
+			return err
+		}
+		return fmt.Errorf("some.error")
+	})
+
+	// Verify results
+	suite.EqualError(err, "some.error")
+	suite.False(suite.hasUnpublished(msg.EntityID))
+}
+
+func (suite *OutboxRepositoryTestSuite) TestMarkPublished_ShouldNoLongerBeUnpublished() {
+	// Setup fixture
+	ctx := context.Background()
+	msg := outbox.Message{
+		EntityID:   entity.ID(987655),
+		Type:       entity.EventItemCheckedOut,
+		Data:       map[string]interface{}{"accountId": 7},
+		OccurredAt: time.Now().UTC(),
+	}
+	id, err := suite.sut.Create(ctx, msg)
+	suite.Require().NoError(err)
+	suite.True(suite.hasUnpublished(msg.EntityID))
+
+	// Exercise SUT
+	err = suite.sut.MarkPublished(ctx, id, time.Now().UTC())
+
+	// Verify results
+	suite.NoError(err)
+	suite.False(suite.hasUnpublished(msg.EntityID))
+}
+
+func (suite *OutboxRepositoryTestSuite) hasUnpublished(entityID entity.ID) bool {
+	var found bool
+	err := suite.transactor.InTransaction(context.Background(), func(ctx context.Context) error {
+		pending, err := suite.sut.FindUnpublished(ctx, 1000000)
+		for _, msg := range pending {
+			if msg.EntityID == entityID {
+				found = true
+			}
+		}
+		return err
+	})
+	suite.Require().NoError(err)
+	return found
+}
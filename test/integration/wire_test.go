@@ -15,19 +15,19 @@ import (
 func TestCreateServerFactory_GivenValidIntegrationConfig_ShouldPass(t *testing.T) {
 	// Setup fixture
 	fixture := goConfig.MapSource(map[string]string{
-		"PORT":             "9010",
-		"MIGRATION_SOURCE": "file://../../migrations",
-		"DB_USER":          "integration",
-		"DB_PASSWORD":      "integration",
-		"DB_NAME":          "integration",
-		"DB_PORT":          "5050",
+		"PORT":        "9010",
+		"DB_USER":     "integration",
+		"DB_PASSWORD": "integration",
+		"DB_NAME":     "integration",
+		"DB_PORT":     "5050",
 	})
 
 	// Exercise SUT
-	actual, err := wire.CreateServerFactory(fixture)
+	actual, shutdown, err := wire.CreateServerFactory(fixture)
 
 	// Verify results
 	assert.NotNil(t, actual)
+	assert.NotNil(t, shutdown)
 	assert.NoError(t, err)
 }
 
@@ -43,5 +43,5 @@ func TestCreateApp_SmokeTest(t *testing.T) {
 	})
 
 	// Exercise SUT
-	wire.CreateApp(fixture)
+	wire.CreateApp([]string{}, fixture)
 }
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 // MockStore is for mocking
@@ -19,6 +23,12 @@ func (s *MockStore) GetPort() int {
 	return args.Int(0)
 }
 
+// GetGrpcPort is for mocking
+func (s *MockStore) GetGrpcPort() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
 // GetDbDriver is for mocking
 func (s *MockStore) GetDbDriver() string {
 	args := s.Called()
@@ -31,6 +41,12 @@ func (s *MockStore) GetMigrationSource() string {
 	return args.String(0)
 }
 
+// GetAutoMigrate is for mocking
+func (s *MockStore) GetAutoMigrate() bool {
+	args := s.Called()
+	return args.Bool(0)
+}
+
 // GetDbUser is for mocking
 func (s *MockStore) GetDbUser() string {
 	args := s.Called()
@@ -60,3 +76,285 @@ func (s *MockStore) GetDbName() string {
 	args := s.Called()
 	return args.String(0)
 }
+
+// GetDatabaseURL is for mocking
+func (s *MockStore) GetDatabaseURL() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetDbSSLMode is for mocking
+func (s *MockStore) GetDbSSLMode() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetDbSSLRootCert is for mocking
+func (s *MockStore) GetDbSSLRootCert() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetDbSSLCert is for mocking
+func (s *MockStore) GetDbSSLCert() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetDbSSLKey is for mocking
+func (s *MockStore) GetDbSSLKey() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetDbMaxOpenConns is for mocking
+func (s *MockStore) GetDbMaxOpenConns() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetDbMaxIdleConns is for mocking
+func (s *MockStore) GetDbMaxIdleConns() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetDbConnMaxLifetime is for mocking
+func (s *MockStore) GetDbConnMaxLifetime() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetDbConnMaxIdleTime is for mocking
+func (s *MockStore) GetDbConnMaxIdleTime() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetDbConnectTimeout is for mocking
+func (s *MockStore) GetDbConnectTimeout() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetDbConnectBackoff is for mocking
+func (s *MockStore) GetDbConnectBackoff() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetDbConnectMaxBackoff is for mocking
+func (s *MockStore) GetDbConnectMaxBackoff() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetTraceExporter is for mocking
+func (s *MockStore) GetTraceExporter() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetTraceOTLPEndpoint is for mocking
+func (s *MockStore) GetTraceOTLPEndpoint() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetTraceServiceName is for mocking
+func (s *MockStore) GetTraceServiceName() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetRequestTimeout is for mocking
+func (s *MockStore) GetRequestTimeout() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetRouteTimeouts is for mocking
+func (s *MockStore) GetRouteTimeouts() map[string]time.Duration {
+	args := s.Called()
+	return args.Get(0).(map[string]time.Duration)
+}
+
+// GetLateFeeRule is for mocking
+func (s *MockStore) GetLateFeeRule() domain.LateFeeRule {
+	args := s.Called()
+	return args.Get(0).(domain.LateFeeRule)
+}
+
+// GetLateFeeFormatRules is for mocking
+func (s *MockStore) GetLateFeeFormatRules() map[entity.Format]domain.LateFeeRule {
+	args := s.Called()
+	return args.Get(0).(map[entity.Format]domain.LateFeeRule)
+}
+
+// GetHoldExpiry is for mocking
+func (s *MockStore) GetHoldExpiry() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetRenewalLimit is for mocking
+func (s *MockStore) GetRenewalLimit() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetCreditLimit is for mocking
+func (s *MockStore) GetCreditLimit() entity.Money {
+	args := s.Called()
+	return args.Get(0).(entity.Money)
+}
+
+// GetRatingScheme is for mocking
+func (s *MockStore) GetRatingScheme() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetRatingMinimumAges is for mocking
+func (s *MockStore) GetRatingMinimumAges() map[string]int {
+	args := s.Called()
+	return args.Get(0).(map[string]int)
+}
+
+// GetLocationFormat is for mocking
+func (s *MockStore) GetLocationFormat() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetOutboxSinks is for mocking
+func (s *MockStore) GetOutboxSinks() []string {
+	args := s.Called()
+	return args.Get(0).([]string)
+}
+
+// GetOutboxPollInterval is for mocking
+func (s *MockStore) GetOutboxPollInterval() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetOutboxBatchSize is for mocking
+func (s *MockStore) GetOutboxBatchSize() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetWebhookTimeout is for mocking
+func (s *MockStore) GetWebhookTimeout() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetWebhookMaxAttempts is for mocking
+func (s *MockStore) GetWebhookMaxAttempts() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetWebhookBackoff is for mocking
+func (s *MockStore) GetWebhookBackoff() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetWebhookMaxBackoff is for mocking
+func (s *MockStore) GetWebhookMaxBackoff() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetEventStreamPollInterval is for mocking
+func (s *MockStore) GetEventStreamPollInterval() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetEventStreamGapTimeout is for mocking
+func (s *MockStore) GetEventStreamGapTimeout() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetGraphQLMaxDepth is for mocking
+func (s *MockStore) GetGraphQLMaxDepth() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetCliOutput is for mocking
+func (s *MockStore) GetCliOutput() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetCliServerURL is for mocking
+func (s *MockStore) GetCliServerURL() string {
+	args := s.Called()
+	return args.String(0)
+}
+
+// GetIdempotencyKeyExpiry is for mocking
+func (s *MockStore) GetIdempotencyKeyExpiry() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetRateLimit is for mocking
+func (s *MockStore) GetRateLimit() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// GetRateLimitPeriod is for mocking
+func (s *MockStore) GetRateLimitPeriod() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
+
+// GetRouteRateLimits is for mocking
+func (s *MockStore) GetRouteRateLimits() map[string]int {
+	args := s.Called()
+	return args.Get(0).(map[string]int)
+}
+
+// GetRateLimitAPIKeys is for mocking
+func (s *MockStore) GetRateLimitAPIKeys() []string {
+	args := s.Called()
+	return args.Get(0).([]string)
+}
+
+// GetCORSAllowedOrigins is for mocking
+func (s *MockStore) GetCORSAllowedOrigins() []string {
+	args := s.Called()
+	return args.Get(0).([]string)
+}
+
+// GetCORSAllowedMethods is for mocking
+func (s *MockStore) GetCORSAllowedMethods() []string {
+	args := s.Called()
+	return args.Get(0).([]string)
+}
+
+// GetCORSAllowedHeaders is for mocking
+func (s *MockStore) GetCORSAllowedHeaders() []string {
+	args := s.Called()
+	return args.Get(0).([]string)
+}
+
+// GetCORSAllowCredentials is for mocking
+func (s *MockStore) GetCORSAllowCredentials() bool {
+	args := s.Called()
+	return args.Bool(0)
+}
+
+// GetCORSMaxAge is for mocking
+func (s *MockStore) GetCORSMaxAge() time.Duration {
+	args := s.Called()
+	return args.Get(0).(time.Duration)
+}
@@ -18,3 +18,9 @@ func (s *MockErrorParser) FromDBRowScan(err error, _type string) error {
 	args := s.Called(err, _type)
 	return args.Error(0)
 }
+
+// FromDBExec is for mocking
+func (s *MockErrorParser) FromDBExec(err error) error {
+	args := s.Called(err)
+	return args.Error(0)
+}
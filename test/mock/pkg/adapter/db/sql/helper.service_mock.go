@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"context"
 	goSql "database/sql"
 
 	"github.com/stretchr/testify/mock"
@@ -17,36 +18,36 @@ type MockHelperService struct {
 var _ sql.HelperService = &MockHelperService{}
 
 // ExecForSingleItem is for mocking
-func (s *MockHelperService) ExecForSingleItem(db *goSql.DB, query string, args ...interface{}) error {
+func (s *MockHelperService) ExecForSingleItem(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) error {
 	allArgs := make([]interface{}, 0)
-	allArgs = append(allArgs, db, query)
+	allArgs = append(allArgs, ctx, db, query, _type)
 	allArgs = append(allArgs, args...)
 	a := s.Called(allArgs...)
 	return a.Error(0)
 }
 
 // SingleRowQuery is for mocking
-func (s *MockHelperService) SingleRowQuery(db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
+func (s *MockHelperService) SingleRowQuery(ctx context.Context, db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
 	allArgs := make([]interface{}, 0)
-	allArgs = append(allArgs, db, query, scanFunc, _type)
+	allArgs = append(allArgs, ctx, db, query, scanFunc, _type)
 	allArgs = append(allArgs, args...)
 	a := s.Called(allArgs...)
 	return a.Error(0)
 }
 
 // ManyRowsQuery is for mocking
-func (s *MockHelperService) ManyRowsQuery(db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
+func (s *MockHelperService) ManyRowsQuery(ctx context.Context, db *goSql.DB, query string, scanFunc sql.ScanFunc, _type string, args ...interface{}) error {
 	allArgs := make([]interface{}, 0)
-	allArgs = append(allArgs, db, query, scanFunc, _type)
+	allArgs = append(allArgs, ctx, db, query, scanFunc, _type)
 	allArgs = append(allArgs, args...)
 	a := s.Called(allArgs...)
 	return a.Error(0)
 }
 
 // SingleQueryForID is for mocking
-func (s *MockHelperService) SingleQueryForID(db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error) {
+func (s *MockHelperService) SingleQueryForID(ctx context.Context, db *goSql.DB, query string, _type string, args ...interface{}) (entity.ID, error) {
 	allArgs := make([]interface{}, 0)
-	allArgs = append(allArgs, db, query, _type)
+	allArgs = append(allArgs, ctx, db, query, _type)
 	allArgs = append(allArgs, args...)
 	a := s.Called(allArgs...)
 	return a.Get(0).(entity.ID), a.Error(1)
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+)
+
+// MockClientIdentifier is for mocking
+type MockClientIdentifier struct {
+	mock.Mock
+}
+
+var _ http.ClientIdentifier = &MockClientIdentifier{}
+
+// Identify is for mocking
+func (m *MockClientIdentifier) Identify(apiKey string, remoteAddr string) string {
+	args := m.Called(apiKey, remoteAddr)
+	return args.String(0)
+}
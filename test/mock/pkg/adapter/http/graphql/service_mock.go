@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ graphql.Service = &MockService{}
+
+// Execute is for mocking
+func (s *MockService) Execute(ctx context.Context, vo *graphql.RequestVO) ([]byte, error) {
+	args := s.Called(ctx, vo)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+func safeArgsGetBytes(args mock.Arguments, idx int) []byte {
+	if val, ok := args.Get(idx).([]byte); ok {
+		return val
+	}
+	return nil
+}
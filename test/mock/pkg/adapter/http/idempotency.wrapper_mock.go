@@ -0,0 +1,23 @@
+package http
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+)
+
+// MockIdempotencyWrapper is for mocking
+type MockIdempotencyWrapper struct {
+	mock.Mock
+}
+
+var _ http.IdempotencyWrapper = &MockIdempotencyWrapper{}
+
+// Wrap is for mocking
+func (m *MockIdempotencyWrapper) Wrap(pattern http.HandlerPattern, handler http.Handler) http.Handler {
+	args := m.Called(pattern, handler)
+	if val, ok := args.Get(0).(http.Handler); ok {
+		return val
+	}
+	return nil
+}
@@ -3,8 +3,17 @@ package json
 import (
 	"github.com/stretchr/testify/mock"
 
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 // MockDecoderService is for mocking
@@ -26,6 +35,60 @@ func (d *MockDecoderService) ToInventoryUpdateItemVo(json []byte) (*inventory.Up
 	return safeArgsGetUpdateItemVo(args, 0), args.Error(1)
 }
 
+// ToInventoryCheckoutVo is for mocking
+func (d *MockDecoderService) ToInventoryCheckoutVo(json []byte) (*inventory.CheckoutVO, error) {
+	args := d.Called(json)
+	return safeArgsGetCheckoutVo(args, 0), args.Error(1)
+}
+
+// ToTitleCreateTitleVo is for mocking
+func (d *MockDecoderService) ToTitleCreateTitleVo(json []byte) (*title.CreateTitleVO, error) {
+	args := d.Called(json)
+	return safeArgsGetCreateTitleVo(args, 0), args.Error(1)
+}
+
+// ToTitleUpdateTitleVo is for mocking
+func (d *MockDecoderService) ToTitleUpdateTitleVo(json []byte) (*title.UpdateTitleVO, error) {
+	args := d.Called(json)
+	return safeArgsGetUpdateTitleVo(args, 0), args.Error(1)
+}
+
+// ToMediaFormatUpdateFormatVo is for mocking
+func (d *MockDecoderService) ToMediaFormatUpdateFormatVo(json []byte) (*mediaformat.UpdateFormatVO, error) {
+	args := d.Called(json)
+	return safeArgsGetUpdateFormatVo(args, 0), args.Error(1)
+}
+
+// ToAccountCreateAccountVo is for mocking
+func (d *MockDecoderService) ToAccountCreateAccountVo(json []byte) (*account.CreateAccountVO, error) {
+	args := d.Called(json)
+	return safeArgsGetCreateAccountVo(args, 0), args.Error(1)
+}
+
+// ToAccountUpdateAccountVo is for mocking
+func (d *MockDecoderService) ToAccountUpdateAccountVo(json []byte) (*account.UpdateAccountVO, error) {
+	args := d.Called(json)
+	return safeArgsGetUpdateAccountVo(args, 0), args.Error(1)
+}
+
+// ToHoldPlaceHoldVo is for mocking
+func (d *MockDecoderService) ToHoldPlaceHoldVo(json []byte) (*hold.PlaceHoldVO, error) {
+	args := d.Called(json)
+	return safeArgsGetPlaceHoldVo(args, 0), args.Error(1)
+}
+
+// ToLedgerRecordPaymentVo is for mocking
+func (d *MockDecoderService) ToLedgerRecordPaymentVo(json []byte) (*ledger.RecordPaymentVO, error) {
+	args := d.Called(json)
+	return safeArgsGetRecordPaymentVo(args, 0), args.Error(1)
+}
+
+// ToLedgerRecordAdjustmentVo is for mocking
+func (d *MockDecoderService) ToLedgerRecordAdjustmentVo(json []byte) (*ledger.RecordAdjustmentVO, error) {
+	args := d.Called(json)
+	return safeArgsGetRecordAdjustmentVo(args, 0), args.Error(1)
+}
+
 func safeArgsGetCreateItemVo(args mock.Arguments, idx int) *inventory.CreateItemVO {
 	if val, ok := args.Get(idx).(*inventory.CreateItemVO); ok {
 		return val
@@ -39,3 +102,157 @@ func safeArgsGetUpdateItemVo(args mock.Arguments, idx int) *inventory.UpdateItem
 	}
 	return nil
 }
+
+func safeArgsGetCreateTitleVo(args mock.Arguments, idx int) *title.CreateTitleVO {
+	if val, ok := args.Get(idx).(*title.CreateTitleVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetUpdateTitleVo(args mock.Arguments, idx int) *title.UpdateTitleVO {
+	if val, ok := args.Get(idx).(*title.UpdateTitleVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetUpdateFormatVo(args mock.Arguments, idx int) *mediaformat.UpdateFormatVO {
+	if val, ok := args.Get(idx).(*mediaformat.UpdateFormatVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetCheckoutVo(args mock.Arguments, idx int) *inventory.CheckoutVO {
+	if val, ok := args.Get(idx).(*inventory.CheckoutVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetCreateAccountVo(args mock.Arguments, idx int) *account.CreateAccountVO {
+	if val, ok := args.Get(idx).(*account.CreateAccountVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetUpdateAccountVo(args mock.Arguments, idx int) *account.UpdateAccountVO {
+	if val, ok := args.Get(idx).(*account.UpdateAccountVO); ok {
+		return val
+	}
+	return nil
+}
+
+// ToInventoryMoveVo is for mocking
+func (d *MockDecoderService) ToInventoryMoveVo(json []byte) (*inventory.MoveVO, error) {
+	args := d.Called(json)
+	return safeArgsGetMoveVo(args, 0), args.Error(1)
+}
+
+// ToLocationCreateLocationVo is for mocking
+func (d *MockDecoderService) ToLocationCreateLocationVo(json []byte) (*location.CreateLocationVO, error) {
+	args := d.Called(json)
+	return safeArgsGetCreateLocationVo(args, 0), args.Error(1)
+}
+
+// ToWebhookCreateSubscriptionVo is for mocking
+func (d *MockDecoderService) ToWebhookCreateSubscriptionVo(json []byte) (*webhook.CreateSubscriptionVO, error) {
+	args := d.Called(json)
+	return safeArgsGetCreateSubscriptionVo(args, 0), args.Error(1)
+}
+
+// ToGraphQLRequestVo is for mocking
+func (d *MockDecoderService) ToGraphQLRequestVo(json []byte) (*graphql.RequestVO, error) {
+	args := d.Called(json)
+	return safeArgsGetGraphQLRequestVo(args, 0), args.Error(1)
+}
+
+// ToInventoryItemView is for mocking
+func (d *MockDecoderService) ToInventoryItemView(json []byte) (*inventory.ViewVO, error) {
+	args := d.Called(json)
+	return safeArgsGetInventoryItemView(args, 0), args.Error(1)
+}
+
+// ToInventoryItemThinViews is for mocking
+func (d *MockDecoderService) ToInventoryItemThinViews(json []byte) ([]inventory.ThinViewVO, error) {
+	args := d.Called(json)
+	return safeArgsGetInventoryItemThinViews(args, 0), args.Error(1)
+}
+
+// ToRentalReceiptLine is for mocking
+func (d *MockDecoderService) ToRentalReceiptLine(json []byte) (*rental.ReceiptLineVO, error) {
+	args := d.Called(json)
+	return safeArgsGetRentalReceiptLine(args, 0), args.Error(1)
+}
+
+func safeArgsGetPlaceHoldVo(args mock.Arguments, idx int) *hold.PlaceHoldVO {
+	if val, ok := args.Get(idx).(*hold.PlaceHoldVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetRecordPaymentVo(args mock.Arguments, idx int) *ledger.RecordPaymentVO {
+	if val, ok := args.Get(idx).(*ledger.RecordPaymentVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetRecordAdjustmentVo(args mock.Arguments, idx int) *ledger.RecordAdjustmentVO {
+	if val, ok := args.Get(idx).(*ledger.RecordAdjustmentVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetMoveVo(args mock.Arguments, idx int) *inventory.MoveVO {
+	if val, ok := args.Get(idx).(*inventory.MoveVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetCreateLocationVo(args mock.Arguments, idx int) *location.CreateLocationVO {
+	if val, ok := args.Get(idx).(*location.CreateLocationVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetCreateSubscriptionVo(args mock.Arguments, idx int) *webhook.CreateSubscriptionVO {
+	if val, ok := args.Get(idx).(*webhook.CreateSubscriptionVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetGraphQLRequestVo(args mock.Arguments, idx int) *graphql.RequestVO {
+	if val, ok := args.Get(idx).(*graphql.RequestVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetInventoryItemView(args mock.Arguments, idx int) *inventory.ViewVO {
+	if val, ok := args.Get(idx).(*inventory.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetInventoryItemThinViews(args mock.Arguments, idx int) []inventory.ThinViewVO {
+	if val, ok := args.Get(idx).([]inventory.ThinViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetRentalReceiptLine(args mock.Arguments, idx int) *rental.ReceiptLineVO {
+	if val, ok := args.Get(idx).(*rental.ReceiptLineVO); ok {
+		return val
+	}
+	return nil
+}
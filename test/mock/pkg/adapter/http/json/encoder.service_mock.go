@@ -4,7 +4,16 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 // MockEncoderService is for mocking
@@ -26,6 +35,138 @@ func (d *MockEncoderService) FromInventoryItemThinViews(views []inventory.ThinVi
 	return safeArgsGetBytes(args, 0), args.Error(1)
 }
 
+// FromTitleView is for mocking
+func (d *MockEncoderService) FromTitleView(view *title.ViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromTitleThinViews is for mocking
+func (d *MockEncoderService) FromTitleThinViews(views []title.ThinViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromMediaFormatView is for mocking
+func (d *MockEncoderService) FromMediaFormatView(view *mediaformat.ViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromMediaFormatViews is for mocking
+func (d *MockEncoderService) FromMediaFormatViews(views []mediaformat.ViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromAccountView is for mocking
+func (d *MockEncoderService) FromAccountView(view *account.ViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromAccountThinViews is for mocking
+func (d *MockEncoderService) FromAccountThinViews(views []account.ThinViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromRentalViews is for mocking
+func (d *MockEncoderService) FromRentalViews(views []rental.ViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromRentalReceiptLine is for mocking
+func (d *MockEncoderService) FromRentalReceiptLine(line *rental.ReceiptLineVO) ([]byte, error) {
+	args := d.Called(line)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromRentalRenewalReceiptLine is for mocking
+func (d *MockEncoderService) FromRentalRenewalReceiptLine(line *rental.RenewalReceiptLineVO) ([]byte, error) {
+	args := d.Called(line)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromHoldViews is for mocking
+func (d *MockEncoderService) FromHoldViews(views []hold.ViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromLedgerStatement is for mocking
+func (d *MockEncoderService) FromLedgerStatement(statement *ledger.StatementVO) ([]byte, error) {
+	args := d.Called(statement)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromInventoryMoveViews is for mocking
+func (d *MockEncoderService) FromInventoryMoveViews(views []inventory.MoveViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromInventoryShelfView is for mocking
+func (d *MockEncoderService) FromInventoryShelfView(view *inventory.ShelfViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromLocationView is for mocking
+func (d *MockEncoderService) FromLocationView(view *location.ViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromLocationViews is for mocking
+func (d *MockEncoderService) FromLocationViews(views []location.ViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromWebhookSubscriptionView is for mocking
+func (d *MockEncoderService) FromWebhookSubscriptionView(view *webhook.SubscriptionViewVO) ([]byte, error) {
+	args := d.Called(view)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromWebhookSubscriptionViews is for mocking
+func (d *MockEncoderService) FromWebhookSubscriptionViews(views []webhook.SubscriptionViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromWebhookDeliveryViews is for mocking
+func (d *MockEncoderService) FromWebhookDeliveryViews(views []webhook.DeliveryViewVO) ([]byte, error) {
+	args := d.Called(views)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromOutboxMessage is for mocking
+func (d *MockEncoderService) FromOutboxMessage(msg outbox.Message) ([]byte, error) {
+	args := d.Called(msg)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromInventoryCreateItemVo is for mocking
+func (d *MockEncoderService) FromInventoryCreateItemVo(vo *inventory.CreateItemVO) ([]byte, error) {
+	args := d.Called(vo)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromInventoryUpdateItemVo is for mocking
+func (d *MockEncoderService) FromInventoryUpdateItemVo(vo *inventory.UpdateItemVO) ([]byte, error) {
+	args := d.Called(vo)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
+// FromInventoryCheckoutVo is for mocking
+func (d *MockEncoderService) FromInventoryCheckoutVo(vo *inventory.CheckoutVO) ([]byte, error) {
+	args := d.Called(vo)
+	return safeArgsGetBytes(args, 0), args.Error(1)
+}
+
 func safeArgsGetBytes(args mock.Arguments, idx int) []byte {
 	if val, ok := args.Get(idx).([]byte); ok {
 		return val
@@ -0,0 +1,24 @@
+package label
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+// MockEncoderService is for mocking
+type MockEncoderService struct {
+	mock.Mock
+}
+
+var _ label.EncoderService = &MockEncoderService{}
+
+// FromInventoryLabels is for mocking
+func (e *MockEncoderService) FromInventoryLabels(format label.Format, vos []inventory.LabelVO) ([]byte, error) {
+	args := e.Called(format, vos)
+	if val, ok := args.Get(0).([]byte); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
@@ -19,3 +20,36 @@ func (p *MockParameterConverter) ToEntityID(m map[string]string, param string) (
 	args := p.Called(m, param)
 	return args.Get(0).(entity.ID), args.Error(1)
 }
+
+// ToFormat is for mocking
+func (p *MockParameterConverter) ToFormat(m map[string]string, param string) (entity.Format, error) {
+	args := p.Called(m, param)
+	return args.Get(0).(entity.Format), args.Error(1)
+}
+
+// ToBarcode is for mocking
+func (p *MockParameterConverter) ToBarcode(m map[string]string, param string) (string, error) {
+	args := p.Called(m, param)
+	return args.String(0), args.Error(1)
+}
+
+// ToString is for mocking
+func (p *MockParameterConverter) ToString(m map[string]string, param string) (string, error) {
+	args := p.Called(m, param)
+	return args.String(0), args.Error(1)
+}
+
+// ToEntityIDs is for mocking
+func (p *MockParameterConverter) ToEntityIDs(m map[string][]string, param string) ([]entity.ID, error) {
+	args := p.Called(m, param)
+	if val, ok := args.Get(0).([]entity.ID); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ToLabelFormat is for mocking
+func (p *MockParameterConverter) ToLabelFormat(m map[string][]string, param string) (label.Format, error) {
+	args := p.Called(m, param)
+	return args.Get(0).(label.Format), args.Error(1)
+}
@@ -26,6 +26,18 @@ func (r *MockResponseFactory) CreateJSON(statusCode uint, body []byte) *http.Res
 	return args.Get(0).(*http.Response)
 }
 
+// CreateFile is for mocking
+func (r *MockResponseFactory) CreateFile(statusCode uint, contentType string, body []byte) *http.Response {
+	args := r.Called(statusCode, contentType, body)
+	return args.Get(0).(*http.Response)
+}
+
+// CreateStream is for mocking
+func (r *MockResponseFactory) CreateStream(statusCode uint, contentType string, stream http.Stream) *http.Response {
+	args := r.Called(statusCode, contentType, stream)
+	return args.Get(0).(*http.Response)
+}
+
 // CreateFromError is for mocking
 func (r *MockResponseFactory) CreateFromError(err error) *http.Response {
 	args := r.Called(err)
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// MockBackoffPolicy is for mocking
+type MockBackoffPolicy struct {
+	mock.Mock
+}
+
+var _ domain.BackoffPolicy = &MockBackoffPolicy{}
+
+// Next is for mocking
+func (b *MockBackoffPolicy) Next(attempts int, now time.Time) (time.Time, bool) {
+	args := b.Called(attempts, now)
+	return args.Get(0).(time.Time), args.Bool(1)
+}
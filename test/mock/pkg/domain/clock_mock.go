@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// MockClock is for mocking
+type MockClock struct {
+	mock.Mock
+}
+
+var _ domain.Clock = &MockClock{}
+
+// Now is for mocking
+func (c *MockClock) Now() time.Time {
+	args := c.Called()
+	return args.Get(0).(time.Time)
+}
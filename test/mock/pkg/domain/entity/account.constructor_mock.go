@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockAccountConstructor is for mocking
+type MockAccountConstructor struct {
+	mock.Mock
+}
+
+var _ entity.AccountConstructor = &MockAccountConstructor{}
+
+// New is for mocking
+func (a *MockAccountConstructor) New(name string, dateOfBirth *time.Time) (entity.Account, error) {
+	args := a.Called(name, dateOfBirth)
+	return safeArgsGetAccount(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (a *MockAccountConstructor) Reincarnate(id entity.ID, name string, dateOfBirth *time.Time, balance entity.Money) entity.Account {
+	args := a.Called(id, name, dateOfBirth, balance)
+	return safeArgsGetAccount(args, 0)
+}
+
+func safeArgsGetAccount(args mock.Arguments, idx int) entity.Account {
+	if val, ok := args.Get(idx).(entity.Account); ok {
+		return val
+	}
+	return nil
+}
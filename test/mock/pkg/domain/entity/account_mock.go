@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockAccount is for mocking
+type MockAccount struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.Account = &MockAccount{}
+
+// ID is for mocking
+func (a *MockAccount) ID() entity.ID {
+	args := a.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// Name is for mocking
+func (a *MockAccount) Name() string {
+	args := a.Called()
+	return args.String(0)
+}
+
+// DateOfBirth is for mocking
+func (a *MockAccount) DateOfBirth() *time.Time {
+	args := a.Called()
+	if val, ok := args.Get(0).(*time.Time); ok {
+		return val
+	}
+	return nil
+}
+
+// Balance is for mocking
+func (a *MockAccount) Balance() entity.Money {
+	args := a.Called()
+	return args.Get(0).(entity.Money)
+}
+
+// AgeOn is for mocking
+func (a *MockAccount) AgeOn(t time.Time) (int, bool) {
+	args := a.Called(t)
+	return args.Int(0), args.Bool(1)
+}
+
+// ChangeName is for mocking
+func (a *MockAccount) ChangeName(name string) error {
+	args := a.Called(name)
+	return args.Error(0)
+}
+
+// ChangeDateOfBirth is for mocking
+func (a *MockAccount) ChangeDateOfBirth(dateOfBirth *time.Time) error {
+	args := a.Called(dateOfBirth)
+	return args.Error(0)
+}
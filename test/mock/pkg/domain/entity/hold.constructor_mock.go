@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockHoldConstructor is for mocking
+type MockHoldConstructor struct {
+	mock.Mock
+}
+
+var _ entity.HoldConstructor = &MockHoldConstructor{}
+
+// New is for mocking
+func (h *MockHoldConstructor) New(titleID entity.ID, accountID entity.ID, placedAt time.Time) (entity.Hold, error) {
+	args := h.Called(titleID, accountID, placedAt)
+	return safeArgsGetHold(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (h *MockHoldConstructor) Reincarnate(id entity.ID, titleID entity.ID, accountID entity.ID, placedAt time.Time, status entity.HoldStatus, itemID entity.ID, expiresAt *time.Time) entity.Hold {
+	args := h.Called(id, titleID, accountID, placedAt, status, itemID, expiresAt)
+	return safeArgsGetHold(args, 0)
+}
+
+func safeArgsGetHold(args mock.Arguments, idx int) entity.Hold {
+	if val, ok := args.Get(idx).(entity.Hold); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockHold is for mocking
+type MockHold struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.Hold = &MockHold{}
+
+// ID is for mocking
+func (h *MockHold) ID() entity.ID {
+	args := h.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// TitleID is for mocking
+func (h *MockHold) TitleID() entity.ID {
+	args := h.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// AccountID is for mocking
+func (h *MockHold) AccountID() entity.ID {
+	args := h.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// PlacedAt is for mocking
+func (h *MockHold) PlacedAt() time.Time {
+	args := h.Called()
+	return args.Get(0).(time.Time)
+}
+
+// Status is for mocking
+func (h *MockHold) Status() entity.HoldStatus {
+	args := h.Called()
+	return args.Get(0).(entity.HoldStatus)
+}
+
+// ItemID is for mocking
+func (h *MockHold) ItemID() entity.ID {
+	args := h.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// ExpiresAt is for mocking
+func (h *MockHold) ExpiresAt() *time.Time {
+	args := h.Called()
+	if val, ok := args.Get(0).(*time.Time); ok {
+		return val
+	}
+	return nil
+}
+
+// IsActive is for mocking
+func (h *MockHold) IsActive() bool {
+	args := h.Called()
+	return args.Bool(0)
+}
+
+// IsExpired is for mocking
+func (h *MockHold) IsExpired(now time.Time) bool {
+	args := h.Called(now)
+	return args.Bool(0)
+}
+
+// Assign is for mocking
+func (h *MockHold) Assign(itemID entity.ID, expiresAt time.Time) error {
+	args := h.Called(itemID, expiresAt)
+	return args.Error(0)
+}
+
+// Fulfil is for mocking
+func (h *MockHold) Fulfil() error {
+	args := h.Called()
+	return args.Error(0)
+}
+
+// Cancel is for mocking
+func (h *MockHold) Cancel() error {
+	args := h.Called()
+	return args.Error(0)
+}
+
+// Expire is for mocking
+func (h *MockHold) Expire() error {
+	args := h.Called()
+	return args.Error(0)
+}
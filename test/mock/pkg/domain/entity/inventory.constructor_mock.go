@@ -14,14 +14,14 @@ type MockInventoryItemConstructor struct {
 var _ entity.InventoryItemConstructor = &MockInventoryItemConstructor{}
 
 // NewAvailable is for mocking
-func (i *MockInventoryItemConstructor) NewAvailable(name string, location string) (entity.InventoryItem, error) {
-	args := i.Called(name, location)
+func (i *MockInventoryItemConstructor) NewAvailable(titleID entity.ID, format entity.Format, barcode string, location entity.Location) (entity.InventoryItem, error) {
+	args := i.Called(titleID, format, barcode, location)
 	return safeArgsGetInventoryItem(args, 0), args.Error(1)
 }
 
 // Reincarnate is for mocking
-func (i *MockInventoryItemConstructor) Reincarnate(id entity.ID, name string, location string, available bool) entity.InventoryItem {
-	args := i.Called(id, name, location, available)
+func (i *MockInventoryItemConstructor) Reincarnate(id entity.ID, titleID entity.ID, format entity.Format, barcode string, location entity.Location, available bool) entity.InventoryItem {
+	args := i.Called(id, titleID, format, barcode, location, available)
 	return safeArgsGetInventoryItem(args, 0)
 }
 
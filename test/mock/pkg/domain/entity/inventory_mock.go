@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
@@ -21,16 +23,28 @@ func (i *MockInventoryItem) ID() entity.ID {
 	return args.Get(0).(entity.ID)
 }
 
-// Name is for mocking
-func (i *MockInventoryItem) Name() string {
+// TitleID is for mocking
+func (i *MockInventoryItem) TitleID() entity.ID {
+	args := i.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// Format is for mocking
+func (i *MockInventoryItem) Format() entity.Format {
+	args := i.Called()
+	return args.Get(0).(entity.Format)
+}
+
+// Barcode is for mocking
+func (i *MockInventoryItem) Barcode() string {
 	args := i.Called()
 	return args.String(0)
 }
 
 // Location is for mocking
-func (i *MockInventoryItem) Location() string {
+func (i *MockInventoryItem) Location() entity.Location {
 	args := i.Called()
-	return args.String(0)
+	return args.Get(0).(entity.Location)
 }
 
 // IsAvailable is for mocking
@@ -46,8 +60,8 @@ func (i *MockInventoryItem) InitID(id entity.ID) error {
 }
 
 // Checkout is for mocking
-func (i *MockInventoryItem) Checkout() error {
-	args := i.Called()
+func (i *MockInventoryItem) Checkout(accountID entity.ID) error {
+	args := i.Called(accountID)
 	return args.Error(0)
 }
 
@@ -57,14 +71,51 @@ func (i *MockInventoryItem) CheckIn() error {
 	return args.Error(0)
 }
 
-// ChangeName is for mocking
-func (i *MockInventoryItem) ChangeName(name string) error {
-	args := i.Called(name)
+// Renew is for mocking
+func (i *MockInventoryItem) Renew(accountID entity.ID, dueAt time.Time) error {
+	args := i.Called(accountID, dueAt)
+	return args.Error(0)
+}
+
+// PutAside is for mocking
+func (i *MockInventoryItem) PutAside(holdID entity.ID, accountID entity.ID) {
+	i.Called(holdID, accountID)
+}
+
+// Delete is for mocking
+func (i *MockInventoryItem) Delete() {
+	i.Called()
+}
+
+// PullEvents is for mocking
+func (i *MockInventoryItem) PullEvents() []entity.Event {
+	args := i.Called()
+	if val, ok := args.Get(0).([]entity.Event); ok {
+		return val
+	}
+	return nil
+}
+
+// ChangeTitle is for mocking
+func (i *MockInventoryItem) ChangeTitle(titleID entity.ID) error {
+	args := i.Called(titleID)
+	return args.Error(0)
+}
+
+// ChangeFormat is for mocking
+func (i *MockInventoryItem) ChangeFormat(format entity.Format) error {
+	args := i.Called(format)
+	return args.Error(0)
+}
+
+// ChangeBarcode is for mocking
+func (i *MockInventoryItem) ChangeBarcode(barcode string) error {
+	args := i.Called(barcode)
 	return args.Error(0)
 }
 
 // ChangeLocation is for mocking
-func (i *MockInventoryItem) ChangeLocation(location string) error {
+func (i *MockInventoryItem) ChangeLocation(location entity.Location) error {
 	args := i.Called(location)
 	return args.Error(0)
 }
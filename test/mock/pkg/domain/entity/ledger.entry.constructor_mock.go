@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockLedgerEntryConstructor is for mocking
+type MockLedgerEntryConstructor struct {
+	mock.Mock
+}
+
+var _ entity.LedgerEntryConstructor = &MockLedgerEntryConstructor{}
+
+// NewCharge is for mocking
+func (l *MockLedgerEntryConstructor) NewCharge(accountID entity.ID, rentalID entity.ID, amount entity.Money, note string, recordedAt time.Time) (entity.LedgerEntry, error) {
+	args := l.Called(accountID, rentalID, amount, note, recordedAt)
+	return safeArgsGetLedgerEntry(args, 0), args.Error(1)
+}
+
+// NewPayment is for mocking
+func (l *MockLedgerEntryConstructor) NewPayment(accountID entity.ID, amount entity.Money, method entity.PaymentMethod, recordedAt time.Time) (entity.LedgerEntry, error) {
+	args := l.Called(accountID, amount, method, recordedAt)
+	return safeArgsGetLedgerEntry(args, 0), args.Error(1)
+}
+
+// NewAdjustment is for mocking
+func (l *MockLedgerEntryConstructor) NewAdjustment(accountID entity.ID, amount entity.Money, note string, recordedAt time.Time) (entity.LedgerEntry, error) {
+	args := l.Called(accountID, amount, note, recordedAt)
+	return safeArgsGetLedgerEntry(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (l *MockLedgerEntryConstructor) Reincarnate(id entity.ID, accountID entity.ID, kind entity.LedgerEntryKind, amount entity.Money, method entity.PaymentMethod, rentalID entity.ID, note string, recordedAt time.Time) entity.LedgerEntry {
+	args := l.Called(id, accountID, kind, amount, method, rentalID, note, recordedAt)
+	return safeArgsGetLedgerEntry(args, 0)
+}
+
+func safeArgsGetLedgerEntry(args mock.Arguments, idx int) entity.LedgerEntry {
+	if val, ok := args.Get(idx).(entity.LedgerEntry); ok {
+		return val
+	}
+	return nil
+}
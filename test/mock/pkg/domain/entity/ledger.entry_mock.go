@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockLedgerEntry is for mocking
+type MockLedgerEntry struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.LedgerEntry = &MockLedgerEntry{}
+
+// ID is for mocking
+func (l *MockLedgerEntry) ID() entity.ID {
+	args := l.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// AccountID is for mocking
+func (l *MockLedgerEntry) AccountID() entity.ID {
+	args := l.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// Kind is for mocking
+func (l *MockLedgerEntry) Kind() entity.LedgerEntryKind {
+	args := l.Called()
+	return args.Get(0).(entity.LedgerEntryKind)
+}
+
+// Amount is for mocking
+func (l *MockLedgerEntry) Amount() entity.Money {
+	args := l.Called()
+	return args.Get(0).(entity.Money)
+}
+
+// Method is for mocking
+func (l *MockLedgerEntry) Method() entity.PaymentMethod {
+	args := l.Called()
+	return args.Get(0).(entity.PaymentMethod)
+}
+
+// RentalID is for mocking
+func (l *MockLedgerEntry) RentalID() entity.ID {
+	args := l.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// Note is for mocking
+func (l *MockLedgerEntry) Note() string {
+	args := l.Called()
+	return args.String(0)
+}
+
+// RecordedAt is for mocking
+func (l *MockLedgerEntry) RecordedAt() time.Time {
+	args := l.Called()
+	return args.Get(0).(time.Time)
+}
+
+// BalanceEffect is for mocking
+func (l *MockLedgerEntry) BalanceEffect() entity.Money {
+	args := l.Called()
+	return args.Get(0).(entity.Money)
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockMediaFormatConstructor is for mocking
+type MockMediaFormatConstructor struct {
+	mock.Mock
+}
+
+var _ entity.MediaFormatConstructor = &MockMediaFormatConstructor{}
+
+// Reincarnate is for mocking
+func (m *MockMediaFormatConstructor) Reincarnate(format entity.Format, name string, rentalPrice entity.Money, rentalPeriodDays int) entity.MediaFormat {
+	args := m.Called(format, name, rentalPrice, rentalPeriodDays)
+	return safeArgsGetMediaFormat(args, 0)
+}
+
+func safeArgsGetMediaFormat(args mock.Arguments, idx int) entity.MediaFormat {
+	if val, ok := args.Get(idx).(entity.MediaFormat); ok {
+		return val
+	}
+	return nil
+}
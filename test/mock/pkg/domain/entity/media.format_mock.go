@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockMediaFormat is for mocking
+type MockMediaFormat struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.MediaFormat = &MockMediaFormat{}
+
+// Format is for mocking
+func (m *MockMediaFormat) Format() entity.Format {
+	args := m.Called()
+	return args.Get(0).(entity.Format)
+}
+
+// Name is for mocking
+func (m *MockMediaFormat) Name() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// RentalPrice is for mocking
+func (m *MockMediaFormat) RentalPrice() entity.Money {
+	args := m.Called()
+	return args.Get(0).(entity.Money)
+}
+
+// RentalPeriodDays is for mocking
+func (m *MockMediaFormat) RentalPeriodDays() int {
+	args := m.Called()
+	return args.Int(0)
+}
+
+// ChangeName is for mocking
+func (m *MockMediaFormat) ChangeName(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// ChangeRentalPrice is for mocking
+func (m *MockMediaFormat) ChangeRentalPrice(rentalPrice entity.Money) error {
+	args := m.Called(rentalPrice)
+	return args.Error(0)
+}
+
+// ChangeRentalPeriodDays is for mocking
+func (m *MockMediaFormat) ChangeRentalPeriodDays(rentalPeriodDays int) error {
+	args := m.Called(rentalPeriodDays)
+	return args.Error(0)
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockRentalConstructor is for mocking
+type MockRentalConstructor struct {
+	mock.Mock
+}
+
+var _ entity.RentalConstructor = &MockRentalConstructor{}
+
+// New is for mocking
+func (r *MockRentalConstructor) New(itemID entity.ID, accountID entity.ID, checkedOutAt time.Time, rentalPeriodDays int) (entity.Rental, error) {
+	args := r.Called(itemID, accountID, checkedOutAt, rentalPeriodDays)
+	return safeArgsGetRental(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (r *MockRentalConstructor) Reincarnate(id entity.ID, itemID entity.ID, accountID entity.ID, checkedOutAt time.Time, dueAt time.Time, returnedAt *time.Time, lateFee entity.Money, renewals int) entity.Rental {
+	args := r.Called(id, itemID, accountID, checkedOutAt, dueAt, returnedAt, lateFee, renewals)
+	return safeArgsGetRental(args, 0)
+}
+
+func safeArgsGetRental(args mock.Arguments, idx int) entity.Rental {
+	if val, ok := args.Get(idx).(entity.Rental); ok {
+		return val
+	}
+	return nil
+}
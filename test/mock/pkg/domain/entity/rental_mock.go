@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockRental is for mocking
+type MockRental struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.Rental = &MockRental{}
+
+// ID is for mocking
+func (r *MockRental) ID() entity.ID {
+	args := r.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// ItemID is for mocking
+func (r *MockRental) ItemID() entity.ID {
+	args := r.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// AccountID is for mocking
+func (r *MockRental) AccountID() entity.ID {
+	args := r.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// CheckedOutAt is for mocking
+func (r *MockRental) CheckedOutAt() time.Time {
+	args := r.Called()
+	return args.Get(0).(time.Time)
+}
+
+// DueAt is for mocking
+func (r *MockRental) DueAt() time.Time {
+	args := r.Called()
+	return args.Get(0).(time.Time)
+}
+
+// LateFee is for mocking
+func (r *MockRental) LateFee() entity.Money {
+	args := r.Called()
+	return args.Get(0).(entity.Money)
+}
+
+// ReturnedAt is for mocking
+func (r *MockRental) ReturnedAt() *time.Time {
+	args := r.Called()
+	if val, ok := args.Get(0).(*time.Time); ok {
+		return val
+	}
+	return nil
+}
+
+// Renewals is for mocking
+func (r *MockRental) Renewals() int {
+	args := r.Called()
+	return args.Int(0)
+}
+
+// IsReturned is for mocking
+func (r *MockRental) IsReturned() bool {
+	args := r.Called()
+	return args.Bool(0)
+}
+
+// IsOverdue is for mocking
+func (r *MockRental) IsOverdue(now time.Time) bool {
+	args := r.Called(now)
+	return args.Bool(0)
+}
+
+// Return is for mocking
+func (r *MockRental) Return(at time.Time, lateFee entity.Money) error {
+	args := r.Called(at, lateFee)
+	return args.Error(0)
+}
+
+// Renew is for mocking
+func (r *MockRental) Renew(rentalPeriodDays int) error {
+	args := r.Called(rentalPeriodDays)
+	return args.Error(0)
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockTitleConstructor is for mocking
+type MockTitleConstructor struct {
+	mock.Mock
+}
+
+var _ entity.TitleConstructor = &MockTitleConstructor{}
+
+// New is for mocking
+func (t *MockTitleConstructor) New(name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) (entity.Title, error) {
+	args := t.Called(name, year, runtime, synopsis, genres, cast, rating)
+	return safeArgsGetTitle(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (t *MockTitleConstructor) Reincarnate(id entity.ID, name string, year int, runtime int, synopsis string, genres []string, cast []string, rating string) entity.Title {
+	args := t.Called(id, name, year, runtime, synopsis, genres, cast, rating)
+	return safeArgsGetTitle(args, 0)
+}
+
+func safeArgsGetTitle(args mock.Arguments, idx int) entity.Title {
+	if val, ok := args.Get(idx).(entity.Title); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockTitle is for mocking
+type MockTitle struct {
+	mock.Mock
+	// Used to distinguish instances
+	Data string
+}
+
+var _ entity.Title = &MockTitle{}
+
+// ID is for mocking
+func (t *MockTitle) ID() entity.ID {
+	args := t.Called()
+	return args.Get(0).(entity.ID)
+}
+
+// Name is for mocking
+func (t *MockTitle) Name() string {
+	args := t.Called()
+	return args.String(0)
+}
+
+// Year is for mocking
+func (t *MockTitle) Year() int {
+	args := t.Called()
+	return args.Int(0)
+}
+
+// Runtime is for mocking
+func (t *MockTitle) Runtime() int {
+	args := t.Called()
+	return args.Int(0)
+}
+
+// Synopsis is for mocking
+func (t *MockTitle) Synopsis() string {
+	args := t.Called()
+	return args.String(0)
+}
+
+// Genres is for mocking
+func (t *MockTitle) Genres() []string {
+	args := t.Called()
+	return safeArgsGetStrings(args, 0)
+}
+
+// Cast is for mocking
+func (t *MockTitle) Cast() []string {
+	args := t.Called()
+	return safeArgsGetStrings(args, 0)
+}
+
+// Rating is for mocking
+func (t *MockTitle) Rating() string {
+	args := t.Called()
+	return args.String(0)
+}
+
+// ChangeName is for mocking
+func (t *MockTitle) ChangeName(name string) error {
+	args := t.Called(name)
+	return args.Error(0)
+}
+
+// ChangeYear is for mocking
+func (t *MockTitle) ChangeYear(year int) error {
+	args := t.Called(year)
+	return args.Error(0)
+}
+
+// ChangeRuntime is for mocking
+func (t *MockTitle) ChangeRuntime(runtime int) error {
+	args := t.Called(runtime)
+	return args.Error(0)
+}
+
+// ChangeSynopsis is for mocking
+func (t *MockTitle) ChangeSynopsis(synopsis string) error {
+	args := t.Called(synopsis)
+	return args.Error(0)
+}
+
+// ChangeGenres is for mocking
+func (t *MockTitle) ChangeGenres(genres []string) error {
+	args := t.Called(genres)
+	return args.Error(0)
+}
+
+// ChangeCast is for mocking
+func (t *MockTitle) ChangeCast(cast []string) error {
+	args := t.Called(cast)
+	return args.Error(0)
+}
+
+// ChangeRating is for mocking
+func (t *MockTitle) ChangeRating(rating string) error {
+	args := t.Called(rating)
+	return args.Error(0)
+}
+
+func safeArgsGetStrings(args mock.Arguments, idx int) []string {
+	if val, ok := args.Get(idx).([]string); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockWebhookDeliveryConstructor is for mocking
+type MockWebhookDeliveryConstructor struct {
+	mock.Mock
+}
+
+var _ entity.WebhookDeliveryConstructor = &MockWebhookDeliveryConstructor{}
+
+// New is for mocking
+func (w *MockWebhookDeliveryConstructor) New(
+	subscriptionID entity.ID,
+	eventID entity.ID,
+	eventType entity.EventType,
+	entityID entity.ID,
+	data map[string]interface{},
+	occurredAt time.Time,
+	now time.Time,
+) (entity.WebhookDelivery, error) {
+	args := w.Called(subscriptionID, eventID, eventType, entityID, data, occurredAt, now)
+	return safeArgsGetWebhookDelivery(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (w *MockWebhookDeliveryConstructor) Reincarnate(
+	id entity.ID,
+	subscriptionID entity.ID,
+	eventID entity.ID,
+	eventType entity.EventType,
+	entityID entity.ID,
+	data map[string]interface{},
+	occurredAt time.Time,
+	status entity.DeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastAttemptAt *time.Time,
+	lastStatusCode int,
+	lastError string,
+) entity.WebhookDelivery {
+	args := w.Called(id, subscriptionID, eventID, eventType, entityID, data, occurredAt,
+		status, attempts, nextAttemptAt, lastAttemptAt, lastStatusCode, lastError)
+	return safeArgsGetWebhookDelivery(args, 0)
+}
+
+func safeArgsGetWebhookDelivery(args mock.Arguments, idx int) entity.WebhookDelivery {
+	if val, ok := args.Get(idx).(entity.WebhookDelivery); ok {
+		return val
+	}
+	return nil
+}
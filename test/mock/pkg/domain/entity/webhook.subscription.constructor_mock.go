@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockWebhookSubscriptionConstructor is for mocking
+type MockWebhookSubscriptionConstructor struct {
+	mock.Mock
+}
+
+var _ entity.WebhookSubscriptionConstructor = &MockWebhookSubscriptionConstructor{}
+
+// New is for mocking
+func (w *MockWebhookSubscriptionConstructor) New(url string, eventTypes []entity.EventType, secret string, createdAt time.Time) (entity.WebhookSubscription, error) {
+	args := w.Called(url, eventTypes, secret, createdAt)
+	return safeArgsGetWebhookSubscription(args, 0), args.Error(1)
+}
+
+// Reincarnate is for mocking
+func (w *MockWebhookSubscriptionConstructor) Reincarnate(id entity.ID, url string, eventTypes []entity.EventType, secret string, createdAt time.Time) entity.WebhookSubscription {
+	args := w.Called(id, url, eventTypes, secret, createdAt)
+	return safeArgsGetWebhookSubscription(args, 0)
+}
+
+func safeArgsGetWebhookSubscription(args mock.Arguments, idx int) entity.WebhookSubscription {
+	if val, ok := args.Get(idx).(entity.WebhookSubscription); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockLateFeePolicy is for mocking
+type MockLateFeePolicy struct {
+	mock.Mock
+}
+
+var _ domain.LateFeePolicy = &MockLateFeePolicy{}
+
+// Calculate is for mocking
+func (l *MockLateFeePolicy) Calculate(format entity.Format, dueAt time.Time, returnedAt time.Time) domain.LateFee {
+	args := l.Called(format, dueAt, returnedAt)
+	return args.Get(0).(domain.LateFee)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockLocationFormat is for mocking
+type MockLocationFormat struct {
+	mock.Mock
+}
+
+var _ domain.LocationFormat = &MockLocationFormat{}
+
+// Parse is for mocking
+func (l *MockLocationFormat) Parse(text string) (entity.Location, error) {
+	args := l.Called(text)
+	return args.Get(0).(entity.Location), args.Error(1)
+}
+
+// Format is for mocking
+func (l *MockLocationFormat) Format(location entity.Location) string {
+	args := l.Called(location)
+	return args.String(0)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+// MockRatingScheme is for mocking
+type MockRatingScheme struct {
+	mock.Mock
+}
+
+var _ domain.RatingScheme = &MockRatingScheme{}
+
+// Validate is for mocking
+func (r *MockRatingScheme) Validate(rating string) error {
+	args := r.Called(rating)
+	return args.Error(0)
+}
+
+// MinimumAge is for mocking
+func (r *MockRatingScheme) MinimumAge(rating string) int {
+	args := r.Called(rating)
+	return args.Int(0)
+}
+
+// CheckAge is for mocking
+func (r *MockRatingScheme) CheckAge(rating string, renter entity.Account, on time.Time) error {
+	args := r.Called(rating, renter, on)
+	return args.Error(0)
+}
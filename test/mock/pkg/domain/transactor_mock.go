@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+// MockTransactor is for mocking. Unless the mocked call fails, the
+// work is run with the given context.
+type MockTransactor struct {
+	mock.Mock
+}
+
+var _ domain.Transactor = &MockTransactor{}
+
+// InTransaction is for mocking
+func (t *MockTransactor) InTransaction(ctx context.Context, work func(ctx context.Context) error) error {
+	args := t.Called(ctx)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return work(ctx)
+}
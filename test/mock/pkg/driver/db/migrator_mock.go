@@ -0,0 +1,50 @@
+package db
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/db"
+)
+
+// MockMigrator is for mocking
+type MockMigrator struct {
+	mock.Mock
+}
+
+var _ db.Migrator = &MockMigrator{}
+
+// Up is for mocking
+func (m *MockMigrator) Up() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Down is for mocking
+func (m *MockMigrator) Down(steps int) error {
+	args := m.Called(steps)
+	return args.Error(0)
+}
+
+// Goto is for mocking
+func (m *MockMigrator) Goto(version uint) error {
+	args := m.Called(version)
+	return args.Error(0)
+}
+
+// Force is for mocking
+func (m *MockMigrator) Force(version int) error {
+	args := m.Called(version)
+	return args.Error(0)
+}
+
+// Version is for mocking
+func (m *MockMigrator) Version() (uint, bool, error) {
+	args := m.Called()
+	return args.Get(0).(uint), args.Bool(1), args.Error(2)
+}
+
+// Close is for mocking
+func (m *MockMigrator) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc"
+)
+
+// MockErrorMapper is for mocking
+type MockErrorMapper struct {
+	mock.Mock
+}
+
+var _ grpc.ErrorMapper = &MockErrorMapper{}
+
+// ToStatus is for mocking
+func (e *MockErrorMapper) ToStatus(err error) error {
+	args := e.Called(err)
+	return args.Error(0)
+}
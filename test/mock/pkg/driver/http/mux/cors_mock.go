@@ -0,0 +1,28 @@
+package mux
+
+import (
+	goHttp "net/http"
+
+	"github.com/stretchr/testify/mock"
+
+	muxDriver "github.com/liampulles/matchstick-video/pkg/driver/http/mux"
+)
+
+// MockCORS is for mocking
+type MockCORS struct {
+	mock.Mock
+}
+
+var _ muxDriver.CORS = &MockCORS{}
+
+// Wrap is for mocking
+func (c *MockCORS) Wrap(next goHttp.Handler) goHttp.Handler {
+	args := c.Called(next)
+	return args.Get(0).(goHttp.Handler)
+}
+
+// Preflight is for mocking
+func (c *MockCORS) Preflight(methods []string) muxDriver.Handler {
+	args := c.Called(methods)
+	return args.Get(0).(func(goHttp.ResponseWriter, *goHttp.Request))
+}
@@ -36,6 +36,12 @@ func (r *RouterMock) HandleFunc(pathPattern string, handler muxDriver.Handler) m
 	return safeArgsGetRouteMock(args, 0)
 }
 
+// Use is for mocking
+func (r *RouterMock) Use(middleware ...muxDriver.Middleware) {
+	r.Called(middleware)
+	return
+}
+
 // ServeHTTP is for mocking
 func (r *RouterMock) ServeHTTP(res goHttp.ResponseWriter, req *goHttp.Request) {
 	r.Called(res, req)
@@ -61,6 +67,12 @@ func (w *MockWrapper) Vars(req *goHttp.Request) map[string]string {
 	return args.Get(0).(map[string]string)
 }
 
+// PathTemplate is for mocking
+func (w *MockWrapper) PathTemplate(req *goHttp.Request) string {
+	args := w.Called(req)
+	return args.String(0)
+}
+
 func safeArgsGetMuxRoute(args mock.Arguments, idx int) *mux.Route {
 	if val, ok := args.Get(idx).(*mux.Route); ok {
 		return val
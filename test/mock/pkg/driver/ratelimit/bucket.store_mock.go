@@ -0,0 +1,29 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/ratelimit"
+)
+
+// MockBucketStore is for mocking
+type MockBucketStore struct {
+	mock.Mock
+}
+
+var _ ratelimit.BucketStore = &MockBucketStore{}
+
+// Take is for mocking
+func (m *MockBucketStore) Take(ctx context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error) {
+	args := m.Called(ctx, key, limit)
+	return safeArgsGetResult(args, 0), args.Error(1)
+}
+
+func safeArgsGetResult(args mock.Arguments, idx int) *ratelimit.Result {
+	if val, ok := args.Get(idx).(*ratelimit.Result); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+// MockTracerService is for mocking
+type MockTracerService struct {
+	mock.Mock
+}
+
+var _ tracing.TracerService = &MockTracerService{}
+
+// Tracer is for mocking
+func (t *MockTracerService) Tracer() trace.Tracer {
+	args := t.Called()
+	return args.Get(0).(trace.Tracer)
+}
+
+// Provider is for mocking
+func (t *MockTracerService) Provider() trace.TracerProvider {
+	args := t.Called()
+	return args.Get(0).(trace.TracerProvider)
+}
+
+// Propagator is for mocking
+func (t *MockTracerService) Propagator() propagation.TextMapPropagator {
+	args := t.Called()
+	return args.Get(0).(propagation.TextMapPropagator)
+}
+
+// Shutdown is for mocking
+func (t *MockTracerService) Shutdown(ctx context.Context) error {
+	args := t.Called(ctx)
+	return args.Error(0)
+}
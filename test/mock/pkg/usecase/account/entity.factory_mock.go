@@ -0,0 +1,21 @@
+package account
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// MockEntityFactory is for mocking
+type MockEntityFactory struct {
+	mock.Mock
+}
+
+var _ account.EntityFactory = &MockEntityFactory{}
+
+// CreateFromVO is for mocking
+func (m *MockEntityFactory) CreateFromVO(vo *account.CreateAccountVO) (entity.Account, error) {
+	args := m.Called(vo)
+	return safeArgsGetAccount(args, 0), args.Error(1)
+}
@@ -0,0 +1,21 @@
+package account
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// MockEntityModifier is for mocking
+type MockEntityModifier struct {
+	mock.Mock
+}
+
+var _ account.EntityModifier = &MockEntityModifier{}
+
+// ModifyWithUpdateAccountVO is for mocking
+func (m *MockEntityModifier) ModifyWithUpdateAccountVO(e entity.Account, vo *account.UpdateAccountVO) error {
+	args := m.Called(e, vo)
+	return args.Error(0)
+}
@@ -0,0 +1,61 @@
+package account
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ account.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, e entity.Account) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByID is for mocking
+func (m *MockRepository) FindByID(ctx context.Context, id entity.ID) (entity.Account, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetAccount(args, 0), args.Error(1)
+}
+
+// FindAll is for mocking
+func (m *MockRepository) FindAll(ctx context.Context) ([]entity.Account, error) {
+	args := m.Called(ctx)
+	return safeArgsGetAccounts(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockRepository) Update(ctx context.Context, e entity.Account) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// DeleteByID is for mocking
+func (m *MockRepository) DeleteByID(ctx context.Context, id entity.ID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func safeArgsGetAccount(args mock.Arguments, idx int) entity.Account {
+	if val, ok := args.Get(idx).(entity.Account); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetAccounts(args mock.Arguments, idx int) []entity.Account {
+	if val, ok := args.Get(idx).([]entity.Account); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package account
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ account.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.Account, rentals []rental.ViewVO) *account.ViewVO {
+	args := v.Called(e, rentals)
+	return safeArgsGetViewVO(args, 0)
+}
+
+// CreateThinViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateThinViewVOsFromEntities(entities []entity.Account) []account.ThinViewVO {
+	args := v.Called(entities)
+	return safeArgsGetThinViewVOs(args, 0)
+}
+
+func safeArgsGetViewVO(args mock.Arguments, idx int) *account.ViewVO {
+	if val, ok := args.Get(idx).(*account.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetThinViewVOs(args mock.Arguments, idx int) []account.ThinViewVO {
+	if val, ok := args.Get(idx).([]account.ThinViewVO); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package hold
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// MockQueue is for mocking
+type MockQueue struct {
+	mock.Mock
+}
+
+var _ hold.Queue = &MockQueue{}
+
+// AssignNext is for mocking
+func (q *MockQueue) AssignNext(ctx context.Context, titleID entity.ID, itemID entity.ID) (entity.Hold, error) {
+	args := q.Called(ctx, titleID, itemID)
+	return safeArgsGetHold(args, 0), args.Error(1)
+}
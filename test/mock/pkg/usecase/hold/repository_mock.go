@@ -0,0 +1,87 @@
+package hold
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ hold.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, e entity.Hold) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByID is for mocking
+func (m *MockRepository) FindByID(ctx context.Context, id entity.ID) (entity.Hold, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetHold(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockRepository) Update(ctx context.Context, e entity.Hold) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// FindActiveByTitleID is for mocking
+func (m *MockRepository) FindActiveByTitleID(ctx context.Context, titleID entity.ID) ([]entity.Hold, error) {
+	args := m.Called(ctx, titleID)
+	return safeArgsGetHolds(args, 0), args.Error(1)
+}
+
+// FindNextWaitingByTitleID is for mocking
+func (m *MockRepository) FindNextWaitingByTitleID(ctx context.Context, titleID entity.ID) (entity.Hold, error) {
+	args := m.Called(ctx, titleID)
+	return safeArgsGetHold(args, 0), args.Error(1)
+}
+
+// FindReadyByItemID is for mocking
+func (m *MockRepository) FindReadyByItemID(ctx context.Context, itemID entity.ID) (entity.Hold, error) {
+	args := m.Called(ctx, itemID)
+	return safeArgsGetHold(args, 0), args.Error(1)
+}
+
+// MockItemRepository is for mocking
+type MockItemRepository struct {
+	mock.Mock
+}
+
+var _ hold.ItemRepository = &MockItemRepository{}
+
+// FindByID is for mocking
+func (m *MockItemRepository) FindByID(ctx context.Context, id entity.ID) (entity.InventoryItem, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetInventoryItem(args, 0), args.Error(1)
+}
+
+func safeArgsGetHold(args mock.Arguments, idx int) entity.Hold {
+	if val, ok := args.Get(idx).(entity.Hold); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetHolds(args mock.Arguments, idx int) []entity.Hold {
+	if val, ok := args.Get(idx).([]entity.Hold); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetInventoryItem(args mock.Arguments, idx int) entity.InventoryItem {
+	if val, ok := args.Get(idx).(entity.InventoryItem); ok {
+		return val
+	}
+	return nil
+}
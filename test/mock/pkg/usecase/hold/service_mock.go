@@ -0,0 +1,35 @@
+package hold
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ hold.Service = &MockService{}
+
+// Place is for mocking
+func (s *MockService) Place(ctx context.Context, titleID entity.ID, vo *hold.PlaceHoldVO) (entity.ID, error) {
+	args := s.Called(ctx, titleID, vo)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// ReadQueue is for mocking
+func (s *MockService) ReadQueue(ctx context.Context, titleID entity.ID) ([]hold.ViewVO, error) {
+	args := s.Called(ctx, titleID)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
+
+// Cancel is for mocking
+func (s *MockService) Cancel(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,37 @@
+package hold
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ hold.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.Hold) *hold.ViewVO {
+	args := v.Called(e)
+	if val, ok := args.Get(0).(*hold.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateViewVOsFromEntities(entities []entity.Hold) []hold.ViewVO {
+	args := v.Called(entities)
+	return safeArgsGetViewVOs(args, 0)
+}
+
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []hold.ViewVO {
+	if val, ok := args.Get(idx).([]hold.ViewVO); ok {
+		return val
+	}
+	return nil
+}
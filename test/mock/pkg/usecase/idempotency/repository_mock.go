@@ -0,0 +1,56 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ idempotency.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, record *idempotency.Record) (bool, error) {
+	args := m.Called(ctx, record)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindByKey is for mocking
+func (m *MockRepository) FindByKey(ctx context.Context, client string, key string) (*idempotency.Record, error) {
+	args := m.Called(ctx, client, key)
+	if val, ok := args.Get(0).(*idempotency.Record); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Finish is for mocking
+func (m *MockRepository) Finish(ctx context.Context, client string, key string, response *idempotency.ResponseVO) error {
+	args := m.Called(ctx, client, key, response)
+	return args.Error(0)
+}
+
+// DeleteByKey is for mocking
+func (m *MockRepository) DeleteByKey(ctx context.Context, client string, key string) error {
+	args := m.Called(ctx, client, key)
+	return args.Error(0)
+}
+
+// DeleteUnfinishedCreatedBefore is for mocking
+func (m *MockRepository) DeleteUnfinishedCreatedBefore(ctx context.Context, client string, key string, before time.Time) (bool, error) {
+	args := m.Called(ctx, client, key, before)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteCreatedBefore is for mocking
+func (m *MockRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) error {
+	args := m.Called(ctx, before)
+	return args.Error(0)
+}
@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ idempotency.Service = &MockService{}
+
+// Begin is for mocking
+func (m *MockService) Begin(ctx context.Context, client string, key string, fingerprint string) (*idempotency.ResponseVO, error) {
+	args := m.Called(ctx, client, key, fingerprint)
+	if val, ok := args.Get(0).(*idempotency.ResponseVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Finish is for mocking
+func (m *MockService) Finish(ctx context.Context, client string, key string, response *idempotency.ResponseVO) error {
+	args := m.Called(ctx, client, key, response)
+	return args.Error(0)
+}
+
+// Abandon is for mocking
+func (m *MockService) Abandon(ctx context.Context, client string, key string) error {
+	args := m.Called(ctx, client, key)
+	return args.Error(0)
+}
@@ -19,3 +19,9 @@ func (m *MockEntityModifier) ModifyWithUpdateItemVO(e entity.InventoryItem, vo *
 	args := m.Called(e, vo)
 	return args.Error(0)
 }
+
+// ModifyWithMoveVO is for mocking
+func (m *MockEntityModifier) ModifyWithMoveVO(e entity.InventoryItem, vo *inventory.MoveVO) error {
+	args := m.Called(e, vo)
+	return args.Error(0)
+}
@@ -1,6 +1,8 @@
 package inventory
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
@@ -15,35 +17,87 @@ type MockRepository struct {
 var _ inventory.Repository = &MockRepository{}
 
 // Create is for mocking
-func (m *MockRepository) Create(e entity.InventoryItem) (entity.ID, error) {
-	args := m.Called(e)
+func (m *MockRepository) Create(ctx context.Context, e entity.InventoryItem) (entity.ID, error) {
+	args := m.Called(ctx, e)
 	return args.Get(0).(entity.ID), args.Error(1)
 }
 
 // FindByID is for mocking
-func (m *MockRepository) FindByID(id entity.ID) (entity.InventoryItem, error) {
-	args := m.Called(id)
+func (m *MockRepository) FindByID(ctx context.Context, id entity.ID) (entity.InventoryItem, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetInventoryItem(args, 0), args.Error(1)
+}
+
+// FindByBarcode is for mocking
+func (m *MockRepository) FindByBarcode(ctx context.Context, barcode string) (entity.InventoryItem, error) {
+	args := m.Called(ctx, barcode)
 	return safeArgsGetInventoryItem(args, 0), args.Error(1)
 }
 
 // FindAll is for mocking
-func (m *MockRepository) FindAll() ([]entity.InventoryItem, error) {
-	args := m.Called()
+func (m *MockRepository) FindAll(ctx context.Context) ([]entity.InventoryItem, error) {
+	args := m.Called(ctx)
+	return safeArgsGetInventoryItems(args, 0), args.Error(1)
+}
+
+// FindByIDs is for mocking
+func (m *MockRepository) FindByIDs(ctx context.Context, ids []entity.ID) ([]entity.InventoryItem, error) {
+	args := m.Called(ctx, ids)
+	return safeArgsGetInventoryItems(args, 0), args.Error(1)
+}
+
+// FindAllOfTitles is for mocking
+func (m *MockRepository) FindAllOfTitles(ctx context.Context, titleIDs []entity.ID) ([]entity.InventoryItem, error) {
+	args := m.Called(ctx, titleIDs)
+	return safeArgsGetInventoryItems(args, 0), args.Error(1)
+}
+
+// FindAllOfFormat is for mocking
+func (m *MockRepository) FindAllOfFormat(ctx context.Context, format entity.Format) ([]entity.InventoryItem, error) {
+	args := m.Called(ctx, format)
+	return safeArgsGetInventoryItems(args, 0), args.Error(1)
+}
+
+// FindAllOnShelf is for mocking
+func (m *MockRepository) FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.InventoryItem, error) {
+	args := m.Called(ctx, store, aisle, shelf)
 	return safeArgsGetInventoryItems(args, 0), args.Error(1)
 }
 
 // Update is for mocking
-func (m *MockRepository) Update(e entity.InventoryItem) error {
-	args := m.Called(e)
+func (m *MockRepository) Update(ctx context.Context, e entity.InventoryItem) error {
+	args := m.Called(ctx, e)
 	return args.Error(0)
 }
 
 // DeleteByID is for mocking
-func (m *MockRepository) DeleteByID(id entity.ID) error {
-	args := m.Called(id)
+func (m *MockRepository) DeleteByID(ctx context.Context, id entity.ID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
+// MockMoveRepository is for mocking
+type MockMoveRepository struct {
+	mock.Mock
+}
+
+var _ inventory.MoveRepository = &MockMoveRepository{}
+
+// Create is for mocking
+func (m *MockMoveRepository) Create(ctx context.Context, move entity.LocationMove) (entity.ID, error) {
+	args := m.Called(ctx, move)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByItemID is for mocking
+func (m *MockMoveRepository) FindByItemID(ctx context.Context, itemID entity.ID) ([]entity.LocationMove, error) {
+	args := m.Called(ctx, itemID)
+	if val, ok := args.Get(0).([]entity.LocationMove); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func safeArgsGetInventoryItem(args mock.Arguments, idx int) entity.InventoryItem {
 	if val, ok := args.Get(idx).(entity.InventoryItem); ok {
 		return val
@@ -1,10 +1,13 @@
 package inventory
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
 )
 
 // MockService is for mocking
@@ -15,43 +18,118 @@ type MockService struct {
 var _ inventory.Service = &MockService{}
 
 // Create is for mocking
-func (s *MockService) Create(vo *inventory.CreateItemVO) (entity.ID, error) {
-	args := s.Called(vo)
+func (s *MockService) Create(ctx context.Context, vo *inventory.CreateItemVO) (entity.ID, error) {
+	args := s.Called(ctx, vo)
 	return args.Get(0).(entity.ID), args.Error(1)
 }
 
 // ReadDetails is for mocking
-func (s *MockService) ReadDetails(id entity.ID) (*inventory.ViewVO, error) {
-	args := s.Called(id)
+func (s *MockService) ReadDetails(ctx context.Context, id entity.ID) (*inventory.ViewVO, error) {
+	args := s.Called(ctx, id)
 	return safeArgsGetViewVO(args, 0), args.Error(1)
 }
 
+// ReadManyDetails is for mocking
+func (s *MockService) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]inventory.ViewVO, error) {
+	args := s.Called(ctx, ids)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
+
+// FindIDByBarcode is for mocking
+func (s *MockService) FindIDByBarcode(ctx context.Context, barcode string) (entity.ID, error) {
+	args := s.Called(ctx, barcode)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
 // ReadAll is for mocking
-func (s *MockService) ReadAll() ([]inventory.ThinViewVO, error) {
-	args := s.Called()
+func (s *MockService) ReadAll(ctx context.Context) ([]inventory.ThinViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetThinViewVOs(args, 0), args.Error(1)
+}
+
+// ReadAllOfFormat is for mocking
+func (s *MockService) ReadAllOfFormat(ctx context.Context, format entity.Format) ([]inventory.ThinViewVO, error) {
+	args := s.Called(ctx, format)
+	return safeArgsGetThinViewVOs(args, 0), args.Error(1)
+}
+
+// ReadAllOfTitles is for mocking
+func (s *MockService) ReadAllOfTitles(ctx context.Context, titleIDs []entity.ID) ([]inventory.ThinViewVO, error) {
+	args := s.Called(ctx, titleIDs)
 	return safeArgsGetThinViewVOs(args, 0), args.Error(1)
 }
 
 // Update is for mocking
-func (s *MockService) Update(id entity.ID, vo *inventory.UpdateItemVO) error {
-	args := s.Called(id, vo)
+func (s *MockService) Update(ctx context.Context, id entity.ID, vo *inventory.UpdateItemVO) error {
+	args := s.Called(ctx, id, vo)
 	return args.Error(0)
 }
 
 // Delete is for mocking
-func (s *MockService) Delete(id entity.ID) error {
-	args := s.Called(id)
+func (s *MockService) Delete(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
 	return args.Error(0)
 }
 
 // Checkout is for mocking
-func (s *MockService) Checkout(id entity.ID) error {
-	args := s.Called(id)
+func (s *MockService) Checkout(ctx context.Context, id entity.ID, vo *inventory.CheckoutVO) error {
+	args := s.Called(ctx, id, vo)
+	return args.Error(0)
+}
+
+// FulfilHold is for mocking
+func (s *MockService) FulfilHold(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
 	return args.Error(0)
 }
 
 // CheckIn is for mocking
-func (s *MockService) CheckIn(id entity.ID) error {
-	args := s.Called(id)
+func (s *MockService) CheckIn(ctx context.Context, id entity.ID) (*rental.ReceiptLineVO, error) {
+	args := s.Called(ctx, id)
+	if val, ok := args.Get(0).(*rental.ReceiptLineVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Renew is for mocking
+func (s *MockService) Renew(ctx context.Context, id entity.ID) (*rental.RenewalReceiptLineVO, error) {
+	args := s.Called(ctx, id)
+	if val, ok := args.Get(0).(*rental.RenewalReceiptLineVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Move is for mocking
+func (s *MockService) Move(ctx context.Context, id entity.ID, vo *inventory.MoveVO) error {
+	args := s.Called(ctx, id, vo)
 	return args.Error(0)
 }
+
+// ReadMoves is for mocking
+func (s *MockService) ReadMoves(ctx context.Context, id entity.ID) ([]inventory.MoveViewVO, error) {
+	args := s.Called(ctx, id)
+	if val, ok := args.Get(0).([]inventory.MoveViewVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ReadLabels is for mocking
+func (s *MockService) ReadLabels(ctx context.Context, ids []entity.ID) ([]inventory.LabelVO, error) {
+	args := s.Called(ctx, ids)
+	if val, ok := args.Get(0).([]inventory.LabelVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ReadShelf is for mocking
+func (s *MockService) ReadShelf(ctx context.Context, store, aisle, shelf string) (*inventory.ShelfViewVO, error) {
+	args := s.Called(ctx, store, aisle, shelf)
+	if val, ok := args.Get(0).(*inventory.ShelfViewVO); ok {
+		return val, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -1,6 +1,8 @@
 package inventory
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
@@ -15,8 +17,8 @@ type MockVOFactory struct {
 var _ inventory.VOFactory = &MockVOFactory{}
 
 // CreateViewVOFromEntity is for mocking
-func (v *MockVOFactory) CreateViewVOFromEntity(e entity.InventoryItem) *inventory.ViewVO {
-	args := v.Called(e)
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.InventoryItem, r entity.Rental, now time.Time) *inventory.ViewVO {
+	args := v.Called(e, r, now)
 	return safeArgsGetViewVO(args, 0)
 }
 
@@ -26,6 +28,33 @@ func (v *MockVOFactory) CreateThinViewVOsFromEntities(entities []entity.Inventor
 	return safeArgsGetThinViewVOs(args, 0)
 }
 
+// CreateMoveViewVOs is for mocking
+func (v *MockVOFactory) CreateMoveViewVOs(moves []entity.LocationMove) []inventory.MoveViewVO {
+	args := v.Called(moves)
+	if val, ok := args.Get(0).([]inventory.MoveViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateShelfViewVO is for mocking
+func (v *MockVOFactory) CreateShelfViewVO(store, aisle, shelf string, locations []entity.Location, items []entity.InventoryItem) *inventory.ShelfViewVO {
+	args := v.Called(store, aisle, shelf, locations, items)
+	if val, ok := args.Get(0).(*inventory.ShelfViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateLabelVO is for mocking
+func (v *MockVOFactory) CreateLabelVO(e entity.InventoryItem, t entity.Title) *inventory.LabelVO {
+	args := v.Called(e, t)
+	if val, ok := args.Get(0).(*inventory.LabelVO); ok {
+		return val
+	}
+	return nil
+}
+
 func safeArgsGetViewVO(args mock.Arguments, idx int) *inventory.ViewVO {
 	if val, ok := args.Get(idx).(*inventory.ViewVO); ok {
 		return val
@@ -33,6 +62,13 @@ func safeArgsGetViewVO(args mock.Arguments, idx int) *inventory.ViewVO {
 	return nil
 }
 
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []inventory.ViewVO {
+	if val, ok := args.Get(idx).([]inventory.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
 func safeArgsGetThinViewVOs(args mock.Arguments, idx int) []inventory.ThinViewVO {
 	if val, ok := args.Get(idx).([]inventory.ThinViewVO); ok {
 		return val
@@ -0,0 +1,42 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ ledger.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, e entity.LedgerEntry) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByAccountID is for mocking
+func (m *MockRepository) FindByAccountID(ctx context.Context, accountID entity.ID) ([]entity.LedgerEntry, error) {
+	args := m.Called(ctx, accountID)
+	return safeArgsGetLedgerEntries(args, 0), args.Error(1)
+}
+
+// BalanceByAccountID is for mocking
+func (m *MockRepository) BalanceByAccountID(ctx context.Context, accountID entity.ID) (entity.Money, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(entity.Money), args.Error(1)
+}
+
+func safeArgsGetLedgerEntries(args mock.Arguments, idx int) []entity.LedgerEntry {
+	if val, ok := args.Get(idx).([]entity.LedgerEntry); ok {
+		return val
+	}
+	return nil
+}
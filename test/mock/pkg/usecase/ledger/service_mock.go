@@ -0,0 +1,35 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ ledger.Service = &MockService{}
+
+// RecordPayment is for mocking
+func (s *MockService) RecordPayment(ctx context.Context, accountID entity.ID, vo *ledger.RecordPaymentVO) (entity.ID, error) {
+	args := s.Called(ctx, accountID, vo)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// RecordAdjustment is for mocking
+func (s *MockService) RecordAdjustment(ctx context.Context, accountID entity.ID, vo *ledger.RecordAdjustmentVO) (entity.ID, error) {
+	args := s.Called(ctx, accountID, vo)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// ReadStatement is for mocking
+func (s *MockService) ReadStatement(ctx context.Context, accountID entity.ID) (*ledger.StatementVO, error) {
+	args := s.Called(ctx, accountID)
+	return safeArgsGetStatementVO(args, 0), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package ledger
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ ledger.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.LedgerEntry) *ledger.ViewVO {
+	args := v.Called(e)
+	if val, ok := args.Get(0).(*ledger.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateStatementVOFromEntities is for mocking
+func (v *MockVOFactory) CreateStatementVOFromEntities(accountID entity.ID, entities []entity.LedgerEntry) *ledger.StatementVO {
+	args := v.Called(accountID, entities)
+	return safeArgsGetStatementVO(args, 0)
+}
+
+func safeArgsGetStatementVO(args mock.Arguments, idx int) *ledger.StatementVO {
+	if val, ok := args.Get(idx).(*ledger.StatementVO); ok {
+		return val
+	}
+	return nil
+}
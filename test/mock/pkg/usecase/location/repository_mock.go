@@ -0,0 +1,54 @@
+package location
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ location.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, l entity.Location) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+// Exists is for mocking
+func (m *MockRepository) Exists(ctx context.Context, l entity.Location) (bool, error) {
+	args := m.Called(ctx, l)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindAll is for mocking
+func (m *MockRepository) FindAll(ctx context.Context) ([]entity.Location, error) {
+	args := m.Called(ctx)
+	return safeArgsGetLocations(args, 0), args.Error(1)
+}
+
+// FindAllOnShelf is for mocking
+func (m *MockRepository) FindAllOnShelf(ctx context.Context, store, aisle, shelf string) ([]entity.Location, error) {
+	args := m.Called(ctx, store, aisle, shelf)
+	return safeArgsGetLocations(args, 0), args.Error(1)
+}
+
+// Delete is for mocking
+func (m *MockRepository) Delete(ctx context.Context, l entity.Location) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+func safeArgsGetLocations(args mock.Arguments, idx int) []entity.Location {
+	if val, ok := args.Get(idx).([]entity.Location); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package location
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ location.Service = &MockService{}
+
+// Create is for mocking
+func (s *MockService) Create(ctx context.Context, vo *location.CreateLocationVO) (*location.ViewVO, error) {
+	args := s.Called(ctx, vo)
+	return safeArgsGetViewVO(args, 0), args.Error(1)
+}
+
+// ReadAll is for mocking
+func (s *MockService) ReadAll(ctx context.Context) ([]location.ViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
+
+// Delete is for mocking
+func (s *MockService) Delete(ctx context.Context, l entity.Location) error {
+	args := s.Called(ctx, l)
+	return args.Error(0)
+}
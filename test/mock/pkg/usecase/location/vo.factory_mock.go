@@ -0,0 +1,41 @@
+package location
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ location.VOFactory = &MockVOFactory{}
+
+// CreateViewVO is for mocking
+func (v *MockVOFactory) CreateViewVO(l entity.Location) *location.ViewVO {
+	args := v.Called(l)
+	return safeArgsGetViewVO(args, 0)
+}
+
+// CreateViewVOs is for mocking
+func (v *MockVOFactory) CreateViewVOs(locations []entity.Location) []location.ViewVO {
+	args := v.Called(locations)
+	return safeArgsGetViewVOs(args, 0)
+}
+
+func safeArgsGetViewVO(args mock.Arguments, idx int) *location.ViewVO {
+	if val, ok := args.Get(idx).(*location.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []location.ViewVO {
+	if val, ok := args.Get(idx).([]location.ViewVO); ok {
+		return val
+	}
+	return nil
+}
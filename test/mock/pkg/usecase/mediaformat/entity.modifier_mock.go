@@ -0,0 +1,21 @@
+package mediaformat
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MockEntityModifier is for mocking
+type MockEntityModifier struct {
+	mock.Mock
+}
+
+var _ mediaformat.EntityModifier = &MockEntityModifier{}
+
+// ModifyWithUpdateFormatVO is for mocking
+func (m *MockEntityModifier) ModifyWithUpdateFormatVO(e entity.MediaFormat, vo *mediaformat.UpdateFormatVO) error {
+	args := m.Called(e, vo)
+	return args.Error(0)
+}
@@ -0,0 +1,68 @@
+package mediaformat
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ mediaformat.Repository = &MockRepository{}
+
+// FindByFormat is for mocking
+func (m *MockRepository) FindByFormat(ctx context.Context, format entity.Format) (entity.MediaFormat, error) {
+	args := m.Called(ctx, format)
+	return safeArgsGetMediaFormat(args, 0), args.Error(1)
+}
+
+// FindAll is for mocking
+func (m *MockRepository) FindAll(ctx context.Context) ([]entity.MediaFormat, error) {
+	args := m.Called(ctx)
+	return safeArgsGetMediaFormats(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockRepository) Update(ctx context.Context, e entity.MediaFormat) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// FindStockByFormat is for mocking
+func (m *MockRepository) FindStockByFormat(ctx context.Context, format entity.Format) (mediaformat.Stock, error) {
+	args := m.Called(ctx, format)
+	return args.Get(0).(mediaformat.Stock), args.Error(1)
+}
+
+// FindAllStock is for mocking
+func (m *MockRepository) FindAllStock(ctx context.Context) (map[entity.Format]mediaformat.Stock, error) {
+	args := m.Called(ctx)
+	return safeArgsGetStockMap(args, 0), args.Error(1)
+}
+
+func safeArgsGetMediaFormat(args mock.Arguments, idx int) entity.MediaFormat {
+	if val, ok := args.Get(idx).(entity.MediaFormat); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetMediaFormats(args mock.Arguments, idx int) []entity.MediaFormat {
+	if val, ok := args.Get(idx).([]entity.MediaFormat); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetStockMap(args mock.Arguments, idx int) map[entity.Format]mediaformat.Stock {
+	if val, ok := args.Get(idx).(map[entity.Format]mediaformat.Stock); ok {
+		return val
+	}
+	return nil
+}
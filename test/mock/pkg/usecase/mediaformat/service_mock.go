@@ -0,0 +1,35 @@
+package mediaformat
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ mediaformat.Service = &MockService{}
+
+// ReadDetails is for mocking
+func (s *MockService) ReadDetails(ctx context.Context, format entity.Format) (*mediaformat.ViewVO, error) {
+	args := s.Called(ctx, format)
+	return safeArgsGetViewVO(args, 0), args.Error(1)
+}
+
+// ReadAll is for mocking
+func (s *MockService) ReadAll(ctx context.Context) ([]mediaformat.ViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (s *MockService) Update(ctx context.Context, format entity.Format, vo *mediaformat.UpdateFormatVO) error {
+	args := s.Called(ctx, format, vo)
+	return args.Error(0)
+}
@@ -0,0 +1,41 @@
+package mediaformat
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ mediaformat.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.MediaFormat, stock mediaformat.Stock) *mediaformat.ViewVO {
+	args := v.Called(e, stock)
+	return safeArgsGetViewVO(args, 0)
+}
+
+// CreateViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateViewVOsFromEntities(entities []entity.MediaFormat, stock map[entity.Format]mediaformat.Stock) []mediaformat.ViewVO {
+	args := v.Called(entities, stock)
+	return safeArgsGetViewVOs(args, 0)
+}
+
+func safeArgsGetViewVO(args mock.Arguments, idx int) *mediaformat.ViewVO {
+	if val, ok := args.Get(idx).(*mediaformat.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []mediaformat.ViewVO {
+	if val, ok := args.Get(idx).([]mediaformat.ViewVO); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// MockFeed is for mocking. Follow calls fn with each of the mocked
+// batches of messages, stopping if it fails.
+type MockFeed struct {
+	mock.Mock
+}
+
+var _ outbox.Feed = &MockFeed{}
+
+// Latest is for mocking
+func (m *MockFeed) Latest(ctx context.Context) (entity.ID, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// Follow is for mocking
+func (m *MockFeed) Follow(ctx context.Context, after entity.ID, fn func([]outbox.Message) error) error {
+	args := m.Called(ctx, after)
+	for _, batch := range safeArgsGetBatches(args, 0) {
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func safeArgsGetBatches(args mock.Arguments, idx int) [][]outbox.Message {
+	if val, ok := args.Get(idx).([][]outbox.Message); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// MockRelay is for mocking
+type MockRelay struct {
+	mock.Mock
+}
+
+var _ outbox.Relay = &MockRelay{}
+
+// RelayPending is for mocking
+func (m *MockRelay) RelayPending(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
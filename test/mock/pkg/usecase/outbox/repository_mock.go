@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ outbox.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, msg outbox.Message) (entity.ID, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindUnpublished is for mocking
+func (m *MockRepository) FindUnpublished(ctx context.Context, limit int) ([]outbox.Message, error) {
+	args := m.Called(ctx, limit)
+	return safeArgsGetMessages(args, 0), args.Error(1)
+}
+
+// MarkPublished is for mocking
+func (m *MockRepository) MarkPublished(ctx context.Context, id entity.ID, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+// FindAfter is for mocking
+func (m *MockRepository) FindAfter(ctx context.Context, after entity.ID, limit int) ([]outbox.Message, error) {
+	args := m.Called(ctx, after, limit)
+	return safeArgsGetMessages(args, 0), args.Error(1)
+}
+
+// FindLatestID is for mocking
+func (m *MockRepository) FindLatestID(ctx context.Context) (entity.ID, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+func safeArgsGetMessages(args mock.Arguments, idx int) []outbox.Message {
+	if val, ok := args.Get(idx).([]outbox.Message); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// MockSink is for mocking
+type MockSink struct {
+	mock.Mock
+}
+
+var _ outbox.Sink = &MockSink{}
+
+// Publish is for mocking
+func (m *MockSink) Publish(ctx context.Context, msg outbox.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+// MockWriter is for mocking
+type MockWriter struct {
+	mock.Mock
+}
+
+var _ outbox.Writer = &MockWriter{}
+
+// Write is for mocking
+func (m *MockWriter) Write(ctx context.Context, entityID entity.ID, recorder entity.EventRecorder) error {
+	args := m.Called(ctx, entityID, recorder)
+	return args.Error(0)
+}
@@ -0,0 +1,74 @@
+package rental
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ rental.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, e entity.Rental) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockRepository) Update(ctx context.Context, e entity.Rental) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// FindActiveByItemID is for mocking
+func (m *MockRepository) FindActiveByItemID(ctx context.Context, itemID entity.ID) (entity.Rental, error) {
+	args := m.Called(ctx, itemID)
+	return safeArgsGetRental(args, 0), args.Error(1)
+}
+
+// FindActiveByItemIDForUpdate is for mocking
+func (m *MockRepository) FindActiveByItemIDForUpdate(ctx context.Context, itemID entity.ID) (entity.Rental, error) {
+	args := m.Called(ctx, itemID)
+	return safeArgsGetRental(args, 0), args.Error(1)
+}
+
+// FindActiveByAccountID is for mocking
+func (m *MockRepository) FindActiveByAccountID(ctx context.Context, accountID entity.ID) ([]entity.Rental, error) {
+	args := m.Called(ctx, accountID)
+	return safeArgsGetRentals(args, 0), args.Error(1)
+}
+
+// FindActiveByItemIDs is for mocking
+func (m *MockRepository) FindActiveByItemIDs(ctx context.Context, itemIDs []entity.ID) ([]entity.Rental, error) {
+	args := m.Called(ctx, itemIDs)
+	return safeArgsGetRentals(args, 0), args.Error(1)
+}
+
+// FindOverdue is for mocking
+func (m *MockRepository) FindOverdue(ctx context.Context, now time.Time) ([]entity.Rental, error) {
+	args := m.Called(ctx, now)
+	return safeArgsGetRentals(args, 0), args.Error(1)
+}
+
+func safeArgsGetRental(args mock.Arguments, idx int) entity.Rental {
+	if val, ok := args.Get(idx).(entity.Rental); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetRentals(args mock.Arguments, idx int) []entity.Rental {
+	if val, ok := args.Get(idx).([]entity.Rental); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package rental
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ rental.Service = &MockService{}
+
+// ReadOverdue is for mocking
+func (s *MockService) ReadOverdue(ctx context.Context) ([]rental.ViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
@@ -0,0 +1,58 @@
+package rental
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ rental.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.Rental, now time.Time) *rental.ViewVO {
+	args := v.Called(e, now)
+	if val, ok := args.Get(0).(*rental.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateViewVOsFromEntities(entities []entity.Rental, now time.Time) []rental.ViewVO {
+	args := v.Called(entities, now)
+	return safeArgsGetViewVOs(args, 0)
+}
+
+// CreateReceiptLineVO is for mocking
+func (v *MockVOFactory) CreateReceiptLineVO(e entity.Rental, fee domain.LateFee) *rental.ReceiptLineVO {
+	args := v.Called(e, fee)
+	if val, ok := args.Get(0).(*rental.ReceiptLineVO); ok {
+		return val
+	}
+	return nil
+}
+
+// CreateRenewalReceiptLineVO is for mocking
+func (v *MockVOFactory) CreateRenewalReceiptLineVO(e entity.Rental, fee entity.Money) *rental.RenewalReceiptLineVO {
+	args := v.Called(e, fee)
+	if val, ok := args.Get(0).(*rental.RenewalReceiptLineVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []rental.ViewVO {
+	if val, ok := args.Get(idx).([]rental.ViewVO); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package title
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// MockEntityFactory is for mocking
+type MockEntityFactory struct {
+	mock.Mock
+}
+
+var _ title.EntityFactory = &MockEntityFactory{}
+
+// CreateFromVO is for mocking
+func (m *MockEntityFactory) CreateFromVO(vo *title.CreateTitleVO) (entity.Title, error) {
+	args := m.Called(vo)
+	return safeArgsGetTitle(args, 0), args.Error(1)
+}
@@ -0,0 +1,21 @@
+package title
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// MockEntityModifier is for mocking
+type MockEntityModifier struct {
+	mock.Mock
+}
+
+var _ title.EntityModifier = &MockEntityModifier{}
+
+// ModifyWithUpdateTitleVO is for mocking
+func (m *MockEntityModifier) ModifyWithUpdateTitleVO(e entity.Title, vo *title.UpdateTitleVO) error {
+	args := m.Called(e, vo)
+	return args.Error(0)
+}
@@ -0,0 +1,92 @@
+package title
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// MockRepository is for mocking
+type MockRepository struct {
+	mock.Mock
+}
+
+var _ title.Repository = &MockRepository{}
+
+// Create is for mocking
+func (m *MockRepository) Create(ctx context.Context, e entity.Title) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByID is for mocking
+func (m *MockRepository) FindByID(ctx context.Context, id entity.ID) (entity.Title, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetTitle(args, 0), args.Error(1)
+}
+
+// FindByIDs is for mocking
+func (m *MockRepository) FindByIDs(ctx context.Context, ids []entity.ID) ([]entity.Title, error) {
+	args := m.Called(ctx, ids)
+	return safeArgsGetTitles(args, 0), args.Error(1)
+}
+
+// FindAll is for mocking
+func (m *MockRepository) FindAll(ctx context.Context) ([]entity.Title, error) {
+	args := m.Called(ctx)
+	return safeArgsGetTitles(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockRepository) Update(ctx context.Context, e entity.Title) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// DeleteByID is for mocking
+func (m *MockRepository) DeleteByID(ctx context.Context, id entity.ID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// FindStockByID is for mocking
+func (m *MockRepository) FindStockByID(ctx context.Context, id entity.ID) (title.Stock, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(title.Stock), args.Error(1)
+}
+
+// FindAllStock is for mocking
+func (m *MockRepository) FindAllStock(ctx context.Context) (map[entity.ID]title.Stock, error) {
+	args := m.Called(ctx)
+	return safeArgsGetStockMap(args, 0), args.Error(1)
+}
+
+// FindStockByIDs is for mocking
+func (m *MockRepository) FindStockByIDs(ctx context.Context, ids []entity.ID) (map[entity.ID]title.Stock, error) {
+	args := m.Called(ctx, ids)
+	return safeArgsGetStockMap(args, 0), args.Error(1)
+}
+
+func safeArgsGetTitle(args mock.Arguments, idx int) entity.Title {
+	if val, ok := args.Get(idx).(entity.Title); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetTitles(args mock.Arguments, idx int) []entity.Title {
+	if val, ok := args.Get(idx).([]entity.Title); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetStockMap(args mock.Arguments, idx int) map[entity.ID]title.Stock {
+	if val, ok := args.Get(idx).(map[entity.ID]title.Stock); ok {
+		return val
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package title
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ title.Service = &MockService{}
+
+// Create is for mocking
+func (s *MockService) Create(ctx context.Context, vo *title.CreateTitleVO) (entity.ID, error) {
+	args := s.Called(ctx, vo)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// ReadDetails is for mocking
+func (s *MockService) ReadDetails(ctx context.Context, id entity.ID) (*title.ViewVO, error) {
+	args := s.Called(ctx, id)
+	return safeArgsGetViewVO(args, 0), args.Error(1)
+}
+
+// ReadManyDetails is for mocking
+func (s *MockService) ReadManyDetails(ctx context.Context, ids []entity.ID) ([]title.ViewVO, error) {
+	args := s.Called(ctx, ids)
+	return safeArgsGetViewVOs(args, 0), args.Error(1)
+}
+
+// ReadAll is for mocking
+func (s *MockService) ReadAll(ctx context.Context) ([]title.ThinViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetThinViewVOs(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (s *MockService) Update(ctx context.Context, id entity.ID, vo *title.UpdateTitleVO) error {
+	args := s.Called(ctx, id, vo)
+	return args.Error(0)
+}
+
+// Delete is for mocking
+func (s *MockService) Delete(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
+	return args.Error(0)
+}
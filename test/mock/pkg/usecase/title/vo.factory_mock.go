@@ -0,0 +1,48 @@
+package title
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ title.VOFactory = &MockVOFactory{}
+
+// CreateViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateViewVOFromEntity(e entity.Title, stock title.Stock) *title.ViewVO {
+	args := v.Called(e, stock)
+	return safeArgsGetViewVO(args, 0)
+}
+
+// CreateThinViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateThinViewVOsFromEntities(entities []entity.Title, stock map[entity.ID]title.Stock) []title.ThinViewVO {
+	args := v.Called(entities, stock)
+	return safeArgsGetThinViewVOs(args, 0)
+}
+
+func safeArgsGetViewVO(args mock.Arguments, idx int) *title.ViewVO {
+	if val, ok := args.Get(idx).(*title.ViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetThinViewVOs(args mock.Arguments, idx int) []title.ThinViewVO {
+	if val, ok := args.Get(idx).([]title.ThinViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetViewVOs(args mock.Arguments, idx int) []title.ViewVO {
+	if val, ok := args.Get(idx).([]title.ViewVO); ok {
+		return val
+	}
+	return nil
+}
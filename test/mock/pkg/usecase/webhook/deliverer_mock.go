@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// MockDeliverer is for mocking
+type MockDeliverer struct {
+	mock.Mock
+}
+
+var _ webhook.Deliverer = &MockDeliverer{}
+
+// DeliverDue is for mocking
+func (m *MockDeliverer) DeliverDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// MockSubscriptionRepository is for mocking
+type MockSubscriptionRepository struct {
+	mock.Mock
+}
+
+var _ webhook.SubscriptionRepository = &MockSubscriptionRepository{}
+
+// Create is for mocking
+func (m *MockSubscriptionRepository) Create(ctx context.Context, e entity.WebhookSubscription) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByID is for mocking
+func (m *MockSubscriptionRepository) FindByID(ctx context.Context, id entity.ID) (entity.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetSubscription(args, 0), args.Error(1)
+}
+
+// FindAll is for mocking
+func (m *MockSubscriptionRepository) FindAll(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	return safeArgsGetSubscriptions(args, 0), args.Error(1)
+}
+
+// DeleteByID is for mocking
+func (m *MockSubscriptionRepository) DeleteByID(ctx context.Context, id entity.ID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// FindByEventType is for mocking
+func (m *MockSubscriptionRepository) FindByEventType(ctx context.Context, eventType entity.EventType) ([]entity.WebhookSubscription, error) {
+	args := m.Called(ctx, eventType)
+	return safeArgsGetSubscriptions(args, 0), args.Error(1)
+}
+
+// MockDeliveryRepository is for mocking
+type MockDeliveryRepository struct {
+	mock.Mock
+}
+
+var _ webhook.DeliveryRepository = &MockDeliveryRepository{}
+
+// Create is for mocking
+func (m *MockDeliveryRepository) Create(ctx context.Context, e entity.WebhookDelivery) (entity.ID, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// FindByID is for mocking
+func (m *MockDeliveryRepository) FindByID(ctx context.Context, id entity.ID) (entity.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	return safeArgsGetDelivery(args, 0), args.Error(1)
+}
+
+// Update is for mocking
+func (m *MockDeliveryRepository) Update(ctx context.Context, e entity.WebhookDelivery) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// FindBySubscriptionID is for mocking
+func (m *MockDeliveryRepository) FindBySubscriptionID(ctx context.Context, subscriptionID entity.ID) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, subscriptionID)
+	return safeArgsGetDeliveries(args, 0), args.Error(1)
+}
+
+// FindDead is for mocking
+func (m *MockDeliveryRepository) FindDead(ctx context.Context) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx)
+	return safeArgsGetDeliveries(args, 0), args.Error(1)
+}
+
+// FindDue is for mocking
+func (m *MockDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, now, limit)
+	return safeArgsGetDeliveries(args, 0), args.Error(1)
+}
+
+func safeArgsGetSubscription(args mock.Arguments, idx int) entity.WebhookSubscription {
+	if val, ok := args.Get(idx).(entity.WebhookSubscription); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetSubscriptions(args mock.Arguments, idx int) []entity.WebhookSubscription {
+	if val, ok := args.Get(idx).([]entity.WebhookSubscription); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetDelivery(args mock.Arguments, idx int) entity.WebhookDelivery {
+	if val, ok := args.Get(idx).(entity.WebhookDelivery); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetDeliveries(args mock.Arguments, idx int) []entity.WebhookDelivery {
+	if val, ok := args.Get(idx).([]entity.WebhookDelivery); ok {
+		return val
+	}
+	return nil
+}
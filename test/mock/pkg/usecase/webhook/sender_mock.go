@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// MockSender is for mocking
+type MockSender struct {
+	mock.Mock
+}
+
+var _ webhook.Sender = &MockSender{}
+
+// Send is for mocking
+func (m *MockSender) Send(ctx context.Context, subscription entity.WebhookSubscription, delivery entity.WebhookDelivery) (int, error) {
+	args := m.Called(ctx, subscription, delivery)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// MockService is for mocking
+type MockService struct {
+	mock.Mock
+}
+
+var _ webhook.Service = &MockService{}
+
+// Create is for mocking
+func (s *MockService) Create(ctx context.Context, vo *webhook.CreateSubscriptionVO) (entity.ID, error) {
+	args := s.Called(ctx, vo)
+	return args.Get(0).(entity.ID), args.Error(1)
+}
+
+// ReadDetails is for mocking
+func (s *MockService) ReadDetails(ctx context.Context, id entity.ID) (*webhook.SubscriptionViewVO, error) {
+	args := s.Called(ctx, id)
+	return safeArgsGetSubscriptionViewVO(args, 0), args.Error(1)
+}
+
+// ReadAll is for mocking
+func (s *MockService) ReadAll(ctx context.Context) ([]webhook.SubscriptionViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetSubscriptionViewVOs(args, 0), args.Error(1)
+}
+
+// Delete is for mocking
+func (s *MockService) Delete(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
+	return args.Error(0)
+}
+
+// ReadDeliveries is for mocking
+func (s *MockService) ReadDeliveries(ctx context.Context, subscriptionID entity.ID) ([]webhook.DeliveryViewVO, error) {
+	args := s.Called(ctx, subscriptionID)
+	return safeArgsGetDeliveryViewVOs(args, 0), args.Error(1)
+}
+
+// ReadDeadLetters is for mocking
+func (s *MockService) ReadDeadLetters(ctx context.Context) ([]webhook.DeliveryViewVO, error) {
+	args := s.Called(ctx)
+	return safeArgsGetDeliveryViewVOs(args, 0), args.Error(1)
+}
+
+// Redeliver is for mocking
+func (s *MockService) Redeliver(ctx context.Context, id entity.ID) error {
+	args := s.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+// MockVOFactory is for mocking
+type MockVOFactory struct {
+	mock.Mock
+}
+
+var _ webhook.VOFactory = &MockVOFactory{}
+
+// CreateSubscriptionViewVOFromEntity is for mocking
+func (v *MockVOFactory) CreateSubscriptionViewVOFromEntity(e entity.WebhookSubscription) *webhook.SubscriptionViewVO {
+	args := v.Called(e)
+	return safeArgsGetSubscriptionViewVO(args, 0)
+}
+
+// CreateSubscriptionViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateSubscriptionViewVOsFromEntities(entities []entity.WebhookSubscription) []webhook.SubscriptionViewVO {
+	args := v.Called(entities)
+	return safeArgsGetSubscriptionViewVOs(args, 0)
+}
+
+// CreateDeliveryViewVOsFromEntities is for mocking
+func (v *MockVOFactory) CreateDeliveryViewVOsFromEntities(entities []entity.WebhookDelivery) []webhook.DeliveryViewVO {
+	args := v.Called(entities)
+	return safeArgsGetDeliveryViewVOs(args, 0)
+}
+
+func safeArgsGetSubscriptionViewVO(args mock.Arguments, idx int) *webhook.SubscriptionViewVO {
+	if val, ok := args.Get(idx).(*webhook.SubscriptionViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetSubscriptionViewVOs(args mock.Arguments, idx int) []webhook.SubscriptionViewVO {
+	if val, ok := args.Get(idx).([]webhook.SubscriptionViewVO); ok {
+		return val
+	}
+	return nil
+}
+
+func safeArgsGetDeliveryViewVOs(args mock.Arguments, idx int) []webhook.DeliveryViewVO {
+	if val, ok := args.Get(idx).([]webhook.DeliveryViewVO); ok {
+		return val
+	}
+	return nil
+}
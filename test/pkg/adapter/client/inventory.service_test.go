@@ -0,0 +1,254 @@
+package client_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/client"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type recorded struct {
+	method string
+	uri    string
+	body   string
+}
+
+func serverFixture(t *testing.T, status int, body string) (*client.InventoryServiceImpl, *recorded) {
+	rec := &recorded{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := ioutil.ReadAll(r.Body)
+		rec.method = r.Method
+		rec.uri = r.URL.RequestURI()
+		rec.body = string(reqBody)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	sut := client.NewInventoryServiceImpl(
+		server.URL+"/",
+		server.Client(),
+		json.NewEncoderServiceImpl(),
+		json.NewDecoderServiceImpl(),
+	)
+	return sut, rec
+}
+
+func TestInventoryService_Create_WhenServerCreates_ShouldReturnID(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusCreated, "101")
+	voFixture := &inventory.CreateItemVO{
+		TitleID:  7,
+		Format:   entity.FormatVHS,
+		Barcode:  "A1",
+		Location: "1-2-3",
+	}
+
+	// Exercise SUT
+	actual, err := sut.Create(context.Background(), voFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ID(101), actual)
+	assert.Equal(t, http.MethodPost, rec.method)
+	assert.Equal(t, "/inventory", rec.uri)
+	assert.JSONEq(t, `{"titleId":7,"format":"vhs","barcode":"A1","location":"1-2-3"}`, rec.body)
+}
+
+func TestInventoryService_Create_WhenServerRejects_ShouldFailWithItsMessage(t *testing.T) {
+	// Setup fixture
+	sut, _ := serverFixture(t, http.StatusBadRequest, "validation error: field=[barcode], problem=[must not be blank]")
+
+	// Exercise SUT
+	actual, err := sut.Create(context.Background(), &inventory.CreateItemVO{})
+
+	// Verify results
+	assert.EqualError(t, err, "could not create inventory item - server responded with status 400: "+
+		"validation error: field=[barcode], problem=[must not be blank]")
+	assert.Equal(t, entity.InvalidID, actual)
+}
+
+func TestInventoryService_ReadDetails_ShouldDecodeItem(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusOK,
+		`{"id":101,"titleId":7,"format":"vhs","barcode":"A1","location":"1-2-3","available":false,"dueAt":"2020-01-02T03:04:05Z","overdue":true}`)
+
+	// Setup expectations
+	dueAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	expected := &inventory.ViewVO{
+		ID:       101,
+		TitleID:  7,
+		Format:   entity.FormatVHS,
+		Barcode:  "A1",
+		Location: "1-2-3",
+		DueAt:    &dueAt,
+		Overdue:  true,
+	}
+
+	// Exercise SUT
+	actual, err := sut.ReadDetails(context.Background(), 101)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, http.MethodGet, rec.method)
+	assert.Equal(t, "/inventory/101", rec.uri)
+}
+
+func TestInventoryService_ReadDetails_WhenServerIsUnreachable_ShouldFail(t *testing.T) {
+	// Setup fixture
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+	sut := client.NewInventoryServiceImpl(url, &http.Client{Timeout: time.Second},
+		json.NewEncoderServiceImpl(), json.NewDecoderServiceImpl())
+
+	// Exercise SUT
+	actual, err := sut.ReadDetails(context.Background(), 101)
+
+	// Verify results
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not read inventory item - server error: ")
+	assert.Nil(t, actual)
+}
+
+func TestInventoryService_FindIDByBarcode_ShouldReturnIDOfItem(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusOK, `{"id":101,"titleId":7,"format":"vhs","barcode":"A 1"}`)
+
+	// Exercise SUT
+	actual, err := sut.FindIDByBarcode(context.Background(), "A 1")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ID(101), actual)
+	assert.Equal(t, "/inventory/by-barcode/A%201", rec.uri)
+}
+
+func TestInventoryService_ReadAllOfFormat_ShouldDecodeItems(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusOK,
+		`[{"id":101,"titleId":7,"format":"vhs","barcode":"A1"},{"id":102,"titleId":8,"format":"vhs","barcode":"B1"}]`)
+
+	// Setup expectations
+	expected := []inventory.ThinViewVO{
+		{ID: 101, TitleID: 7, Format: entity.FormatVHS, Barcode: "A1"},
+		{ID: 102, TitleID: 8, Format: entity.FormatVHS, Barcode: "B1"},
+	}
+
+	// Exercise SUT
+	actual, err := sut.ReadAllOfFormat(context.Background(), entity.FormatVHS)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, "/inventory?format=vhs", rec.uri)
+}
+
+func TestInventoryService_Update_ShouldPutItem(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusNoContent, "")
+	voFixture := &inventory.UpdateItemVO{
+		TitleID:  7,
+		Format:   entity.FormatVHS,
+		Barcode:  "A1",
+		Location: "1-2-3",
+	}
+
+	// Exercise SUT
+	err := sut.Update(context.Background(), 101, voFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/inventory/101", rec.uri)
+	assert.JSONEq(t, `{"titleId":7,"format":"vhs","barcode":"A1","location":"1-2-3"}`, rec.body)
+}
+
+func TestInventoryService_Delete_WhenItemDoesNotExist_ShouldFailWithItsMessage(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusNotFound, "entity not found: type=[inventory item]")
+
+	// Exercise SUT
+	err := sut.Delete(context.Background(), 101)
+
+	// Verify results
+	assert.EqualError(t, err, "could not delete inventory item - server responded with status 404: entity not found: type=[inventory item]")
+	assert.Equal(t, http.MethodDelete, rec.method)
+	assert.Equal(t, "/inventory/101", rec.uri)
+}
+
+func TestInventoryService_Checkout_ShouldPutAccount(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusNoContent, "")
+
+	// Exercise SUT
+	err := sut.Checkout(context.Background(), 101, &inventory.CheckoutVO{AccountID: 5})
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/inventory/101/checkout", rec.uri)
+	assert.JSONEq(t, `{"accountId":5}`, rec.body)
+}
+
+func TestInventoryService_CheckIn_ShouldDecodeReceiptLine(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusOK,
+		`{"rentalId":1,"itemId":101,"accountId":5,"dueAt":"2020-01-02T00:00:00Z","returnedAt":"2020-01-04T00:00:00Z","daysLate":2,"lateFeeCents":200}`)
+
+	// Setup expectations
+	expected := &rental.ReceiptLineVO{
+		RentalID:   1,
+		ItemID:     101,
+		AccountID:  5,
+		DueAt:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		ReturnedAt: time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC),
+		DaysLate:   2,
+		LateFee:    200,
+	}
+
+	// Exercise SUT
+	actual, err := sut.CheckIn(context.Background(), 101)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/inventory/101/checkin", rec.uri)
+}
+
+func TestInventoryService_CheckIn_WhenItemWasNotRentedOut_ShouldReturnNoReceiptLine(t *testing.T) {
+	// Setup fixture
+	sut, rec := serverFixture(t, http.StatusNoContent, "")
+
+	// Exercise SUT
+	actual, err := sut.CheckIn(context.Background(), 101)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Nil(t, actual)
+	assert.Equal(t, "/inventory/101/checkin", rec.uri)
+}
+
+func TestInventoryService_Move_ShouldNotBeImplemented(t *testing.T) {
+	// Setup fixture
+	sut, _ := serverFixture(t, http.StatusNoContent, "")
+
+	// Exercise SUT
+	err := sut.Move(context.Background(), 101, &inventory.MoveVO{})
+
+	// Verify results
+	assert.IsType(t, &commonerror.NotImplemented{}, err)
+}
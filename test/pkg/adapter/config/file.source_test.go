@@ -0,0 +1,97 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+func TestNewFileSource_GivenUnsupportedExtension_ShouldFail(t *testing.T) {
+	// Setup fixture
+	path := writeFile(t, "config.ini", "PORT=1")
+
+	// Exercise SUT
+	actual, err := config.NewFileSource(path)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, "could not read config file - unsupported extension: .ini")
+}
+
+func TestNewFileSource_GivenMissingFile_ShouldFail(t *testing.T) {
+	// Exercise SUT
+	actual, err := config.NewFileSource(filepath.Join(t.TempDir(), "missing.yaml"))
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.ErrorContains(t, err, "could not read config file: ")
+}
+
+func TestNewFileSource_GivenMalformedFile_ShouldFail(t *testing.T) {
+	// Setup fixture
+	path := writeFile(t, "config.toml", "PORT = = 1")
+
+	// Exercise SUT
+	actual, err := config.NewFileSource(path)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.ErrorContains(t, err, "could not read config file - parse error: ")
+}
+
+func TestFileSource_GetString_GivenYAML_ShouldFlattenKeys(t *testing.T) {
+	// Setup fixture
+	path := writeFile(t, "config.yaml", `
+port: 9000
+db:
+  host: some.host
+  password-file: /run/secrets/db
+route-timeouts:
+  - GET /inventory=5s
+  - PUT /inventory/{id}=2s
+`)
+	sut, err := config.NewFileSource(path)
+	assert.NoError(t, err)
+
+	// Exercise SUT & verify results
+	assertProperty(t, sut, "PORT", "9000")
+	assertProperty(t, sut, "DB_HOST", "some.host")
+	assertProperty(t, sut, "DB_PASSWORD_FILE", "/run/secrets/db")
+	assertProperty(t, sut, "ROUTE_TIMEOUTS", "GET /inventory=5s,PUT /inventory/{id}=2s")
+}
+
+func TestFileSource_GetString_GivenTOML_ShouldFlattenKeys(t *testing.T) {
+	// Setup fixture
+	path := writeFile(t, "config.toml", `
+PORT = 9000
+
+[db]
+host = "some.host"
+`)
+	sut, err := config.NewFileSource(path)
+	assert.NoError(t, err)
+
+	// Exercise SUT & verify results
+	assertProperty(t, sut, "PORT", "9000")
+	assertProperty(t, sut, "DB_HOST", "some.host")
+	_, err = sut.GetString("DB_NAME")
+	assert.EqualError(t, err, "DB_NAME property is not set")
+}
+
+func writeFile(t *testing.T, name string, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func assertProperty(t *testing.T, sut *config.FileSource, property string, expected string) {
+	actual, err := sut.GetString(property)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual, property)
+}
@@ -0,0 +1,50 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+func TestNewFlagSource_GivenUnknownFlag_ShouldFail(t *testing.T) {
+	// Exercise SUT
+	actual, positional, err := config.NewFlagSource([]string{"--not-a-flag=1"})
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.Nil(t, positional)
+	assert.EqualError(t, err, "flag provided but not defined: -not-a-flag")
+}
+
+func TestNewFlagSource_GivenInterleavedFlags_ShouldSplitPositionalArgs(t *testing.T) {
+	// Setup fixture
+	fixture := []string{"--port", "9000", "config", "--db-host=some.host", "print"}
+
+	// Exercise SUT
+	actual, positional, err := config.NewFlagSource(fixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"config", "print"}, positional)
+	assertFlag(t, actual, "PORT", "9000")
+	assertFlag(t, actual, "DB_HOST", "some.host")
+}
+
+func TestFlagSource_GetString_GivenFlagNotGiven_ShouldFailAsNotSet(t *testing.T) {
+	// Setup fixture
+	sut, _, _ := config.NewFlagSource([]string{})
+
+	// Exercise SUT
+	_, err := sut.GetString("PORT")
+
+	// Verify results
+	assert.EqualError(t, err, "PORT property is not set")
+}
+
+func assertFlag(t *testing.T, sut *config.FlagSource, property string, expected string) {
+	actual, err := sut.GetString(property)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual, property)
+}
@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	goConfig "github.com/liampulles/go-config"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+func TestLayeredSource_GetString_GivenPropertyInManyLayers_ShouldPreferEarliest(t *testing.T) {
+	// Setup fixture
+	sut := config.NewLayeredSource(
+		goConfig.MapSource(map[string]string{}),
+		goConfig.MapSource(map[string]string{"PORT": "2"}),
+		goConfig.MapSource(map[string]string{"PORT": "3"}),
+	)
+
+	// Exercise SUT
+	actual, err := sut.GetString("PORT")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "2", actual)
+}
+
+func TestLayeredSource_GetString_GivenPropertyInNoLayers_ShouldFailAsNotSet(t *testing.T) {
+	// Setup fixture
+	sut := config.NewLayeredSource(
+		goConfig.MapSource(map[string]string{}),
+	)
+
+	// Exercise SUT
+	_, err := sut.GetString("PORT")
+
+	// Verify results
+	assert.IsType(t, &goConfig.ErrPropertyNotSet{}, err)
+	assert.EqualError(t, err, "PORT property is not set")
+}
+
+func TestLayeredSource_GetString_WhenLayerFails_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := config.NewLayeredSource(
+		goConfig.MapSource(map[string]string{}),
+	)
+
+	// Exercise SUT
+	_, err := sut.GetString("")
+
+	// Verify results
+	assert.Equal(t, goConfig.ErrEmptyProperty, err)
+}
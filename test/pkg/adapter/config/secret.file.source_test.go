@@ -0,0 +1,68 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	goConfig "github.com/liampulles/go-config"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+func TestSecretFileSource_GetString_GivenProperty_ShouldPreferProperty(t *testing.T) {
+	// Setup fixture
+	sut := config.NewSecretFileSource(goConfig.MapSource(map[string]string{
+		"DB_PASSWORD":      "some.password",
+		"DB_PASSWORD_FILE": "/not/a/file",
+	}))
+
+	// Exercise SUT
+	actual, err := sut.GetString("DB_PASSWORD")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "some.password", actual)
+}
+
+func TestSecretFileSource_GetString_GivenFileVariant_ShouldReadFile(t *testing.T) {
+	// Setup fixture
+	path := filepath.Join(t.TempDir(), "password")
+	os.WriteFile(path, []byte("some.password\n"), 0600)
+	sut := config.NewSecretFileSource(goConfig.MapSource(map[string]string{
+		"DB_PASSWORD_FILE": path,
+	}))
+
+	// Exercise SUT
+	actual, err := sut.GetString("DB_PASSWORD")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "some.password", actual)
+}
+
+func TestSecretFileSource_GetString_GivenMissingFile_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := config.NewSecretFileSource(goConfig.MapSource(map[string]string{
+		"DB_PASSWORD_FILE": filepath.Join(t.TempDir(), "missing"),
+	}))
+
+	// Exercise SUT
+	_, err := sut.GetString("DB_PASSWORD")
+
+	// Verify results
+	assert.ErrorContains(t, err, "could not read DB_PASSWORD_FILE: ")
+}
+
+func TestSecretFileSource_GetString_GivenNeither_ShouldFailAsNotSet(t *testing.T) {
+	// Setup fixture
+	sut := config.NewSecretFileSource(goConfig.MapSource(map[string]string{}))
+
+	// Exercise SUT
+	_, err := sut.GetString("DB_PASSWORD")
+
+	// Verify results
+	assert.EqualError(t, err, "DB_PASSWORD property is not set")
+}
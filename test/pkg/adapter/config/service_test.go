@@ -2,12 +2,15 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	goConfig "github.com/liampulles/go-config"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
 func TestStore_NewStoreImpl_WhenConfigIsWrongType_ShouldFail(t *testing.T) {
@@ -55,6 +58,50 @@ func TestStore_GetPort_ShouldReturnPort(t *testing.T) {
 	assert.Equal(t, 9001, actual)
 }
 
+func TestStore_GetGrpcPort_ShouldReturnGrpcPort(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"GRPC_PORT": "9002",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetGrpcPort()
+
+	// Verify results
+	assert.Equal(t, 9002, actual)
+}
+
+func TestStore_GetGrpcPort_WhenNotSet_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetGrpcPort()
+
+	// Verify results
+	assert.Equal(t, 9090, actual)
+}
+
+func TestStore_NewStoreImpl_WhenGrpcPortIsPort_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"PORT":      "9001",
+		"GRPC_PORT": "9001",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: GRPC_PORT must differ from PORT (both are 9001)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
 func TestStore_GetMigrationSource_ShouldReturnMigrationSource(t *testing.T) {
 	// Setup fixture
 	fixture := goConfig.MapSource(map[string]string{
@@ -68,3 +115,1036 @@ func TestStore_GetMigrationSource_ShouldReturnMigrationSource(t *testing.T) {
 	// Verify results
 	assert.Equal(t, "some.migration.source", actual)
 }
+
+func TestStore_NewStoreImpl_WhenPortIsOutOfRange_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"PORT": "70000",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: PORT must be between 1 and 65535 (is 70000)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenRequiredPropertyIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_HOST": " ",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_HOST must not be blank"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_Settings_ShouldReturnEffectiveValuesInPropertyOrder(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_PASSWORD": "some.password",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.Settings()
+
+	// Verify results
+	assert.Len(t, actual, len(config.Properties))
+	for i, setting := range actual {
+		assert.Equal(t, config.Properties[i], setting.Property)
+	}
+	assert.Contains(t, actual, config.Setting{
+		Property: config.Property{Name: "DB_PASSWORD", Default: "password", Description: "Password for DB", Secret: true},
+		Value:    "some.password",
+	})
+	assert.Contains(t, actual, config.Setting{
+		Property: config.Property{Name: "DB_USER", Default: "matchvid", Description: "Username for DB"},
+		Value:    "matchvid",
+	})
+}
+
+func TestStore_GetTraceExporter_GivenNoConfig_ShouldReturnNone(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetTraceExporter()
+
+	// Verify results
+	assert.Equal(t, "none", actual)
+}
+
+func TestStore_GetTraceOTLPEndpoint_ShouldReturnTraceOTLPEndpoint(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"TRACE_OTLP_ENDPOINT": "some.collector:4318",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetTraceOTLPEndpoint()
+
+	// Verify results
+	assert.Equal(t, "some.collector:4318", actual)
+}
+
+func TestStore_GetTraceServiceName_ShouldReturnTraceServiceName(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"TRACE_SERVICE_NAME": "some.service",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetTraceServiceName()
+
+	// Verify results
+	assert.Equal(t, "some.service", actual)
+}
+
+func TestStore_NewStoreImpl_WhenRequestTimeoutIsNotADuration_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"REQUEST_TIMEOUT": "not.a.duration",
+	})
+
+	// Setup expectations
+	expectedErr := "could not fetch config: value of REQUEST_TIMEOUT property can not be converted to duration (is not.a.duration)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenRouteTimeoutsAreMalformed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"ROUTE_TIMEOUTS": "GET /inventory",
+	})
+
+	// Setup expectations
+	expectedErr := "could not fetch config: value of ROUTE_TIMEOUTS property can not be converted to METHOD /path=duration (is GET /inventory)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetRequestTimeout_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetRequestTimeout()
+
+	// Verify results
+	assert.Equal(t, 30*time.Second, actual)
+}
+
+func TestStore_GetRouteTimeouts_ShouldReturnParsedRouteTimeouts(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"ROUTE_TIMEOUTS": "GET /inventory=5s, PUT  /inventory/{id}/checkout=250ms",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Setup expectations
+	expected := map[string]time.Duration{
+		"GET /inventory":               5 * time.Second,
+		"PUT /inventory/{id}/checkout": 250 * time.Millisecond,
+	}
+
+	// Exercise SUT
+	actual := sut.GetRouteTimeouts()
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestStore_NewStoreImpl_WhenSSLModeIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_SSL_MODE": "sometimes",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_SSL_MODE must be one of disable, allow, prefer, require, verify-ca, verify-full (is sometimes)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenClientCertHasNoKey_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_SSL_CERT": "/certs/client.crt",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_SSL_KEY must be set when DB_SSL_CERT is set"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenMaxOpenConnsIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_MAX_OPEN_CONNS": "-1",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_MAX_OPEN_CONNS must not be negative (is -1)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_DBConnectionGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DATABASE_URL":           "postgres://u:p@db/matchvid",
+		"DB_SSL_MODE":            "verify-full",
+		"DB_SSL_ROOT_CERT":       "/certs/ca.crt",
+		"DB_SSL_CERT":            "/certs/client.crt",
+		"DB_SSL_KEY":             "/certs/client.key",
+		"DB_MAX_OPEN_CONNS":      "20",
+		"DB_MAX_IDLE_CONNS":      "5",
+		"DB_CONN_MAX_LIFETIME":   "1h",
+		"DB_CONN_MAX_IDLE_TIME":  "10m",
+		"DB_CONNECT_TIMEOUT":     "1m",
+		"DB_CONNECT_BACKOFF":     "1s",
+		"DB_CONNECT_MAX_BACKOFF": "10s",
+	})
+	sut, err := config.NewStoreImpl(fixture)
+	assert.NoError(t, err)
+
+	// Exercise SUT & verify results
+	assert.Equal(t, "postgres://u:p@db/matchvid", sut.GetDatabaseURL())
+	assert.Equal(t, "verify-full", sut.GetDbSSLMode())
+	assert.Equal(t, "/certs/ca.crt", sut.GetDbSSLRootCert())
+	assert.Equal(t, "/certs/client.crt", sut.GetDbSSLCert())
+	assert.Equal(t, "/certs/client.key", sut.GetDbSSLKey())
+	assert.Equal(t, 20, sut.GetDbMaxOpenConns())
+	assert.Equal(t, 5, sut.GetDbMaxIdleConns())
+	assert.Equal(t, time.Hour, sut.GetDbConnMaxLifetime())
+	assert.Equal(t, 10*time.Minute, sut.GetDbConnMaxIdleTime())
+	assert.Equal(t, time.Minute, sut.GetDbConnectTimeout())
+	assert.Equal(t, time.Second, sut.GetDbConnectBackoff())
+	assert.Equal(t, 10*time.Second, sut.GetDbConnectMaxBackoff())
+}
+
+func TestStore_DBConnectionGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT & verify results
+	assert.Equal(t, "", sut.GetDatabaseURL())
+	assert.Equal(t, "disable", sut.GetDbSSLMode())
+	assert.Equal(t, 0, sut.GetDbMaxOpenConns())
+	assert.Equal(t, 2, sut.GetDbMaxIdleConns())
+	assert.Equal(t, time.Duration(0), sut.GetDbConnMaxLifetime())
+	assert.Equal(t, 30*time.Second, sut.GetDbConnectTimeout())
+	assert.Equal(t, 500*time.Millisecond, sut.GetDbConnectBackoff())
+	assert.Equal(t, 5*time.Second, sut.GetDbConnectMaxBackoff())
+}
+
+func TestStore_NewStoreImpl_WhenDBConnectBackoffIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_CONNECT_BACKOFF": "0s",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_CONNECT_BACKOFF must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenDBConnectMaxBackoffIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_CONNECT_MAX_BACKOFF": "0s",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: DB_CONNECT_MAX_BACKOFF must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetAutoMigrate_GivenNoConfig_ShouldReturnTrue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetAutoMigrate()
+
+	// Verify results
+	assert.True(t, actual)
+}
+
+func TestStore_NewStoreImpl_WhenAutoMigrateIsNotABool_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"AUTO_MIGRATE": "sometimes",
+	})
+
+	// Setup expectations
+	expectedErr := "could not fetch config: value of AUTO_MIGRATE property can not be converted to bool (is sometimes)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_LateFeeGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT & verify results
+	assert.Equal(t, domain.LateFeeRule{PerDay: 100}, sut.GetLateFeeRule())
+	assert.Empty(t, sut.GetLateFeeFormatRules())
+}
+
+func TestStore_LateFeeGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"LATE_FEE_PER_DAY":          "150",
+		"LATE_FEE_GRACE_DAYS":       "1",
+		"LATE_FEE_CAP":              "1000",
+		"LATE_FEE_FORMAT_OVERRIDES": "vhs=50/2/500, 4k = 200/0/0",
+	})
+	sut, err := config.NewStoreImpl(fixture)
+	assert.NoError(t, err)
+
+	// Setup expectations
+	expectedRule := domain.LateFeeRule{PerDay: 150, GraceDays: 1, Cap: 1000}
+	expectedOverrides := map[entity.Format]domain.LateFeeRule{
+		entity.FormatVHS: {PerDay: 50, GraceDays: 2, Cap: 500},
+		entity.Format4K:  {PerDay: 200},
+	}
+
+	// Exercise SUT & verify results
+	assert.Equal(t, expectedRule, sut.GetLateFeeRule())
+	assert.Equal(t, expectedOverrides, sut.GetLateFeeFormatRules())
+}
+
+func TestStore_NewStoreImpl_WhenLateFeeOverridesAreMalformed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"LATE_FEE_FORMAT_OVERRIDES": "vhs=50/2",
+	})
+
+	// Setup expectations
+	expectedErr := "could not fetch config: value of LATE_FEE_FORMAT_OVERRIDES property can not be converted to format=perDay/graceDays/cap (is vhs=50/2)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenLateFeeOverrideFormatIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"LATE_FEE_FORMAT_OVERRIDES": "laserdisc=50/0/0",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: LATE_FEE_FORMAT_OVERRIDES has an unknown format (is laserdisc)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenLateFeeIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"LATE_FEE_PER_DAY": "-5",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: LATE_FEE_PER_DAY must not be negative (is -5)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetHoldExpiry_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetHoldExpiry()
+
+	// Verify results
+	assert.Equal(t, 72*time.Hour, actual)
+}
+
+func TestStore_GetHoldExpiry_ShouldReturnConfiguredValue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"HOLD_EXPIRY": "36h",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetHoldExpiry()
+
+	// Verify results
+	assert.Equal(t, 36*time.Hour, actual)
+}
+
+func TestStore_NewStoreImpl_WhenHoldExpiryIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"HOLD_EXPIRY": "0s",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: HOLD_EXPIRY must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetRenewalLimit_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetRenewalLimit()
+
+	// Verify results
+	assert.Equal(t, 2, actual)
+}
+
+func TestStore_NewStoreImpl_WhenRenewalLimitIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RENEWAL_LIMIT": "-1",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: RENEWAL_LIMIT must not be negative (is -1)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetCreditLimit_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetCreditLimit()
+
+	// Verify results
+	assert.Equal(t, entity.Money(1000), actual)
+}
+
+func TestStore_NewStoreImpl_WhenCreditLimitIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CREDIT_LIMIT": "-1",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: CREDIT_LIMIT must not be negative (is -1)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetRatingScheme_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetRatingScheme()
+
+	// Verify results
+	assert.Equal(t, "mpaa", actual)
+}
+
+func TestStore_NewStoreImpl_WhenRatingSchemeIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATING_SCHEME": "pegi",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: RATING_SCHEME must be one of mpaa, bbfc (is pegi)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetRatingMinimumAges_ShouldReturnConfiguredValue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATING_MINIMUM_AGES": "R=18, X = 18",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Setup expectations
+	expected := map[string]int{
+		"R": 18,
+		"X": 18,
+	}
+
+	// Exercise SUT
+	actual := sut.GetRatingMinimumAges()
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestStore_NewStoreImpl_WhenRatingMinimumAgeIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATING_MINIMUM_AGES": "R=-1",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: RATING_MINIMUM_AGES must not be negative (is -1)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetLocationFormat_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetLocationFormat()
+
+	// Verify results
+	assert.Equal(t, "{store}-{aisle}-{shelf}-{slot}", actual)
+}
+
+func TestStore_NewStoreImpl_WhenLocationFormatIsMissingAPart_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"LOCATION_FORMAT": "{store}-{aisle}-{shelf}",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: LOCATION_FORMAT must have each of {store}, {aisle}, {shelf} and {slot} (is {store}-{aisle}-{shelf})"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_OutboxGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Empty(t, sut.GetOutboxSinks())
+	assert.Equal(t, time.Second, sut.GetOutboxPollInterval())
+	assert.Equal(t, 100, sut.GetOutboxBatchSize())
+}
+
+func TestStore_GetOutboxSinks_ShouldReturnConfiguredValue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"OUTBOX_SINKS": " stdout, ",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetOutboxSinks()
+
+	// Verify results
+	assert.Equal(t, []string{"stdout"}, actual)
+}
+
+func TestStore_NewStoreImpl_WhenOutboxSinkIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"OUTBOX_SINKS": "stdout,kafka",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: OUTBOX_SINKS must be one of stdout, webhook (is kafka)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenOutboxBatchSizeIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"OUTBOX_BATCH_SIZE": "0",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: OUTBOX_BATCH_SIZE must be positive (is 0)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_WebhookGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, 10*time.Second, sut.GetWebhookTimeout())
+	assert.Equal(t, 8, sut.GetWebhookMaxAttempts())
+	assert.Equal(t, 30*time.Second, sut.GetWebhookBackoff())
+	assert.Equal(t, time.Hour, sut.GetWebhookMaxBackoff())
+}
+
+func TestStore_WebhookGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"WEBHOOK_TIMEOUT":      "2s",
+		"WEBHOOK_MAX_ATTEMPTS": "3",
+		"WEBHOOK_BACKOFF":      "100ms",
+		"WEBHOOK_MAX_BACKOFF":  "1s",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, 2*time.Second, sut.GetWebhookTimeout())
+	assert.Equal(t, 3, sut.GetWebhookMaxAttempts())
+	assert.Equal(t, 100*time.Millisecond, sut.GetWebhookBackoff())
+	assert.Equal(t, time.Second, sut.GetWebhookMaxBackoff())
+}
+
+func TestStore_NewStoreImpl_WhenWebhookMaxAttemptsIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"WEBHOOK_MAX_ATTEMPTS": "0",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: WEBHOOK_MAX_ATTEMPTS must be positive (is 0)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_EventStreamGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, time.Second, sut.GetEventStreamPollInterval())
+	assert.Equal(t, 5*time.Second, sut.GetEventStreamGapTimeout())
+}
+
+func TestStore_EventStreamGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"EVENT_STREAM_POLL_INTERVAL": "250ms",
+		"EVENT_STREAM_GAP_TIMEOUT":   "0",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, 250*time.Millisecond, sut.GetEventStreamPollInterval())
+	assert.Equal(t, time.Duration(0), sut.GetEventStreamGapTimeout())
+}
+
+func TestStore_NewStoreImpl_WhenEventStreamPollIntervalIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"EVENT_STREAM_POLL_INTERVAL": "0",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: EVENT_STREAM_POLL_INTERVAL must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetGraphQLMaxDepth_WhenNotSet_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetGraphQLMaxDepth()
+
+	// Verify results
+	assert.Equal(t, 5, actual)
+}
+
+func TestStore_GetGraphQLMaxDepth_ShouldReturnConfiguredValue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"GRAPHQL_MAX_DEPTH": "8",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetGraphQLMaxDepth()
+
+	// Verify results
+	assert.Equal(t, 8, actual)
+}
+
+func TestStore_NewStoreImpl_WhenGraphQLMaxDepthIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"GRAPHQL_MAX_DEPTH": "0",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: GRAPHQL_MAX_DEPTH must be positive (is 0)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_CliGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, "table", sut.GetCliOutput())
+	assert.Equal(t, "", sut.GetCliServerURL())
+}
+
+func TestStore_CliGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CLI_OUTPUT":     "json",
+		"CLI_SERVER_URL": "http://localhost:8080",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, "json", sut.GetCliOutput())
+	assert.Equal(t, "http://localhost:8080", sut.GetCliServerURL())
+}
+
+func TestStore_NewStoreImpl_WhenCliOutputIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CLI_OUTPUT": "yaml",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: CLI_OUTPUT must be one of table, json (is yaml)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_GetIdempotencyKeyExpiry_GivenNoConfig_ShouldReturnDefault(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetIdempotencyKeyExpiry()
+
+	// Verify results
+	assert.Equal(t, 24*time.Hour, actual)
+}
+
+func TestStore_GetIdempotencyKeyExpiry_ShouldReturnConfiguredValue(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"IDEMPOTENCY_KEY_EXPIRY": "1h",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT
+	actual := sut.GetIdempotencyKeyExpiry()
+
+	// Verify results
+	assert.Equal(t, time.Hour, actual)
+}
+
+func TestStore_NewStoreImpl_WhenIdempotencyKeyExpiryIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"IDEMPOTENCY_KEY_EXPIRY": "0s",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: IDEMPOTENCY_KEY_EXPIRY must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_RateLimitGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, 0, sut.GetRateLimit())
+	assert.Equal(t, time.Minute, sut.GetRateLimitPeriod())
+	assert.Empty(t, sut.GetRouteRateLimits())
+	assert.Empty(t, sut.GetRateLimitAPIKeys())
+}
+
+func TestStore_RateLimitGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATE_LIMIT":          "100",
+		"RATE_LIMIT_PERIOD":   "10s",
+		"ROUTE_RATE_LIMITS":   "GET /inventory=60, POST  /inventory=0",
+		"RATE_LIMIT_API_KEYS": "kiosk-key, shop-key",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Setup expectations
+	expectedRoutes := map[string]int{
+		"GET /inventory":  60,
+		"POST /inventory": 0,
+	}
+
+	// Exercise SUT and verify results
+	assert.Equal(t, 100, sut.GetRateLimit())
+	assert.Equal(t, 10*time.Second, sut.GetRateLimitPeriod())
+	assert.Equal(t, expectedRoutes, sut.GetRouteRateLimits())
+	assert.Equal(t, []string{"kiosk-key", "shop-key"}, sut.GetRateLimitAPIKeys())
+}
+
+func TestStore_NewStoreImpl_WhenRateLimitIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATE_LIMIT": "-1",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: RATE_LIMIT must not be negative (is -1)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenRateLimitPeriodIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"RATE_LIMIT_PERIOD": "0s",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: RATE_LIMIT_PERIOD must be positive (is 0s)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenRouteRateLimitIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"ROUTE_RATE_LIMITS": "GET /inventory=-5",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: ROUTE_RATE_LIMITS must not be negative (is -5)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_NewStoreImpl_WhenRouteRateLimitsAreMalformed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"ROUTE_RATE_LIMITS": "GET /inventory",
+	})
+
+	// Setup expectations
+	expectedErr := "could not fetch config: value of ROUTE_RATE_LIMITS property can not be converted to METHOD /path=requests (is GET /inventory)"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestStore_CORSGetters_GivenNoConfig_ShouldReturnDefaults(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Empty(t, sut.GetCORSAllowedOrigins())
+	assert.Empty(t, sut.GetCORSAllowedMethods())
+	assert.Equal(t, []string{"Content-Type", "Idempotency-Key", "X-API-Key", "traceparent"}, sut.GetCORSAllowedHeaders())
+	assert.False(t, sut.GetCORSAllowCredentials())
+	assert.Equal(t, 10*time.Minute, sut.GetCORSMaxAge())
+}
+
+func TestStore_CORSGetters_ShouldReturnConfiguredValues(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CORS_ALLOWED_ORIGINS":   "https://shop.example.com, http://localhost:3000",
+		"CORS_ALLOWED_METHODS":   "GET,POST",
+		"CORS_ALLOWED_HEADERS":   "Content-Type",
+		"CORS_ALLOW_CREDENTIALS": "true",
+		"CORS_MAX_AGE":           "1h",
+	})
+	sut, _ := config.NewStoreImpl(fixture)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, []string{"https://shop.example.com", "http://localhost:3000"}, sut.GetCORSAllowedOrigins())
+	assert.Equal(t, []string{"GET", "POST"}, sut.GetCORSAllowedMethods())
+	assert.Equal(t, []string{"Content-Type"}, sut.GetCORSAllowedHeaders())
+	assert.True(t, sut.GetCORSAllowCredentials())
+	assert.Equal(t, time.Hour, sut.GetCORSMaxAge())
+}
+
+func TestStore_NewStoreImpl_WhenCredentialsAreAllowedFromAnyOrigin_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CORS_ALLOWED_ORIGINS":   "*",
+		"CORS_ALLOW_CREDENTIALS": "true",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: CORS_ALLOWED_ORIGINS must not be * when CORS_ALLOW_CREDENTIALS is set"
+
+	// Exercise SUT
+	actual, err := config.NewStoreImpl(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	goConfig "github.com/liampulles/go-config"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/config"
+)
+
+func TestNewCommandLineSource_ShouldLayerFlagsOverEnvOverFile(t *testing.T) {
+	// Setup fixture
+	secret := writeFile(t, "secret", "some.file.password")
+	file := writeFile(t, "config.yaml", `
+port: 1
+db-host: file.host
+db-name: file.name
+db-password-file: `+secret+`
+`)
+	env := goConfig.MapSource(map[string]string{
+		"CONFIG_FILE": file,
+		"PORT":        "2",
+		"DB_HOST":     "env.host",
+	})
+	args := []string{"--port=3", "serve"}
+
+	// Exercise SUT
+	actual, positional, err := config.NewCommandLineSource(args, env)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"serve"}, positional)
+	assertSource(t, actual, "PORT", "3")
+	assertSource(t, actual, "DB_HOST", "env.host")
+	assertSource(t, actual, "DB_NAME", "file.name")
+	assertSource(t, actual, "DB_PASSWORD", "some.file.password")
+}
+
+func TestNewCommandLineSource_GivenConfigFileFlag_ShouldReadFile(t *testing.T) {
+	// Setup fixture
+	file := writeFile(t, "config.toml", `db_name = "file.name"`)
+	env := goConfig.MapSource(map[string]string{})
+
+	// Exercise SUT
+	actual, _, err := config.NewCommandLineSource([]string{"--config-file", file}, env)
+
+	// Verify results
+	assert.NoError(t, err)
+	assertSource(t, actual, "DB_NAME", "file.name")
+}
+
+func TestNewCommandLineSource_GivenMissingConfigFile_ShouldFail(t *testing.T) {
+	// Setup fixture
+	env := goConfig.MapSource(map[string]string{
+		"CONFIG_FILE": filepath.Join(t.TempDir(), "missing.yaml"),
+	})
+
+	// Exercise SUT
+	actual, _, err := config.NewCommandLineSource([]string{}, env)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.ErrorContains(t, err, "could not read config file: ")
+}
+
+func assertSource(t *testing.T, sut goConfig.Source, property string, expected string) {
+	actual, err := sut.GetString(property)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual, property)
+}
@@ -63,3 +63,59 @@ func (suite *ErrorParserTestSuite) TestFromDBRowScan_WhenIsArbitraryError_Should
 	// Verify results
 	suite.EqualError(err, expectedErr)
 }
+
+func (suite *ErrorParserTestSuite) TestFromDBRowScan_WhenIsForeignKeyConstraint_ShouldReturnForeignKeyConstraintError() {
+	// Setup fixture
+	fixture := fmt.Errorf("something violates foreign key constraint")
+
+	// Setup expectations
+	expectedErr := "foreign key constraint error: something violates foreign key constraint"
+
+	// Exercise SUT
+	err := suite.sut.FromDBRowScan(fixture, "some.type")
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ErrorParserTestSuite) TestFromDBExec_WhenIsUniquenessConstraint_ShouldReturnUniquenessConstraintError() {
+	// Setup fixture
+	fixture := fmt.Errorf("something violates unique constraint")
+
+	// Setup expectations
+	expectedErr := "uniqueness constraint error: something violates unique constraint"
+
+	// Exercise SUT
+	err := suite.sut.FromDBExec(fixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ErrorParserTestSuite) TestFromDBExec_WhenIsForeignKeyConstraint_ShouldReturnForeignKeyConstraintError() {
+	// Setup fixture
+	fixture := fmt.Errorf("something violates foreign key constraint")
+
+	// Setup expectations
+	expectedErr := "foreign key constraint error: something violates foreign key constraint"
+
+	// Exercise SUT
+	err := suite.sut.FromDBExec(fixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ErrorParserTestSuite) TestFromDBExec_WhenIsArbitraryError_ShouldReturnSameError() {
+	// Setup fixture
+	fixture := fmt.Errorf("there were no rows in result set")
+
+	// Setup expectations
+	expectedErr := "there were no rows in result set"
+
+	// Exercise SUT
+	err := suite.sut.FromDBExec(fixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
@@ -0,0 +1,21 @@
+package db_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+)
+
+func TestForeignKeyConstraintError_Error_ShouldReturnCorrectMessage(t *testing.T) {
+	// Setup fixture
+	err := db.NewForeignKeyConstraintError(fmt.Errorf("some.error"))
+
+	// Setup expectations
+	expected := "foreign key constraint error: some.error"
+
+	// Verify results
+	assert.EqualError(t, err, expected)
+}
@@ -0,0 +1,207 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type AccountRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockAccountConstructor
+	sut               *sql.AccountRepositoryImpl
+}
+
+func TestAccountRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountRepositoryTestSuite))
+}
+
+func (suite *AccountRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockAccountConstructor{}
+	suite.sut = sql.NewAccountRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *AccountRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		name, 
+		date_of_birth, 
+		COALESCE(balance, 0) 
+	FROM account
+	LEFT JOIN account_balance ON account_balance.account_id=account.id
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "account", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	_, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *AccountRepositoryTestSuite) TestFindByID_WhenRowIsScanned_ShouldReincarnate() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.FixedZone("", 0))
+	rowFixture := &stubRow{values: []interface{}{entity.ID(101), "some.name", &dateOfBirth, entity.Money(250)}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "account", idFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	expectedDateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	suite.mockConstructor.On("Reincarnate", entity.ID(101), "some.name", &expectedDateOfBirth, entity.Money(250)).
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *AccountRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		name, 
+		date_of_birth, 
+		COALESCE(balance, 0) 
+	FROM account
+	LEFT JOIN account_balance ON account_balance.account_id=account.id
+	ORDER BY 
+		name, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "account").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *AccountRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldReturnID() {
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO account
+		(
+			name, 
+			date_of_birth
+		)
+	VALUES ($1, $2)
+	RETURNING id;`
+	expectedID := entity.ID(101)
+
+	// Setup mocks
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("Name").Return("some.name")
+	mockEntity.On("DateOfBirth").Return(&dateOfBirth)
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "account",
+		"some.name",
+		&dateOfBirth,
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *AccountRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	UPDATE account
+	SET
+		name=$1, date_of_birth=$2
+	WHERE 
+		id=$3;`
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ID").Return(entity.ID(101)).
+		On("Name").Return("some.name").
+		On("DateOfBirth").Return(nil)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "account",
+		"some.name",
+		(*time.Time)(nil),
+		entity.ID(101),
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *AccountRepositoryTestSuite) TestDeleteByID_WhenHelperServicePasses_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM account
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "account", idFixture).
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.DeleteByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
@@ -1,6 +1,7 @@
 package sql_test
 
 import (
+	"context"
 	goSql "database/sql"
 	"fmt"
 	"testing"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db"
 
+	adapterDb "github.com/liampulles/matchstick-video/pkg/adapter/db"
 	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
@@ -18,6 +20,7 @@ import (
 type HelperServiceTestSuite struct {
 	suite.Suite
 	db              *goSql.DB
+	ctxFixture      context.Context
 	mockDb          sqlmock.Sqlmock
 	mockErrorParser *db.MockErrorParser
 	sut             *sql.HelperServiceImpl
@@ -33,6 +36,7 @@ func (suite *HelperServiceTestSuite) SetupTest() {
 		panic(err)
 	}
 	suite.db = d
+	suite.ctxFixture = context.Background()
 	suite.mockDb = mock
 	suite.mockErrorParser = &db.MockErrorParser{}
 	suite.sut = sql.NewHelperServiceImpl(
@@ -43,6 +47,7 @@ func (suite *HelperServiceTestSuite) SetupTest() {
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenPrepareContextFails_ShouldFail() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
@@ -53,9 +58,10 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenPrepareContextFai
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDb.ExpectPrepare(queryFixture).
 		WillReturnError(mockErr)
+	suite.mockErrorParser.On("FromDBExec", mockErr).Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -64,6 +70,7 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenPrepareContextFai
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenExecContextFails_ShouldFail() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
@@ -76,9 +83,36 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenExecContextFails_
 		ExpectExec().
 		WithArgs(arg1Fixture, arg2Fixture).
 		WillReturnError(mockErr)
+	suite.mockErrorParser.On("FromDBExec", mockErr).Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenExecContextFailsOnConstraint_ShouldReturnParsedError() {
+	// Setup fixture
+	queryFixture := "some.query"
+	typeFixture := "some.type"
+	arg1Fixture := "arg.1"
+	arg2Fixture := 2
+
+	// Setup expectations
+	expectedErr := "cannot execute exec - db exec error: foreign key constraint error: mock.error"
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDb.ExpectPrepare(queryFixture).
+		ExpectExec().
+		WithArgs(arg1Fixture, arg2Fixture).
+		WillReturnError(mockErr)
+	suite.mockErrorParser.On("FromDBExec", mockErr).
+		Return(adapterDb.NewForeignKeyConstraintError(mockErr))
+
+	// Exercise SUT
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -87,6 +121,7 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenExecContextFails_
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedFails_ShouldFail() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
@@ -101,9 +136,10 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedFails
 		WithArgs(arg1Fixture, arg2Fixture).
 		WillReturnResult(mockResult)
 	mockResult.On("RowsAffected").Return(int64(-1), mockErr)
+	suite.mockErrorParser.On("FromDBExec", mockErr).Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -112,11 +148,12 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedFails
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsZero_ShouldFail() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
 	// Setup expectations
-	expectedErr := "entity not found: type=[inventory item]"
+	expectedErr := "entity not found: type=[some.type]"
 
 	// Setup mocks
 	mockResult := &mockResult{}
@@ -127,7 +164,7 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsZer
 	mockResult.On("RowsAffected").Return(int64(0), nil)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -136,6 +173,7 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsZer
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsMoreThanOne_ShouldFail() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
@@ -151,7 +189,7 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsMor
 	mockResult.On("RowsAffected").Return(int64(2), nil)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -160,22 +198,25 @@ func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsMor
 func (suite *HelperServiceTestSuite) TestExecForSingleItem_WhenRowsAffectedIsOne_ShouldPass() {
 	// Setup fixture
 	queryFixture := "some.query"
+	typeFixture := "some.type"
 	arg1Fixture := "arg.1"
 	arg2Fixture := 2
 
 	// Setup mocks
 	mockResult := &mockResult{}
 	suite.mockDb.ExpectPrepare(queryFixture).
+		WillBeClosed().
 		ExpectExec().
 		WithArgs(arg1Fixture, arg2Fixture).
 		WillReturnResult(mockResult)
 	mockResult.On("RowsAffected").Return(int64(1), nil)
 
 	// Exercise SUT
-	err := suite.sut.ExecForSingleItem(suite.db, queryFixture, arg1Fixture, arg2Fixture)
+	err := suite.sut.ExecForSingleItem(suite.ctxFixture, suite.db, queryFixture, typeFixture, arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.NoError(err)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
 }
 
 func (suite *HelperServiceTestSuite) TestSingleRowQuery_WhenPrepareContextFails_ShouldFail() {
@@ -196,7 +237,7 @@ func (suite *HelperServiceTestSuite) TestSingleRowQuery_WhenPrepareContextFails_
 		WillReturnError(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.SingleRowQuery(suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.SingleRowQuery(suite.ctxFixture, suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -227,7 +268,7 @@ func (suite *HelperServiceTestSuite) TestSingleRowQuery_WhenScanFuncFails_Should
 		Return(mockParsedErr)
 
 	// Exercise SUT
-	err := suite.sut.SingleRowQuery(suite.db, queryFixture, failingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.SingleRowQuery(suite.ctxFixture, suite.db, queryFixture, failingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -243,18 +284,21 @@ func (suite *HelperServiceTestSuite) TestSingleRowQuery_WhenScanFuncPasses_Shoul
 	mockRows := suite.mockDb.NewRows([]string{"some", "columns"}).
 		FromCSVString("with,data")
 	passingFunc := func(row sql.Row) error {
-		return nil
+		var some, columns string
+		return row.Scan(&some, &columns)
 	}
 	suite.mockDb.ExpectPrepare(queryFixture).
+		WillBeClosed().
 		ExpectQuery().
 		WithArgs(arg1Fixture, arg2Fixture).
 		WillReturnRows(mockRows)
 
 	// Exercise SUT
-	err := suite.sut.SingleRowQuery(suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.SingleRowQuery(suite.ctxFixture, suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.NoError(err)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
 }
 
 func (suite *HelperServiceTestSuite) TestSingleQueryForID_WhenPrepareContextFails_ShouldFail() {
@@ -272,7 +316,7 @@ func (suite *HelperServiceTestSuite) TestSingleQueryForID_WhenPrepareContextFail
 		WillReturnError(mockErr)
 
 	// Exercise SUT
-	actual, err := suite.sut.SingleQueryForID(suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
+	actual, err := suite.sut.SingleQueryForID(suite.ctxFixture, suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.Equal(entity.InvalidID, actual)
@@ -299,7 +343,7 @@ func (suite *HelperServiceTestSuite) TestSingleQueryForID_WhenScanFails_ShouldFa
 		Return(mockParsedErr)
 
 	// Exercise SUT
-	actual, err := suite.sut.SingleQueryForID(suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
+	actual, err := suite.sut.SingleQueryForID(suite.ctxFixture, suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.Equal(entity.InvalidID, actual)
@@ -321,7 +365,7 @@ func (suite *HelperServiceTestSuite) TestSingleQueryForID_WhenScanPasses_ShouldR
 		WillReturnRows(mockRows)
 
 	// Exercise SUT
-	actual, err := suite.sut.SingleQueryForID(suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
+	actual, err := suite.sut.SingleQueryForID(suite.ctxFixture, suite.db, queryFixture, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.NoError(err)
@@ -346,7 +390,7 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenPrepareContextFails_S
 		WillReturnError(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -372,7 +416,7 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenQueryRowContextFails_
 		WillReturnError(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, passingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -396,17 +440,20 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenScanFuncFails_ShouldF
 	mockRows := suite.mockDb.NewRows([]string{"something"}).
 		FromCSVString("some.data")
 	suite.mockDb.ExpectPrepare(queryFixture).
+		WillBeClosed().
 		ExpectQuery().
 		WithArgs(arg1Fixture, arg2Fixture).
-		WillReturnRows(mockRows)
+		WillReturnRows(mockRows).
+		RowsWillBeClosed()
 	suite.mockErrorParser.On("FromDBRowScan", mockErr, "some.type").
 		Return(mockParsedErr)
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, failingFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, failingFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
 }
 
 func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenFirstRowScanFails_ShouldFail() {
@@ -438,7 +485,7 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenFirstRowScanFails_Sho
 		WillReturnRows(mockRows)
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -474,7 +521,7 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenSecondRowScanFails_Sh
 		WillReturnRows(mockRows)
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -506,16 +553,19 @@ func (suite *HelperServiceTestSuite) TestManyRowsQuery_WhenIterationPasses_Shoul
 		FromCSVString("some.data.1").
 		FromCSVString("some.data.2")
 	suite.mockDb.ExpectPrepare(queryFixture).
+		WillBeClosed().
 		ExpectQuery().
 		WithArgs(arg1Fixture, arg2Fixture).
-		WillReturnRows(mockRows)
+		WillReturnRows(mockRows).
+		RowsWillBeClosed()
 
 	// Exercise SUT
-	err := suite.sut.ManyRowsQuery(suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
+	err := suite.sut.ManyRowsQuery(suite.ctxFixture, suite.db, queryFixture, scanFunc, "some.type", arg1Fixture, arg2Fixture)
 
 	// Verify results
 	suite.NoError(err)
 	suite.Equal(expected, actual)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
 }
 
 type mockResult struct {
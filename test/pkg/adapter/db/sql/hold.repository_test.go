@@ -0,0 +1,331 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type HoldRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	placedFixture     time.Time
+	expiresFixture    time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockHoldConstructor
+	sut               *sql.HoldRepositoryImpl
+}
+
+func TestHoldRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldRepositoryTestSuite))
+}
+
+func (suite *HoldRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.placedFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.expiresFixture = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockHoldConstructor{}
+	suite.sut = sql.NewHoldRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *HoldRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldStoreUnassignedItemAsNull() {
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO hold
+		(
+			title_id, 
+			account_id, 
+			placed_at, 
+			status, 
+			inventory_item_id, 
+			expires_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id;`
+	expectedID := entity.ID(301)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{}
+	mockEntity.On("TitleID").Return(entity.ID(11)).
+		On("AccountID").Return(entity.ID(7)).
+		On("PlacedAt").Return(suite.placedFixture).
+		On("Status").Return(entity.HoldWaiting).
+		On("ItemID").Return(entity.InvalidID).
+		On("ExpiresAt").Return(nil)
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "hold",
+		entity.ID(11),
+		entity.ID(7),
+		suite.placedFixture,
+		"waiting",
+		(*entity.ID)(nil),
+		(*time.Time)(nil),
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *HoldRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	itemIDFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	UPDATE hold
+	SET
+		status=$1, inventory_item_id=$2, expires_at=$3
+	WHERE 
+		id=$4;`
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{}
+	mockEntity.On("ID").Return(entity.ID(301)).
+		On("Status").Return(entity.HoldReady).
+		On("ItemID").Return(itemIDFixture).
+		On("ExpiresAt").Return(&suite.expiresFixture)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "hold",
+		"ready",
+		&itemIDFixture,
+		&suite.expiresFixture,
+		entity.ID(301),
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "hold", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindByID_WhenRowIsScanned_ShouldReincarnateInUTC() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+	itemIDFixture := entity.ID(101)
+	zone := time.FixedZone("some.zone", 2*60*60)
+	expiresInZone := suite.expiresFixture.In(zone)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(301), entity.ID(11), entity.ID(7),
+		suite.placedFixture.In(zone), "ready", &itemIDFixture, &expiresInZone,
+	}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "mock.data"}
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "hold", idFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(301), entity.ID(11), entity.ID(7),
+		suite.placedFixture, entity.HoldReady, itemIDFixture, &suite.expiresFixture).
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindActiveByTitleID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		title_id=$1 AND status IN ('waiting', 'ready')
+	ORDER BY 
+		placed_at, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "hold", titleIDFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByTitleID(suite.ctxFixture, titleIDFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindNextWaitingByTitleID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		title_id=$1 AND status='waiting'
+	ORDER BY 
+		placed_at, id
+	LIMIT 1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "hold", titleIDFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindNextWaitingByTitleID(suite.ctxFixture, titleIDFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindNextWaitingByTitleID_WhenRowIsScanned_ShouldReincarnateUnassigned() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(301), entity.ID(11), entity.ID(7),
+		suite.placedFixture, "waiting", (*entity.ID)(nil), (*time.Time)(nil),
+	}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "mock.data"}
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "hold", titleIDFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(301), entity.ID(11), entity.ID(7),
+		suite.placedFixture, entity.HoldWaiting, entity.InvalidID, (*time.Time)(nil)).
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindNextWaitingByTitleID(suite.ctxFixture, titleIDFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindReadyByItemID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	itemIDFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		account_id, 
+		placed_at, 
+		status, 
+		inventory_item_id, 
+		expires_at 
+	FROM hold
+	WHERE 
+		inventory_item_id=$1 AND status='ready';`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "hold", itemIDFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindReadyByItemID(suite.ctxFixture, itemIDFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *HoldRepositoryTestSuite) TestFindReadyByItemID_WhenNoRows_ShouldReturnNil() {
+	// Setup fixture
+	itemIDFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "hold", itemIDFixture).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindReadyByItemID(suite.ctxFixture, itemIDFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+}
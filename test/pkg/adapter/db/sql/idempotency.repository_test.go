@@ -0,0 +1,356 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+type IdempotencyRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	createdFixture    time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	sut               *sql.IdempotencyRepositoryImpl
+}
+
+func TestIdempotencyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyRepositoryTestSuite))
+}
+
+func (suite *IdempotencyRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.createdFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.sut = sql.NewIdempotencyRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestCreate_WhenKeyIsFree_ShouldReturnTrue() {
+	// Setup fixture
+	fixture := &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.createdFixture,
+	}
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO idempotency_key
+		(
+			client,
+			key,
+			fingerprint,
+			created_at
+		)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (client, key) DO NOTHING
+	RETURNING key;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", "some.fingerprint", suite.createdFixture).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.True(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestCreate_WhenKeyIsTaken_ShouldReturnFalse() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error: %w", db.NewNotFoundError("idempotency key"))
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", "some.fingerprint", suite.createdFixture).
+		Return(mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.createdFixture,
+	})
+
+	// Verify results
+	suite.NoError(err)
+	suite.False(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestCreate_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", "some.fingerprint", suite.createdFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.createdFixture,
+	})
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+	suite.False(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestFindByKey_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key", "ip:10.0.0.1", "some.key").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByKey(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestFindByKey_WhenNoneMatch_ShouldReturnNil() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error: %w", db.NewNotFoundError("idempotency key"))
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key", "ip:10.0.0.1", "some.key").
+		Return(mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByKey(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestFindByKey_WhenRequestIsFinished_ShouldReturnResponse() {
+	// Setup fixture
+	zone := time.FixedZone("some.zone", 2*60*60)
+	statusCode := 201
+	contentType := "text/plain"
+	rowFixture := &stubRow{values: []interface{}{
+		"ip:10.0.0.1", "some.key", "some.fingerprint", &statusCode, &contentType, []byte("101"), suite.createdFixture.In(zone),
+	}}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT
+		client,
+		key,
+		fingerprint,
+		status_code,
+		content_type,
+		body,
+		created_at
+	FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2;`
+	expected := &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		Response: &idempotency.ResponseVO{
+			StatusCode:  201,
+			ContentType: "text/plain",
+			Body:        []byte("101"),
+		},
+		CreatedAt: suite.createdFixture,
+	}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "idempotency key", "ip:10.0.0.1", "some.key").
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByKey(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestFindByKey_WhenRequestIsUnfinished_ShouldReturnNoResponse() {
+	// Setup fixture
+	rowFixture := &stubRow{values: []interface{}{
+		"ip:10.0.0.1", "some.key", "some.fingerprint", (*int)(nil), (*string)(nil), []byte(nil), suite.createdFixture,
+	}}
+
+	// Setup expectations
+	expected := &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.createdFixture,
+	}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key", "ip:10.0.0.1", "some.key").
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByKey(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestFinish_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	UPDATE idempotency_key
+	SET
+		status_code=$1,
+		content_type=$2,
+		body=$3
+	WHERE
+		client=$4 AND key=$5;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "idempotency key",
+			204, "", []byte(nil), "ip:10.0.0.1", "some.key").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Finish(suite.ctxFixture, "ip:10.0.0.1", "some.key", &idempotency.ResponseVO{StatusCode: 204})
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteByKey_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "idempotency key", "ip:10.0.0.1", "some.key").
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.DeleteByKey(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteUnfinishedCreatedBefore_WhenRecordMatches_ShouldReturnTrue() {
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM idempotency_key
+	WHERE
+		client=$1 AND key=$2 AND status_code IS NULL AND created_at < $3
+	RETURNING key;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", suite.createdFixture).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeleteUnfinishedCreatedBefore(suite.ctxFixture, "ip:10.0.0.1", "some.key", suite.createdFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.True(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteUnfinishedCreatedBefore_WhenNoneMatch_ShouldReturnFalse() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error: %w", db.NewNotFoundError("idempotency key"))
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", suite.createdFixture).
+		Return(mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeleteUnfinishedCreatedBefore(suite.ctxFixture, "ip:10.0.0.1", "some.key", suite.createdFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.False(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteUnfinishedCreatedBefore_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "idempotency key",
+			"ip:10.0.0.1", "some.key", suite.createdFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.DeleteUnfinishedCreatedBefore(suite.ctxFixture, "ip:10.0.0.1", "some.key", suite.createdFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+	suite.False(actual)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteCreatedBefore_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	WITH deleted AS (
+		DELETE FROM idempotency_key
+		WHERE
+			created_at < $1
+		RETURNING key
+	)
+	SELECT
+		COUNT(*)
+	FROM deleted;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "idempotency key", suite.createdFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.DeleteCreatedBefore(suite.ctxFixture, suite.createdFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
@@ -1,6 +1,7 @@
 package sql_test
 
 import (
+	"context"
 	goSql "database/sql"
 	"fmt"
 	"testing"
@@ -19,6 +20,7 @@ import (
 type InventoryRepositoryTestSuite struct {
 	suite.Suite
 	db                *goSql.DB
+	ctxFixture        context.Context
 	mockDb            sqlmock.Sqlmock
 	mockDbService     *sqlMocks.MockDatabaseStore
 	mockHelperService *sqlMocks.MockHelperService
@@ -36,6 +38,7 @@ func (suite *InventoryRepositoryTestSuite) SetupTest() {
 		panic(err)
 	}
 	suite.db = db
+	suite.ctxFixture = context.Background()
 	suite.mockDb = mock
 	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
 	suite.mockHelperService = &sqlMocks.MockHelperService{}
@@ -53,8 +56,13 @@ func (suite *InventoryRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_S
 	expectedSql := `
 	SELECT 
 		id, 
-		name, 
-		location, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
 		available 
 	FROM inventory_item
 	WHERE 
@@ -65,11 +73,43 @@ func (suite *InventoryRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_S
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDbService.On("Get").Return(suite.db)
 	suite.mockHelperService.
-		On("SingleRowQuery", suite.db, expectedSql, mock.Anything, "inventory item", idFixture).
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", idFixture).
 		Return(mockErr)
 
 	// Exercise SUT
-	_, err := suite.sut.FindByID(idFixture)
+	_, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindByBarcode_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		barcode=$1;`
+	expectedErr := "mock.error"
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", "MV00000001").
+		Return(mockErr)
+
+	// Exercise SUT
+	_, err := suite.sut.FindByBarcode(suite.ctxFixture, "MV00000001")
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -80,8 +120,13 @@ func (suite *InventoryRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_Sh
 	expectedSql := `
 	SELECT 
 		id, 
-		name, 
-		location, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
 		available 
 	FROM inventory_item;`
 	expectedErr := "mock.error"
@@ -90,11 +135,11 @@ func (suite *InventoryRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_Sh
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDbService.On("Get").Return(suite.db)
 	suite.mockHelperService.
-		On("ManyRowsQuery", suite.db, expectedSql, mock.Anything, "inventory item").
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item").
 		Return(mockErr)
 
 	// Exercise SUT
-	actual, err := suite.sut.FindAll()
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
 
 	// Verify results
 	suite.Nil(actual)
@@ -106,19 +151,184 @@ func (suite *InventoryRepositoryTestSuite) TestFindAll_WhenHelperServicePasses_S
 	expectedSql := `
 	SELECT 
 		id, 
-		name, 
-		location, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
 		available 
 	FROM inventory_item;`
 
 	// Setup mocks
 	suite.mockDbService.On("Get").Return(suite.db)
 	suite.mockHelperService.
-		On("ManyRowsQuery", suite.db, expectedSql, mock.Anything, "inventory item").
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item").
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindAllOfFormat_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		format=$1;`
+	expectedErr := "mock.error"
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", entity.FormatVHS).
+		Return(mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAllOfFormat(suite.ctxFixture, entity.FormatVHS)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindAllOfFormat_WhenHelperServicePasses_ShouldPass() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		format=$1;`
+
+	// Setup mocks
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", entity.FormatVHS).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.FindAllOfFormat(suite.ctxFixture, entity.FormatVHS)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindByIDs_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		id=ANY($1)
+	ORDER BY 
+		id;`
+
+	// Setup mocks
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", []int64{101, 102}).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByIDs(suite.ctxFixture, []entity.ID{101, 102})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindAllOfTitles_WhenHelperServicePasses_ShouldPass() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		title_id=ANY($1)
+	ORDER BY 
+		id;`
+
+	// Setup mocks
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", []int64{201}).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.FindAllOfTitles(suite.ctxFixture, []entity.ID{201})
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindAllOnShelf_WhenHelperServicePasses_ShouldPass() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		title_id, 
+		format, 
+		barcode, 
+		location_store, 
+		location_aisle, 
+		location_shelf, 
+		location_slot, 
+		available 
+	FROM inventory_item
+	WHERE 
+		location_store=$1 AND location_aisle=$2 AND location_shelf=$3
+	ORDER BY 
+		location_slot, id;`
+
+	// Setup mocks
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "inventory item", "main", "A", "1").
 		Return(nil)
 
 	// Exercise SUT
-	_, err := suite.sut.FindAll()
+	_, err := suite.sut.FindAllOnShelf(suite.ctxFixture, "main", "A", "1")
 
 	// Verify results
 	suite.NoError(err)
@@ -129,11 +339,16 @@ func (suite *InventoryRepositoryTestSuite) TestCreate_WhenHelperServiceFails_Sho
 	expectedSql := `
 	INSERT INTO inventory_item
 		(
-			name, 
-			location, 
+			title_id, 
+			format, 
+			barcode, 
+			location_store, 
+			location_aisle, 
+			location_shelf, 
+			location_slot, 
 			available
 		)
-	VALUES ($1, $2, $3)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	RETURNING id;`
 	expectedErr := "mock.error"
 
@@ -141,17 +356,24 @@ func (suite *InventoryRepositoryTestSuite) TestCreate_WhenHelperServiceFails_Sho
 	mockEntity := &entityMocks.MockInventoryItem{}
 	mockErr := fmt.Errorf(expectedErr)
 	suite.mockDbService.On("Get").Return(suite.db)
-	mockEntity.On("Name").Return("some.name").
-		On("Location").Return("some.location").
+	mockEntity.On("TitleID").Return(entity.ID(11)).
+		On("Format").Return(entity.FormatDVD).
+		On("Barcode").Return("some.barcode").
+		On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).
 		On("IsAvailable").Return(true)
-	suite.mockHelperService.On("SingleQueryForID", suite.db, expectedSql, "inventory item",
-		"some.name",
-		"some.location",
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "inventory item",
+		entity.ID(11),
+		entity.FormatDVD,
+		"some.barcode",
+		"main",
+		"A",
+		"1",
+		"12",
 		true,
 	).Return(entity.InvalidID, mockErr)
 
 	// Exercise SUT
-	actual, err := suite.sut.Create(mockEntity)
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
 
 	// Verify results
 	suite.Equal(actual, entity.InvalidID)
@@ -163,28 +385,40 @@ func (suite *InventoryRepositoryTestSuite) TestCreate_WhenHelperServiceSucceeds_
 	expectedSql := `
 	INSERT INTO inventory_item
 		(
-			name, 
-			location, 
+			title_id, 
+			format, 
+			barcode, 
+			location_store, 
+			location_aisle, 
+			location_shelf, 
+			location_slot, 
 			available
 		)
-	VALUES ($1, $2, $3)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	RETURNING id;`
 	expectedID := entity.ID(101)
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	suite.mockDbService.On("Get").Return(suite.db)
-	mockEntity.On("Name").Return("some.name").
-		On("Location").Return("some.location").
+	mockEntity.On("TitleID").Return(entity.ID(11)).
+		On("Format").Return(entity.FormatDVD).
+		On("Barcode").Return("some.barcode").
+		On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).
 		On("IsAvailable").Return(true)
-	suite.mockHelperService.On("SingleQueryForID", suite.db, expectedSql, "inventory item",
-		"some.name",
-		"some.location",
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "inventory item",
+		entity.ID(11),
+		entity.FormatDVD,
+		"some.barcode",
+		"main",
+		"A",
+		"1",
+		"12",
 		true,
 	).Return(expectedID, nil)
 
 	// Exercise SUT
-	actual, err := suite.sut.Create(mockEntity)
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
 
 	// Verify results
 	suite.NoError(err)
@@ -205,11 +439,11 @@ func (suite *InventoryRepositoryTestSuite) TestDeleteByID_WhenHelperServiceFails
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDbService.On("Get").Return(suite.db)
-	suite.mockHelperService.On("ExecForSingleItem", suite.db, expectedSql, idFixture).
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "inventory item", idFixture).
 		Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.DeleteByID(idFixture)
+	err := suite.sut.DeleteByID(suite.ctxFixture, idFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -227,11 +461,11 @@ func (suite *InventoryRepositoryTestSuite) TestDeleteByID_WhenHelperServicePasse
 
 	// Setup mocks
 	suite.mockDbService.On("Get").Return(suite.db)
-	suite.mockHelperService.On("ExecForSingleItem", suite.db, expectedSql, idFixture).
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "inventory item", idFixture).
 		Return(nil)
 
 	// Exercise SUT
-	err := suite.sut.DeleteByID(idFixture)
+	err := suite.sut.DeleteByID(suite.ctxFixture, idFixture)
 
 	// Verify results
 	suite.NoError(err)
@@ -242,9 +476,9 @@ func (suite *InventoryRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_Sho
 	expectedSql := `
 	UPDATE inventory_item
 	SET
-		name=$1, location=$2, available=$3
+		title_id=$1, format=$2, barcode=$3, location_store=$4, location_aisle=$5, location_shelf=$6, location_slot=$7, available=$8
 	WHERE 
-		id=$4;`
+		id=$9;`
 	expectedErr := "mock.error"
 
 	// Setup mocks
@@ -252,18 +486,25 @@ func (suite *InventoryRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_Sho
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDbService.On("Get").Return(suite.db)
 	mockEntity.On("ID").Return(entity.ID(101)).
-		On("Name").Return("some.name").
-		On("Location").Return("some.location").
+		On("TitleID").Return(entity.ID(11)).
+		On("Format").Return(entity.FormatDVD).
+		On("Barcode").Return("some.barcode").
+		On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).
 		On("IsAvailable").Return(true)
-	suite.mockHelperService.On("ExecForSingleItem", suite.db, expectedSql,
-		"some.name",
-		"some.location",
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "inventory item",
+		entity.ID(11),
+		entity.FormatDVD,
+		"some.barcode",
+		"main",
+		"A",
+		"1",
+		"12",
 		true,
 		entity.ID(101),
 	).Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.Update(mockEntity)
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -274,26 +515,33 @@ func (suite *InventoryRepositoryTestSuite) TestUpdate_WhenHelperServicePasses_Sh
 	expectedSql := `
 	UPDATE inventory_item
 	SET
-		name=$1, location=$2, available=$3
+		title_id=$1, format=$2, barcode=$3, location_store=$4, location_aisle=$5, location_shelf=$6, location_slot=$7, available=$8
 	WHERE 
-		id=$4;`
+		id=$9;`
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	suite.mockDbService.On("Get").Return(suite.db)
 	mockEntity.On("ID").Return(entity.ID(101)).
-		On("Name").Return("some.name").
-		On("Location").Return("some.location").
+		On("TitleID").Return(entity.ID(11)).
+		On("Format").Return(entity.FormatDVD).
+		On("Barcode").Return("some.barcode").
+		On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).
 		On("IsAvailable").Return(true)
-	suite.mockHelperService.On("ExecForSingleItem", suite.db, expectedSql,
-		"some.name",
-		"some.location",
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "inventory item",
+		entity.ID(11),
+		entity.FormatDVD,
+		"some.barcode",
+		"main",
+		"A",
+		"1",
+		"12",
 		true,
 		entity.ID(101),
 	).Return(nil)
 
 	// Exercise SUT
-	err := suite.sut.Update(mockEntity)
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
 
 	// Verify results
 	suite.NoError(err)
@@ -0,0 +1,248 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type LedgerRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	recordedFixture   time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockLedgerEntryConstructor
+	sut               *sql.LedgerRepositoryImpl
+}
+
+func TestLedgerRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerRepositoryTestSuite))
+}
+
+func (suite *LedgerRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.recordedFixture = time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockLedgerEntryConstructor{}
+	suite.sut = sql.NewLedgerRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *LedgerRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldStoreChargeWithoutMethodAsNull() {
+	// Setup fixture
+	rentalIDFixture := entity.ID(201)
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO ledger_entry
+		(
+			account_id, 
+			kind, 
+			amount, 
+			method, 
+			rental_id, 
+			note, 
+			recorded_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id;`
+	expectedID := entity.ID(401)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockLedgerEntry{}
+	mockEntity.On("AccountID").Return(entity.ID(7)).
+		On("Kind").Return(entity.LedgerCharge).
+		On("Amount").Return(entity.Money(200)).
+		On("Method").Return(entity.PaymentMethod("")).
+		On("RentalID").Return(rentalIDFixture).
+		On("Note").Return("late fee").
+		On("RecordedAt").Return(suite.recordedFixture)
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "ledger entry",
+		entity.ID(7),
+		"charge",
+		entity.Money(200),
+		(*string)(nil),
+		&rentalIDFixture,
+		"late fee",
+		suite.recordedFixture,
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *LedgerRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldStorePaymentWithoutRentalAsNull() {
+	// Setup fixture
+	methodFixture := "cash"
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockLedgerEntry{}
+	mockEntity.On("AccountID").Return(entity.ID(7)).
+		On("Kind").Return(entity.LedgerPayment).
+		On("Amount").Return(entity.Money(500)).
+		On("Method").Return(entity.PaymentCash).
+		On("RentalID").Return(entity.InvalidID).
+		On("Note").Return("").
+		On("RecordedAt").Return(suite.recordedFixture)
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, mock.Anything, "ledger entry",
+		entity.ID(7),
+		"payment",
+		entity.Money(500),
+		&methodFixture,
+		(*entity.ID)(nil),
+		"",
+		suite.recordedFixture,
+	).Return(entity.ID(402), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(402), actual)
+}
+
+func (suite *LedgerRepositoryTestSuite) TestFindByAccountID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	accountIDFixture := entity.ID(7)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		account_id, 
+		kind, 
+		amount, 
+		method, 
+		rental_id, 
+		note, 
+		recorded_at 
+	FROM ledger_entry
+	WHERE 
+		account_id=$1
+	ORDER BY 
+		recorded_at, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "ledger entry", accountIDFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByAccountID(suite.ctxFixture, accountIDFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *LedgerRepositoryTestSuite) TestFindByAccountID_WhenRowsAreScanned_ShouldReincarnateInUTC() {
+	// Setup fixture
+	accountIDFixture := entity.ID(7)
+	rentalIDFixture := entity.ID(201)
+	methodFixture := "card"
+	zone := time.FixedZone("some.zone", 2*60*60)
+	chargeRow := &stubRow{values: []interface{}{
+		entity.ID(401), entity.ID(7), "charge", entity.Money(200),
+		(*string)(nil), &rentalIDFixture, "late fee", suite.recordedFixture.In(zone),
+	}}
+	paymentRow := &stubRow{values: []interface{}{
+		entity.ID(402), entity.ID(7), "payment", entity.Money(500),
+		&methodFixture, (*entity.ID)(nil), "", suite.recordedFixture.In(zone),
+	}}
+
+	// Setup mocks
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockPayment := &entityMocks.MockLedgerEntry{Data: "some.payment"}
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "ledger entry", accountIDFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(chargeRow)
+			args.Get(3).(sql.ScanFunc)(paymentRow)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(401), entity.ID(7), entity.LedgerCharge, entity.Money(200),
+		entity.PaymentMethod(""), rentalIDFixture, "late fee", suite.recordedFixture).
+		Return(mockCharge)
+	suite.mockConstructor.On("Reincarnate", entity.ID(402), entity.ID(7), entity.LedgerPayment, entity.Money(500),
+		entity.PaymentCard, entity.InvalidID, "", suite.recordedFixture).
+		Return(mockPayment)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByAccountID(suite.ctxFixture, accountIDFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]entity.LedgerEntry{mockCharge, mockPayment}, actual)
+}
+
+func (suite *LedgerRepositoryTestSuite) TestBalanceByAccountID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	accountIDFixture := entity.ID(7)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		COALESCE(SUM(balance), 0) 
+	FROM account_balance
+	WHERE 
+		account_id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "account balance", accountIDFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	_, err := suite.sut.BalanceByAccountID(suite.ctxFixture, accountIDFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *LedgerRepositoryTestSuite) TestBalanceByAccountID_WhenRowIsScanned_ShouldReturnBalance() {
+	// Setup fixture
+	accountIDFixture := entity.ID(7)
+	rowFixture := &stubRow{values: []interface{}{entity.Money(-300)}}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "account balance", accountIDFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.BalanceByAccountID(suite.ctxFixture, accountIDFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.Money(-300), actual)
+}
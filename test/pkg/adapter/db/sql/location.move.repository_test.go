@@ -0,0 +1,124 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type LocationMoveRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	movedFixture      time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	sut               *sql.LocationMoveRepositoryImpl
+}
+
+func TestLocationMoveRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationMoveRepositoryTestSuite))
+}
+
+func (suite *LocationMoveRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.movedFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.sut = sql.NewLocationMoveRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *LocationMoveRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldReturnID() {
+	// Setup fixture
+	fixture := entity.LocationMove{
+		ItemID:  101,
+		From:    entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"},
+		To:      entity.Location{Store: "main", Aisle: "B", Shelf: "2", Slot: "3"},
+		MovedAt: suite.movedFixture,
+	}
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO location_move
+		(
+			inventory_item_id, 
+			from_store, 
+			from_aisle, 
+			from_shelf, 
+			from_slot, 
+			to_store, 
+			to_aisle, 
+			to_shelf, 
+			to_slot, 
+			moved_at
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	RETURNING id;`
+
+	// Setup mocks
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "location move",
+		entity.ID(101),
+		"main", "A", "1", "12",
+		"main", "B", "2", "3",
+		suite.movedFixture,
+	).Return(entity.ID(7), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(7), actual)
+}
+
+func (suite *LocationMoveRepositoryTestSuite) TestFindByItemID_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		inventory_item_id, 
+		from_store, 
+		from_aisle, 
+		from_shelf, 
+		from_slot, 
+		to_store, 
+		to_aisle, 
+		to_shelf, 
+		to_slot, 
+		moved_at 
+	FROM location_move
+	WHERE 
+		inventory_item_id=$1
+	ORDER BY 
+		moved_at, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "location move", entity.ID(101)).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByItemID(suite.ctxFixture, entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
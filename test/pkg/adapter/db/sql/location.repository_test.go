@@ -0,0 +1,165 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type LocationRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	locationFixture   entity.Location
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	sut               *sql.LocationRepositoryImpl
+}
+
+func TestLocationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationRepositoryTestSuite))
+}
+
+func (suite *LocationRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.locationFixture = entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.sut = sql.NewLocationRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *LocationRepositoryTestSuite) TestCreate_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO location
+		(
+			store, 
+			aisle, 
+			shelf, 
+			slot
+		)
+	VALUES ($1, $2, $3, $4);`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "location",
+		"main", "A", "1", "12",
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Create(suite.ctxFixture, suite.locationFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *LocationRepositoryTestSuite) TestExists_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		EXISTS (
+			SELECT 1 
+			FROM location 
+			WHERE 
+				store=$1 AND aisle=$2 AND shelf=$3 AND slot=$4
+		);`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "location", "main", "A", "1", "12").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Exists(suite.ctxFixture, suite.locationFixture)
+
+	// Verify results
+	suite.False(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *LocationRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		store, 
+		aisle, 
+		shelf, 
+		slot 
+	FROM location
+	ORDER BY 
+		store, aisle, shelf, slot;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "location").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *LocationRepositoryTestSuite) TestFindAllOnShelf_WhenHelperServicePasses_ShouldPass() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		store, 
+		aisle, 
+		shelf, 
+		slot 
+	FROM location
+	WHERE 
+		store=$1 AND aisle=$2 AND shelf=$3
+	ORDER BY 
+		slot;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "location", "main", "A", "1").
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.FindAllOnShelf(suite.ctxFixture, "main", "A", "1")
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *LocationRepositoryTestSuite) TestDelete_WhenHelperServicePasses_ShouldPass() {
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM location
+	WHERE 
+		store=$1 AND aisle=$2 AND shelf=$3 AND slot=$4;`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "location",
+		"main", "A", "1", "12",
+	).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, suite.locationFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
@@ -0,0 +1,251 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseMediaFormat "github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type MediaFormatRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockMediaFormatConstructor
+	sut               *sql.MediaFormatRepositoryImpl
+}
+
+func TestMediaFormatRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaFormatRepositoryTestSuite))
+}
+
+func (suite *MediaFormatRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockMediaFormatConstructor{}
+	suite.sut = sql.NewMediaFormatRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindByFormat_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		format, 
+		name, 
+		rental_price, 
+		rental_period_days 
+	FROM media_format
+	WHERE 
+		format=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "media format", entity.FormatDVD).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	_, err := suite.sut.FindByFormat(suite.ctxFixture, entity.FormatDVD)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindByFormat_WhenRowIsScanned_ShouldReincarnate() {
+	// Setup fixture
+	rowFixture := &stubRow{values: []interface{}{
+		entity.FormatDVD, "DVD", entity.Money(300), 3,
+	}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "media format", entity.FormatDVD).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.FormatDVD, "DVD", entity.Money(300), 3).
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByFormat(suite.ctxFixture, entity.FormatDVD)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		format, 
+		name, 
+		rental_price, 
+		rental_period_days 
+	FROM media_format
+	ORDER BY 
+		sort_order;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "media format").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindAll_WhenRowsAreScanned_ShouldReincarnateEach() {
+	// Setup fixture
+	rowFixtures := []*stubRow{
+		{values: []interface{}{entity.FormatVHS, "VHS", entity.Money(150), 7}},
+		{values: []interface{}{entity.FormatDVD, "DVD", entity.Money(300), 3}},
+	}
+
+	// Setup mocks
+	mockVHS := &entityMocks.MockMediaFormat{Data: "vhs"}
+	mockDVD := &entityMocks.MockMediaFormat{Data: "dvd"}
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "media format").
+		Run(func(args mock.Arguments) {
+			for _, row := range rowFixtures {
+				args.Get(3).(sql.ScanFunc)(row)
+			}
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.FormatVHS, "VHS", entity.Money(150), 7).
+		Return(mockVHS)
+	suite.mockConstructor.On("Reincarnate", entity.FormatDVD, "DVD", entity.Money(300), 3).
+		Return(mockDVD)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]entity.MediaFormat{mockVHS, mockDVD}, actual)
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestUpdate_ShouldPassFieldsToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	UPDATE media_format
+	SET
+		name=$1, rental_price=$2, rental_period_days=$3
+	WHERE 
+		format=$4;`
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("Format").Return(entity.FormatBluRay).
+		On("Name").Return("Blu-ray").
+		On("RentalPrice").Return(entity.Money(400)).
+		On("RentalPeriodDays").Return(3)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "media format",
+		"Blu-ray",
+		entity.Money(400),
+		3,
+		entity.FormatBluRay,
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindStockByFormat_ShouldScanCounts() {
+	// Setup fixture
+	rowFixture := &stubRow{values: []interface{}{3, 1}}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available) 
+	FROM inventory_item
+	WHERE 
+		format=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "media format stock", entity.FormatVHS).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindStockByFormat(suite.ctxFixture, entity.FormatVHS)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(usecaseMediaFormat.Stock{Copies: 3, Available: 1}, actual)
+}
+
+func (suite *MediaFormatRepositoryTestSuite) TestFindAllStock_ShouldScanCountsByFormat() {
+	// Setup fixture
+	rowFixtures := []*stubRow{
+		{values: []interface{}{entity.FormatVHS, 3, 1}},
+		{values: []interface{}{entity.Format4K, 1, 0}},
+	}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		format, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available) 
+	FROM inventory_item
+	GROUP BY 
+		format;`
+	expected := map[entity.Format]usecaseMediaFormat.Stock{
+		entity.FormatVHS: {Copies: 3, Available: 1},
+		entity.Format4K:  {Copies: 1, Available: 0},
+	}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "media format stock").
+		Run(func(args mock.Arguments) {
+			for _, row := range rowFixtures {
+				args.Get(3).(sql.ScanFunc)(row)
+			}
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAllStock(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,233 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	occurredFixture   time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	sut               *sql.OutboxRepositoryImpl
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
+
+func (suite *OutboxRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.occurredFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.sut = sql.NewOutboxRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestCreate_WhenDataCannotBeMarshalled_ShouldFail() {
+	// Setup fixture
+	fixture := outbox.Message{
+		EntityID: 101,
+		Type:     entity.EventItemCheckedIn,
+		Data:     map[string]interface{}{"bad": make(chan int)},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, fixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.Error(err)
+	suite.Contains(err.Error(), "could not create outbox message - marshal error")
+}
+
+func (suite *OutboxRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldReturnID() {
+	// Setup fixture
+	fixture := outbox.Message{
+		EntityID:   101,
+		Type:       entity.EventItemCheckedOut,
+		Data:       map[string]interface{}{"accountId": entity.ID(7)},
+		OccurredAt: suite.occurredFixture,
+	}
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO outbox_message
+		(
+			entity_id, 
+			type, 
+			data, 
+			occurred_at
+		)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id;`
+
+	// Setup mocks
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "outbox message",
+		entity.ID(101),
+		"inventory.item.checked-out",
+		[]byte(`{"accountId":7}`),
+		suite.occurredFixture,
+	).Return(entity.ID(1), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(1), actual)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestFindUnpublished_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		entity_id, 
+		type, 
+		data, 
+		occurred_at 
+	FROM outbox_message
+	WHERE 
+		published_at IS NULL
+	ORDER BY 
+		id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "outbox message", 10).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindUnpublished(suite.ctxFixture, 10)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *OutboxRepositoryTestSuite) TestMarkPublished_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	UPDATE outbox_message
+	SET 
+		published_at=$1
+	WHERE 
+		id=$2;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "outbox message", suite.occurredFixture, entity.ID(1)).
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.MarkPublished(suite.ctxFixture, entity.ID(1), suite.occurredFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestFindAfter_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "outbox message", entity.ID(12), 10).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAfter(suite.ctxFixture, entity.ID(12), 10)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *OutboxRepositoryTestSuite) TestFindAfter_WhenHelperServicePasses_ShouldReturnMessages() {
+	// Setup fixture
+	zone := time.FixedZone("some.zone", 2*60*60)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(13), entity.ID(101), "inventory.item.checked-in", []byte(`{"titleId":11}`), suite.occurredFixture.In(zone),
+	}}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		entity_id, 
+		type, 
+		data, 
+		occurred_at 
+	FROM outbox_message
+	WHERE 
+		id > $1
+	ORDER BY 
+		id
+	LIMIT $2;`
+	expected := []outbox.Message{{
+		ID:         13,
+		EntityID:   101,
+		Type:       entity.EventItemCheckedIn,
+		Data:       map[string]interface{}{"titleId": float64(11)},
+		OccurredAt: suite.occurredFixture,
+	}}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "outbox message", entity.ID(12), 10).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAfter(suite.ctxFixture, entity.ID(12), 10)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestFindLatestID_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		COALESCE(MAX(id), 0) 
+	FROM outbox_message;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "outbox message").
+		Return(entity.ID(12), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindLatestID(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(12), actual)
+}
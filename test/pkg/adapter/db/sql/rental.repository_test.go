@@ -0,0 +1,414 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type RentalRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	checkedOutFixture time.Time
+	dueFixture        time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockRentalConstructor
+	sut               *sql.RentalRepositoryImpl
+}
+
+func TestRentalRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RentalRepositoryTestSuite))
+}
+
+func (suite *RentalRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.checkedOutFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.dueFixture = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockRentalConstructor{}
+	suite.sut = sql.NewRentalRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *RentalRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldReturnID() {
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO rental
+		(
+			inventory_item_id, 
+			account_id, 
+			checked_out_at, 
+			due_at, 
+			returned_at, 
+			late_fee, 
+			renewals
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id;`
+	expectedID := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockRental{}
+	mockEntity.On("ItemID").Return(entity.ID(7)).
+		On("AccountID").Return(entity.ID(8)).
+		On("CheckedOutAt").Return(suite.checkedOutFixture).
+		On("DueAt").Return(suite.dueFixture).
+		On("ReturnedAt").Return(nil).
+		On("LateFee").Return(entity.Money(0)).
+		On("Renewals").Return(0)
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "rental",
+		entity.ID(7),
+		entity.ID(8),
+		suite.checkedOutFixture,
+		suite.dueFixture,
+		(*time.Time)(nil),
+		entity.Money(0),
+		0,
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *RentalRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	returnedFixture := suite.dueFixture.Add(-time.Hour)
+
+	// Setup expectations
+	expectedSql := `
+	UPDATE rental
+	SET
+		due_at=$1, returned_at=$2, late_fee=$3, renewals=$4
+	WHERE 
+		id=$5 AND returned_at IS NULL;`
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockRental{}
+	mockEntity.On("ID").Return(entity.ID(101)).
+		On("DueAt").Return(suite.dueFixture).
+		On("ReturnedAt").Return(&returnedFixture).
+		On("LateFee").Return(entity.Money(300)).
+		On("Renewals").Return(1)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "rental",
+		suite.dueFixture,
+		&returnedFixture,
+		entity.Money(300),
+		1,
+		entity.ID(101),
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *RentalRepositoryTestSuite) TestUpdate_WhenAlreadyReturned_ShouldFailWithConflict() {
+	// Setup fixture
+	returnedFixture := suite.dueFixture.Add(-time.Hour)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockRental{}
+	mockEntity.On("ID").Return(entity.ID(101)).
+		On("DueAt").Return(suite.dueFixture).
+		On("ReturnedAt").Return(&returnedFixture).
+		On("LateFee").Return(entity.Money(300)).
+		On("Renewals").Return(1)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, mock.Anything, "rental",
+		suite.dueFixture,
+		&returnedFixture,
+		entity.Money(300),
+		1,
+		entity.ID(101),
+	).Return(db.NewNotFoundError("rental"))
+
+	// Setup expectations
+	expectedErr := "conflict error: type=[rental], problem=[it has already been returned]"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *RentalRepositoryTestSuite) TestUpdate_WhenHelperServiceSucceeds_ShouldPass() {
+	// Setup fixture
+	returnedFixture := suite.dueFixture.Add(-time.Hour)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockRental{}
+	mockEntity.On("ID").Return(entity.ID(101)).
+		On("DueAt").Return(suite.dueFixture).
+		On("ReturnedAt").Return(&returnedFixture).
+		On("LateFee").Return(entity.Money(300)).
+		On("Renewals").Return(1)
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, mock.Anything, "rental",
+		suite.dueFixture,
+		&returnedFixture,
+		entity.Money(300),
+		1,
+		entity.ID(101),
+	).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(7)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=$1 AND returned_at IS NULL;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "rental", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemID_WhenNoRows_ShouldReturnNil() {
+	// Setup fixture
+	idFixture := entity.ID(7)
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "rental", idFixture).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemID_WhenRowIsScanned_ShouldReincarnateInUTC() {
+	// Setup fixture
+	idFixture := entity.ID(7)
+	zone := time.FixedZone("some.zone", 2*60*60)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(101), entity.ID(7), entity.ID(8),
+		suite.checkedOutFixture.In(zone), suite.dueFixture.In(zone), (*time.Time)(nil), entity.Money(0), 1,
+	}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockRental{Data: "mock.data"}
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "rental", idFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(101), entity.ID(7), entity.ID(8),
+		suite.checkedOutFixture, suite.dueFixture, (*time.Time)(nil), entity.Money(0), 1).
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemIDForUpdate_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(7)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=$1 AND returned_at IS NULL
+	FOR UPDATE;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "rental", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemIDForUpdate(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemIDForUpdate_WhenNoRows_ShouldReturnNil() {
+	// Setup fixture
+	idFixture := entity.ID(7)
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "rental", idFixture).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemIDForUpdate(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByAccountID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(8)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		account_id=$1 AND returned_at IS NULL
+	ORDER BY 
+		due_at, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "rental", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByAccountID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindActiveByItemIDs_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		inventory_item_id=ANY($1) AND returned_at IS NULL
+	ORDER BY 
+		id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "rental", []int64{101, 102}).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindActiveByItemIDs(suite.ctxFixture, []entity.ID{101, 102})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *RentalRepositoryTestSuite) TestFindOverdue_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	nowFixture := suite.dueFixture.Add(time.Hour)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		inventory_item_id, 
+		account_id, 
+		checked_out_at, 
+		due_at, 
+		returned_at, 
+		late_fee, 
+		renewals 
+	FROM rental
+	WHERE 
+		returned_at IS NULL AND due_at < $1
+	ORDER BY 
+		due_at, id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "rental", nowFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindOverdue(suite.ctxFixture, nowFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
@@ -0,0 +1,464 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	usecaseTitle "github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type TitleRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockTitleConstructor
+	sut               *sql.TitleRepositoryImpl
+}
+
+func TestTitleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TitleRepositoryTestSuite))
+}
+
+func (suite *TitleRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockTitleConstructor{}
+	suite.sut = sql.NewTitleRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title", idFixture).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	_, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindByID_WhenRowIsScanned_ShouldDecodeListsAndReincarnate() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(101), "some.name", 1999, 136, "some.synopsis",
+		`["some.genre"]`, `["some.cast.1", "some.cast.2"]`, "some.rating",
+	}}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "title", idFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(101), "some.name", 1999, 136, "some.synopsis",
+		[]string{"some.genre"}, []string{"some.cast.1", "some.cast.2"}, "some.rating").
+		Return(mockEntity)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindByID_WhenGenresCannotBeDecoded_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(101), "some.name", 1999, 136, "some.synopsis",
+		`not json`, `[]`, "some.rating",
+	}}
+
+	// Setup mocks
+	var scanErr error
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "title", idFixture).
+		Run(func(args mock.Arguments) {
+			scanErr = args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.FindByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.EqualError(scanErr, "could not decode genres: invalid character 'o' in literal null (expecting 'u')")
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindAll_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	ORDER BY 
+		name, year;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindByIDs_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		name, 
+		year, 
+		runtime, 
+		synopsis, 
+		genres::text, 
+		cast_members::text, 
+		rating 
+	FROM title
+	WHERE 
+		id=ANY($1)
+	ORDER BY 
+		id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title", []int64{101, 102}).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByIDs(suite.ctxFixture, []entity.ID{101, 102})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *TitleRepositoryTestSuite) TestCreate_ShouldEncodeListsAsJSON() {
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO title
+		(
+			name, 
+			year, 
+			runtime, 
+			synopsis, 
+			genres, 
+			cast_members, 
+			rating
+		)
+	VALUES ($1, $2, $3, $4, $5::jsonb, $6::jsonb, $7)
+	RETURNING id;`
+	expectedID := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("Name").Return("some.name").
+		On("Year").Return(1999).
+		On("Runtime").Return(136).
+		On("Synopsis").Return("some.synopsis").
+		On("Genres").Return([]string{"some.genre"}).
+		On("Cast").Return(nil).
+		On("Rating").Return("some.rating")
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "title",
+		"some.name",
+		1999,
+		136,
+		"some.synopsis",
+		`["some.genre"]`,
+		`[]`,
+		"some.rating",
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *TitleRepositoryTestSuite) TestUpdate_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	UPDATE title
+	SET
+		name=$1, year=$2, runtime=$3, synopsis=$4, genres=$5::jsonb, cast_members=$6::jsonb, rating=$7
+	WHERE 
+		id=$8;`
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ID").Return(entity.ID(101)).
+		On("Name").Return("some.name").
+		On("Year").Return(1999).
+		On("Runtime").Return(136).
+		On("Synopsis").Return("some.synopsis").
+		On("Genres").Return([]string{}).
+		On("Cast").Return([]string{"some.cast"}).
+		On("Rating").Return("some.rating")
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "title",
+		"some.name",
+		1999,
+		136,
+		"some.synopsis",
+		`[]`,
+		`["some.cast"]`,
+		"some.rating",
+		entity.ID(101),
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *TitleRepositoryTestSuite) TestDeleteByID_WhenHelperServicePasses_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM title
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "title", idFixture).
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.DeleteByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindStockByID_ShouldScanCounts() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	rowFixture := &stubRow{values: []interface{}{3, 1}}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	WHERE 
+		title_id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title stock", idFixture).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindStockByID(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(usecaseTitle.Stock{Copies: 3, Available: 1}, actual)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindAllStock_ShouldScanCountsByTitle() {
+	// Setup fixture
+	rowFixtures := []*stubRow{
+		{values: []interface{}{entity.ID(101), 3, 1}},
+		{values: []interface{}{entity.ID(102), 1, 0}},
+	}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		title_id, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	GROUP BY 
+		title_id;`
+	expected := map[entity.ID]usecaseTitle.Stock{
+		entity.ID(101): {Copies: 3, Available: 1},
+		entity.ID(102): {Copies: 1, Available: 0},
+	}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title stock").
+		Run(func(args mock.Arguments) {
+			for _, row := range rowFixtures {
+				args.Get(3).(sql.ScanFunc)(row)
+			}
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAllStock(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindStockByIDs_ShouldScanCountsOfTheGivenTitles() {
+	// Setup fixture
+	rowFixtures := []*stubRow{
+		{values: []interface{}{entity.ID(101), 3, 1}},
+	}
+
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		title_id, 
+		COUNT(*), 
+		COUNT(*) FILTER (WHERE available AND NOT EXISTS (
+			SELECT 1 FROM hold 
+			WHERE hold.inventory_item_id=inventory_item.id AND hold.status='ready' AND hold.expires_at > now()
+		)) 
+	FROM inventory_item
+	WHERE 
+		title_id=ANY($1)
+	GROUP BY 
+		title_id;`
+	expected := map[entity.ID]usecaseTitle.Stock{
+		entity.ID(101): {Copies: 3, Available: 1},
+	}
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "title stock", []int64{101, 102}).
+		Run(func(args mock.Arguments) {
+			for _, row := range rowFixtures {
+				args.Get(3).(sql.ScanFunc)(row)
+			}
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindStockByIDs(suite.ctxFixture, []entity.ID{101, 102})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleRepositoryTestSuite) TestFindStockByIDs_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "title stock", []int64{101}).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindStockByIDs(suite.ctxFixture, []entity.ID{101})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+// stubRow scans fixed values into the destinations it is given
+type stubRow struct {
+	values []interface{}
+}
+
+func (s *stubRow) Scan(dest ...interface{}) error {
+	for i, d := range dest {
+		switch ptr := d.(type) {
+		case *entity.ID:
+			*ptr = s.values[i].(entity.ID)
+		case **entity.ID:
+			*ptr = s.values[i].(*entity.ID)
+		case *entity.Format:
+			*ptr = s.values[i].(entity.Format)
+		case *entity.Money:
+			*ptr = s.values[i].(entity.Money)
+		case *int:
+			*ptr = s.values[i].(int)
+		case **int:
+			*ptr = s.values[i].(*int)
+		case *string:
+			*ptr = s.values[i].(string)
+		case *[]byte:
+			*ptr = s.values[i].([]byte)
+		case **string:
+			*ptr = s.values[i].(*string)
+		case *time.Time:
+			*ptr = s.values[i].(time.Time)
+		case **time.Time:
+			*ptr = s.values[i].(*time.Time)
+		default:
+			return fmt.Errorf("unsupported scan destination: %T", d)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+
+	dbMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db"
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+)
+
+type TransactorTestSuite struct {
+	suite.Suite
+	db            *goSql.DB
+	ctxFixture    context.Context
+	mockDb        sqlmock.Sqlmock
+	mockDbService *sqlMocks.MockDatabaseStore
+	sut           *sql.TransactorImpl
+}
+
+func TestTransactorTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactorTestSuite))
+}
+
+func (suite *TransactorTestSuite) SetupTest() {
+	d, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = d
+	suite.ctxFixture = context.Background()
+	suite.mockDb = mock
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockDbService.On("Get").Return(suite.db)
+	suite.sut = sql.NewTransactorImpl(
+		suite.mockDbService,
+	)
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenBeginFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDb.ExpectBegin().WillReturnError(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not run transaction - db begin error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		suite.Fail("work should not run")
+		return nil
+	})
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenWorkFails_ShouldRollbackAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDb.ExpectBegin()
+	suite.mockDb.ExpectRollback()
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		return mockErr
+	})
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenRollbackFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDb.ExpectBegin()
+	suite.mockDb.ExpectRollback().WillReturnError(fmt.Errorf("mock.rollback.error"))
+
+	// Setup expectations
+	expectedErr := "could not run transaction - db rollback error: mock.rollback.error, after: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		return fmt.Errorf("mock.error")
+	})
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenCommitFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDb.ExpectBegin()
+	suite.mockDb.ExpectCommit().WillReturnError(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not run transaction - db commit error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		return nil
+	})
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenWorkSucceeds_ShouldRunQueriesInTransactionAndCommit() {
+	// Setup fixture
+	helper := sql.NewHelperServiceImpl(&dbMocks.MockErrorParser{})
+
+	// Setup mocks
+	suite.mockDb.ExpectBegin()
+	suite.mockDb.ExpectPrepare("some.query").
+		ExpectExec().
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mockDb.ExpectCommit()
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		return helper.ExecForSingleItem(ctx, suite.db, "some.query", "some.type")
+	})
+
+	// Verify results
+	suite.NoError(err)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
+}
+
+func (suite *TransactorTestSuite) TestInTransaction_WhenNested_ShouldJoinOuterTransaction() {
+	// Setup mocks
+	suite.mockDb.ExpectBegin()
+	suite.mockDb.ExpectCommit()
+
+	// Exercise SUT
+	err := suite.sut.InTransaction(suite.ctxFixture, func(ctx context.Context) error {
+		return suite.sut.InTransaction(ctx, func(ctx context.Context) error {
+			return nil
+		})
+	})
+
+	// Verify results
+	suite.NoError(err)
+	suite.NoError(suite.mockDb.ExpectationsWereMet())
+}
@@ -0,0 +1,262 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type WebhookDeliveryRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	occurredFixture   time.Time
+	attemptedFixture  time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockWebhookDeliveryConstructor
+	sut               *sql.WebhookDeliveryRepositoryImpl
+}
+
+func TestWebhookDeliveryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryRepositoryTestSuite))
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.occurredFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.attemptedFixture = time.Date(2020, 1, 1, 12, 1, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockWebhookDeliveryConstructor{}
+	suite.sut = sql.NewWebhookDeliveryRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldIgnoreRepeatsAndReturnID() {
+	// Setup fixture
+	entityFixture := entity.TestWebhookDeliveryImplConstructor(entity.InvalidID, 401, 12,
+		entity.EventItemCheckedIn, 101, suite.occurredFixture, 0)
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO webhook_delivery
+		(
+			subscription_id, 
+			event_id, 
+			event_type, 
+			entity_id, 
+			data, 
+			occurred_at, 
+			status, 
+			attempts, 
+			next_attempt_at, 
+			last_attempt_at, 
+			last_status_code, 
+			last_error
+		)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT (subscription_id, event_id) DO UPDATE
+	SET 
+		subscription_id=EXCLUDED.subscription_id
+	RETURNING id;`
+	expectedID := entity.ID(501)
+
+	// Setup mocks
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "webhook delivery",
+		entity.ID(401),
+		entity.ID(12),
+		"inventory.item.checked-in",
+		entity.ID(101),
+		[]byte("null"),
+		suite.occurredFixture,
+		"pending",
+		0,
+		suite.occurredFixture,
+		(*time.Time)(nil),
+		0,
+		"",
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, entityFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestUpdate_WhenErrorIsLong_ShouldTruncateIt() {
+	// Setup fixture
+	nextFixture := suite.attemptedFixture.Add(time.Minute)
+	entityFixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12,
+		entity.EventItemCheckedIn, 101, suite.occurredFixture, 0)
+	suite.Require().NoError(entityFixture.Retry(suite.attemptedFixture, 500, strings.Repeat("é", 600), nextFixture))
+
+	// Setup expectations
+	expectedSql := `
+	UPDATE webhook_delivery
+	SET
+		status=$1, attempts=$2, next_attempt_at=$3, last_attempt_at=$4, last_status_code=$5, last_error=$6
+	WHERE 
+		id=$7;`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "webhook delivery",
+		"pending",
+		1,
+		nextFixture,
+		&suite.attemptedFixture,
+		500,
+		strings.Repeat("é", 511),
+		entity.ID(501),
+	).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, entityFixture)
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestFindByID_WhenRowIsScanned_ShouldReincarnateInUTC() {
+	// Setup fixture
+	zone := time.FixedZone("some.zone", 2*60*60)
+	attemptedInZone := suite.attemptedFixture.In(zone)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(501), entity.ID(401), entity.ID(12), "inventory.item.checked-in", entity.ID(101),
+		[]byte(`{"titleId":11}`), suite.occurredFixture.In(zone), "dead", 8,
+		suite.occurredFixture.In(zone), &attemptedInZone, 503, "some.error",
+	}}
+	entityFixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12,
+		entity.EventItemCheckedIn, 101, suite.occurredFixture, 8)
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "webhook delivery", entity.ID(501)).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(501), entity.ID(401), entity.ID(12),
+		entity.EventItemCheckedIn, entity.ID(101), map[string]interface{}{"titleId": float64(11)},
+		suite.occurredFixture, entity.DeliveryDead, 8, suite.occurredFixture, &suite.attemptedFixture,
+		503, "some.error").
+		Return(entityFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, entity.ID(501))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entityFixture, actual)
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestFindBySubscriptionID_WhenHelperServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "webhook delivery", entity.ID(401)).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindBySubscriptionID(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestFindDead_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		subscription_id, 
+		event_id, 
+		event_type, 
+		entity_id, 
+		data, 
+		occurred_at, 
+		status, 
+		attempts, 
+		next_attempt_at, 
+		last_attempt_at, 
+		last_status_code, 
+		last_error 
+	FROM webhook_delivery
+	WHERE 
+		status='dead'
+	ORDER BY 
+		id DESC;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "webhook delivery").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindDead(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *WebhookDeliveryRepositoryTestSuite) TestFindDue_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		subscription_id, 
+		event_id, 
+		event_type, 
+		entity_id, 
+		data, 
+		occurred_at, 
+		status, 
+		attempts, 
+		next_attempt_at, 
+		last_attempt_at, 
+		last_status_code, 
+		last_error 
+	FROM webhook_delivery
+	WHERE 
+		status='pending' AND next_attempt_at<=$1
+	ORDER BY 
+		next_attempt_at, id
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "webhook delivery",
+			suite.attemptedFixture, 10).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindDue(suite.ctxFixture, suite.attemptedFixture, 10)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
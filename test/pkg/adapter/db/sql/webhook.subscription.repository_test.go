@@ -0,0 +1,213 @@
+package sql_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db/sql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type WebhookSubscriptionRepositoryTestSuite struct {
+	suite.Suite
+	db                *goSql.DB
+	ctxFixture        context.Context
+	createdFixture    time.Time
+	mockDbService     *sqlMocks.MockDatabaseStore
+	mockHelperService *sqlMocks.MockHelperService
+	mockConstructor   *entityMocks.MockWebhookSubscriptionConstructor
+	sut               *sql.WebhookSubscriptionRepositoryImpl
+}
+
+func TestWebhookSubscriptionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriptionRepositoryTestSuite))
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) SetupTest() {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	suite.db = db
+	suite.ctxFixture = context.Background()
+	suite.createdFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockDbService = &sqlMocks.MockDatabaseStore{}
+	suite.mockHelperService = &sqlMocks.MockHelperService{}
+	suite.mockConstructor = &entityMocks.MockWebhookSubscriptionConstructor{}
+	suite.sut = sql.NewWebhookSubscriptionRepositoryImpl(
+		suite.mockDbService, suite.mockHelperService, suite.mockConstructor,
+	)
+	suite.mockDbService.On("Get").Return(suite.db)
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestCreate_WhenHelperServicePasses_ShouldStoreEventTypesAsJSON() {
+	// Setup fixture
+	entityFixture := entity.TestWebhookSubscriptionImplConstructor(entity.InvalidID, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut, entity.EventItemCheckedIn}, "some.secret.which.is.long", suite.createdFixture)
+
+	// Setup expectations
+	expectedSql := `
+	INSERT INTO webhook_subscription
+		(
+			url, 
+			event_types, 
+			secret, 
+			created_at
+		)
+	VALUES ($1, $2::jsonb, $3, $4)
+	RETURNING id;`
+	expectedID := entity.ID(401)
+
+	// Setup mocks
+	suite.mockHelperService.On("SingleQueryForID", suite.ctxFixture, suite.db, expectedSql, "webhook subscription",
+		"https://example.com/hook",
+		`["inventory.item.checked-out","inventory.item.checked-in"]`,
+		"some.secret.which.is.long",
+		suite.createdFixture,
+	).Return(expectedID, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, entityFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expectedID, actual)
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestFindByID_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		url, 
+		event_types::text, 
+		secret, 
+		created_at 
+	FROM webhook_subscription
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "webhook subscription", entity.ID(401)).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestFindByID_WhenRowIsScanned_ShouldReincarnateInUTC() {
+	// Setup fixture
+	zone := time.FixedZone("some.zone", 2*60*60)
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(401), "https://example.com/hook", `["inventory.item.checked-out"]`,
+		"some.secret.which.is.long", suite.createdFixture.In(zone),
+	}}
+	entityFixture := entity.TestWebhookSubscriptionImplConstructor(401, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.createdFixture)
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("SingleRowQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "webhook subscription", entity.ID(401)).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+	suite.mockConstructor.On("Reincarnate", entity.ID(401), "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.createdFixture).
+		Return(entityFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByID(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entityFixture, actual)
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestFindByEventType_WhenHelperServiceFails_ShouldFail() {
+	// Setup expectations
+	expectedSql := `
+	SELECT 
+		id, 
+		url, 
+		event_types::text, 
+		secret, 
+		created_at 
+	FROM webhook_subscription
+	WHERE 
+		event_types @> jsonb_build_array($1::text)
+	ORDER BY 
+		id;`
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, expectedSql, mock.Anything, "webhook subscription", "inventory.item.deleted").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.FindByEventType(suite.ctxFixture, entity.EventItemDeleted)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestFindAll_WhenEventTypesCannotBeDecoded_ShouldFailScan() {
+	// Setup fixture
+	rowFixture := &stubRow{values: []interface{}{
+		entity.ID(401), "https://example.com/hook", `not json`,
+		"some.secret.which.is.long", suite.createdFixture,
+	}}
+	var scanErr error
+
+	// Setup mocks
+	suite.mockHelperService.
+		On("ManyRowsQuery", suite.ctxFixture, suite.db, mock.Anything, mock.Anything, "webhook subscription").
+		Run(func(args mock.Arguments) {
+			scanErr = args.Get(3).(sql.ScanFunc)(rowFixture)
+		}).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.Error(scanErr)
+	suite.Contains(scanErr.Error(), "could not decode event types")
+	suite.mockConstructor.AssertNumberOfCalls(suite.T(), "Reincarnate", 0)
+}
+
+func (suite *WebhookSubscriptionRepositoryTestSuite) TestDeleteByID_ShouldPassOnToHelperService() {
+	// Setup expectations
+	expectedSql := `
+	DELETE FROM webhook_subscription
+	WHERE 
+		id=$1;`
+
+	// Setup mocks
+	suite.mockHelperService.On("ExecForSingleItem", suite.ctxFixture, suite.db, expectedSql, "webhook subscription", entity.ID(401)).
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.DeleteByID(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+}
@@ -0,0 +1,386 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+type AccountControllerTestSuite struct {
+	suite.Suite
+	mockAccountService     *accountMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.AccountControllerImpl
+}
+
+func TestAccountControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountControllerTestSuite))
+}
+
+func (suite *AccountControllerTestSuite) SetupTest() {
+	suite.mockAccountService = &accountMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewAccountControllerImpl(
+		suite.mockAccountService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *AccountControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/accounts",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/accounts/{id}",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/accounts",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/accounts/{id}",
+		},
+		{
+			Method:      goHttp.MethodDelete,
+			PathPattern: "/accounts/{id}",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *AccountControllerTestSuite) TestCreate_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 501,
+		Body:       []byte("some.error"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDecoderService.On("ToAccountCreateAccountVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestCreate_WhenAccountServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("101"),
+	}
+
+	// Setup mocks
+	mockVo := &account.CreateAccountVO{Name: "some.name"}
+	suite.mockDecoderService.On("ToAccountCreateAccountVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockAccountService.On("Create", suite.ctxFixture, mockVo).
+		Return(entity.ID(101), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(101)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestReadDetails_WhenAccountServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockAccountService.On("ReadDetails", suite.ctxFixture, entity.ID(101)).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestReadDetails_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockView := &account.ViewVO{Name: "some.name"}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockAccountService.On("ReadDetails", suite.ctxFixture, entity.ID(101)).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromAccountView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestReadAll_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockViews := []account.ThinViewVO{{Name: "some.name"}}
+	suite.mockAccountService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromAccountThinViews", mockViews).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []account.ThinViewVO{{Name: "some.name"}}
+	mockJson := []byte("some.json")
+	suite.mockAccountService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromAccountThinViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestUpdate_WhenAccountServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &account.UpdateAccountVO{Name: "some.name"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockDecoderService.On("ToAccountUpdateAccountVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockAccountService.On("Update", suite.ctxFixture, entity.ID(101), mockVo).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestUpdate_WhenAccountServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	mockVo := &account.UpdateAccountVO{Name: "some.name"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockDecoderService.On("ToAccountUpdateAccountVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockAccountService.On("Update", suite.ctxFixture, entity.ID(101), mockVo).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestDelete_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *AccountControllerTestSuite) TestDelete_WhenAccountServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockAccountService.On("Delete", suite.ctxFixture, entity.ID(101)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
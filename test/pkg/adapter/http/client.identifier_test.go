@@ -0,0 +1,61 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	configMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/config"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+)
+
+type ClientIdentifierTestSuite struct {
+	suite.Suite
+	mockConfigStore *configMocks.MockStore
+	sut             *http.ClientIdentifierImpl
+}
+
+func TestClientIdentifierTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientIdentifierTestSuite))
+}
+
+func (suite *ClientIdentifierTestSuite) SetupTest() {
+	suite.mockConfigStore = &configMocks.MockStore{}
+	suite.mockConfigStore.On("GetRateLimitAPIKeys").Return([]string{"kiosk", "secret"})
+	suite.sut = http.NewClientIdentifierImpl(
+		suite.mockConfigStore,
+	)
+}
+
+func (suite *ClientIdentifierTestSuite) TestIdentify_GivenConfiguredAPIKey_ShouldIdentifyByHashedKey() {
+	// Exercise SUT
+	actual := suite.sut.Identify("secret", "10.0.0.1:5000")
+
+	// Verify results
+	suite.Equal("key:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", actual)
+}
+
+func (suite *ClientIdentifierTestSuite) TestIdentify_GivenUnknownAPIKey_ShouldIdentifyByIPAddress() {
+	// Exercise SUT
+	actual := suite.sut.Identify("rotated-1", "10.0.0.1:5000")
+
+	// Verify results
+	suite.Equal("ip:10.0.0.1", actual)
+}
+
+func (suite *ClientIdentifierTestSuite) TestIdentify_GivenNoAPIKey_ShouldIdentifyByIPAddress() {
+	// Exercise SUT
+	actual := suite.sut.Identify("", "[::1]:5000")
+
+	// Verify results
+	suite.Equal("ip:::1", actual)
+}
+
+func (suite *ClientIdentifierTestSuite) TestIdentify_GivenAddressWithoutPort_ShouldUseItAsIs() {
+	// Exercise SUT
+	actual := suite.sut.Identify("", "10.0.0.1")
+
+	// Verify results
+	suite.Equal("ip:10.0.0.1", actual)
+}
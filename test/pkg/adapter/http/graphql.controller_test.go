@@ -0,0 +1,150 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	graphqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/graphql"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+)
+
+type GraphQLControllerTestSuite struct {
+	suite.Suite
+	mockGraphQLService  *graphqlMocks.MockService
+	mockDecoderService  *jsonMocks.MockDecoderService
+	mockResponseFactory *httpMocks.MockResponseFactory
+	ctxFixture          context.Context
+	sut                 *http.GraphQLControllerImpl
+}
+
+func TestGraphQLControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLControllerTestSuite))
+}
+
+func (suite *GraphQLControllerTestSuite) SetupTest() {
+	suite.mockGraphQLService = &graphqlMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewGraphQLControllerImpl(
+		suite.mockGraphQLService,
+		suite.mockDecoderService,
+		suite.mockResponseFactory,
+	)
+}
+
+func (suite *GraphQLControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/graphql",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *GraphQLControllerTestSuite) TestQuery_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDecoderService.On("ToGraphQLRequestVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Query(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *GraphQLControllerTestSuite) TestQuery_WhenGraphQLServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &graphql.RequestVO{Query: "{ titles { name } }"}
+	suite.mockDecoderService.On("ToGraphQLRequestVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockGraphQLService.On("Execute", suite.ctxFixture, mockVo).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Query(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *GraphQLControllerTestSuite) TestQuery_WhenGraphQLServiceSucceeds_ShouldReturnJSON() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVo := &graphql.RequestVO{Query: "{ titles { name } }"}
+	mockBody := []byte(`{"data":{"titles":[]}}`)
+	suite.mockDecoderService.On("ToGraphQLRequestVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockGraphQLService.On("Execute", suite.ctxFixture, mockVo).
+		Return(mockBody, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockBody).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Query(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
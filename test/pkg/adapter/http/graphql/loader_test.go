@@ -0,0 +1,92 @@
+package graphql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type LoaderTestSuite struct {
+	suite.Suite
+	batches  [][]entity.ID
+	batchErr error
+	sut      *graphql.Loader
+}
+
+func TestLoaderTestSuite(t *testing.T) {
+	suite.Run(t, new(LoaderTestSuite))
+}
+
+func (suite *LoaderTestSuite) SetupTest() {
+	suite.batches = nil
+	suite.batchErr = nil
+	suite.sut = graphql.NewLoader(func(ctx context.Context, ids []entity.ID) (map[entity.ID]interface{}, error) {
+		suite.batches = append(suite.batches, ids)
+		if suite.batchErr != nil {
+			return nil, suite.batchErr
+		}
+		found := make(map[entity.ID]interface{})
+		for _, id := range ids {
+			if id != 3 {
+				found[id] = fmt.Sprintf("value.%d", id)
+			}
+		}
+		return found, nil
+	})
+}
+
+func (suite *LoaderTestSuite) TestLoad_WhenIdsArePrimed_ShouldLoadThemInOneBatch() {
+	// Setup fixture
+	suite.sut.Prime(1, 2, 2, 3)
+
+	// Exercise SUT
+	actual1, err1 := suite.sut.Load(context.Background(), 2)
+	actual2, err2 := suite.sut.Load(context.Background(), 1)
+	actual3, err3 := suite.sut.Load(context.Background(), 3)
+
+	// Verify results
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.NoError(err3)
+	suite.Equal("value.2", actual1)
+	suite.Equal("value.1", actual2)
+	suite.Nil(actual3)
+	suite.Equal([][]entity.ID{{1, 2, 3}}, suite.batches)
+}
+
+func (suite *LoaderTestSuite) TestLoad_WhenPrimedAgain_ShouldOnlyLoadWhatIsNew() {
+	// Setup fixture
+	suite.sut.Prime(1, 2)
+	suite.sut.Load(context.Background(), 1)
+	suite.sut.Prime(2, 4)
+
+	// Exercise SUT
+	actual, err := suite.sut.Load(context.Background(), 5)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("value.5", actual)
+	suite.Equal([][]entity.ID{{1, 2}, {4, 5}}, suite.batches)
+}
+
+func (suite *LoaderTestSuite) TestLoad_WhenBatchFails_ShouldFailForEachIdOfIt() {
+	// Setup fixture
+	suite.batchErr = fmt.Errorf("mock.error")
+	suite.sut.Prime(1, 2)
+
+	// Exercise SUT
+	actual1, err1 := suite.sut.Load(context.Background(), 1)
+	actual2, err2 := suite.sut.Load(context.Background(), 2)
+
+	// Verify results
+	suite.Nil(actual1)
+	suite.Nil(actual2)
+	suite.EqualError(err1, "mock.error")
+	suite.EqualError(err2, "mock.error")
+	suite.Len(suite.batches, 1)
+}
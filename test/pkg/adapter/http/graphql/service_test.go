@@ -0,0 +1,257 @@
+package graphql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockTitleService     *titleMocks.MockService
+	mockInventoryService *inventoryMocks.MockService
+	mockAccountService   *accountMocks.MockService
+	sut                  *graphql.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockTitleService = &titleMocks.MockService{}
+	suite.mockInventoryService = &inventoryMocks.MockService{}
+	suite.mockAccountService = &accountMocks.MockService{}
+
+	sut, err := graphql.NewServiceImpl(
+		suite.mockTitleService,
+		suite.mockInventoryService,
+		suite.mockAccountService,
+		4,
+	)
+	suite.Require().NoError(err)
+	suite.sut = sut
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenTitlesAndTheirItemsAreQueried_ShouldLoadEachInOneBatch() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ titles { id name items { barcode available title { name } } } }`,
+	}
+
+	// Setup mocks
+	suite.mockTitleService.On("ReadAll", mock.Anything).Return([]title.ThinViewVO{
+		{ID: 1}, {ID: 2},
+	}, nil)
+	suite.mockTitleService.On("ReadManyDetails", mock.Anything, []entity.ID{1, 2}).Return([]title.ViewVO{
+		{ID: 1, Name: "Alien"}, {ID: 2, Name: "Heat"},
+	}, nil)
+	suite.mockInventoryService.On("ReadAllOfTitles", mock.Anything, []entity.ID{1, 2}).Return([]inventory.ThinViewVO{
+		{ID: 11, TitleID: 1}, {ID: 12, TitleID: 1}, {ID: 21, TitleID: 2},
+	}, nil)
+	suite.mockInventoryService.On("ReadManyDetails", mock.Anything, []entity.ID{11, 12, 21}).Return([]inventory.ViewVO{
+		{ID: 11, TitleID: 1, Barcode: "A1", Available: true},
+		{ID: 12, TitleID: 1, Barcode: "A2"},
+		{ID: 21, TitleID: 2, Barcode: "H1", Available: true},
+	}, nil)
+
+	// Setup expectations
+	expected := `{"data":{"titles":[` +
+		`{"id":"1","name":"Alien","items":[` +
+		`{"barcode":"A1","available":true,"title":{"name":"Alien"}},` +
+		`{"barcode":"A2","available":false,"title":{"name":"Alien"}}]},` +
+		`{"id":"2","name":"Heat","items":[` +
+		`{"barcode":"H1","available":true,"title":{"name":"Heat"}}]}]}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+	suite.mockTitleService.AssertNumberOfCalls(suite.T(), "ReadManyDetails", 1)
+	suite.mockInventoryService.AssertNumberOfCalls(suite.T(), "ReadAllOfTitles", 1)
+	suite.mockInventoryService.AssertNumberOfCalls(suite.T(), "ReadManyDetails", 1)
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenItemsOfFormatAreQueried_ShouldLoadTheirTitlesInOneBatch() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query:     `query Items($format: String) { items(format: $format) { id dueAt title { name } } }`,
+		Variables: map[string]interface{}{"format": "vhs"},
+	}
+
+	// Setup mocks
+	dueAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockInventoryService.On("ReadAllOfFormat", mock.Anything, entity.FormatVHS).Return([]inventory.ThinViewVO{
+		{ID: 11}, {ID: 21},
+	}, nil)
+	suite.mockInventoryService.On("ReadManyDetails", mock.Anything, []entity.ID{11, 21}).Return([]inventory.ViewVO{
+		{ID: 11, TitleID: 1, DueAt: &dueAt},
+		{ID: 21, TitleID: 2},
+	}, nil)
+	suite.mockTitleService.On("ReadManyDetails", mock.Anything, []entity.ID{1, 2}).Return([]title.ViewVO{
+		{ID: 1, Name: "Alien"}, {ID: 2, Name: "Heat"},
+	}, nil)
+
+	// Setup expectations
+	expected := `{"data":{"items":[` +
+		`{"id":"11","dueAt":"2020-01-02T03:04:05Z","title":{"name":"Alien"}},` +
+		`{"id":"21","dueAt":null,"title":{"name":"Heat"}}]}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+	suite.mockTitleService.AssertNumberOfCalls(suite.T(), "ReadManyDetails", 1)
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenAccountIsQueried_ShouldLoadRentedItemsInOneBatch() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ account(id: "7") { name balanceCents overdue rentals { overdue item { barcode } } } }`,
+	}
+
+	// Setup mocks
+	suite.mockAccountService.On("ReadDetails", mock.Anything, entity.ID(7)).Return(&account.ViewVO{
+		ID:      7,
+		Name:    "Jane",
+		Balance: 250,
+		Overdue: true,
+		Rentals: []rental.ViewVO{
+			{ID: 1, ItemID: 11, Overdue: true},
+			{ID: 2, ItemID: 21},
+		},
+	}, nil)
+	suite.mockInventoryService.On("ReadManyDetails", mock.Anything, []entity.ID{11, 21}).Return([]inventory.ViewVO{
+		{ID: 11, Barcode: "A1"},
+		{ID: 21, Barcode: "H1"},
+	}, nil)
+
+	// Setup expectations
+	expected := `{"data":{"account":{"name":"Jane","balanceCents":250,"overdue":true,"rentals":[` +
+		`{"overdue":true,"item":{"barcode":"A1"}},` +
+		`{"overdue":false,"item":{"barcode":"H1"}}]}}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+	suite.mockInventoryService.AssertNumberOfCalls(suite.T(), "ReadManyDetails", 1)
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenBalanceIsTooLargeForInt_ShouldGiveError() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ account(id: "7") { name balanceCents } }`,
+	}
+
+	// Setup mocks
+	suite.mockAccountService.On("ReadDetails", mock.Anything, entity.ID(7)).Return(&account.ViewVO{
+		ID:      7,
+		Name:    "Jane",
+		Balance: 3000000000,
+	}, nil)
+
+	// Setup expectations
+	expected := `{"errors":[{"message":"could not resolve balanceCents - 3000000000 is out of range for Int",` +
+		`"path":["account","balanceCents"]}],"data":{"account":null}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenAccountDoesNotExist_ShouldGiveNull() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ account(id: "7") { name } }`,
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error: %w", db.NewNotFoundError("account"))
+	suite.mockAccountService.On("ReadDetails", mock.Anything, entity.ID(7)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(`{"data":{"account":null}}`, string(actual))
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenIDIsNotANumber_ShouldGiveError() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ item(id: "abc") { barcode } }`,
+	}
+
+	// Setup expectations
+	expected := `{"errors":[{"message":"could not convert graphql id - validation error: field=[id], problem=[must be a whole number]",` +
+		`"path":["item"]}],"data":{"item":null}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenServiceFails_ShouldGiveError() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ title(id: "1") { name } }`,
+	}
+
+	// Setup mocks
+	suite.mockTitleService.On("ReadManyDetails", mock.Anything, []entity.ID{1}).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expected := `{"errors":[{"message":"mock.error","path":["title"]}],"data":{"title":null}}`
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.JSONEq(expected, string(actual))
+}
+
+func (suite *ServiceImplTestSuite) TestExecute_WhenQueryIsTooDeep_ShouldRefuseWithoutResolving() {
+	// Setup fixture
+	voFixture := &graphql.RequestVO{
+		Query: `{ titles { items { title { items { barcode } } } } }`,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.Execute(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Contains(string(actual), `has depth 5 that exceeds max depth 4`)
+	suite.mockTitleService.AssertNumberOfCalls(suite.T(), "ReadAll", 0)
+}
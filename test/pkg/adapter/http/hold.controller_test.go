@@ -0,0 +1,452 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	holdMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/hold"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+type HoldControllerTestSuite struct {
+	suite.Suite
+	mockHoldService        *holdMocks.MockService
+	mockInventoryService   *inventoryMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.HoldControllerImpl
+}
+
+func TestHoldControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldControllerTestSuite))
+}
+
+func (suite *HoldControllerTestSuite) SetupTest() {
+	suite.mockHoldService = &holdMocks.MockService{}
+	suite.mockInventoryService = &inventoryMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewHoldControllerImpl(
+		suite.mockHoldService,
+		suite.mockInventoryService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *HoldControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/titles/{id}/holds",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/titles/{id}/holds",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/holds/{id}/cancel",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/holds/{id}/fulfil",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *HoldControllerTestSuite) TestPlace_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Place(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestPlace_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockDecoderService.On("ToHoldPlaceHoldVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Place(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestPlace_WhenHoldServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &hold.PlaceHoldVO{AccountID: 7}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockDecoderService.On("ToHoldPlaceHoldVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockHoldService.On("Place", suite.ctxFixture, entity.ID(11), mockVo).
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Place(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestPlace_WhenHoldServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("301"),
+	}
+
+	// Setup mocks
+	mockVo := &hold.PlaceHoldVO{AccountID: 7}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockDecoderService.On("ToHoldPlaceHoldVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockHoldService.On("Place", suite.ctxFixture, entity.ID(11), mockVo).
+		Return(entity.ID(301), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(301)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Place(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestReadQueue_WhenHoldServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockHoldService.On("ReadQueue", suite.ctxFixture, entity.ID(11)).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadQueue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestReadQueue_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockViews := []hold.ViewVO{{ID: entity.ID(301)}}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockHoldService.On("ReadQueue", suite.ctxFixture, entity.ID(11)).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromHoldViews", mockViews).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadQueue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestReadQueue_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []hold.ViewVO{{ID: entity.ID(301)}}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(11), nil)
+	suite.mockHoldService.On("ReadQueue", suite.ctxFixture, entity.ID(11)).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromHoldViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadQueue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestCancel_WhenHoldServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(301), nil)
+	suite.mockHoldService.On("Cancel", suite.ctxFixture, entity.ID(301)).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Cancel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestCancel_WhenHoldServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(301), nil)
+	suite.mockHoldService.On("Cancel", suite.ctxFixture, entity.ID(301)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Cancel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestFulfil_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Fulfil(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestFulfil_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(301), nil)
+	suite.mockInventoryService.On("FulfilHold", suite.ctxFixture, entity.ID(301)).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Fulfil(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *HoldControllerTestSuite) TestFulfil_WhenInventoryServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(301), nil)
+	suite.mockInventoryService.On("FulfilHold", suite.ctxFixture, entity.ID(301)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Fulfil(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
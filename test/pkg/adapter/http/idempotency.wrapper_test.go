@@ -0,0 +1,319 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	idempotencyMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/idempotency"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+type IdempotencyWrapperTestSuite struct {
+	suite.Suite
+	mockIdempotencyService *idempotencyMocks.MockService
+	mockClientIdentifier   *httpMocks.MockClientIdentifier
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	ctxFixture             context.Context
+	patternFixture         http.HandlerPattern
+	handled                int
+	sut                    *http.IdempotencyWrapperImpl
+}
+
+func TestIdempotencyWrapperTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyWrapperTestSuite))
+}
+
+func (suite *IdempotencyWrapperTestSuite) SetupTest() {
+	suite.mockIdempotencyService = &idempotencyMocks.MockService{}
+	suite.mockClientIdentifier = &httpMocks.MockClientIdentifier{}
+	suite.mockClientIdentifier.On("Identify", "", "10.0.0.1:5000").Return("ip:10.0.0.1")
+	suite.mockClientIdentifier.On("Identify", "secret", "10.0.0.1:5000").Return("key:some.hash")
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.ctxFixture = context.Background()
+	suite.patternFixture = http.HandlerPattern{Method: goHttp.MethodPost, PathPattern: "/inventory"}
+	suite.handled = 0
+	suite.sut = http.NewIdempotencyWrapperImpl(
+		suite.mockIdempotencyService,
+		suite.mockClientIdentifier,
+		suite.mockResponseFactory,
+	)
+}
+
+func (suite *IdempotencyWrapperTestSuite) handler(statusCode uint) http.Handler {
+	return func(*http.Request) *http.Response {
+		suite.handled++
+		return &http.Response{
+			ContentType: "text/plain",
+			StatusCode:  statusCode,
+			Body:        []byte("101"),
+		}
+	}
+}
+
+func (suite *IdempotencyWrapperTestSuite) requestFixture(key string, body string) *http.Request {
+	return &http.Request{
+		Context:    suite.ctxFixture,
+		Header:     map[string]string{http.IdempotencyKeyHeader: key},
+		Body:       []byte(body),
+		RemoteAddr: "10.0.0.1:5000",
+	}
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenPatternDoesNotHonourKeys_ShouldJustHandle() {
+	// Setup fixture
+	patternFixture := http.HandlerPattern{Method: goHttp.MethodPut, PathPattern: "/inventory/{id}"}
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(patternFixture, suite.handler(204))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(uint(204), actual.StatusCode)
+	suite.Equal(1, suite.handled)
+	suite.mockIdempotencyService.AssertNumberOfCalls(suite.T(), "Begin", 0)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenRequestHasNoKey_ShouldJustHandle() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Header:  map[string]string{},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(requestFixture)
+
+	// Verify results
+	suite.Equal(uint(201), actual.StatusCode)
+	suite.Equal(1, suite.handled)
+	suite.mockIdempotencyService.AssertNumberOfCalls(suite.T(), "Begin", 0)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenKeyIsTooLong_ShouldFail() {
+	// Setup expectations
+	expected := &http.Response{StatusCode: 400}
+
+	// Setup mocks
+	suite.mockResponseFactory.On("CreateFromError",
+		commonerror.NewValidation("Idempotency-Key", "must be 1 to 255 characters")).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(
+		suite.requestFixture(strings.Repeat("k", 256), "{}"))
+
+	// Verify results
+	suite.Equal(expected, actual)
+	suite.Equal(0, suite.handled)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenBeginFails_ShouldFail() {
+	// Setup expectations
+	expected := &http.Response{StatusCode: 422}
+
+	// Setup mocks
+	mockErr := idempotency.NewReusedKeyError("some.key")
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(expected, actual)
+	suite.Equal(0, suite.handled)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenRequestWasHandled_ShouldReplayResponse() {
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain",
+		StatusCode:  201,
+		Body:        []byte("99"),
+	}
+
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(&idempotency.ResponseVO{
+			StatusCode:  201,
+			ContentType: "text/plain",
+			Body:        []byte("99"),
+		}, nil)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(expected, actual)
+	suite.Equal(0, suite.handled)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenRequestIsNew_ShouldHandleAndKeepResponse() {
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Finish", mock.Anything, "ip:10.0.0.1", "some.key", &idempotency.ResponseVO{
+		StatusCode:  201,
+		ContentType: "text/plain",
+		Body:        []byte("101"),
+	}).Return(nil)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(uint(201), actual.StatusCode)
+	suite.Equal(1, suite.handled)
+	suite.mockIdempotencyService.AssertExpectations(suite.T())
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenRequestTimesOut_ShouldStillKeepResponse() {
+	// Setup fixture
+	ctx, cancel := context.WithTimeout(suite.ctxFixture, time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+	requestFixture := suite.requestFixture("some.key", "{}")
+	requestFixture.Context = ctx
+
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", ctx, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Finish", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), "ip:10.0.0.1", "some.key", mock.Anything).Return(nil)
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.patternFixture, suite.handler(400))(requestFixture)
+
+	// Verify results
+	suite.mockIdempotencyService.AssertExpectations(suite.T())
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenFinishFails_ShouldStillGiveResponse() {
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Finish", mock.Anything, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(uint(201), actual.StatusCode)
+	suite.Equal([]byte("101"), actual.Body)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenHandlingFailsUnexpectedly_ShouldAbandonKey() {
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Abandon", mock.Anything, "ip:10.0.0.1", "some.key").
+		Return(nil)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(500))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(uint(500), actual.StatusCode)
+	suite.mockIdempotencyService.AssertExpectations(suite.T())
+	suite.mockIdempotencyService.AssertNumberOfCalls(suite.T(), "Finish", 0)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenAbandonFails_ShouldStillGiveResponse() {
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Abandon", mock.Anything, "ip:10.0.0.1", "some.key").
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(500))(suite.requestFixture("some.key", "{}"))
+
+	// Verify results
+	suite.Equal(uint(500), actual.StatusCode)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_WhenHandlingPanics_ShouldAbandonKey() {
+	// Setup fixture
+	handler := func(*http.Request) *http.Response {
+		panic("mock.panic")
+	}
+
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Return(nil, nil)
+	suite.mockIdempotencyService.On("Abandon", mock.Anything, "ip:10.0.0.1", "some.key").
+		Return(nil)
+
+	// Exercise SUT
+	wrapped := suite.sut.Wrap(suite.patternFixture, handler)
+
+	// Verify results
+	suite.PanicsWithValue("mock.panic", func() {
+		wrapped(suite.requestFixture("some.key", "{}"))
+	})
+	suite.mockIdempotencyService.AssertExpectations(suite.T())
+	suite.mockIdempotencyService.AssertNumberOfCalls(suite.T(), "Finish", 0)
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_GivenAPIKey_ShouldKeepKeysForThatClient() {
+	// Setup fixture
+	requestFixture := suite.requestFixture("some.key", "{}")
+	requestFixture.Header["X-Api-Key"] = "secret"
+
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "key:some.hash", "some.key", mock.Anything).
+		Return(&idempotency.ResponseVO{StatusCode: 201}, nil)
+
+	// Exercise SUT
+	actual := suite.sut.Wrap(suite.patternFixture, suite.handler(201))(requestFixture)
+
+	// Verify results
+	suite.Equal(uint(201), actual.StatusCode)
+	suite.mockIdempotencyService.AssertExpectations(suite.T())
+}
+
+func (suite *IdempotencyWrapperTestSuite) TestWrap_ShouldFingerprintWhatRequestAsksFor() {
+	// Setup fixture
+	patternFixture := http.HandlerPattern{Method: goHttp.MethodPut, PathPattern: "/inventory/{id}/checkout"}
+	request := func(id string, body string) *http.Request {
+		r := suite.requestFixture("some.key", body)
+		r.PathParam = map[string]string{"id": id}
+		return r
+	}
+	var fingerprints []string
+
+	// Setup mocks
+	suite.mockIdempotencyService.On("Begin", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fingerprints = append(fingerprints, args.String(3))
+		}).
+		Return(&idempotency.ResponseVO{StatusCode: 204}, nil)
+
+	// Exercise SUT
+	wrapped := suite.sut.Wrap(patternFixture, suite.handler(204))
+	wrapped(request("101", `{"accountId":5}`))
+	wrapped(request("101", `{"accountId":5}`))
+	wrapped(request("102", `{"accountId":5}`))
+	wrapped(request("101", `{"accountId":6}`))
+
+	// Verify results
+	suite.Len(fingerprints, 4)
+	suite.Len(fingerprints[0], 64)
+	suite.Equal(fingerprints[0], fingerprints[1])
+	suite.NotEqual(fingerprints[0], fingerprints[2])
+	suite.NotEqual(fingerprints[0], fingerprints[3])
+}
@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"fmt"
 	goHttp "net/http"
 	"testing"
@@ -9,11 +10,14 @@ import (
 
 	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
 	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	labelMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/label"
 	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
 )
 
 type InventoryControllerTestSuite struct {
@@ -21,8 +25,10 @@ type InventoryControllerTestSuite struct {
 	mockInventoryService   *inventoryMocks.MockService
 	mockDecoderService     *jsonMocks.MockDecoderService
 	mockEncoderService     *jsonMocks.MockEncoderService
+	mockLabelService       *labelMocks.MockEncoderService
 	mockResponseFactory    *httpMocks.MockResponseFactory
 	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
 	sut                    *http.InventoryControllerImpl
 }
 
@@ -34,12 +40,15 @@ func (suite *InventoryControllerTestSuite) SetupTest() {
 	suite.mockInventoryService = &inventoryMocks.MockService{}
 	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
 	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockLabelService = &labelMocks.MockEncoderService{}
 	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
 	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
 	suite.sut = http.NewInventoryControllerImpl(
 		suite.mockInventoryService,
 		suite.mockDecoderService,
 		suite.mockEncoderService,
+		suite.mockLabelService,
 		suite.mockResponseFactory,
 		suite.mockParameterConverter,
 	)
@@ -76,6 +85,38 @@ func (suite *InventoryControllerTestSuite) TestGetHandlers_ShouldReturnAllHandle
 			Method:      goHttp.MethodPut,
 			PathPattern: "/inventory/{id}/checkin",
 		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/inventory/{id}/renew",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/inventory/{id}/move",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/inventory/{id}/moves",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/inventory/by-barcode/{code}",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/inventory/by-barcode/{code}/checkout",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/inventory/by-barcode/{code}/checkin",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/inventory/{id}/label",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/labels",
+		},
 	}
 
 	// Exercise SUT
@@ -91,7 +132,8 @@ func (suite *InventoryControllerTestSuite) TestCreate_WhenDecoderServiceFails_Sh
 	// Setup fixture
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
-		Body: bodyFixture,
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
 	}
 
 	// Setup expectations
@@ -118,7 +160,8 @@ func (suite *InventoryControllerTestSuite) TestCreate_WhenInventoryServiceFails_
 	// Setup fixture
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
-		Body: bodyFixture,
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
 	}
 
 	// Setup expectations
@@ -128,11 +171,11 @@ func (suite *InventoryControllerTestSuite) TestCreate_WhenInventoryServiceFails_
 	}
 
 	// Setup mocks
-	mockVo := &inventory.CreateItemVO{Name: "some.name"}
+	mockVo := &inventory.CreateItemVO{Barcode: "some.barcode"}
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockDecoderService.On("ToInventoryCreateItemVo", bodyFixture).
 		Return(mockVo, nil)
-	suite.mockInventoryService.On("Create", mockVo).
+	suite.mockInventoryService.On("Create", suite.ctxFixture, mockVo).
 		Return(entity.InvalidID, mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -148,7 +191,8 @@ func (suite *InventoryControllerTestSuite) TestCreate_WhenInventoryServicePasses
 	// Setup fixture
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
-		Body: bodyFixture,
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
 	}
 
 	// Setup expectations
@@ -158,11 +202,11 @@ func (suite *InventoryControllerTestSuite) TestCreate_WhenInventoryServicePasses
 	}
 
 	// Setup mocks
-	mockVo := &inventory.CreateItemVO{Name: "some.name"}
+	mockVo := &inventory.CreateItemVO{Barcode: "some.barcode"}
 	mockId := entity.ID(101)
 	suite.mockDecoderService.On("ToInventoryCreateItemVo", bodyFixture).
 		Return(mockVo, nil)
-	suite.mockInventoryService.On("Create", mockVo).
+	suite.mockInventoryService.On("Create", suite.ctxFixture, mockVo).
 		Return(mockId, nil)
 	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), mockId).
 		Return(expected)
@@ -178,6 +222,7 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenParameterConverte
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -205,6 +250,7 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenInventoryServiceF
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -219,7 +265,7 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenInventoryServiceF
 	mockID := entity.ID(101)
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("ReadDetails", mockID).
+	suite.mockInventoryService.On("ReadDetails", suite.ctxFixture, mockID).
 		Return(nil, mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -235,6 +281,7 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenEncoderServiceFai
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -247,10 +294,10 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenEncoderServiceFai
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
 	mockID := entity.ID(101)
-	mockView := &inventory.ViewVO{Name: "some.name"}
+	mockView := &inventory.ViewVO{Barcode: "some.barcode"}
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("ReadDetails", mockID).
+	suite.mockInventoryService.On("ReadDetails", suite.ctxFixture, mockID).
 		Return(mockView, nil)
 	suite.mockEncoderService.On("FromInventoryItemView", mockView).
 		Return(nil, mockErr)
@@ -268,6 +315,7 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenEncoderServicePas
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -279,11 +327,11 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenEncoderServicePas
 
 	// Setup mocks
 	mockID := entity.ID(101)
-	mockView := &inventory.ViewVO{Name: "some.name"}
+	mockView := &inventory.ViewVO{Barcode: "some.barcode"}
 	mockJson := []byte("some.json")
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("ReadDetails", mockID).
+	suite.mockInventoryService.On("ReadDetails", suite.ctxFixture, mockID).
 		Return(mockView, nil)
 	suite.mockEncoderService.On("FromInventoryItemView", mockView).
 		Return(mockJson, nil)
@@ -299,7 +347,9 @@ func (suite *InventoryControllerTestSuite) TestReadDetails_WhenEncoderServicePas
 
 func (suite *InventoryControllerTestSuite) TestReadAll_WhenInventoryServiceFails_ShouldFail() {
 	// Setup fixture
-	requestFixture := &http.Request{}
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
 
 	// Setup expectations
 	expected := &http.Response{
@@ -309,7 +359,7 @@ func (suite *InventoryControllerTestSuite) TestReadAll_WhenInventoryServiceFails
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockInventoryService.On("ReadAll").
+	suite.mockInventoryService.On("ReadAll", suite.ctxFixture).
 		Return(nil, mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -323,7 +373,9 @@ func (suite *InventoryControllerTestSuite) TestReadAll_WhenInventoryServiceFails
 
 func (suite *InventoryControllerTestSuite) TestReadAll_WhenEncoderServiceFails_ShouldFail() {
 	// Setup fixture
-	requestFixture := &http.Request{}
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
 
 	// Setup expectations
 	expected := &http.Response{
@@ -333,8 +385,8 @@ func (suite *InventoryControllerTestSuite) TestReadAll_WhenEncoderServiceFails_S
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	mockVos := []inventory.ThinViewVO{inventory.ThinViewVO{Name: "some.name"}}
-	suite.mockInventoryService.On("ReadAll").
+	mockVos := []inventory.ThinViewVO{inventory.ThinViewVO{Barcode: "some.barcode"}}
+	suite.mockInventoryService.On("ReadAll", suite.ctxFixture).
 		Return(mockVos, nil)
 	suite.mockEncoderService.On("FromInventoryItemThinViews", mockVos).
 		Return(nil, mockErr)
@@ -350,7 +402,41 @@ func (suite *InventoryControllerTestSuite) TestReadAll_WhenEncoderServiceFails_S
 
 func (suite *InventoryControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnOK() {
 	// Setup fixture
-	requestFixture := &http.Request{}
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVos := []inventory.ThinViewVO{inventory.ThinViewVO{Barcode: "some.barcode"}}
+	mockJson := []byte("some.json")
+	suite.mockInventoryService.On("ReadAll", suite.ctxFixture).
+		Return(mockVos, nil)
+	suite.mockEncoderService.On("FromInventoryItemThinViews", mockVos).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadAll_WhenFormatIsQueried_ShouldReadAllOfFormat() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		QueryParam: map[string][]string{
+			"format": {"vhs"},
+		},
+	}
 
 	// Setup expectations
 	expected := &http.Response{
@@ -359,9 +445,9 @@ func (suite *InventoryControllerTestSuite) TestReadAll_WhenEncoderServicePasses_
 	}
 
 	// Setup mocks
-	mockVos := []inventory.ThinViewVO{inventory.ThinViewVO{Name: "some.name"}}
+	mockVos := []inventory.ThinViewVO{{Barcode: "some.barcode"}}
 	mockJson := []byte("some.json")
-	suite.mockInventoryService.On("ReadAll").
+	suite.mockInventoryService.On("ReadAllOfFormat", suite.ctxFixture, entity.FormatVHS).
 		Return(mockVos, nil)
 	suite.mockEncoderService.On("FromInventoryItemThinViews", mockVos).
 		Return(mockJson, nil)
@@ -379,6 +465,7 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenParameterConverterFail
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -407,6 +494,7 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenDecoderServiceFails_Sh
 	pathParamFixture := map[string]string{"some": "param"}
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 		Body:      bodyFixture,
 	}
@@ -439,6 +527,7 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenInventoryServiceFails_
 	pathParamFixture := map[string]string{"some": "param"}
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 		Body:      bodyFixture,
 	}
@@ -452,12 +541,12 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenInventoryServiceFails_
 	// Setup mocks
 	mockErr := fmt.Errorf("some.error")
 	mockID := entity.ID(101)
-	mockVo := &inventory.UpdateItemVO{Name: "some.name"}
+	mockVo := &inventory.UpdateItemVO{Barcode: "some.barcode"}
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
 	suite.mockDecoderService.On("ToInventoryUpdateItemVo", bodyFixture).
 		Return(mockVo, nil)
-	suite.mockInventoryService.On("Update", mockID, mockVo).
+	suite.mockInventoryService.On("Update", suite.ctxFixture, mockID, mockVo).
 		Return(mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -474,6 +563,7 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenInventoryServicePasses
 	pathParamFixture := map[string]string{"some": "param"}
 	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 		Body:      bodyFixture,
 	}
@@ -486,12 +576,12 @@ func (suite *InventoryControllerTestSuite) TestUpdate_WhenInventoryServicePasses
 
 	// Setup mocks
 	mockID := entity.ID(101)
-	mockVo := &inventory.UpdateItemVO{Name: "some.name"}
+	mockVo := &inventory.UpdateItemVO{Barcode: "some.barcode"}
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
 	suite.mockDecoderService.On("ToInventoryUpdateItemVo", bodyFixture).
 		Return(mockVo, nil)
-	suite.mockInventoryService.On("Update", mockID, mockVo).
+	suite.mockInventoryService.On("Update", suite.ctxFixture, mockID, mockVo).
 		Return(nil)
 	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
 		Return(expected)
@@ -507,6 +597,7 @@ func (suite *InventoryControllerTestSuite) TestDelete_WhenParameterConverterFail
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -534,6 +625,7 @@ func (suite *InventoryControllerTestSuite) TestDelete_WhenInventoryServiceFails_
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -548,7 +640,7 @@ func (suite *InventoryControllerTestSuite) TestDelete_WhenInventoryServiceFails_
 	mockErr := fmt.Errorf("some.error")
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("Delete", mockID).
+	suite.mockInventoryService.On("Delete", suite.ctxFixture, mockID).
 		Return(mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -564,6 +656,7 @@ func (suite *InventoryControllerTestSuite) TestDelete_WhenInventoryServicePasses
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -577,7 +670,7 @@ func (suite *InventoryControllerTestSuite) TestDelete_WhenInventoryServicePasses
 	mockID := entity.ID(101)
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("Delete", mockID).
+	suite.mockInventoryService.On("Delete", suite.ctxFixture, mockID).
 		Return(nil)
 	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
 		Return(expected)
@@ -593,6 +686,7 @@ func (suite *InventoryControllerTestSuite) TestCheckout_WhenParameterConverterFa
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -616,11 +710,47 @@ func (suite *InventoryControllerTestSuite) TestCheckout_WhenParameterConverterFa
 	suite.Equal(expected, actual)
 }
 
+func (suite *InventoryControllerTestSuite) TestCheckout_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	mockID := entity.ID(101)
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockDecoderService.On("ToInventoryCheckoutVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Checkout(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
 func (suite *InventoryControllerTestSuite) TestCheckout_WhenInventoryServiceFails_ShouldFail() {
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
+		Body:      bodyFixture,
 	}
 
 	// Setup expectations
@@ -631,10 +761,13 @@ func (suite *InventoryControllerTestSuite) TestCheckout_WhenInventoryServiceFail
 
 	// Setup mocks
 	mockID := entity.ID(101)
+	mockVo := &inventory.CheckoutVO{AccountID: 7}
 	mockErr := fmt.Errorf("some.error")
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("Checkout", mockID).
+	suite.mockDecoderService.On("ToInventoryCheckoutVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockInventoryService.On("Checkout", suite.ctxFixture, mockID, mockVo).
 		Return(mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
@@ -649,8 +782,11 @@ func (suite *InventoryControllerTestSuite) TestCheckout_WhenInventoryServiceFail
 func (suite *InventoryControllerTestSuite) TestCheckout_WhenInventoryServicePasses_ShouldReturnAsExpected() {
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
+		Body:      bodyFixture,
 	}
 
 	// Setup expectations
@@ -661,9 +797,12 @@ func (suite *InventoryControllerTestSuite) TestCheckout_WhenInventoryServicePass
 
 	// Setup mocks
 	mockID := entity.ID(101)
+	mockVo := &inventory.CheckoutVO{AccountID: 7}
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("Checkout", mockID).
+	suite.mockDecoderService.On("ToInventoryCheckoutVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockInventoryService.On("Checkout", suite.ctxFixture, mockID, mockVo).
 		Return(nil)
 	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
 		Return(expected)
@@ -679,6 +818,7 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenParameterConverterFai
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -706,6 +846,7 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServiceFails
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -720,8 +861,8 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServiceFails
 	mockErr := fmt.Errorf("some.error")
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("CheckIn", mockID).
-		Return(mockErr)
+	suite.mockInventoryService.On("CheckIn", suite.ctxFixture, mockID).
+		Return(nil, mockErr)
 	suite.mockResponseFactory.On("CreateFromError", mockErr).
 		Return(expected)
 
@@ -732,10 +873,11 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServiceFails
 	suite.Equal(expected, actual)
 }
 
-func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+func (suite *InventoryControllerTestSuite) TestCheckIn_WhenThereIsNoReceipt_ShouldReturnEmpty() {
 	// Setup fixture
 	pathParamFixture := map[string]string{"some": "param"}
 	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
 		PathParam: pathParamFixture,
 	}
 
@@ -749,8 +891,8 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServicePasse
 	mockID := entity.ID(101)
 	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
 		Return(mockID, nil)
-	suite.mockInventoryService.On("CheckIn", mockID).
-		Return(nil)
+	suite.mockInventoryService.On("CheckIn", suite.ctxFixture, mockID).
+		Return(nil, nil)
 	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
 		Return(expected)
 
@@ -761,15 +903,812 @@ func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServicePasse
 	suite.Equal(expected, actual)
 }
 
-// EqualKeys matches the keys of a map
-func equalKeys(expected []http.HandlerPattern, actual map[http.HandlerPattern]http.Handler) error {
-	if len(actual) != len(expected) {
-		return fmt.Errorf("Maps are different lengths. Expected: %d, Actual: %d", len(expected), len(actual))
+func (suite *InventoryControllerTestSuite) TestCheckIn_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
 	}
-	for _, k := range expected {
-		if _, ok := actual[k]; !ok {
-			return fmt.Errorf("Key mismatch. Expected: %v, but was not in Actual.", k)
-		}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
 	}
-	return nil
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockReceipt := &rental.ReceiptLineVO{RentalID: entity.ID(5)}
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("CheckIn", suite.ctxFixture, mockID).
+		Return(mockReceipt, nil)
+	suite.mockEncoderService.On("FromRentalReceiptLine", mockReceipt).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.CheckIn(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestCheckIn_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockReceipt := &rental.ReceiptLineVO{RentalID: entity.ID(5)}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("CheckIn", suite.ctxFixture, mockID).
+		Return(mockReceipt, nil)
+	suite.mockEncoderService.On("FromRentalReceiptLine", mockReceipt).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.CheckIn(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestRenew_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Renew(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestRenew_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("Renew", suite.ctxFixture, mockID).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Renew(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestRenew_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockReceipt := &rental.RenewalReceiptLineVO{RentalID: entity.ID(5)}
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("Renew", suite.ctxFixture, mockID).
+		Return(mockReceipt, nil)
+	suite.mockEncoderService.On("FromRentalRenewalReceiptLine", mockReceipt).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Renew(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestRenew_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockReceipt := &rental.RenewalReceiptLineVO{RentalID: entity.ID(5)}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("Renew", suite.ctxFixture, mockID).
+		Return(mockReceipt, nil)
+	suite.mockEncoderService.On("FromRentalRenewalReceiptLine", mockReceipt).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Renew(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestMove_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	mockID := entity.ID(101)
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockDecoderService.On("ToInventoryMoveVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Move(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestMove_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	mockID := entity.ID(101)
+	mockVo := &inventory.MoveVO{Location: "main-A-1-12"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockDecoderService.On("ToInventoryMoveVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockInventoryService.On("Move", suite.ctxFixture, mockID, mockVo).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Move(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestMove_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockVo := &inventory.MoveVO{Location: "main-A-1-12"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockDecoderService.On("ToInventoryMoveVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockInventoryService.On("Move", suite.ctxFixture, mockID, mockVo).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Move(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadMoves_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("ReadMoves", suite.ctxFixture, mockID).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadMoves(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadMoves_WhenEncoderServicePasses_ShouldReturnOK() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockVos := []inventory.MoveViewVO{{ItemID: mockID, From: "main-A-1-12", To: "main-B-2-3"}}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("ReadMoves", suite.ctxFixture, mockID).
+		Return(mockVos, nil)
+	suite.mockEncoderService.On("FromInventoryMoveViews", mockVos).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadMoves(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadDetailsByBarcode_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("", mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetailsByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+	suite.mockInventoryService.AssertNotCalled(suite.T(), "FindIDByBarcode")
+}
+
+func (suite *InventoryControllerTestSuite) TestReadDetailsByBarcode_WhenInventoryServiceFindFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("MV00000001", nil)
+	suite.mockInventoryService.On("FindIDByBarcode", suite.ctxFixture, "MV00000001").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetailsByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadDetailsByBarcode_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockView := &inventory.ViewVO{Barcode: "MV00000001"}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("MV00000001", nil)
+	suite.mockInventoryService.On("FindIDByBarcode", suite.ctxFixture, "MV00000001").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("ReadDetails", suite.ctxFixture, mockID).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromInventoryItemView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetailsByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestCheckoutByBarcode_WhenInventoryServiceFindFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("MV00000001", nil)
+	suite.mockInventoryService.On("FindIDByBarcode", suite.ctxFixture, "MV00000001").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.CheckoutByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+	suite.mockInventoryService.AssertNotCalled(suite.T(), "Checkout")
+}
+
+func (suite *InventoryControllerTestSuite) TestCheckoutByBarcode_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockVo := &inventory.CheckoutVO{AccountID: 7}
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("MV00000001", nil)
+	suite.mockInventoryService.On("FindIDByBarcode", suite.ctxFixture, "MV00000001").
+		Return(mockID, nil)
+	suite.mockDecoderService.On("ToInventoryCheckoutVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockInventoryService.On("Checkout", suite.ctxFixture, mockID, mockVo).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.CheckoutByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestCheckInByBarcode_WhenInventoryServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockReceipt := &rental.ReceiptLineVO{RentalID: entity.ID(5)}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToBarcode", pathParamFixture, "code").
+		Return("MV00000001", nil)
+	suite.mockInventoryService.On("FindIDByBarcode", suite.ctxFixture, "MV00000001").
+		Return(mockID, nil)
+	suite.mockInventoryService.On("CheckIn", suite.ctxFixture, mockID).
+		Return(mockReceipt, nil)
+	suite.mockEncoderService.On("FromRentalReceiptLine", mockReceipt).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.CheckInByBarcode(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+// EqualKeys matches the keys of a map
+func equalKeys(expected []http.HandlerPattern, actual map[http.HandlerPattern]http.Handler) error {
+	if len(actual) != len(expected) {
+		return fmt.Errorf("Maps are different lengths. Expected: %d, Actual: %d", len(expected), len(actual))
+	}
+	for _, k := range expected {
+		if _, ok := actual[k]; !ok {
+			return fmt.Errorf("Key mismatch. Expected: %v, but was not in Actual.", k)
+		}
+	}
+	return nil
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabel_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabel_WhenLabelFormatIsInvalid_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	queryParamFixture := map[string][]string{"format": {"gif"}}
+	requestFixture := &http.Request{
+		PathParam:  pathParamFixture,
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockParameterConverter.On("ToLabelFormat", queryParamFixture, "format").
+		Return(label.Format(""), mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabel_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	queryParamFixture := map[string][]string{"format": {"pdf"}}
+	requestFixture := &http.Request{
+		Context:    suite.ctxFixture,
+		PathParam:  pathParamFixture,
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockParameterConverter.On("ToLabelFormat", queryParamFixture, "format").
+		Return(label.FormatPDF, nil)
+	suite.mockInventoryService.On("ReadLabels", suite.ctxFixture, []entity.ID{mockID}).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabel_WhenLabelServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	queryParamFixture := map[string][]string{"format": {"pdf"}}
+	requestFixture := &http.Request{
+		Context:    suite.ctxFixture,
+		PathParam:  pathParamFixture,
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockVos := []inventory.LabelVO{{ItemID: mockID, Barcode: "MV00000001"}}
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockParameterConverter.On("ToLabelFormat", queryParamFixture, "format").
+		Return(label.FormatPDF, nil)
+	suite.mockInventoryService.On("ReadLabels", suite.ctxFixture, []entity.ID{mockID}).
+		Return(mockVos, nil)
+	suite.mockLabelService.On("FromInventoryLabels", label.FormatPDF, mockVos).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabel_WhenLabelServicePasses_ShouldReturnFile() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	queryParamFixture := map[string][]string{"format": {"pdf"}}
+	requestFixture := &http.Request{
+		Context:    suite.ctxFixture,
+		PathParam:  pathParamFixture,
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockID := entity.ID(101)
+	mockVos := []inventory.LabelVO{{ItemID: mockID, Barcode: "MV00000001"}}
+	mockFile := []byte("some.pdf")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(mockID, nil)
+	suite.mockParameterConverter.On("ToLabelFormat", queryParamFixture, "format").
+		Return(label.FormatPDF, nil)
+	suite.mockInventoryService.On("ReadLabels", suite.ctxFixture, []entity.ID{mockID}).
+		Return(mockVos, nil)
+	suite.mockLabelService.On("FromInventoryLabels", label.FormatPDF, mockVos).
+		Return(mockFile, nil)
+	suite.mockResponseFactory.On("CreateFile", uint(200), "application/pdf", mockFile).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabel(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabels_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	queryParamFixture := map[string][]string{"some": {"param"}}
+	requestFixture := &http.Request{
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToEntityIDs", queryParamFixture, "id").
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabels(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryControllerTestSuite) TestReadLabels_WhenLabelServicePasses_ShouldReturnFile() {
+	// Setup fixture
+	queryParamFixture := map[string][]string{"id": {"101", "102"}}
+	requestFixture := &http.Request{
+		Context:    suite.ctxFixture,
+		QueryParam: queryParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockIDs := []entity.ID{101, 102}
+	mockVos := []inventory.LabelVO{{ItemID: 101, Barcode: "MV00000001"}, {ItemID: 102, Barcode: "MV00000002"}}
+	mockFile := []byte("some.zpl")
+	suite.mockParameterConverter.On("ToEntityIDs", queryParamFixture, "id").
+		Return(mockIDs, nil)
+	suite.mockParameterConverter.On("ToLabelFormat", queryParamFixture, "format").
+		Return(label.FormatZPL, nil)
+	suite.mockInventoryService.On("ReadLabels", suite.ctxFixture, mockIDs).
+		Return(mockVos, nil)
+	suite.mockLabelService.On("FromInventoryLabels", label.FormatZPL, mockVos).
+		Return(mockFile, nil)
+	suite.mockResponseFactory.On("CreateFile", uint(200), "application/zpl", mockFile).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadLabels(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
 }
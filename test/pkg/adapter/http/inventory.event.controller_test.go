@@ -0,0 +1,233 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type InventoryEventControllerTestSuite struct {
+	suite.Suite
+	mockFeed               *outboxMocks.MockFeed
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.InventoryEventControllerImpl
+}
+
+func TestInventoryEventControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryEventControllerTestSuite))
+}
+
+func (suite *InventoryEventControllerTestSuite) SetupTest() {
+	suite.mockFeed = &outboxMocks.MockFeed{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewInventoryEventControllerImpl(
+		suite.mockFeed,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *InventoryEventControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/inventory/events",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *InventoryEventControllerTestSuite) TestStream_WhenLastEventIDIsInvalid_ShouldFail() {
+	// Setup fixture
+	headerFixture := map[string]string{"Last-Event-Id": "not.an.id"}
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Header:  headerFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", headerFixture, "Last-Event-Id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Stream(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryEventControllerTestSuite) TestStream_WhenFeedLatestFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockFeed.On("Latest", suite.ctxFixture).
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Stream(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *InventoryEventControllerTestSuite) TestStream_WhenLastEventIDIsGiven_ShouldStreamEventsAfterIt() {
+	// Setup fixture
+	headerFixture := map[string]string{"Last-Event-Id": "12"}
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Header:  headerFixture,
+	}
+	msg1 := outbox.Message{ID: 13, EntityID: 101, Type: entity.EventItemCheckedOut}
+	msg2 := outbox.Message{ID: 14, EntityID: 102, Type: entity.EventItemMoved}
+
+	// Setup expectations
+	expected := "id: 13\nevent: inventory.item.checked-out\ndata: some.data.1\n\n" +
+		"id: 14\nevent: inventory.item.moved\ndata: some.data.2\n\n" +
+		": keep-alive\n\n"
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", headerFixture, "Last-Event-Id").
+		Return(entity.ID(12), nil)
+	suite.mockFeed.On("Follow", suite.ctxFixture, entity.ID(12)).
+		Return([][]outbox.Message{{msg1, msg2}, {}}, nil)
+	suite.mockEncoderService.On("FromOutboxMessage", msg1).
+		Return([]byte("some.data.1"), nil)
+	suite.mockEncoderService.On("FromOutboxMessage", msg2).
+		Return([]byte("some.data.2"), nil)
+	stream := suite.mockCreateStream()
+
+	// Exercise SUT
+	suite.sut.Stream(requestFixture)
+	actual := &stubStreamWriter{}
+	(*stream)(actual)
+
+	// Verify results
+	suite.Equal(expected, actual.String())
+	suite.Equal(2, actual.flushes)
+}
+
+func (suite *InventoryEventControllerTestSuite) TestStream_WhenNoLastEventIDIsGiven_ShouldStreamEventsFromNow() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Header:  map[string]string{},
+	}
+	msg := outbox.Message{ID: 13, EntityID: 101, Type: entity.EventItemCheckedIn}
+
+	// Setup expectations
+	expected := "id: 13\nevent: inventory.item.checked-in\ndata: some.data\n\n"
+
+	// Setup mocks
+	suite.mockFeed.On("Latest", suite.ctxFixture).
+		Return(entity.ID(12), nil)
+	suite.mockFeed.On("Follow", suite.ctxFixture, entity.ID(12)).
+		Return([][]outbox.Message{{msg}}, nil)
+	suite.mockEncoderService.On("FromOutboxMessage", msg).
+		Return([]byte("some.data"), nil)
+	stream := suite.mockCreateStream()
+
+	// Exercise SUT
+	suite.sut.Stream(requestFixture)
+	actual := &stubStreamWriter{}
+	(*stream)(actual)
+
+	// Verify results
+	suite.Equal(expected, actual.String())
+}
+
+func (suite *InventoryEventControllerTestSuite) TestStream_WhenEncoderServiceFails_ShouldStopStreaming() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+	msg1 := outbox.Message{ID: 13, EntityID: 101, Type: entity.EventItemCheckedOut}
+	msg2 := outbox.Message{ID: 14, EntityID: 102, Type: entity.EventItemCheckedIn}
+
+	// Setup mocks
+	suite.mockFeed.On("Latest", suite.ctxFixture).
+		Return(entity.ID(12), nil)
+	suite.mockFeed.On("Follow", suite.ctxFixture, entity.ID(12)).
+		Return([][]outbox.Message{{msg1}, {msg2}}, nil)
+	suite.mockEncoderService.On("FromOutboxMessage", msg1).
+		Return(nil, fmt.Errorf("mock.error"))
+	stream := suite.mockCreateStream()
+
+	// Exercise SUT
+	suite.sut.Stream(requestFixture)
+	actual := &stubStreamWriter{}
+	(*stream)(actual)
+
+	// Verify results
+	suite.Empty(actual.String())
+	suite.mockEncoderService.AssertNumberOfCalls(suite.T(), "FromOutboxMessage", 1)
+}
+
+// mockCreateStream expects an event stream response to be created,
+// and returns where its stream is kept.
+func (suite *InventoryEventControllerTestSuite) mockCreateStream() *http.Stream {
+	var stream http.Stream
+	suite.mockResponseFactory.On("CreateStream", uint(200), "text/event-stream", mock.Anything).
+		Run(func(args mock.Arguments) {
+			stream = args.Get(2).(http.Stream)
+		}).
+		Return(&http.Response{})
+	return &stream
+}
+
+type stubStreamWriter struct {
+	bytes.Buffer
+	flushes int
+}
+
+func (s *stubStreamWriter) Flush() {
+	s.flushes++
+}
@@ -2,11 +2,22 @@ package json_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/graphql"
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 type DecoderServiceImplTestSuite struct {
@@ -39,11 +50,13 @@ func (suite *DecoderServiceImplTestSuite) TestToInventoryCreateItemVo_WhenUnmars
 
 func (suite *DecoderServiceImplTestSuite) TestToInventoryCreateItemVo_WhenUnmarshalPasses_ShouldPass() {
 	// Setup fixture
-	fixture := []byte("{\"name\": \"some.name\", \"location\": \"some.location\"}")
+	fixture := []byte("{\"titleId\": 11, \"format\": \"vhs\", \"barcode\": \"some.barcode\", \"location\": \"some.location\"}")
 
 	// Setup expectations
 	expected := &inventory.CreateItemVO{
-		Name:     "some.name",
+		TitleID:  11,
+		Format:   entity.FormatVHS,
+		Barcode:  "some.barcode",
 		Location: "some.location",
 	}
 
@@ -72,11 +85,13 @@ func (suite *DecoderServiceImplTestSuite) TestToInventoryUpdateItemVo_WhenUnmars
 
 func (suite *DecoderServiceImplTestSuite) TestToInventoryUpdateItemVo_WhenUnmarshalPasses_ShouldPass() {
 	// Setup fixture
-	fixture := []byte("{\"name\": \"some.name\", \"location\": \"some.location\"}")
+	fixture := []byte("{\"titleId\": 11, \"format\": \"vhs\", \"barcode\": \"some.barcode\", \"location\": \"some.location\"}")
 
 	// Setup expectations
 	expected := &inventory.UpdateItemVO{
-		Name:     "some.name",
+		TitleID:  11,
+		Format:   entity.FormatVHS,
+		Barcode:  "some.barcode",
 		Location: "some.location",
 	}
 
@@ -87,3 +102,587 @@ func (suite *DecoderServiceImplTestSuite) TestToInventoryUpdateItemVo_WhenUnmars
 	suite.NoError(err)
 	suite.Equal(expected, actual)
 }
+
+func (suite *DecoderServiceImplTestSuite) TestToTitleCreateTitleVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to title create title vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToTitleCreateTitleVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToTitleCreateTitleVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{
+		"title": "some.title",
+		"year": 1999,
+		"runtime": 136,
+		"synopsis": "some.synopsis",
+		"genres": ["some.genre"],
+		"cast": ["some.actor.1", "some.actor.2"],
+		"rating": "some.rating"
+	}`)
+
+	// Setup expectations
+	expected := &title.CreateTitleVO{
+		Name:     "some.title",
+		Year:     1999,
+		Runtime:  136,
+		Synopsis: "some.synopsis",
+		Genres:   []string{"some.genre"},
+		Cast:     []string{"some.actor.1", "some.actor.2"},
+		Rating:   "some.rating",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToTitleCreateTitleVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToTitleUpdateTitleVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to title update title vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToTitleUpdateTitleVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToTitleUpdateTitleVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"title": "some.title", "year": 1999}`)
+
+	// Setup expectations
+	expected := &title.UpdateTitleVO{
+		Name: "some.title",
+		Year: 1999,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToTitleUpdateTitleVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToMediaFormatUpdateFormatVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to media format update format vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToMediaFormatUpdateFormatVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToMediaFormatUpdateFormatVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"name": "Blu-ray", "rentalPriceCents": 450, "rentalPeriodDays": 2}`)
+
+	// Setup expectations
+	expected := &mediaformat.UpdateFormatVO{
+		Name:             "Blu-ray",
+		RentalPrice:      450,
+		RentalPeriodDays: 2,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToMediaFormatUpdateFormatVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryCheckoutVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to inventory checkout vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryCheckoutVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryCheckoutVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"accountId": 7}`)
+
+	// Setup expectations
+	expected := &inventory.CheckoutVO{
+		AccountID: entity.ID(7),
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryCheckoutVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountCreateAccountVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to account create account vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountCreateAccountVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountCreateAccountVo_WhenDateOfBirthIsInvalid_ShouldFail() {
+	// Setup fixture
+	fixture := []byte(`{"name": "Jane Doe", "dateOfBirth": "04/03/1990"}`)
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to account create account vo: validation error: field=[dateOfBirth], problem=[must be a date like 2006-01-02]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountCreateAccountVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountCreateAccountVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"name": "Jane Doe", "dateOfBirth": "1990-03-04"}`)
+
+	// Setup expectations
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	expected := &account.CreateAccountVO{
+		Name:        "Jane Doe",
+		DateOfBirth: &dateOfBirth,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountCreateAccountVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountUpdateAccountVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to account update account vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountUpdateAccountVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountUpdateAccountVo_WhenDateOfBirthIsInvalid_ShouldFail() {
+	// Setup fixture
+	fixture := []byte(`{"name": "Jane Doe", "dateOfBirth": "04/03/1990"}`)
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to account update account vo: validation error: field=[dateOfBirth], problem=[must be a date like 2006-01-02]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountUpdateAccountVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToAccountUpdateAccountVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"name": "Jane Doe", "dateOfBirth": "1990-03-04"}`)
+
+	// Setup expectations
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	expected := &account.UpdateAccountVO{
+		Name:        "Jane Doe",
+		DateOfBirth: &dateOfBirth,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToAccountUpdateAccountVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToHoldPlaceHoldVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to hold place hold vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToHoldPlaceHoldVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToHoldPlaceHoldVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"accountId": 7}`)
+
+	// Setup expectations
+	expected := &hold.PlaceHoldVO{
+		AccountID: entity.ID(7),
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToHoldPlaceHoldVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLedgerRecordPaymentVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to ledger record payment vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLedgerRecordPaymentVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLedgerRecordPaymentVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"amountCents": 500, "method": "cash"}`)
+
+	// Setup expectations
+	expected := &ledger.RecordPaymentVO{
+		Amount: entity.Money(500),
+		Method: entity.PaymentCash,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLedgerRecordPaymentVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLedgerRecordAdjustmentVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to ledger record adjustment vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLedgerRecordAdjustmentVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLedgerRecordAdjustmentVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"amountCents": -200, "note": "Waived late fee"}`)
+
+	// Setup expectations
+	expected := &ledger.RecordAdjustmentVO{
+		Amount: entity.Money(-200),
+		Note:   "Waived late fee",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLedgerRecordAdjustmentVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryMoveVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to inventory move vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryMoveVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryMoveVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"location": "main-A-1-12"}`)
+
+	// Setup expectations
+	expected := &inventory.MoveVO{
+		Location: "main-A-1-12",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryMoveVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLocationCreateLocationVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to location create location vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLocationCreateLocationVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToLocationCreateLocationVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"location": "main-A-1-12"}`)
+
+	// Setup expectations
+	expected := &location.CreateLocationVO{
+		Location: "main-A-1-12",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLocationCreateLocationVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToWebhookCreateSubscriptionVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to webhook create subscription vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToWebhookCreateSubscriptionVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToWebhookCreateSubscriptionVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"url": "https://example.com/hook", "eventTypes": ["inventory.item.checked-out"], "secret": "some.secret.which.is.long"}`)
+
+	// Setup expectations
+	expected := &webhook.CreateSubscriptionVO{
+		URL:        "https://example.com/hook",
+		EventTypes: []entity.EventType{entity.EventItemCheckedOut},
+		Secret:     "some.secret.which.is.long",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToWebhookCreateSubscriptionVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToGraphQLRequestVo_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to graphql request vo: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToGraphQLRequestVo(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToGraphQLRequestVo_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"query": "query Item($id: ID!) { item(id: $id) { barcode } }", "operationName": "Item", "variables": {"id": "101"}}`)
+
+	// Setup expectations
+	expected := &graphql.RequestVO{
+		Query:         "query Item($id: ID!) { item(id: $id) { barcode } }",
+		OperationName: "Item",
+		Variables:     map[string]interface{}{"id": "101"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToGraphQLRequestVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryItemView_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to inventory item view: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryItemView(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryItemView_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"id":101,"titleId":1,"format":"vhs","barcode":"MV00000001","location":"main-A-1-1","available":false,"dueAt":"2020-01-04T12:00:00Z","overdue":true}`)
+
+	// Setup expectations
+	dueAt := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	expected := &inventory.ViewVO{
+		ID:        101,
+		TitleID:   1,
+		Format:    entity.FormatVHS,
+		Barcode:   "MV00000001",
+		Location:  "main-A-1-1",
+		Available: false,
+		DueAt:     &dueAt,
+		Overdue:   true,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryItemView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryItemThinViews_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to inventory item views: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryItemThinViews(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToInventoryItemThinViews_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`[{"id":101,"titleId":1,"format":"vhs","barcode":"MV00000001"},{"id":102,"titleId":2,"format":"dvd","barcode":"MV00000002"}]`)
+
+	// Setup expectations
+	expected := []inventory.ThinViewVO{
+		{ID: 101, TitleID: 1, Format: entity.FormatVHS, Barcode: "MV00000001"},
+		{ID: 102, TitleID: 2, Format: entity.FormatDVD, Barcode: "MV00000002"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToInventoryItemThinViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToRentalReceiptLine_WhenUnmarshalFails_ShouldFail() {
+	// Setup fixture
+	fixture := []byte("not.json")
+
+	// Setup expectations
+	expectedErr := "could not unmarshal to rental receipt line: invalid character 'o' in literal null (expecting 'u')"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToRentalReceiptLine(fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DecoderServiceImplTestSuite) TestToRentalReceiptLine_WhenUnmarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []byte(`{"rentalId":201,"itemId":101,"accountId":7,"dueAt":"2020-01-04T12:00:00Z","returnedAt":"2020-01-06T09:00:00Z","daysLate":2,"lateFeeCents":200}`)
+
+	// Setup expectations
+	expected := &rental.ReceiptLineVO{
+		RentalID:   201,
+		ItemID:     101,
+		AccountID:  7,
+		DueAt:      time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC),
+		ReturnedAt: time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC),
+		DaysLate:   2,
+		LateFee:    200,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToRentalReceiptLine(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
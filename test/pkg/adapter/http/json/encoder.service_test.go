@@ -2,11 +2,22 @@ package json_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http/json"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
 )
 
 type EncoderServiceImplTestSuite struct {
@@ -26,13 +37,40 @@ func (suite *EncoderServiceImplTestSuite) TestFromInventoryItemView_WhenMarshalP
 	// Setup fixture
 	fixture := &inventory.ViewVO{
 		ID:        101,
-		Name:      "some.name",
+		TitleID:   11,
+		Format:    entity.FormatDVD,
+		Barcode:   "some.barcode",
 		Location:  "some.location",
 		Available: true,
 	}
 
 	// Setup expectations
-	expected := "{\"id\":101,\"name\":\"some.name\",\"location\":\"some.location\",\"available\":true}"
+	expected := "{\"id\":101,\"titleId\":11,\"format\":\"dvd\",\"barcode\":\"some.barcode\",\"location\":\"some.location\",\"available\":true,\"dueAt\":null,\"overdue\":false}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryItemView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryItemView_GivenRentedItem_WhenMarshalPasses_ShouldIncludeDueDate() {
+	// Setup fixture
+	dueAt := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	fixture := &inventory.ViewVO{
+		ID:        101,
+		TitleID:   11,
+		Format:    entity.FormatDVD,
+		Barcode:   "some.barcode",
+		Location:  "some.location",
+		Available: false,
+		DueAt:     &dueAt,
+		Overdue:   true,
+	}
+
+	// Setup expectations
+	expected := "{\"id\":101,\"titleId\":11,\"format\":\"dvd\",\"barcode\":\"some.barcode\",\"location\":\"some.location\",\"available\":false,\"dueAt\":\"2020-01-04T12:00:00Z\",\"overdue\":true}"
 
 	// Exercise SUT
 	actual, err := suite.sut.FromInventoryItemView(fixture)
@@ -46,17 +84,21 @@ func (suite *EncoderServiceImplTestSuite) TestFromInventoryItemThinViews_WhenMar
 	// Setup fixture
 	fixture := []inventory.ThinViewVO{
 		inventory.ThinViewVO{
-			ID:   101,
-			Name: "some.name.1",
+			ID:      101,
+			TitleID: 11,
+			Format:  entity.FormatDVD,
+			Barcode: "some.barcode.1",
 		},
 		inventory.ThinViewVO{
-			ID:   102,
-			Name: "some.name.2",
+			ID:      102,
+			TitleID: 12,
+			Format:  entity.FormatVHS,
+			Barcode: "some.barcode.2",
 		},
 	}
 
 	// Setup expectations
-	expected := "[{\"id\":101,\"name\":\"some.name.1\"},{\"id\":102,\"name\":\"some.name.2\"}]"
+	expected := "[{\"id\":101,\"titleId\":11,\"format\":\"dvd\",\"barcode\":\"some.barcode.1\"},{\"id\":102,\"titleId\":12,\"format\":\"vhs\",\"barcode\":\"some.barcode.2\"}]"
 
 	// Exercise SUT
 	actual, err := suite.sut.FromInventoryItemThinViews(fixture)
@@ -77,3 +119,642 @@ func (suite *EncoderServiceImplTestSuite) TestFromInventoryItemThinViews_GivenNi
 	suite.NoError(err)
 	suite.Equal(expected, string(actual))
 }
+
+func (suite *EncoderServiceImplTestSuite) TestFromTitleView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &title.ViewVO{
+		ID:              101,
+		Name:            "some.title",
+		Year:            1999,
+		Runtime:         136,
+		Synopsis:        "some.synopsis",
+		Genres:          []string{"some.genre"},
+		Rating:          "some.rating",
+		Copies:          3,
+		AvailableCopies: 1,
+	}
+
+	// Setup expectations
+	expected := `{"id":101,"title":"some.title","year":1999,"runtime":136,"synopsis":"some.synopsis",` +
+		`"genres":["some.genre"],"cast":[],"rating":"some.rating","copies":3,"availableCopies":1}`
+
+	// Exercise SUT
+	actual, err := suite.sut.FromTitleView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromTitleThinViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []title.ThinViewVO{
+		{
+			ID:              101,
+			Name:            "some.title.1",
+			Year:            1999,
+			Copies:          3,
+			AvailableCopies: 1,
+		},
+		{
+			ID:   102,
+			Name: "some.title.2",
+			Year: 1993,
+		},
+	}
+
+	// Setup expectations
+	expected := `[{"id":101,"title":"some.title.1","year":1999,"copies":3,"availableCopies":1},` +
+		`{"id":102,"title":"some.title.2","year":1993,"copies":0,"availableCopies":0}]`
+
+	// Exercise SUT
+	actual, err := suite.sut.FromTitleThinViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromTitleThinViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromTitleThinViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromMediaFormatView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &mediaformat.ViewVO{
+		Format:           entity.FormatBluRay,
+		Name:             "Blu-ray",
+		RentalPrice:      400,
+		RentalPeriodDays: 3,
+		Copies:           5,
+		AvailableCopies:  2,
+	}
+
+	// Setup expectations
+	expected := `{"format":"bluray","name":"Blu-ray","rentalPriceCents":400,"rentalPeriodDays":3,"copies":5,"availableCopies":2}`
+
+	// Exercise SUT
+	actual, err := suite.sut.FromMediaFormatView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromMediaFormatViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []mediaformat.ViewVO{
+		{
+			Format:           entity.FormatVHS,
+			Name:             "VHS",
+			RentalPrice:      150,
+			RentalPeriodDays: 7,
+		},
+	}
+
+	// Setup expectations
+	expected := `[{"format":"vhs","name":"VHS","rentalPriceCents":150,"rentalPeriodDays":7,"copies":0,"availableCopies":0}]`
+
+	// Exercise SUT
+	actual, err := suite.sut.FromMediaFormatViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromMediaFormatViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromMediaFormatViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromAccountView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	fixture := &account.ViewVO{
+		ID:          7,
+		Name:        "Jane Doe",
+		DateOfBirth: &dateOfBirth,
+		Balance:     250,
+		Rentals: []rental.ViewVO{
+			{
+				ID:           201,
+				ItemID:       101,
+				AccountID:    7,
+				CheckedOutAt: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+				DueAt:        time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC),
+				Overdue:      true,
+			},
+		},
+		Overdue: true,
+	}
+
+	// Setup expectations
+	expected := "{\"id\":7,\"name\":\"Jane Doe\",\"dateOfBirth\":\"1990-03-04\",\"balanceCents\":250,\"rentals\":[{\"id\":201,\"itemId\":101,\"accountId\":7,\"checkedOutAt\":\"2020-01-01T12:00:00Z\",\"dueAt\":\"2020-01-04T12:00:00Z\",\"overdue\":true}],\"overdue\":true}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromAccountView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromAccountView_GivenNoRentals_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Setup fixture
+	fixture := &account.ViewVO{
+		ID:   7,
+		Name: "Jane Doe",
+	}
+
+	// Setup expectations
+	expected := "{\"id\":7,\"name\":\"Jane Doe\",\"dateOfBirth\":null,\"balanceCents\":0,\"rentals\":[],\"overdue\":false}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromAccountView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromAccountThinViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []account.ThinViewVO{
+		{ID: 7, Name: "Jane Doe"},
+		{ID: 8, Name: "John Doe"},
+	}
+
+	// Setup expectations
+	expected := "[{\"id\":7,\"name\":\"Jane Doe\"},{\"id\":8,\"name\":\"John Doe\"}]"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromAccountThinViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromAccountThinViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromAccountThinViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromRentalViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []rental.ViewVO{
+		{
+			ID:           201,
+			ItemID:       101,
+			AccountID:    7,
+			CheckedOutAt: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+			DueAt:        time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC),
+			Overdue:      true,
+		},
+	}
+
+	// Setup expectations
+	expected := "[{\"id\":201,\"itemId\":101,\"accountId\":7,\"checkedOutAt\":\"2020-01-01T12:00:00Z\",\"dueAt\":\"2020-01-04T12:00:00Z\",\"overdue\":true}]"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromRentalViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromRentalViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromRentalViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromRentalReceiptLine_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &rental.ReceiptLineVO{
+		RentalID:   201,
+		ItemID:     101,
+		AccountID:  7,
+		DueAt:      time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC),
+		ReturnedAt: time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC),
+		DaysLate:   2,
+		LateFee:    200,
+	}
+
+	// Setup expectations
+	expected := "{\"rentalId\":201,\"itemId\":101,\"accountId\":7,\"dueAt\":\"2020-01-04T12:00:00Z\",\"returnedAt\":\"2020-01-06T09:00:00Z\",\"daysLate\":2,\"lateFeeCents\":200}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromRentalReceiptLine(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromRentalRenewalReceiptLine_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &rental.RenewalReceiptLineVO{
+		RentalID:   201,
+		ItemID:     101,
+		AccountID:  7,
+		DueAt:      time.Date(2020, 1, 7, 12, 0, 0, 0, time.UTC),
+		Renewals:   1,
+		RenewalFee: 300,
+	}
+
+	// Setup expectations
+	expected := "{\"rentalId\":201,\"itemId\":101,\"accountId\":7,\"dueAt\":\"2020-01-07T12:00:00Z\",\"renewals\":1,\"renewalFeeCents\":300}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromRentalRenewalReceiptLine(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromHoldViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	expiresAt := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	fixture := []hold.ViewVO{
+		{
+			ID:        301,
+			TitleID:   11,
+			AccountID: 7,
+			PlacedAt:  time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+			Status:    entity.HoldReady,
+			ItemID:    101,
+			ExpiresAt: &expiresAt,
+		},
+		{
+			ID:        302,
+			TitleID:   11,
+			AccountID: 8,
+			PlacedAt:  time.Date(2020, 1, 1, 13, 0, 0, 0, time.UTC),
+			Status:    entity.HoldWaiting,
+			ItemID:    entity.InvalidID,
+		},
+	}
+
+	// Setup expectations
+	expected := "[{\"id\":301,\"titleId\":11,\"accountId\":7,\"placedAt\":\"2020-01-01T12:00:00Z\",\"status\":\"ready\",\"itemId\":101,\"expiresAt\":\"2020-01-04T12:00:00Z\"}," +
+		"{\"id\":302,\"titleId\":11,\"accountId\":8,\"placedAt\":\"2020-01-01T13:00:00Z\",\"status\":\"waiting\",\"itemId\":null,\"expiresAt\":null}]"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromHoldViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromHoldViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromHoldViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromLedgerStatement_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &ledger.StatementVO{
+		AccountID: 7,
+		Balance:   -300,
+		Entries: []ledger.ViewVO{
+			{
+				ID:         401,
+				AccountID:  7,
+				Kind:       entity.LedgerCharge,
+				Amount:     200,
+				RentalID:   201,
+				Note:       "late fee",
+				RecordedAt: time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:         402,
+				AccountID:  7,
+				Kind:       entity.LedgerPayment,
+				Amount:     500,
+				Method:     entity.PaymentCash,
+				RentalID:   entity.InvalidID,
+				RecordedAt: time.Date(2020, 1, 6, 9, 5, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	// Setup expectations
+	expected := "{\"accountId\":7,\"balanceCents\":-300,\"entries\":[" +
+		"{\"id\":401,\"kind\":\"charge\",\"amountCents\":200,\"method\":null,\"rentalId\":201,\"note\":\"late fee\",\"recordedAt\":\"2020-01-06T09:00:00Z\"}," +
+		"{\"id\":402,\"kind\":\"payment\",\"amountCents\":500,\"method\":\"cash\",\"rentalId\":null,\"note\":\"\",\"recordedAt\":\"2020-01-06T09:05:00Z\"}]}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromLedgerStatement(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromLedgerStatement_WhenNoEntries_ShouldReturnEmptyList() {
+	// Setup fixture
+	fixture := &ledger.StatementVO{AccountID: 7}
+
+	// Exercise SUT
+	actual, err := suite.sut.FromLedgerStatement(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(`{"accountId":7,"balanceCents":0,"entries":[]}`, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryMoveViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := []inventory.MoveViewVO{
+		{
+			ItemID:  101,
+			From:    "main-A-1-12",
+			To:      "main-B-2-3",
+			MovedAt: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	// Setup expectations
+	expected := "[{\"itemId\":101,\"from\":\"main-A-1-12\",\"to\":\"main-B-2-3\",\"movedAt\":\"2020-01-01T12:00:00Z\"}]"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryMoveViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryMoveViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryMoveViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryShelfView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &inventory.ShelfViewVO{
+		Store: "main",
+		Aisle: "A",
+		Shelf: "1",
+		Slots: []inventory.SlotViewVO{
+			{
+				Slot:     "12",
+				Location: "main-A-1-12",
+				Items: []inventory.ThinViewVO{
+					{ID: 101, TitleID: 11, Format: entity.FormatDVD, Barcode: "some.barcode"},
+				},
+			},
+			{
+				Slot:     "13",
+				Location: "main-A-1-13",
+			},
+		},
+	}
+
+	// Setup expectations
+	expected := "{\"store\":\"main\",\"aisle\":\"A\",\"shelf\":\"1\",\"slots\":[" +
+		"{\"slot\":\"12\",\"location\":\"main-A-1-12\",\"items\":[{\"id\":101,\"titleId\":11,\"format\":\"dvd\",\"barcode\":\"some.barcode\"}]}," +
+		"{\"slot\":\"13\",\"location\":\"main-A-1-13\",\"items\":[]}]}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryShelfView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromLocationView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &location.ViewVO{
+		Location: "main-A-1-12",
+		Store:    "main",
+		Aisle:    "A",
+		Shelf:    "1",
+		Slot:     "12",
+	}
+
+	// Setup expectations
+	expected := "{\"location\":\"main-A-1-12\",\"store\":\"main\",\"aisle\":\"A\",\"shelf\":\"1\",\"slot\":\"12\"}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromLocationView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromLocationViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromLocationViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromWebhookSubscriptionView_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &webhook.SubscriptionViewVO{
+		ID:         401,
+		URL:        "https://example.com/hook",
+		EventTypes: []entity.EventType{entity.EventItemCheckedOut, entity.EventItemCheckedIn},
+		CreatedAt:  time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	// Setup expectations
+	expected := "{\"id\":401,\"url\":\"https://example.com/hook\",\"eventTypes\":[\"inventory.item.checked-out\",\"inventory.item.checked-in\"],\"createdAt\":\"2020-01-01T12:00:00Z\"}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromWebhookSubscriptionView(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromWebhookSubscriptionViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromWebhookSubscriptionViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromWebhookDeliveryViews_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	nextAttemptAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastAttemptAt := time.Date(2020, 1, 1, 12, 5, 0, 0, time.UTC)
+	fixture := []webhook.DeliveryViewVO{
+		{
+			ID:             501,
+			SubscriptionID: 401,
+			EventID:        12,
+			EventType:      entity.EventItemCheckedOut,
+			EntityID:       101,
+			Status:         entity.DeliveryPending,
+			NextAttemptAt:  &nextAttemptAt,
+		},
+		{
+			ID:             502,
+			SubscriptionID: 401,
+			EventID:        13,
+			EventType:      entity.EventItemCheckedIn,
+			EntityID:       101,
+			Status:         entity.DeliveryDead,
+			Attempts:       8,
+			LastAttemptAt:  &lastAttemptAt,
+			LastStatusCode: 503,
+			LastError:      "some.error",
+		},
+	}
+
+	// Setup expectations
+	expected := "[{\"id\":501,\"subscriptionId\":401,\"eventId\":12,\"eventType\":\"inventory.item.checked-out\",\"entityId\":101,\"status\":\"pending\",\"attempts\":0,\"nextAttemptAt\":\"2020-01-01T12:00:00Z\",\"lastAttemptAt\":null,\"lastStatusCode\":null,\"lastError\":\"\"}," +
+		"{\"id\":502,\"subscriptionId\":401,\"eventId\":13,\"eventType\":\"inventory.item.checked-in\",\"entityId\":101,\"status\":\"dead\",\"attempts\":8,\"nextAttemptAt\":null,\"lastAttemptAt\":\"2020-01-01T12:05:00Z\",\"lastStatusCode\":503,\"lastError\":\"some.error\"}]"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromWebhookDeliveryViews(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromWebhookDeliveryViews_GivenNilInput_WhenMarshalPasses_ShouldReturnEmptyJsonArray() {
+	// Exercise SUT
+	actual, err := suite.sut.FromWebhookDeliveryViews(nil)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[]", string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromOutboxMessage_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := outbox.Message{
+		ID:         12,
+		EntityID:   101,
+		Type:       entity.EventItemCheckedOut,
+		Data:       map[string]interface{}{"accountId": 7},
+		OccurredAt: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	// Setup expectations
+	expected := "{\"id\":12,\"type\":\"inventory.item.checked-out\",\"entityId\":101,\"occurredAt\":\"2020-01-02T03:04:05Z\",\"data\":{\"accountId\":7}}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromOutboxMessage(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromOutboxMessage_GivenNilData_WhenMarshalPasses_ShouldReturnEmptyData() {
+	// Setup fixture
+	fixture := outbox.Message{
+		ID:         12,
+		EntityID:   101,
+		Type:       entity.EventItemDeleted,
+		OccurredAt: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	// Setup expectations
+	expected := "{\"id\":12,\"type\":\"inventory.item.deleted\",\"entityId\":101,\"occurredAt\":\"2020-01-02T03:04:05Z\",\"data\":{}}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromOutboxMessage(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryCreateItemVo_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &inventory.CreateItemVO{
+		TitleID:  1,
+		Format:   entity.FormatVHS,
+		Barcode:  "MV00000001",
+		Location: "main-A-1-1",
+	}
+
+	// Setup expectations
+	expected := "{\"titleId\":1,\"format\":\"vhs\",\"barcode\":\"MV00000001\",\"location\":\"main-A-1-1\"}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryCreateItemVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryUpdateItemVo_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &inventory.UpdateItemVO{
+		TitleID:  1,
+		Format:   entity.FormatDVD,
+		Barcode:  "MV00000001",
+		Location: "main-A-1-2",
+	}
+
+	// Setup expectations
+	expected := "{\"titleId\":1,\"format\":\"dvd\",\"barcode\":\"MV00000001\",\"location\":\"main-A-1-2\"}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryUpdateItemVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryCheckoutVo_WhenMarshalPasses_ShouldPass() {
+	// Setup fixture
+	fixture := &inventory.CheckoutVO{
+		AccountID: 7,
+	}
+
+	// Setup expectations
+	expected := "{\"accountId\":7}"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryCheckoutVo(fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
@@ -0,0 +1,60 @@
+package label_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+)
+
+func TestEncodeCode128(t *testing.T) {
+	var tests = []struct {
+		data     string
+		expected string
+	}{
+		{
+			// Start B, "A", checksum 34, stop
+			"A",
+			"11010010000" + "10100011000" + "10001011000" + "1100011101011",
+		},
+		{
+			// Start B, "a", "1", checksum 100, stop
+			"a1",
+			"11010010000" + "10010110000" + "10011100110" + "10111101110" + "1100011101011",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.data, func(t *testing.T) {
+			// Exercise SUT
+			actual, err := label.EncodeCode128(test.data)
+
+			// Verify result
+			assert.NoError(t, err, i)
+			assert.Equal(t, test.expected, modulesToString(actual), i)
+		})
+	}
+}
+
+func TestEncodeCode128_WhenNotInCodeSetB_ShouldFail(t *testing.T) {
+	// Exercise SUT
+	actual, err := label.EncodeCode128("café")
+
+	// Verify result
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, "could not encode code 128 - 'é' is not in code set B")
+}
+
+func modulesToString(modules []bool) string {
+	var sb strings.Builder
+	for _, m := range modules {
+		if m {
+			sb.WriteString("1")
+		} else {
+			sb.WriteString("0")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,137 @@
+package label_test
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+)
+
+type EncoderServiceImplTestSuite struct {
+	suite.Suite
+	sut *label.EncoderServiceImpl
+}
+
+func TestEncoderServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(EncoderServiceImplTestSuite))
+}
+
+func (suite *EncoderServiceImplTestSuite) SetupTest() {
+	suite.sut = label.NewEncoderServiceImpl()
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenBarcodeIsNotInCodeSetB_ShouldFail() {
+	// Setup fixture
+	fixture := []inventory.LabelVO{{ItemID: 101, Barcode: "café"}}
+
+	// Setup expectations
+	expectedErr := "could not render labels - layout error: could not lay out label for item 101 - encode error: could not encode code 128 - 'é' is not in code set B"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.FormatPNG, fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenBarcodeIsTooLong_ShouldFail() {
+	// Setup fixture
+	fixture := []inventory.LabelVO{{ItemID: 101, Barcode: strings.Repeat("A", 60)}}
+
+	// Setup expectations
+	expectedErr := "could not render labels - layout error: could not lay out label for item 101 - barcode is too long"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.FormatPNG, fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenFormatIsUnknown_ShouldFail() {
+	// Setup fixture
+	fixture := []inventory.LabelVO{{ItemID: 101, Barcode: "MV00000001"}}
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.Format("gif"), fixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not render labels - unknown format \"gif\"")
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenPNG_ShouldStackLabels() {
+	// Setup fixture
+	fixture := []inventory.LabelVO{
+		{ItemID: 101, Barcode: "MV00000001", Title: "some.title", Location: "main-A-1-12"},
+		{ItemID: 102, Barcode: "4006381333931", Title: "other.title", Location: "main-A-1-13"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.FormatPNG, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	img, err := png.Decode(bytes.NewReader(actual))
+	suite.NoError(err)
+	suite.Equal(609, img.Bounds().Dx())
+	suite.Equal(2*203, img.Bounds().Dy())
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenPDF_ShouldFillSheets() {
+	// Setup fixture
+	var fixture []inventory.LabelVO
+	for i := 1; i <= 21; i++ {
+		fixture = append(fixture, inventory.LabelVO{
+			ItemID:   101,
+			Barcode:  fmt.Sprintf("MV%08d", i),
+			Title:    "Tom & Jerry (Part 2)",
+			Location: "main-A-1-12",
+		})
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.FormatPDF, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	pdf := string(actual)
+	suite.True(strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	suite.True(strings.HasSuffix(pdf, "%%EOF\n"))
+	suite.Contains(pdf, "/Kids [5 0 R 7 0 R] /Count 2")
+	suite.Contains(pdf, "(Tom & Jerry \\(Part 2\\)) Tj")
+	suite.Contains(pdf, "(MV00000021) Tj")
+}
+
+func (suite *EncoderServiceImplTestSuite) TestFromInventoryLabels_WhenZPL_ShouldWriteFormats() {
+	// Setup fixture
+	fixture := []inventory.LabelVO{
+		{ItemID: 101, Barcode: "MV00000001", Title: "Some_Title^", Location: "main-A-1-12"},
+	}
+
+	// Setup expectations
+	expected := "^XA\n" +
+		"^CI28\n" +
+		"^PW609\n" +
+		"^LL203\n" +
+		"^FO16,12^A0N,24,24^FB577,1,0,L^FH^FDSome_5FTitle_5E^FS\n" +
+		"^FO87,44^BY3^BCN,90,N,N,N^FH^FD>:MV00000001^FS\n" +
+		"^FO16,140^A0N,20,20^FB577,1,0,C^FH^FDMV00000001^FS\n" +
+		"^FO16,170^A0N,22,22^FB577,1,0,L^FH^FDmain-A-1-12^FS\n" +
+		"^XZ\n"
+
+	// Exercise SUT
+	actual, err := suite.sut.FromInventoryLabels(label.FormatZPL, fixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, string(actual))
+}
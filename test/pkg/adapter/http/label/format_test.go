@@ -0,0 +1,42 @@
+package label_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
+)
+
+func TestParseFormat(t *testing.T) {
+	var tests = []struct {
+		str                 string
+		expected            label.Format
+		expectedContentType string
+	}{
+		{"png", label.FormatPNG, "image/png"},
+		{"pdf", label.FormatPDF, "application/pdf"},
+		{"zpl", label.FormatZPL, "application/zpl"},
+	}
+
+	for i, test := range tests {
+		t.Run(test.str, func(t *testing.T) {
+			// Exercise SUT
+			actual, err := label.ParseFormat(test.str)
+
+			// Verify result
+			assert.NoError(t, err, i)
+			assert.Equal(t, test.expected, actual, i)
+			assert.Equal(t, test.expectedContentType, actual.ContentType(), i)
+		})
+	}
+}
+
+func TestParseFormat_WhenUnknown_ShouldFail(t *testing.T) {
+	// Exercise SUT
+	actual, err := label.ParseFormat("gif")
+
+	// Verify result
+	assert.Equal(t, label.Format(""), actual)
+	assert.EqualError(t, err, "validation error: field=[format], problem=[must be one of png, pdf or zpl]")
+}
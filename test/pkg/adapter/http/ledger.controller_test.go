@@ -0,0 +1,457 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	ledgerMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/ledger"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+type LedgerControllerTestSuite struct {
+	suite.Suite
+	mockLedgerService      *ledgerMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.LedgerControllerImpl
+}
+
+func TestLedgerControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerControllerTestSuite))
+}
+
+func (suite *LedgerControllerTestSuite) SetupTest() {
+	suite.mockLedgerService = &ledgerMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewLedgerControllerImpl(
+		suite.mockLedgerService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *LedgerControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/accounts/{id}/ledger",
+		},
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/accounts/{id}/payments",
+		},
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/accounts/{id}/adjustments",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *LedgerControllerTestSuite) TestReadStatement_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadStatement(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestReadStatement_WhenLedgerServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockLedgerService.On("ReadStatement", suite.ctxFixture, entity.ID(7)).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadStatement(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestReadStatement_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &ledger.StatementVO{AccountID: 7, Balance: 250}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockLedgerService.On("ReadStatement", suite.ctxFixture, entity.ID(7)).
+		Return(mockVo, nil)
+	suite.mockEncoderService.On("FromLedgerStatement", mockVo).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadStatement(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestReadStatement_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVo := &ledger.StatementVO{AccountID: 7, Balance: 250}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockLedgerService.On("ReadStatement", suite.ctxFixture, entity.ID(7)).
+		Return(mockVo, nil)
+	suite.mockEncoderService.On("FromLedgerStatement", mockVo).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadStatement(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordPayment_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordPayment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordPayment_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordPaymentVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordPayment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordPayment_WhenLedgerServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCash}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordPaymentVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLedgerService.On("RecordPayment", suite.ctxFixture, entity.ID(7), mockVo).
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordPayment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordPayment_WhenLedgerServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("301"),
+	}
+
+	// Setup mocks
+	mockVo := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCash}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordPaymentVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLedgerService.On("RecordPayment", suite.ctxFixture, entity.ID(7), mockVo).
+		Return(entity.ID(301), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(301)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordPayment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordAdjustment_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordAdjustment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordAdjustment_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordAdjustmentVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordAdjustment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordAdjustment_WhenLedgerServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordAdjustmentVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLedgerService.On("RecordAdjustment", suite.ctxFixture, entity.ID(7), mockVo).
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordAdjustment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LedgerControllerTestSuite) TestRecordAdjustment_WhenLedgerServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("301"),
+	}
+
+	// Setup mocks
+	mockVo := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(7), nil)
+	suite.mockDecoderService.On("ToLedgerRecordAdjustmentVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLedgerService.On("RecordAdjustment", suite.ctxFixture, entity.ID(7), mockVo).
+		Return(entity.ID(301), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(301)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.RecordAdjustment(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
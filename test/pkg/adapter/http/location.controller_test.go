@@ -0,0 +1,306 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+	locationMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/location"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+type LocationControllerTestSuite struct {
+	suite.Suite
+	mockLocationService    *locationMocks.MockService
+	mockInventoryService   *inventoryMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.LocationControllerImpl
+}
+
+func TestLocationControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationControllerTestSuite))
+}
+
+func (suite *LocationControllerTestSuite) SetupTest() {
+	suite.mockLocationService = &locationMocks.MockService{}
+	suite.mockInventoryService = &inventoryMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewLocationControllerImpl(
+		suite.mockLocationService,
+		suite.mockInventoryService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *LocationControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		http.HandlerPattern{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/locations",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/locations",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodDelete,
+			PathPattern: "/stores/{store}/aisles/{aisle}/shelves/{shelf}/slots/{slot}",
+		},
+		http.HandlerPattern{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/stores/{store}/aisles/{aisle}/shelves/{shelf}",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *LocationControllerTestSuite) TestCreate_WhenLocationServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVo := &location.CreateLocationVO{Location: "main-A-1-12"}
+	mockErr := fmt.Errorf("some.error")
+	suite.mockDecoderService.On("ToLocationCreateLocationVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLocationService.On("Create", suite.ctxFixture, mockVo).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestCreate_WhenLocationServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVo := &location.CreateLocationVO{Location: "main-A-1-12"}
+	mockView := &location.ViewVO{Location: "main-A-1-12"}
+	mockJson := []byte("some.json")
+	suite.mockDecoderService.On("ToLocationCreateLocationVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockLocationService.On("Create", suite.ctxFixture, mockVo).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromLocationView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(201), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnOK() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVos := []location.ViewVO{{Location: "main-A-1-12"}}
+	mockJson := []byte("some.json")
+	suite.mockLocationService.On("ReadAll", suite.ctxFixture).
+		Return(mockVos, nil)
+	suite.mockEncoderService.On("FromLocationViews", mockVos).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestDelete_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockParameterConverter.On("ToString", pathParamFixture, "store").
+		Return("", mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestDelete_WhenLocationServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	suite.mockShelfParams(pathParamFixture)
+	suite.mockParameterConverter.On("ToString", pathParamFixture, "slot").
+		Return("12", nil)
+	suite.mockLocationService.On("Delete", suite.ctxFixture, entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestReadShelf_WhenInventoryServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("some.error")
+	suite.mockShelfParams(pathParamFixture)
+	suite.mockInventoryService.On("ReadShelf", suite.ctxFixture, "main", "A", "1").
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadShelf(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) TestReadShelf_WhenEncoderServicePasses_ShouldReturnOK() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockVo := &inventory.ShelfViewVO{Store: "main", Aisle: "A", Shelf: "1"}
+	mockJson := []byte("some.json")
+	suite.mockShelfParams(pathParamFixture)
+	suite.mockInventoryService.On("ReadShelf", suite.ctxFixture, "main", "A", "1").
+		Return(mockVo, nil)
+	suite.mockEncoderService.On("FromInventoryShelfView", mockVo).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadShelf(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *LocationControllerTestSuite) mockShelfParams(pathParamFixture map[string]string) {
+	suite.mockParameterConverter.On("ToString", pathParamFixture, "store").
+		Return("main", nil)
+	suite.mockParameterConverter.On("ToString", pathParamFixture, "aisle").
+		Return("A", nil)
+	suite.mockParameterConverter.On("ToString", pathParamFixture, "shelf").
+		Return("1", nil)
+}
@@ -0,0 +1,321 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	mediaformatMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/mediaformat"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type MediaFormatControllerTestSuite struct {
+	suite.Suite
+	mockFormatService      *mediaformatMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.MediaFormatControllerImpl
+}
+
+func TestMediaFormatControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaFormatControllerTestSuite))
+}
+
+func (suite *MediaFormatControllerTestSuite) SetupTest() {
+	suite.mockFormatService = &mediaformatMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewMediaFormatControllerImpl(
+		suite.mockFormatService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/formats/{format}",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/formats",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/formats/{format}",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *MediaFormatControllerTestSuite) TestReadDetails_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.Format(""), mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestReadDetails_WhenFormatServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.FormatDVD, nil)
+	suite.mockFormatService.On("ReadDetails", suite.ctxFixture, entity.FormatDVD).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestReadDetails_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockView := &mediaformat.ViewVO{Name: "DVD"}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.FormatDVD, nil)
+	suite.mockFormatService.On("ReadDetails", suite.ctxFixture, entity.FormatDVD).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromMediaFormatView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestReadAll_WhenFormatServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockFormatService.On("ReadAll", suite.ctxFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []mediaformat.ViewVO{{Name: "DVD"}}
+	mockJson := []byte("some.json")
+	suite.mockFormatService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromMediaFormatViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestUpdate_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.FormatDVD, nil)
+	suite.mockDecoderService.On("ToMediaFormatUpdateFormatVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestUpdate_WhenFormatServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &mediaformat.UpdateFormatVO{Name: "DVD"}
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.FormatDVD, nil)
+	suite.mockDecoderService.On("ToMediaFormatUpdateFormatVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockFormatService.On("Update", suite.ctxFixture, entity.FormatDVD, mockVo).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MediaFormatControllerTestSuite) TestUpdate_WhenFormatServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	mockVo := &mediaformat.UpdateFormatVO{Name: "DVD"}
+	suite.mockParameterConverter.On("ToFormat", pathParamFixture, "format").
+		Return(entity.FormatDVD, nil)
+	suite.mockDecoderService.On("ToMediaFormatUpdateFormatVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockFormatService.On("Update", suite.ctxFixture, entity.FormatDVD, mockVo).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/adapter/http/label"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 )
 
@@ -76,3 +77,227 @@ func (suite *ParameterConverterImplTestSuite) TestToEntityID_WhenValueIsRightTyp
 	suite.NoError(err)
 	suite.Equal(expected, actual)
 }
+
+func (suite *ParameterConverterImplTestSuite) TestToFormat_WhenValueNotPresent_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"id": "vhs",
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to format - \"format\" is not in the parameter list"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToFormat(mapFixture, "format")
+
+	// Verify results
+	suite.Equal(entity.Format(""), actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToFormat_WhenValueIsUnknown_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"format": "laserdisc",
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to format - validation error: field=[format], problem=[must be one of vhs, dvd, bluray, 4k]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToFormat(mapFixture, "format")
+
+	// Verify results
+	suite.Equal(entity.Format(""), actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToFormat_WhenValueIsKnown_ShouldReturnFormat() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"something": "else",
+		"format":    "bluray",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToFormat(mapFixture, "format")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.FormatBluRay, actual)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToString_WhenValueNotPresent_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"something": "else",
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to string - \"shelf\" is not in the parameter list"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToString(mapFixture, "shelf")
+
+	// Verify results
+	suite.Equal("", actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToString_WhenValueIsPresent_ShouldReturnValue() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"something": "else",
+		"shelf":     "1",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToString(mapFixture, "shelf")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("1", actual)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToBarcode_WhenValueNotPresent_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"id": "101",
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to barcode - \"code\" is not in the parameter list"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToBarcode(mapFixture, "code")
+
+	// Verify results
+	suite.Equal("", actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToBarcode_WhenValueIsInvalid_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"code": "4006381333932",
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to barcode - validation error: field=[barcode], problem=[must end with a valid EAN-13 check digit]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToBarcode(mapFixture, "code")
+
+	// Verify results
+	suite.Equal("", actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToBarcode_WhenValueIsValid_ShouldReturnBarcode() {
+	// Setup fixture
+	mapFixture := map[string]string{
+		"something": "else",
+		"code":      "4006381333931",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToBarcode(mapFixture, "code")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("4006381333931", actual)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToEntityIDs_WhenValueNotPresent_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"format": {"png"},
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to entity ids - validation error: field=[id], problem=[must be given]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToEntityIDs(mapFixture, "id")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToEntityIDs_WhenAValueIsNotAnInt_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"id": {"101", "not.an.int"},
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to entity ids - validation error: field=[id], problem=[must be a whole number]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToEntityIDs(mapFixture, "id")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToEntityIDs_WhenValuesAreInts_ShouldReturnIDs() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"something": {"else"},
+		"id":        {"101", "102"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToEntityIDs(mapFixture, "id")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]entity.ID{101, 102}, actual)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToLabelFormat_WhenValueNotPresent_ShouldReturnPNG() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"id": {"101"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLabelFormat(mapFixture, "format")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(label.FormatPNG, actual)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToLabelFormat_WhenValueIsInvalid_ShouldFail() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"format": {"gif"},
+	}
+
+	// Setup expectations
+	expectedErr := "could not convert parameters to label format - validation error: field=[format], problem=[must be one of png, pdf or zpl]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLabelFormat(mapFixture, "format")
+
+	// Verify results
+	suite.Equal(label.Format(""), actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ParameterConverterImplTestSuite) TestToLabelFormat_WhenValueIsValid_ShouldReturnFormat() {
+	// Setup fixture
+	mapFixture := map[string][]string{
+		"format": {"zpl"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ToLabelFormat(mapFixture, "format")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(label.FormatZPL, actual)
+}
@@ -0,0 +1,145 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	rentalMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/rental"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type RentalControllerTestSuite struct {
+	suite.Suite
+	mockRentalService   *rentalMocks.MockService
+	mockEncoderService  *jsonMocks.MockEncoderService
+	mockResponseFactory *httpMocks.MockResponseFactory
+	ctxFixture          context.Context
+	sut                 *http.RentalControllerImpl
+}
+
+func TestRentalControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(RentalControllerTestSuite))
+}
+
+func (suite *RentalControllerTestSuite) SetupTest() {
+	suite.mockRentalService = &rentalMocks.MockService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewRentalControllerImpl(
+		suite.mockRentalService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+	)
+}
+
+func (suite *RentalControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/rentals/overdue",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *RentalControllerTestSuite) TestReadOverdue_WhenRentalServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRentalService.On("ReadOverdue", suite.ctxFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadOverdue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *RentalControllerTestSuite) TestReadOverdue_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockViews := []rental.ViewVO{{ID: entity.ID(101)}}
+	suite.mockRentalService.On("ReadOverdue", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromRentalViews", mockViews).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadOverdue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *RentalControllerTestSuite) TestReadOverdue_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []rental.ViewVO{{ID: entity.ID(101)}}
+	mockJson := []byte("some.json")
+	suite.mockRentalService.On("ReadOverdue", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromRentalViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadOverdue(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
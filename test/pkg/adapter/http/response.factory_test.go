@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/liampulles/matchstick-video/pkg/adapter/http"
 	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
 )
 
 type ResponseFactoryImplTestSuite struct {
@@ -53,6 +55,39 @@ func (suite *ResponseFactoryImplTestSuite) TestCreateJSON_ShouldCreateResponse()
 	suite.Equal(expected, actual)
 }
 
+func (suite *ResponseFactoryImplTestSuite) TestCreateFile_ShouldCreateResponse() {
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "image/png",
+		StatusCode:  501,
+		Body:        []byte("some.data"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFile(501, "image/png", []byte("some.data"))
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateStream_ShouldCreateResponse() {
+	// Setup fixture
+	var streamed bool
+	stream := func(http.StreamWriter) {
+		streamed = true
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateStream(200, "text/event-stream", stream)
+
+	// Verify results
+	suite.Equal("text/event-stream", actual.ContentType)
+	suite.Equal(uint(200), actual.StatusCode)
+	suite.Nil(actual.Body)
+	actual.Stream(nil)
+	suite.True(streamed)
+}
+
 func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsValidationError_ShouldReturnBadRequest() {
 	// Setup fixture
 	fixture := &commonerror.Validation{
@@ -74,6 +109,42 @@ func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsValidationE
 	suite.Equal(expected, actual)
 }
 
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenDeadlineExceeded_ShouldReturnGatewayTimeout() {
+	// Setup fixture
+	fixture := fmt.Errorf("some.wrapper: %w", context.DeadlineExceeded)
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  504,
+		Body:        []byte("some.wrapper: context deadline exceeded"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenCanceled_ShouldReturnServiceUnavailable() {
+	// Setup fixture
+	fixture := fmt.Errorf("some.wrapper: %w", context.Canceled)
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  503,
+		Body:        []byte("some.wrapper: context canceled"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
 func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsNotImplementedError_ShouldReturnNotImplemented() {
 	// Setup fixture
 	fixture := commonerror.NewNotImplemented("some.package", "some.struct", "some.method")
@@ -92,6 +163,63 @@ func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsNotImplemen
 	suite.Equal(expected, actual)
 }
 
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsAgeRestrictionError_ShouldReturnForbidden() {
+	// Setup fixture
+	fixture := fmt.Errorf("could not checkout inventory item - %w",
+		commonerror.NewAgeRestriction("R", 17, "account holder is 15"))
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  403,
+		Body:        []byte("could not checkout inventory item - age restriction error: rating=[R], minimumAge=[17], problem=[account holder is 15]"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsCreditLimitError_ShouldReturnPaymentRequired() {
+	// Setup fixture
+	fixture := fmt.Errorf("could not checkout inventory item - %w",
+		commonerror.NewCreditLimit(1500, 1000))
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  402,
+		Body:        []byte("could not checkout inventory item - credit limit error: balanceCents=[1500], limitCents=[1000]"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsConflictError_ShouldReturnConflict() {
+	// Setup fixture
+	fixture := fmt.Errorf("could not renew inventory item - %w",
+		commonerror.NewConflict("inventory item", "it is not rented out"))
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  409,
+		Body:        []byte("could not renew inventory item - conflict error: type=[inventory item], problem=[it is not rented out]"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
 func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsNotFoundError_ShouldReturnNotFound() {
 	// Setup fixture
 	fixture := db.NewNotFoundError("some.type")
@@ -128,6 +256,60 @@ func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsUniqueConst
 	suite.Equal(expected, actual)
 }
 
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsForeignKeyConstraintError_ShouldReturnValidationError() {
+	// Setup fixture
+	fixture := db.NewForeignKeyConstraintError(fmt.Errorf("some.error"))
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  400,
+		Body:        []byte("foreign key constraint error: some.error"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsInProgressError_ShouldReturnConflict() {
+	// Setup fixture
+	fixture := fmt.Errorf("some.wrapper: %w", idempotency.NewInProgressError("some.key"))
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  409,
+		Body:        []byte("some.wrapper: request with idempotency key is still being handled: key=[some.key]"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsReusedKeyError_ShouldReturnUnprocessableEntity() {
+	// Setup fixture
+	fixture := idempotency.NewReusedKeyError("some.key")
+
+	// Setup expectations
+	expected := &http.Response{
+		ContentType: "text/plain; charset=utf-8",
+		StatusCode:  422,
+		Body:        []byte("idempotency key was used with a different request: key=[some.key]"),
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateFromError(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
 func (suite *ResponseFactoryImplTestSuite) TestCreateFromError_WhenIsArbitraryError_ShouldReturnInternalServerError() {
 	// Setup fixture
 	fixture := fmt.Errorf("some.error")
@@ -1,9 +1,11 @@
 package http_test
 
 import (
+	"fmt"
 	goHttp "net/http"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
@@ -15,6 +17,8 @@ import (
 type ServerFactoryTestSuite struct {
 	suite.Suite
 	mockInventoryController *httpMocks.MockController
+	mockTitleController     *httpMocks.MockController
+	mockIdempotencyWrapper  *httpMocks.MockIdempotencyWrapper
 	mockServerConfiguration *httpMocks.MockServerConfiguration
 	sut                     *http.ServerFactoryImpl
 }
@@ -25,14 +29,21 @@ func TestServerFactoryTestSuite(t *testing.T) {
 
 func (suite *ServerFactoryTestSuite) SetupTest() {
 	suite.mockInventoryController = &httpMocks.MockController{}
+	suite.mockTitleController = &httpMocks.MockController{}
+	suite.mockIdempotencyWrapper = &httpMocks.MockIdempotencyWrapper{}
 	suite.mockServerConfiguration = &httpMocks.MockServerConfiguration{}
 	suite.sut = http.NewServerFactoryImpl(
-		suite.mockInventoryController,
+		[]http.Controller{
+			suite.mockInventoryController,
+			suite.mockTitleController,
+		},
+		suite.mockIdempotencyWrapper,
 		suite.mockServerConfiguration,
+		nil,
 	)
 }
 
-func (suite *ServerFactoryTestSuite) TestCreate_ShouldCreateRunnableFromHandlers() {
+func (suite *ServerFactoryTestSuite) TestCreate_ShouldCreateRunnableFromWrappedHandlers() {
 	// Setup expectations
 	data := "previous"
 	expectedRunnable := domain.Runnable(func() error {
@@ -41,15 +52,30 @@ func (suite *ServerFactoryTestSuite) TestCreate_ShouldCreateRunnableFromHandlers
 	})
 
 	// Setup mocks
-	mockHandlers := map[http.HandlerPattern]http.Handler{
-		http.HandlerPattern{
-			Method:      goHttp.MethodGet,
-			PathPattern: "some.path.pattern",
-		}: mockHandler,
+	inventoryPattern := http.HandlerPattern{
+		Method:      goHttp.MethodGet,
+		PathPattern: "some.path.pattern",
+	}
+	titlePattern := http.HandlerPattern{
+		Method:      goHttp.MethodGet,
+		PathPattern: "another.path.pattern",
 	}
 	suite.mockInventoryController.On("GetHandlers").
-		Return(mockHandlers)
-	suite.mockServerConfiguration.On("CreateRunnable", mockHandlers).
+		Return(map[http.HandlerPattern]http.Handler{
+			inventoryPattern: mockHandler,
+		})
+	suite.mockTitleController.On("GetHandlers").
+		Return(map[http.HandlerPattern]http.Handler{
+			titlePattern: mockHandler,
+		})
+	suite.mockIdempotencyWrapper.On("Wrap", mock.Anything, mock.Anything).
+		Return(http.Handler(mockWrappedHandler))
+	suite.mockServerConfiguration.On("CreateRunnable", mock.MatchedBy(func(handlers map[http.HandlerPattern]http.Handler) bool {
+		inventoryHandler, hasInventory := handlers[inventoryPattern]
+		titleHandler, hasTitle := handlers[titlePattern]
+		return len(handlers) == 2 && hasInventory && hasTitle &&
+			inventoryHandler(nil).StatusCode == 299 && titleHandler(nil).StatusCode == 299
+	})).
 		Return(expectedRunnable)
 
 	// Exercise SUT
@@ -58,6 +84,64 @@ func (suite *ServerFactoryTestSuite) TestCreate_ShouldCreateRunnableFromHandlers
 	// Verify results
 	actual()
 	suite.Equal(data, "after")
+	suite.mockIdempotencyWrapper.AssertCalled(suite.T(), "Wrap", inventoryPattern, mock.Anything)
+	suite.mockIdempotencyWrapper.AssertCalled(suite.T(), "Wrap", titlePattern, mock.Anything)
+}
+
+func (suite *ServerFactoryTestSuite) TestCreate_GivenWorkers_ShouldRunThemAlongsideServer() {
+	// Setup fixture
+	started := make(chan struct{})
+	worker := domain.Runnable(func() error {
+		close(started)
+		return nil
+	})
+	sut := http.NewServerFactoryImpl(
+		[]http.Controller{},
+		suite.mockIdempotencyWrapper,
+		suite.mockServerConfiguration,
+		[]domain.Runnable{worker},
+	)
+
+	// Setup mocks
+	suite.mockServerConfiguration.On("CreateRunnable", mock.Anything).
+		Return(domain.Runnable(func() error {
+			<-started
+			return nil
+		}))
+
+	// Exercise SUT
+	actual := sut.Create()
+
+	// Verify results
+	suite.NoError(actual())
+}
+
+func (suite *ServerFactoryTestSuite) TestCreate_WhenWorkerFails_ShouldFail() {
+	// Setup fixture
+	stop := make(chan struct{})
+	defer close(stop)
+	worker := domain.Runnable(func() error {
+		return fmt.Errorf("mock.error")
+	})
+	sut := http.NewServerFactoryImpl(
+		[]http.Controller{},
+		suite.mockIdempotencyWrapper,
+		suite.mockServerConfiguration,
+		[]domain.Runnable{worker},
+	)
+
+	// Setup mocks
+	suite.mockServerConfiguration.On("CreateRunnable", mock.Anything).
+		Return(domain.Runnable(func() error {
+			<-stop
+			return nil
+		}))
+
+	// Exercise SUT
+	actual := sut.Create()
+
+	// Verify results
+	suite.EqualError(actual(), "mock.error")
 }
 
 func mockHandler(*http.Request) *http.Response {
@@ -67,3 +151,7 @@ func mockHandler(*http.Request) *http.Response {
 func mockRunnable() error {
 	return nil
 }
+
+func mockWrappedHandler(*http.Request) *http.Response {
+	return &http.Response{StatusCode: 299}
+}
@@ -0,0 +1,386 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type TitleControllerTestSuite struct {
+	suite.Suite
+	mockTitleService       *titleMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.TitleControllerImpl
+}
+
+func TestTitleControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TitleControllerTestSuite))
+}
+
+func (suite *TitleControllerTestSuite) SetupTest() {
+	suite.mockTitleService = &titleMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewTitleControllerImpl(
+		suite.mockTitleService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *TitleControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/titles",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/titles/{id}",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/titles",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/titles/{id}",
+		},
+		{
+			Method:      goHttp.MethodDelete,
+			PathPattern: "/titles/{id}",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *TitleControllerTestSuite) TestCreate_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 501,
+		Body:       []byte("some.error"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDecoderService.On("ToTitleCreateTitleVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestCreate_WhenTitleServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("101"),
+	}
+
+	// Setup mocks
+	mockVo := &title.CreateTitleVO{Name: "some.name"}
+	suite.mockDecoderService.On("ToTitleCreateTitleVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockTitleService.On("Create", suite.ctxFixture, mockVo).
+		Return(entity.ID(101), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(101)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestReadDetails_WhenTitleServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockTitleService.On("ReadDetails", suite.ctxFixture, entity.ID(101)).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestReadDetails_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockView := &title.ViewVO{Name: "some.name"}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockTitleService.On("ReadDetails", suite.ctxFixture, entity.ID(101)).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromTitleView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestReadAll_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockViews := []title.ThinViewVO{{Name: "some.name"}}
+	suite.mockTitleService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromTitleThinViews", mockViews).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []title.ThinViewVO{{Name: "some.name"}}
+	mockJson := []byte("some.json")
+	suite.mockTitleService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromTitleThinViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestUpdate_WhenTitleServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &title.UpdateTitleVO{Name: "some.name"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockDecoderService.On("ToTitleUpdateTitleVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockTitleService.On("Update", suite.ctxFixture, entity.ID(101), mockVo).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestUpdate_WhenTitleServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+		Body:      bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	mockVo := &title.UpdateTitleVO{Name: "some.name"}
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockDecoderService.On("ToTitleUpdateTitleVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockTitleService.On("Update", suite.ctxFixture, entity.ID(101), mockVo).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Update(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestDelete_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *TitleControllerTestSuite) TestDelete_WhenTitleServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(101), nil)
+	suite.mockTitleService.On("Delete", suite.ctxFixture, entity.ID(101)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
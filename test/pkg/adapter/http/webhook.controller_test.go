@@ -0,0 +1,507 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	goHttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type WebhookControllerTestSuite struct {
+	suite.Suite
+	mockWebhookService     *webhookMocks.MockService
+	mockDecoderService     *jsonMocks.MockDecoderService
+	mockEncoderService     *jsonMocks.MockEncoderService
+	mockResponseFactory    *httpMocks.MockResponseFactory
+	mockParameterConverter *httpMocks.MockParameterConverter
+	ctxFixture             context.Context
+	sut                    *http.WebhookControllerImpl
+}
+
+func TestWebhookControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookControllerTestSuite))
+}
+
+func (suite *WebhookControllerTestSuite) SetupTest() {
+	suite.mockWebhookService = &webhookMocks.MockService{}
+	suite.mockDecoderService = &jsonMocks.MockDecoderService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.mockResponseFactory = &httpMocks.MockResponseFactory{}
+	suite.mockParameterConverter = &httpMocks.MockParameterConverter{}
+	suite.ctxFixture = context.Background()
+	suite.sut = http.NewWebhookControllerImpl(
+		suite.mockWebhookService,
+		suite.mockDecoderService,
+		suite.mockEncoderService,
+		suite.mockResponseFactory,
+		suite.mockParameterConverter,
+	)
+}
+
+func (suite *WebhookControllerTestSuite) TestGetHandlers_ShouldReturnAllHandlers() {
+	// Setup expectations
+	expected := []http.HandlerPattern{
+		{
+			Method:      goHttp.MethodPost,
+			PathPattern: "/webhooks",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/webhooks",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/webhooks/{id}",
+		},
+		{
+			Method:      goHttp.MethodDelete,
+			PathPattern: "/webhooks/{id}",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/webhooks/{id}/deliveries",
+		},
+		{
+			Method:      goHttp.MethodGet,
+			PathPattern: "/webhook-deliveries/dead",
+		},
+		{
+			Method:      goHttp.MethodPut,
+			PathPattern: "/webhook-deliveries/{id}/redeliver",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.GetHandlers()
+
+	// Verify results
+	if err := equalKeys(expected, actual); err != nil {
+		suite.Failf("Unexpected result.", "%s", err)
+	}
+}
+
+func (suite *WebhookControllerTestSuite) TestCreate_WhenDecoderServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDecoderService.On("ToWebhookCreateSubscriptionVo", bodyFixture).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestCreate_WhenWebhookServiceFails_ShouldFail() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockVo := &webhook.CreateSubscriptionVO{URL: "https://example.com/hook"}
+	suite.mockDecoderService.On("ToWebhookCreateSubscriptionVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockWebhookService.On("Create", suite.ctxFixture, mockVo).
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestCreate_WhenWebhookServicePasses_ShouldReturnCreated() {
+	// Setup fixture
+	bodyFixture := []byte("some.body")
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+		Body:    bodyFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 201,
+		Body:       []byte("401"),
+	}
+
+	// Setup mocks
+	mockVo := &webhook.CreateSubscriptionVO{URL: "https://example.com/hook"}
+	suite.mockDecoderService.On("ToWebhookCreateSubscriptionVo", bodyFixture).
+		Return(mockVo, nil)
+	suite.mockWebhookService.On("Create", suite.ctxFixture, mockVo).
+		Return(entity.ID(401), nil)
+	suite.mockResponseFactory.On("CreateFromEntityID", uint(201), entity.ID(401)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Create(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadDetails_WhenParameterConverterFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.InvalidID, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadDetails_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockView := &webhook.SubscriptionViewVO{ID: entity.ID(401)}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(401), nil)
+	suite.mockWebhookService.On("ReadDetails", suite.ctxFixture, entity.ID(401)).
+		Return(mockView, nil)
+	suite.mockEncoderService.On("FromWebhookSubscriptionView", mockView).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDetails(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadAll_WhenEncoderServiceFails_ShouldFail() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockViews := []webhook.SubscriptionViewVO{{ID: entity.ID(401)}}
+	suite.mockWebhookService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromWebhookSubscriptionViews", mockViews).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadAll_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []webhook.SubscriptionViewVO{{ID: entity.ID(401)}}
+	mockJson := []byte("some.json")
+	suite.mockWebhookService.On("ReadAll", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromWebhookSubscriptionViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadAll(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestDelete_WhenWebhookServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(401), nil)
+	suite.mockWebhookService.On("Delete", suite.ctxFixture, entity.ID(401)).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestDelete_WhenWebhookServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(401), nil)
+	suite.mockWebhookService.On("Delete", suite.ctxFixture, entity.ID(401)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Delete(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadDeliveries_WhenWebhookServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(401), nil)
+	suite.mockWebhookService.On("ReadDeliveries", suite.ctxFixture, entity.ID(401)).
+		Return(nil, mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDeliveries(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadDeliveries_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []webhook.DeliveryViewVO{{ID: entity.ID(501)}}
+	mockJson := []byte("some.json")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(401), nil)
+	suite.mockWebhookService.On("ReadDeliveries", suite.ctxFixture, entity.ID(401)).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromWebhookDeliveryViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDeliveries(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestReadDeadLetters_WhenEncoderServicePasses_ShouldReturnAsExpected() {
+	// Setup fixture
+	requestFixture := &http.Request{
+		Context: suite.ctxFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockViews := []webhook.DeliveryViewVO{{ID: entity.ID(501)}}
+	mockJson := []byte("some.json")
+	suite.mockWebhookService.On("ReadDeadLetters", suite.ctxFixture).
+		Return(mockViews, nil)
+	suite.mockEncoderService.On("FromWebhookDeliveryViews", mockViews).
+		Return(mockJson, nil)
+	suite.mockResponseFactory.On("CreateJSON", uint(200), mockJson).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.ReadDeadLetters(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestRedeliver_WhenWebhookServiceFails_ShouldFail() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 101,
+		Body:       []byte("some.response"),
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(501), nil)
+	suite.mockWebhookService.On("Redeliver", suite.ctxFixture, entity.ID(501)).
+		Return(mockErr)
+	suite.mockResponseFactory.On("CreateFromError", mockErr).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Redeliver(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *WebhookControllerTestSuite) TestRedeliver_WhenWebhookServicePasses_ShouldReturnNoContent() {
+	// Setup fixture
+	pathParamFixture := map[string]string{"some": "param"}
+	requestFixture := &http.Request{
+		Context:   suite.ctxFixture,
+		PathParam: pathParamFixture,
+	}
+
+	// Setup expectations
+	expected := &http.Response{
+		StatusCode: 204,
+	}
+
+	// Setup mocks
+	suite.mockParameterConverter.On("ToEntityID", pathParamFixture, "id").
+		Return(entity.ID(501), nil)
+	suite.mockWebhookService.On("Redeliver", suite.ctxFixture, entity.ID(501)).
+		Return(nil)
+	suite.mockResponseFactory.On("CreateEmpty", uint(204)).
+		Return(expected)
+
+	// Exercise SUT
+	actual := suite.sut.Redeliver(requestFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
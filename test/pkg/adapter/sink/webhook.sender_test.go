@@ -0,0 +1,96 @@
+package sink_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/sink"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+const secretFixture = "some.secret.which.is.long"
+
+func TestWebhookSender_Send_WhenReceiverAccepts_ShouldPostSignedEvent(t *testing.T) {
+	// Setup fixture
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	sut := sink.NewWebhookSenderImpl(server.Client())
+
+	// Setup expectations
+	expectedBody := `{"id":12,"type":"inventory.item.checked-in","entityId":101,"occurredAt":"2020-01-02T03:04:05Z","data":{}}`
+	mac := hmac.New(sha256.New, []byte(secretFixture))
+	mac.Write([]byte(expectedBody))
+	expectedSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	// Exercise SUT
+	actual, err := sut.Send(context.Background(), subscriptionFixture(server.URL), deliveryFixture())
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, actual)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "inventory.item.checked-in", received.Header.Get(sink.EventHeader))
+	assert.Equal(t, "501", received.Header.Get(sink.DeliveryHeader))
+	assert.Equal(t, expectedSignature, received.Header.Get(sink.SignatureHeader))
+	assert.Equal(t, expectedBody, string(body))
+}
+
+func TestWebhookSender_Send_WhenReceiverRejects_ShouldReturnStatusAndFail(t *testing.T) {
+	// Setup fixture
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(strings.Repeat("x", 100000)))
+	}))
+	defer server.Close()
+	sut := sink.NewWebhookSenderImpl(server.Client())
+
+	// Exercise SUT
+	actual, err := sut.Send(context.Background(), subscriptionFixture(server.URL), deliveryFixture())
+
+	// Verify results
+	assert.EqualError(t, err, "could not send webhook - receiver responded with status 503")
+	assert.Equal(t, http.StatusServiceUnavailable, actual)
+}
+
+func TestWebhookSender_Send_WhenReceiverIsUnreachable_ShouldFail(t *testing.T) {
+	// Setup fixture
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+	sut := sink.NewWebhookSenderImpl(&http.Client{Timeout: time.Second})
+
+	// Exercise SUT
+	actual, err := sut.Send(context.Background(), subscriptionFixture(url), deliveryFixture())
+
+	// Verify results
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not send webhook - post error: ")
+	assert.Equal(t, 0, actual)
+}
+
+func subscriptionFixture(url string) entity.WebhookSubscription {
+	return entity.TestWebhookSubscriptionImplConstructor(401, url,
+		[]entity.EventType{entity.EventItemCheckedIn}, secretFixture, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func deliveryFixture() entity.WebhookDelivery {
+	return entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101,
+		time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), 0)
+}
@@ -0,0 +1,66 @@
+package sink_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/sink"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+func TestWriterSink_Publish_ShouldWriteLineOfJSON(t *testing.T) {
+	// Setup fixture
+	var out bytes.Buffer
+	sut := sink.NewWriterSinkImpl(&out)
+	msgs := []outbox.Message{
+		{
+			ID:         1,
+			EntityID:   101,
+			Type:       entity.EventItemCheckedOut,
+			Data:       map[string]interface{}{"accountId": 7},
+			OccurredAt: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			ID:         2,
+			EntityID:   101,
+			Type:       entity.EventItemCheckedIn,
+			OccurredAt: time.Date(2020, 1, 3, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	// Setup expectations
+	expected := `{"id":1,"type":"inventory.item.checked-out","entityId":101,"occurredAt":"2020-01-02T03:04:05Z","data":{"accountId":7}}
+{"id":2,"type":"inventory.item.checked-in","entityId":101,"occurredAt":"2020-01-03T03:04:05Z","data":{}}
+`
+
+	// Exercise SUT
+	for _, msg := range msgs {
+		assert.NoError(t, sut.Publish(context.Background(), msg))
+	}
+
+	// Verify results
+	assert.Equal(t, expected, out.String())
+}
+
+func TestWriterSink_Publish_WhenWriteFails_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := sink.NewWriterSinkImpl(failingWriter{})
+
+	// Exercise SUT
+	err := sut.Publish(context.Background(), outbox.Message{ID: 1})
+
+	// Verify results
+	assert.EqualError(t, err, "could not publish outbox message - write error: mock.error")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("mock.error")
+}
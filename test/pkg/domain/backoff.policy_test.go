@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+func TestBackoffPolicy_Next(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var tests = []struct {
+		attempts      int
+		expectedDelay time.Duration
+		expectedOk    bool
+	}{
+		// Doubles after each attempt
+		{1, 30 * time.Second, true},
+		{2, time.Minute, true},
+		{3, 2 * time.Minute, true},
+		// Capped
+		{4, 3 * time.Minute, true},
+		{6, 3 * time.Minute, true},
+		// Gives up
+		{7, 0, false},
+		{8, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := domain.NewBackoffPolicyImpl(30*time.Second, 3*time.Minute, 7)
+
+			// Exercise SUT
+			actual, ok := sut.Next(test.attempts, now)
+
+			// Verify results
+			assert.Equal(t, test.expectedOk, ok)
+			if test.expectedOk {
+				assert.Equal(t, now.Add(test.expectedDelay), actual)
+			}
+		})
+	}
+}
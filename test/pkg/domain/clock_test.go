@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+)
+
+func TestClock_Now_ShouldReturnCurrentTimeInUTC(t *testing.T) {
+	// Setup fixture
+	sut := domain.NewClockImpl()
+	before := time.Now()
+
+	// Exercise SUT
+	actual := sut.Now()
+
+	// Verify results
+	after := time.Now()
+	assert.Equal(t, time.UTC, actual.Location())
+	assert.False(t, actual.Before(before.Truncate(time.Second)))
+	assert.False(t, actual.After(after))
+}
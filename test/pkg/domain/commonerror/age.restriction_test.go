@@ -0,0 +1,20 @@
+package commonerror_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+func TestAgeRestrictionError_Error_ShouldReturnCorrectMessage(t *testing.T) {
+	// Setup fixture
+	err := commonerror.NewAgeRestriction("some.rating", 18, "some.problem")
+
+	// Setup expectations
+	expected := "age restriction error: rating=[some.rating], minimumAge=[18], problem=[some.problem]"
+
+	// Verify results
+	assert.EqualError(t, err, expected)
+}
@@ -0,0 +1,20 @@
+package commonerror_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+func TestConflictError_Error_ShouldReturnCorrectMessage(t *testing.T) {
+	// Setup fixture
+	err := commonerror.NewConflict("some.type", "some.problem")
+
+	// Setup expectations
+	expected := "conflict error: type=[some.type], problem=[some.problem]"
+
+	// Verify results
+	assert.EqualError(t, err, expected)
+}
@@ -0,0 +1,20 @@
+package commonerror_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+)
+
+func TestCreditLimitError_Error_ShouldReturnCorrectMessage(t *testing.T) {
+	// Setup fixture
+	err := commonerror.NewCreditLimit(1500, 1000)
+
+	// Setup expectations
+	expected := "credit limit error: balanceCents=[1500], limitCents=[1000]"
+
+	// Verify results
+	assert.EqualError(t, err, expected)
+}
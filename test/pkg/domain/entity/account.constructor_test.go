@@ -0,0 +1,79 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type AccountConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.AccountConstructorImpl
+}
+
+func TestAccountConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountConstructorTestSuite))
+}
+
+func (suite *AccountConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewAccountConstructorImpl()
+}
+
+func (suite *AccountConstructorTestSuite) TestNew_WhenNameValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[name], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New("Jane Doe ", nil)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *AccountConstructorTestSuite) TestNew_WhenDateOfBirthValidationFails_ShouldFail() {
+	// Setup fixture
+	dateOfBirth := time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[dateOfBirth], problem=[must not be before 1900-01-01]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New("Jane Doe", &dateOfBirth)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *AccountConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreateEntity() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	// Exercise SUT
+	actual, err := suite.sut.New("Jane Doe", &dateOfBirth)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal("Jane Doe", actual.Name())
+	suite.Equal(&dateOfBirth, actual.DateOfBirth())
+	suite.Equal(entity.Money(0), actual.Balance())
+}
+
+func (suite *AccountConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Setup fixture
+	dateOfBirth := time.Date(1850, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(entity.ID(101), " not validated ", &dateOfBirth, -5)
+
+	// Verify results
+	suite.Equal(entity.ID(101), actual.ID())
+	suite.Equal(" not validated ", actual.Name())
+	suite.Equal(&dateOfBirth, actual.DateOfBirth())
+	suite.Equal(entity.Money(-5), actual.Balance())
+}
@@ -0,0 +1,135 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestAccount_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	dateOfBirth := date(1990, time.March, 4)
+	fixture := entity.TestAccountImplConstructor(101, "some.name", &dateOfBirth, 250)
+
+	// Verify results
+	assert.Equal(t, entity.ID(101), fixture.ID())
+	assert.Equal(t, "some.name", fixture.Name())
+	assert.Equal(t, &dateOfBirth, fixture.DateOfBirth())
+	assert.Equal(t, entity.Money(250), fixture.Balance())
+}
+
+func TestAccount_ChangeName_WhenGivenNameIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestAccountImplConstructor(101, "some.name", nil, 250)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[name], problem=[must not be blank]"
+
+	// Exercise SUT
+	err := sut.ChangeName(" ")
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, "some.name", sut.Name())
+}
+
+func TestAccount_ChangeName_WhenGivenNamePassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestAccountImplConstructor(101, "some.name", nil, 250)
+
+	// Exercise SUT
+	err := sut.ChangeName("Jane Doe")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", sut.Name())
+}
+
+func TestAccount_AgeOn(t *testing.T) {
+	dateOfBirth := date(2000, time.June, 15)
+	var tests = []struct {
+		dateOfBirth *time.Time
+		on          time.Time
+		expected    int
+		expectedOk  bool
+	}{
+		// Unknown date of birth
+		{nil, date(2020, time.June, 15), 0, false},
+		// Day before birthday
+		{&dateOfBirth, date(2018, time.June, 14), 17, true},
+		// On birthday
+		{&dateOfBirth, date(2018, time.June, 15), 18, true},
+		// Later in the day, in another timezone
+		{&dateOfBirth, time.Date(2018, time.June, 14, 23, 0, 0, 0, time.FixedZone("", -2*60*60)), 18, true},
+		// Earlier month
+		{&dateOfBirth, date(2018, time.May, 30), 17, true},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestAccountImplConstructor(101, "some.name", test.dateOfBirth, 0)
+
+			// Exercise SUT
+			actual, ok := sut.AgeOn(test.on)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expectedOk, ok)
+		})
+	}
+}
+
+func TestAccount_ChangeDateOfBirth_WhenGivenDateIsBefore1900_ShouldFail(t *testing.T) {
+	// Setup fixture
+	dateOfBirth := date(1990, time.March, 4)
+	sut := entity.TestAccountImplConstructor(101, "some.name", &dateOfBirth, 250)
+	tooEarly := date(1899, time.December, 31)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[dateOfBirth], problem=[must not be before 1900-01-01]"
+
+	// Exercise SUT
+	err := sut.ChangeDateOfBirth(&tooEarly)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, &dateOfBirth, sut.DateOfBirth())
+}
+
+func TestAccount_ChangeDateOfBirth_WhenGivenDatePassesValidation_ShouldKeepOnlyTheDate(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestAccountImplConstructor(101, "some.name", nil, 250)
+	given := time.Date(1990, time.March, 4, 15, 30, 0, 0, time.FixedZone("", 2*60*60))
+
+	// Setup expectations
+	expected := date(1990, time.March, 4)
+
+	// Exercise SUT
+	err := sut.ChangeDateOfBirth(&given)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, sut.DateOfBirth())
+}
+
+func TestAccount_ChangeDateOfBirth_WhenGivenNil_ShouldClear(t *testing.T) {
+	// Setup fixture
+	dateOfBirth := date(1990, time.March, 4)
+	sut := entity.TestAccountImplConstructor(101, "some.name", &dateOfBirth, 250)
+
+	// Exercise SUT
+	err := sut.ChangeDateOfBirth(nil)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Nil(t, sut.DateOfBirth())
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,69 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestSymbologyOf(t *testing.T) {
+	var tests = []struct {
+		name     string
+		fixture  string
+		expected entity.Symbology
+	}{
+		{"13 digits", "4006381333931", entity.SymbologyEAN13},
+		{"12 digits", "036000291452", entity.SymbologyUPCA},
+		{"8 digits", "96385074", entity.SymbologyCode128},
+		{"letters and digits", "MV00000001", entity.SymbologyCode128},
+		{"13 characters with a letter", "400638133393A", entity.SymbologyCode128},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Exercise SUT
+			actual := entity.SymbologyOf(test.fixture)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestValidateBarcode(t *testing.T) {
+	var tests = []struct {
+		name        string
+		fixture     string
+		expectedErr string
+	}{
+		{"valid EAN-13", "4006381333931", ""},
+		{"valid EAN-13 with a zero check digit", "5901234123457", ""},
+		{"valid UPC-A", "036000291452", ""},
+		{"valid Code 128", "MV00000001", ""},
+		{"valid Code 128 with punctuation", "some.barcode_1-a", ""},
+		{"blank", "", "validation error: field=[barcode], problem=[must not be blank]"},
+		{"untrimmed", "MV00000001 ", "validation error: field=[barcode], problem=[must not have whitespace at the beginning or the end]"},
+		{"EAN-13 with a wrong check digit", "4006381333932", "validation error: field=[barcode], problem=[must end with a valid EAN-13 check digit]"},
+		{"UPC-A with a wrong check digit", "036000291453", "validation error: field=[barcode], problem=[must end with a valid UPC-A check digit]"},
+		{"Code 128 with a slash", "MV/0001", "validation error: field=[barcode], problem=[must only have letters, digits, \"-\", \".\" and \"_\"]"},
+		{"Code 128 with a space", "MV 0001", "validation error: field=[barcode], problem=[must only have letters, digits, \"-\", \".\" and \"_\"]"},
+		{"Code 128 of the longest length", "MV000000000000000000000000000001", ""},
+		{"Code 128 which is too long", "MV0000000000000000000000000000001", "validation error: field=[barcode], problem=[must be at most 32 characters]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Exercise SUT
+			err := entity.ValidateBarcode(test.fixture)
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestEventType_Validate_WhenEventTypeIsKnown_ShouldPass(t *testing.T) {
+	for _, fixture := range entity.EventTypes {
+		t.Run(string(fixture), func(t *testing.T) {
+			// Exercise SUT
+			err := fixture.Validate()
+
+			// Verify results
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEventType_Validate_WhenEventTypeIsUnknown_ShouldFail(t *testing.T) {
+	var tests = []entity.EventType{
+		"",
+		"inventory.item",
+		"Inventory.Item.Created",
+		"title.created",
+	}
+	for _, fixture := range tests {
+		t.Run(string(fixture), func(t *testing.T) {
+			// Setup expectations
+			expectedErr := "validation error: field=[eventType], problem=[must be one of inventory.item.created, inventory.item.checked-out, inventory.item.checked-in, inventory.item.held, inventory.item.renewed, inventory.item.deleted, inventory.item.moved]"
+
+			// Exercise SUT
+			err := fixture.Validate()
+
+			// Verify results
+			assert.EqualError(t, err, expectedErr)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestFormat_Validate_WhenFormatIsKnown_ShouldPass(t *testing.T) {
+	for _, fixture := range entity.Formats {
+		t.Run(string(fixture), func(t *testing.T) {
+			// Exercise SUT
+			err := fixture.Validate()
+
+			// Verify results
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFormat_Validate_WhenFormatIsUnknown_ShouldFail(t *testing.T) {
+	var tests = []entity.Format{
+		"",
+		"DVD",
+		"laserdisc",
+		" dvd",
+	}
+	for _, fixture := range tests {
+		t.Run(string(fixture), func(t *testing.T) {
+			// Setup expectations
+			expectedErr := "validation error: field=[format], problem=[must be one of vhs, dvd, bluray, 4k]"
+
+			// Exercise SUT
+			err := fixture.Validate()
+
+			// Verify results
+			assert.EqualError(t, err, expectedErr)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type HoldConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.HoldConstructorImpl
+}
+
+func TestHoldConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldConstructorTestSuite))
+}
+
+func (suite *HoldConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewHoldConstructorImpl()
+}
+
+func (suite *HoldConstructorTestSuite) TestNew_WhenTitleIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[titleId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(entity.InvalidID, 7, placedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *HoldConstructorTestSuite) TestNew_WhenAccountIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[accountId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(11, 0, placedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *HoldConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreateWaitingHold() {
+	// Exercise SUT
+	actual, err := suite.sut.New(11, 7, placedFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(11), actual.TitleID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(placedFixture, actual.PlacedAt())
+	suite.Equal(entity.HoldWaiting, actual.Status())
+	suite.Equal(entity.InvalidID, actual.ItemID())
+	suite.Nil(actual.ExpiresAt())
+}
+
+func (suite *HoldConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(301, 11, 7, placedFixture, entity.HoldReady, 101, &expiresFixture)
+
+	// Verify results
+	suite.Equal(entity.ID(301), actual.ID())
+	suite.Equal(entity.ID(11), actual.TitleID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(placedFixture, actual.PlacedAt())
+	suite.Equal(entity.HoldReady, actual.Status())
+	suite.Equal(entity.ID(101), actual.ItemID())
+	suite.Equal(&expiresFixture, actual.ExpiresAt())
+}
@@ -0,0 +1,215 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+var (
+	placedFixture  = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresFixture = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+)
+
+func TestHold_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldReady, 101, &expiresFixture)
+
+	// Verify results
+	assert.Equal(t, entity.ID(301), fixture.ID())
+	assert.Equal(t, entity.ID(11), fixture.TitleID())
+	assert.Equal(t, entity.ID(7), fixture.AccountID())
+	assert.Equal(t, placedFixture, fixture.PlacedAt())
+	assert.Equal(t, entity.HoldReady, fixture.Status())
+	assert.Equal(t, entity.ID(101), fixture.ItemID())
+	assert.Equal(t, &expiresFixture, fixture.ExpiresAt())
+}
+
+func TestHold_IsActive(t *testing.T) {
+	var tests = []struct {
+		status   entity.HoldStatus
+		expected bool
+	}{
+		{entity.HoldWaiting, true},
+		{entity.HoldReady, true},
+		{entity.HoldFulfilled, false},
+		{entity.HoldCancelled, false},
+		{entity.HoldExpired, false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.status), func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, test.status, entity.InvalidID, nil)
+
+			// Exercise SUT
+			actual := sut.IsActive()
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestHold_IsExpired(t *testing.T) {
+	var tests = []struct {
+		status    entity.HoldStatus
+		expiresAt *time.Time
+		now       time.Time
+		expected  bool
+	}{
+		// Ready
+		{entity.HoldReady, &expiresFixture, expiresFixture.Add(-time.Second), false},
+		{entity.HoldReady, &expiresFixture, expiresFixture, false},
+		{entity.HoldReady, &expiresFixture, expiresFixture.Add(time.Second), true},
+		// Not ready
+		{entity.HoldWaiting, nil, expiresFixture.Add(time.Second), false},
+		{entity.HoldFulfilled, &expiresFixture, expiresFixture.Add(time.Second), false},
+	}
+
+	for _, test := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, test.status, 101, test.expiresAt)
+
+			// Exercise SUT
+			actual := sut.IsExpired(test.now)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestHold_Assign_WhenWaiting_ShouldPutItemAside(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldWaiting, entity.InvalidID, nil)
+
+	// Exercise SUT
+	err := sut.Assign(101, expiresFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.HoldReady, sut.Status())
+	assert.Equal(t, entity.ID(101), sut.ItemID())
+	assert.Equal(t, &expiresFixture, sut.ExpiresAt())
+}
+
+func TestHold_Assign_WhenItemIDValidationFails_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldWaiting, entity.InvalidID, nil)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[itemId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	err := sut.Assign(entity.InvalidID, expiresFixture)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, entity.HoldWaiting, sut.Status())
+}
+
+func TestHold_Assign_WhenNotWaiting_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldReady, 101, &expiresFixture)
+
+	// Setup expectations
+	expectedErr := "cannot assign hold - conflict error: type=[hold], problem=[it is ready]"
+
+	// Exercise SUT
+	err := sut.Assign(102, expiresFixture)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, entity.ID(101), sut.ItemID())
+}
+
+func TestHold_Fulfil_WhenReady_ShouldBeFulfilled(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldReady, 101, &expiresFixture)
+
+	// Exercise SUT
+	err := sut.Fulfil()
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.HoldFulfilled, sut.Status())
+	assert.False(t, sut.IsActive())
+}
+
+func TestHold_Fulfil_WhenNotReady_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldWaiting, entity.InvalidID, nil)
+
+	// Setup expectations
+	expectedErr := "cannot fulfil hold - conflict error: type=[hold], problem=[it is waiting]"
+
+	// Exercise SUT
+	err := sut.Fulfil()
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, entity.HoldWaiting, sut.Status())
+}
+
+func TestHold_Cancel_WhenActive_ShouldBeCancelled(t *testing.T) {
+	for _, status := range []entity.HoldStatus{entity.HoldWaiting, entity.HoldReady} {
+		t.Run(string(status), func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, status, 101, &expiresFixture)
+
+			// Exercise SUT
+			err := sut.Cancel()
+
+			// Verify results
+			assert.NoError(t, err)
+			assert.Equal(t, entity.HoldCancelled, sut.Status())
+		})
+	}
+}
+
+func TestHold_Cancel_WhenNotActive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldFulfilled, 101, &expiresFixture)
+
+	// Setup expectations
+	expectedErr := "cannot cancel hold - conflict error: type=[hold], problem=[it is fulfilled]"
+
+	// Exercise SUT
+	err := sut.Cancel()
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, entity.HoldFulfilled, sut.Status())
+}
+
+func TestHold_Expire_WhenReady_ShouldBeExpired(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldReady, 101, &expiresFixture)
+
+	// Exercise SUT
+	err := sut.Expire()
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.HoldExpired, sut.Status())
+}
+
+func TestHold_Expire_WhenNotReady_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestHoldImplConstructor(301, 11, 7, placedFixture, entity.HoldCancelled, entity.InvalidID, nil)
+
+	// Setup expectations
+	expectedErr := "cannot expire hold - conflict error: type=[hold], problem=[it is cancelled]"
+
+	// Exercise SUT
+	err := sut.Expire()
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, entity.HoldCancelled, sut.Status())
+}
@@ -21,16 +21,54 @@ func (suite *InventoryItemConstructorTestSuite) SetupTest() {
 	suite.sut = entity.NewInventoryItemConstructorImpl()
 }
 
-func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenNameValidationFails_ShouldFail() {
+func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenTitleValidationFails_ShouldFail() {
 	// Setup fixture
-	nameFixture := "some.name "
-	locationFixture := "some.location"
+	titleIDFixture := entity.InvalidID
+	formatFixture := entity.FormatDVD
+	barcodeFixture := "some.barcode"
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
 
 	// Setup expectations
-	expectedErr := "validation error: field=[name], problem=[must not have whitespace at the beginning or the end]"
+	expectedErr := "validation error: field=[titleId], problem=[must be a positive id]"
 
 	// Exercise SUT
-	actual, err := suite.sut.NewAvailable(nameFixture, locationFixture)
+	actual, err := suite.sut.NewAvailable(titleIDFixture, formatFixture, barcodeFixture, locationFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenFormatValidationFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	formatFixture := entity.Format("laserdisc")
+	barcodeFixture := "some.barcode"
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	// Setup expectations
+	expectedErr := "validation error: field=[format], problem=[must be one of vhs, dvd, bluray, 4k]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewAvailable(titleIDFixture, formatFixture, barcodeFixture, locationFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenBarcodeValidationFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	formatFixture := entity.FormatDVD
+	barcodeFixture := "some.barcode "
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	// Setup expectations
+	expectedErr := "validation error: field=[barcode], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewAvailable(titleIDFixture, formatFixture, barcodeFixture, locationFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -39,14 +77,16 @@ func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenNameValidat
 
 func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenLocationValidationFails_ShouldFail() {
 	// Setup fixture
-	nameFixture := "some.name"
-	locationFixture := "some.location "
+	titleIDFixture := entity.ID(11)
+	formatFixture := entity.FormatDVD
+	barcodeFixture := "some.barcode"
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12 "}
 
 	// Setup expectations
-	expectedErr := "validation error: field=[location], problem=[must not have whitespace at the beginning or the end]"
+	expectedErr := "validation error: field=[location.slot], problem=[must not have whitespace at the beginning or the end]"
 
 	// Exercise SUT
-	actual, err := suite.sut.NewAvailable(nameFixture, locationFixture)
+	actual, err := suite.sut.NewAvailable(titleIDFixture, formatFixture, barcodeFixture, locationFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
@@ -55,33 +95,50 @@ func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenLocationVal
 
 func (suite *InventoryItemConstructorTestSuite) TestNewAvailable_WhenValidationPasses_ShouldCreateAvailableEntity() {
 	// Setup fixture
-	nameFixture := "some.name"
-	locationFixture := "some.location"
+	titleIDFixture := entity.ID(11)
+	formatFixture := entity.FormatDVD
+	barcodeFixture := "some.barcode"
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
 
 	// Exercise SUT
-	actual, err := suite.sut.NewAvailable(nameFixture, locationFixture)
+	actual, err := suite.sut.NewAvailable(titleIDFixture, formatFixture, barcodeFixture, locationFixture)
 
 	// Verify results
 	suite.NoError(err)
 	suite.Equal(actual.ID(), entity.InvalidID)
-	suite.Equal(actual.Name(), nameFixture)
+	suite.Equal(actual.TitleID(), titleIDFixture)
+	suite.Equal(actual.Format(), formatFixture)
+	suite.Equal(actual.Barcode(), barcodeFixture)
 	suite.Equal(actual.Location(), locationFixture)
 	suite.True(actual.IsAvailable())
+	suite.Equal([]entity.Event{{
+		Type: entity.EventItemCreated,
+		Data: map[string]interface{}{
+			"titleId": titleIDFixture,
+			"format":  formatFixture,
+			"barcode": barcodeFixture,
+		},
+	}}, actual.PullEvents())
 }
 
 func (suite *InventoryItemConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
 	// Setup fixture
 	idFixture := entity.ID(101)
-	nameFixture := "some.name"
-	locationFixture := "some.location"
+	titleIDFixture := entity.ID(11)
+	formatFixture := entity.FormatDVD
+	barcodeFixture := "some.barcode"
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
 	availableFixture := true
 
 	// Exercise SUT
-	actual := suite.sut.Reincarnate(idFixture, nameFixture, locationFixture, availableFixture)
+	actual := suite.sut.Reincarnate(idFixture, titleIDFixture, formatFixture, barcodeFixture, locationFixture, availableFixture)
 
 	// Verify results
 	suite.Equal(actual.ID(), idFixture)
-	suite.Equal(actual.Name(), nameFixture)
+	suite.Equal(actual.TitleID(), titleIDFixture)
+	suite.Equal(actual.Format(), formatFixture)
+	suite.Equal(actual.Barcode(), barcodeFixture)
 	suite.Equal(actual.Location(), locationFixture)
 	suite.True(actual.IsAvailable())
+	suite.Empty(actual.PullEvents())
 }
@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,7 +11,7 @@ import (
 
 func TestInventoryItem_ID_ShouldReturnID(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
 
 	// Exercise SUT
 	actual := fixture.ID()
@@ -19,31 +20,53 @@ func TestInventoryItem_ID_ShouldReturnID(t *testing.T) {
 	assert.Equal(t, actual, entity.ID(101))
 }
 
-func TestInventoryItem_Name_ShouldReturnName(t *testing.T) {
+func TestInventoryItem_TitleID_ShouldReturnTitleID(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "some.name", "", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
 
 	// Exercise SUT
-	actual := fixture.Name()
+	actual := fixture.TitleID()
 
 	// Verify results
-	assert.Equal(t, actual, "some.name")
+	assert.Equal(t, actual, entity.ID(11))
+}
+
+func TestInventoryItem_Format_ShouldReturnFormat(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatVHS, "", entity.Location{}, true)
+
+	// Exercise SUT
+	actual := fixture.Format()
+
+	// Verify results
+	assert.Equal(t, actual, entity.FormatVHS)
+}
+
+func TestInventoryItem_Barcode_ShouldReturnBarcode(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "some.barcode", entity.Location{}, true)
+
+	// Exercise SUT
+	actual := fixture.Barcode()
+
+	// Verify results
+	assert.Equal(t, actual, "some.barcode")
 }
 
 func TestInventoryItem_Location_ShouldReturnLocation(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "some.location", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, true)
 
 	// Exercise SUT
 	actual := fixture.Location()
 
 	// Verify results
-	assert.Equal(t, actual, "some.location")
+	assert.Equal(t, actual, entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
 }
 
 func TestInventoryItem_IsAvailable_FalseCase(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", false)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, false)
 
 	// Exercise SUT
 	actual := fixture.IsAvailable()
@@ -54,7 +77,7 @@ func TestInventoryItem_IsAvailable_FalseCase(t *testing.T) {
 
 func TestInventoryItem_IsAvailable_TrueCase(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
 
 	// Exercise SUT
 	actual := fixture.IsAvailable()
@@ -65,41 +88,54 @@ func TestInventoryItem_IsAvailable_TrueCase(t *testing.T) {
 
 func TestInventoryItem_Checkout_WhenUnavailable_ShouldFail(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", false)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, false)
 
 	// Exercise SUT
-	err := fixture.Checkout()
+	err := fixture.Checkout(21)
 
 	// Verify results
 	assert.Error(t, err)
+	assert.Empty(t, fixture.PullEvents())
 }
 
 func TestInventoryItem_Checkout_WhenAvailable_ShouldPass(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+
+	// Setup expectations
+	expectedEvents := []entity.Event{
+		{Type: entity.EventItemCheckedOut, Data: map[string]interface{}{"accountId": entity.ID(21)}},
+	}
 
 	// Exercise SUT
-	err := fixture.Checkout()
+	err := fixture.Checkout(21)
 
 	// Verify results
 	assert.NoError(t, err)
 	assert.False(t, fixture.IsAvailable())
+	assert.Equal(t, expectedEvents, fixture.PullEvents())
 }
 
 func TestInventoryItem_CheckIn_WhenAvailable_ShouldFail(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", true)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
 
 	// Exercise SUT
 	err := fixture.CheckIn()
 
 	// Verify results
 	assert.Error(t, err)
+	assert.Empty(t, fixture.PullEvents())
 }
 
 func TestInventoryItem_CheckIn_WhenUnavailable_ShouldPass(t *testing.T) {
 	// Setup fixture
-	fixture := entity.TestInventoryItemImplConstructor(101, "", "", false)
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, false)
+
+	// Setup expectations
+	expectedEvents := []entity.Event{
+		{Type: entity.EventItemCheckedIn, Data: map[string]interface{}{"titleId": entity.ID(11)}},
+	}
 
 	// Exercise SUT
 	err := fixture.CheckIn()
@@ -107,58 +143,196 @@ func TestInventoryItem_CheckIn_WhenUnavailable_ShouldPass(t *testing.T) {
 	// Verify results
 	assert.NoError(t, err)
 	assert.True(t, fixture.IsAvailable())
+	assert.Equal(t, expectedEvents, fixture.PullEvents())
+}
+
+func TestInventoryItem_PutAside_ShouldRecordEvent(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "MV00000001", entity.Location{}, true)
+
+	// Setup expectations
+	expectedEvents := []entity.Event{
+		{Type: entity.EventItemHeld, Data: map[string]interface{}{"holdId": entity.ID(31), "accountId": entity.ID(21)}},
+	}
+
+	// Exercise SUT
+	fixture.PutAside(31, 21)
+
+	// Verify results
+	assert.Equal(t, expectedEvents, fixture.PullEvents())
+}
+
+func TestInventoryItem_Renew_WhenAvailable_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+
+	// Exercise SUT
+	err := fixture.Renew(21, time.Date(2020, 1, 5, 3, 4, 5, 0, time.UTC))
+
+	// Verify results
+	assert.Error(t, err)
+	assert.Empty(t, fixture.PullEvents())
+}
+
+func TestInventoryItem_Renew_WhenUnavailable_ShouldRecordEvent(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, false)
+	dueAt := time.Date(2020, 1, 5, 3, 4, 5, 0, time.UTC)
+
+	// Setup expectations
+	expectedEvents := []entity.Event{
+		{Type: entity.EventItemRenewed, Data: map[string]interface{}{"accountId": entity.ID(21), "dueAt": dueAt}},
+	}
+
+	// Exercise SUT
+	err := fixture.Renew(21, dueAt)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.False(t, fixture.IsAvailable())
+	assert.Equal(t, expectedEvents, fixture.PullEvents())
+}
+
+func TestInventoryItem_Delete_ShouldRecordEvent(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "MV00000001", entity.Location{}, true)
+
+	// Setup expectations
+	expectedEvents := []entity.Event{
+		{Type: entity.EventItemDeleted, Data: map[string]interface{}{"barcode": "MV00000001"}},
+	}
+
+	// Exercise SUT
+	fixture.Delete()
+
+	// Verify results
+	assert.Equal(t, expectedEvents, fixture.PullEvents())
+}
+
+func TestInventoryItem_PullEvents_ShouldForgetPulledEvents(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	fixture.Checkout(21)
+	fixture.CheckIn()
+
+	// Exercise SUT
+	first := fixture.PullEvents()
+	second := fixture.PullEvents()
+
+	// Verify results
+	assert.Len(t, first, 2)
+	assert.Equal(t, entity.EventItemCheckedOut, first[0].Type)
+	assert.Equal(t, entity.EventItemCheckedIn, first[1].Type)
+	assert.Empty(t, second)
 }
 
-func TestInventoryItem_ChangeName_WhenGivenNameIsBlank_ShouldFail(t *testing.T) {
+func TestInventoryItem_ChangeTitle_WhenGivenIDIsNotPositive_ShouldFail(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	nameFixture := ""
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	titleIDFixture := entity.InvalidID
 
 	// Setup expectations
-	expectedErr := "validation error: field=[name], problem=[must not be blank]"
+	expectedErr := "validation error: field=[titleId], problem=[must be a positive id]"
 
 	// Exercise SUT
-	err := sut.ChangeName(nameFixture)
+	err := sut.ChangeTitle(titleIDFixture)
 
 	// Verify results
 	assert.EqualError(t, err, expectedErr)
 }
 
-func TestInventoryItem_ChangeName_WhenGivenNameIsNotTrimmed_ShouldFail(t *testing.T) {
+func TestInventoryItem_ChangeTitle_WhenGivenIDPassesValidation_ShouldChange(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	nameFixture := " duck"
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	titleIDFixture := entity.ID(12)
+
+	// Exercise SUT
+	err := sut.ChangeTitle(titleIDFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, sut.TitleID(), titleIDFixture)
+}
+
+func TestInventoryItem_ChangeFormat_WhenGivenFormatIsUnknown_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	formatFixture := entity.Format("betamax")
 
 	// Setup expectations
-	expectedErr := "validation error: field=[name], problem=[must not have whitespace at the beginning or the end]"
+	expectedErr := "validation error: field=[format], problem=[must be one of vhs, dvd, bluray, 4k]"
 
 	// Exercise SUT
-	err := sut.ChangeName(nameFixture)
+	err := sut.ChangeFormat(formatFixture)
 
 	// Verify results
 	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, sut.Format(), entity.FormatDVD)
 }
 
-func TestInventoryItem_ChangeName_WhenGivenNamePassesValidation_ShouldChange(t *testing.T) {
+func TestInventoryItem_ChangeFormat_WhenGivenFormatPassesValidation_ShouldChange(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	nameFixture := "duck"
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	formatFixture := entity.FormatBluRay
 
 	// Exercise SUT
-	err := sut.ChangeName(nameFixture)
+	err := sut.ChangeFormat(formatFixture)
 
 	// Verify results
 	assert.NoError(t, err)
-	assert.Equal(t, sut.Name(), nameFixture)
+	assert.Equal(t, sut.Format(), formatFixture)
+}
+
+func TestInventoryItem_ChangeBarcode_WhenGivenBarcodeIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	barcodeFixture := ""
+
+	// Setup expectations
+	expectedErr := "validation error: field=[barcode], problem=[must not be blank]"
+
+	// Exercise SUT
+	err := sut.ChangeBarcode(barcodeFixture)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestInventoryItem_ChangeBarcode_WhenGivenBarcodeIsNotTrimmed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	barcodeFixture := " duck"
+
+	// Setup expectations
+	expectedErr := "validation error: field=[barcode], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	err := sut.ChangeBarcode(barcodeFixture)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestInventoryItem_ChangeBarcode_WhenGivenBarcodePassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	barcodeFixture := "duck"
+
+	// Exercise SUT
+	err := sut.ChangeBarcode(barcodeFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, sut.Barcode(), barcodeFixture)
 }
 
 func TestInventoryItem_ChangeLocation_WhenGivenLocationIsBlank_ShouldFail(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	locationFixture := ""
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "", Slot: "12"}
 
 	// Setup expectations
-	expectedErr := "validation error: field=[location], problem=[must not be blank]"
+	expectedErr := "validation error: field=[location.shelf], problem=[must not be blank]"
 
 	// Exercise SUT
 	err := sut.ChangeLocation(locationFixture)
@@ -169,11 +343,11 @@ func TestInventoryItem_ChangeLocation_WhenGivenLocationIsBlank_ShouldFail(t *tes
 
 func TestInventoryItem_ChangeLocation_WhenGivenLocationIsNotTrimmed_ShouldFail(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	locationFixture := " duck"
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	locationFixture := entity.Location{Store: "main", Aisle: " A", Shelf: "1", Slot: "12"}
 
 	// Setup expectations
-	expectedErr := "validation error: field=[location], problem=[must not have whitespace at the beginning or the end]"
+	expectedErr := "validation error: field=[location.aisle], problem=[must not have whitespace at the beginning or the end]"
 
 	// Exercise SUT
 	err := sut.ChangeLocation(locationFixture)
@@ -184,8 +358,8 @@ func TestInventoryItem_ChangeLocation_WhenGivenLocationIsNotTrimmed_ShouldFail(t
 
 func TestInventoryItem_ChangeLocation_WhenGivenLocationPassesValidation_ShouldChange(t *testing.T) {
 	// Setup fixture
-	sut := entity.TestInventoryItemImplConstructor(101, "", "", true)
-	locationFixture := "duck"
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
 
 	// Exercise SUT
 	err := sut.ChangeLocation(locationFixture)
@@ -194,3 +368,52 @@ func TestInventoryItem_ChangeLocation_WhenGivenLocationPassesValidation_ShouldCh
 	assert.NoError(t, err)
 	assert.Equal(t, sut.Location(), locationFixture)
 }
+
+func TestInventoryItem_ChangeLocation_WhenPlacedForTheFirstTime_ShouldNotRecordMove(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{}, true)
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	// Exercise SUT
+	err := sut.ChangeLocation(locationFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Empty(t, sut.PullEvents())
+}
+
+func TestInventoryItem_ChangeLocation_WhenLocationIsUnchanged_ShouldNotRecordMove(t *testing.T) {
+	// Setup fixture
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", locationFixture, true)
+
+	// Exercise SUT
+	err := sut.ChangeLocation(locationFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Empty(t, sut.PullEvents())
+}
+
+func TestInventoryItem_ChangeLocation_WhenMoved_ShouldRecordMove(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestInventoryItemImplConstructor(101, 11, entity.FormatDVD, "", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, true)
+	locationFixture := entity.Location{Store: "main", Aisle: "B", Shelf: "2", Slot: "3"}
+
+	// Setup expectations
+	expectedEvents := []entity.Event{{
+		Type: entity.EventItemMoved,
+		Data: map[string]interface{}{
+			"from": map[string]interface{}{"store": "main", "aisle": "A", "shelf": "1", "slot": "12"},
+			"to":   map[string]interface{}{"store": "main", "aisle": "B", "shelf": "2", "slot": "3"},
+		},
+	}}
+
+	// Exercise SUT
+	err := sut.ChangeLocation(locationFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, locationFixture, sut.Location())
+	assert.Equal(t, expectedEvents, sut.PullEvents())
+}
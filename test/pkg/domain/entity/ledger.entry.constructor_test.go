@@ -0,0 +1,205 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type LedgerEntryConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.LedgerEntryConstructorImpl
+}
+
+func TestLedgerEntryConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerEntryConstructorTestSuite))
+}
+
+func (suite *LedgerEntryConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewLedgerEntryConstructorImpl()
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewCharge_WhenAccountIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[accountId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewCharge(entity.InvalidID, 201, 200, "late fee", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewCharge_WhenRentalIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[rentalId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewCharge(7, 0, 200, "late fee", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewCharge_WhenAmountIsNotPositive_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[amount], problem=[must be positive]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewCharge(7, 201, 0, "late fee", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewCharge_WhenNoteIsBlank_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[note], problem=[must not be blank]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewCharge(7, 201, 200, " ", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewCharge_WhenValidationPasses_ShouldCreateCharge() {
+	// Exercise SUT
+	actual, err := suite.sut.NewCharge(7, 201, 200, "late fee", recordedFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(entity.LedgerCharge, actual.Kind())
+	suite.Equal(entity.Money(200), actual.Amount())
+	suite.Equal(entity.PaymentMethod(""), actual.Method())
+	suite.Equal(entity.ID(201), actual.RentalID())
+	suite.Equal("late fee", actual.Note())
+	suite.Equal(recordedFixture, actual.RecordedAt())
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewPayment_WhenAccountIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[accountId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewPayment(entity.InvalidID, 500, entity.PaymentCash, recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewPayment_WhenAmountIsNotPositive_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[amount], problem=[must be positive]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewPayment(7, -500, entity.PaymentCash, recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewPayment_WhenMethodIsUnknown_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[method], problem=[must be one of cash, card]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewPayment(7, 500, "cheque", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewPayment_WhenValidationPasses_ShouldCreatePayment() {
+	// Exercise SUT
+	actual, err := suite.sut.NewPayment(7, 500, entity.PaymentCard, recordedFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(entity.LedgerPayment, actual.Kind())
+	suite.Equal(entity.Money(500), actual.Amount())
+	suite.Equal(entity.PaymentCard, actual.Method())
+	suite.Equal(entity.InvalidID, actual.RentalID())
+	suite.Equal("", actual.Note())
+	suite.Equal(recordedFixture, actual.RecordedAt())
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewAdjustment_WhenAccountIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[accountId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewAdjustment(entity.InvalidID, -200, "waived", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewAdjustment_WhenAmountIsZero_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[amount], problem=[must not be zero]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewAdjustment(7, 0, "waived", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewAdjustment_WhenNoteIsBlank_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[note], problem=[must not be blank]"
+
+	// Exercise SUT
+	actual, err := suite.sut.NewAdjustment(7, -200, "", recordedFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestNewAdjustment_WhenValidationPasses_ShouldCreateAdjustment() {
+	// Exercise SUT
+	actual, err := suite.sut.NewAdjustment(7, -200, "waived", recordedFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(entity.LedgerAdjustment, actual.Kind())
+	suite.Equal(entity.Money(-200), actual.Amount())
+	suite.Equal(entity.PaymentMethod(""), actual.Method())
+	suite.Equal(entity.InvalidID, actual.RentalID())
+	suite.Equal("waived", actual.Note())
+	suite.Equal(recordedFixture, actual.RecordedAt())
+}
+
+func (suite *LedgerEntryConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(401, 7, entity.LedgerPayment, 500, entity.PaymentCash, entity.InvalidID, "", recordedFixture)
+
+	// Verify results
+	suite.Equal(entity.ID(401), actual.ID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(entity.LedgerPayment, actual.Kind())
+	suite.Equal(entity.Money(500), actual.Amount())
+	suite.Equal(entity.PaymentCash, actual.Method())
+	suite.Equal(entity.InvalidID, actual.RentalID())
+	suite.Equal("", actual.Note())
+	suite.Equal(recordedFixture, actual.RecordedAt())
+}
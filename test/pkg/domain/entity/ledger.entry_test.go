@@ -0,0 +1,53 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+var recordedFixture = time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC)
+
+func TestLedgerEntry_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestLedgerEntryImplConstructor(401, 7, entity.LedgerCharge, 200, "", 201, "late fee", recordedFixture)
+
+	// Verify results
+	assert.Equal(t, entity.ID(401), fixture.ID())
+	assert.Equal(t, entity.ID(7), fixture.AccountID())
+	assert.Equal(t, entity.LedgerCharge, fixture.Kind())
+	assert.Equal(t, entity.Money(200), fixture.Amount())
+	assert.Equal(t, entity.PaymentMethod(""), fixture.Method())
+	assert.Equal(t, entity.ID(201), fixture.RentalID())
+	assert.Equal(t, "late fee", fixture.Note())
+	assert.Equal(t, recordedFixture, fixture.RecordedAt())
+}
+
+func TestLedgerEntry_BalanceEffect(t *testing.T) {
+	var tests = []struct {
+		kind     entity.LedgerEntryKind
+		amount   entity.Money
+		expected entity.Money
+	}{
+		{entity.LedgerCharge, 200, 200},
+		{entity.LedgerPayment, 200, -200},
+		{entity.LedgerAdjustment, 200, 200},
+		{entity.LedgerAdjustment, -200, -200},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.kind), func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestLedgerEntryImplConstructor(401, 7, test.kind, test.amount, "", entity.InvalidID, "", recordedFixture)
+
+			// Exercise SUT
+			actual := sut.BalanceEffect()
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
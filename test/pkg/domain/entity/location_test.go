@@ -0,0 +1,37 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestLocation_Validate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		fixture     entity.Location
+		expectedErr string
+	}{
+		{"valid", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, ""},
+		{"blank store", entity.Location{Store: "", Aisle: "A", Shelf: "1", Slot: "12"}, "validation error: field=[location.store], problem=[must not be blank]"},
+		{"untrimmed aisle", entity.Location{Store: "main", Aisle: "A ", Shelf: "1", Slot: "12"}, "validation error: field=[location.aisle], problem=[must not have whitespace at the beginning or the end]"},
+		{"blank shelf", entity.Location{Store: "main", Aisle: "A", Shelf: " ", Slot: "12"}, "validation error: field=[location.shelf], problem=[must not be blank]"},
+		{"blank slot", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: ""}, "validation error: field=[location.slot], problem=[must not be blank]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Exercise SUT
+			err := test.fixture.Validate()
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type MediaFormatConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.MediaFormatConstructorImpl
+}
+
+func TestMediaFormatConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaFormatConstructorTestSuite))
+}
+
+func (suite *MediaFormatConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewMediaFormatConstructorImpl()
+}
+
+func (suite *MediaFormatConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(entity.FormatVHS, "VHS", 150, 7)
+
+	// Verify results
+	suite.Equal(actual.Format(), entity.FormatVHS)
+	suite.Equal(actual.Name(), "VHS")
+	suite.Equal(actual.RentalPrice(), entity.Money(150))
+	suite.Equal(actual.RentalPeriodDays(), 7)
+}
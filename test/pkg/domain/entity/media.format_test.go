@@ -0,0 +1,116 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestMediaFormat_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+	// Exercise SUT and verify results
+	assert.Equal(t, fixture.Format(), entity.FormatBluRay)
+	assert.Equal(t, fixture.Name(), "Blu-ray")
+	assert.Equal(t, fixture.RentalPrice(), entity.Money(400))
+	assert.Equal(t, fixture.RentalPeriodDays(), 3)
+}
+
+func TestMediaFormat_ChangeName_WhenGivenNameIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[name], problem=[must not be blank]"
+
+	// Exercise SUT
+	err := sut.ChangeName("")
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, sut.Name(), "Blu-ray")
+}
+
+func TestMediaFormat_ChangeName_WhenGivenNamePassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+	// Exercise SUT
+	err := sut.ChangeName("Blu-ray Disc")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, sut.Name(), "Blu-ray Disc")
+}
+
+func TestMediaFormat_ChangeRentalPrice_WhenGivenPriceIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[rentalPrice], problem=[must not be negative]"
+
+	// Exercise SUT
+	err := sut.ChangeRentalPrice(-1)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, sut.RentalPrice(), entity.Money(400))
+}
+
+func TestMediaFormat_ChangeRentalPrice_WhenGivenPricePassesValidation_ShouldChange(t *testing.T) {
+	var tests = []entity.Money{0, 1, 450}
+	for _, fixture := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+			// Exercise SUT
+			err := sut.ChangeRentalPrice(fixture)
+
+			// Verify results
+			assert.NoError(t, err)
+			assert.Equal(t, sut.RentalPrice(), fixture)
+		})
+	}
+}
+
+func TestMediaFormat_ChangeRentalPeriodDays_WhenGivenDaysAreOutOfRange_ShouldFail(t *testing.T) {
+	var tests = []int{-1, 0, 366}
+	for _, fixture := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+			// Setup expectations
+			expectedErr := "validation error: field=[rentalPeriodDays], problem=[must be between 1 and 365]"
+
+			// Exercise SUT
+			err := sut.ChangeRentalPeriodDays(fixture)
+
+			// Verify results
+			assert.EqualError(t, err, expectedErr)
+			assert.Equal(t, sut.RentalPeriodDays(), 3)
+		})
+	}
+}
+
+func TestMediaFormat_ChangeRentalPeriodDays_WhenGivenDaysPassValidation_ShouldChange(t *testing.T) {
+	var tests = []int{1, 7, 365}
+	for _, fixture := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestMediaFormatImplConstructor(entity.FormatBluRay, "Blu-ray", 400, 3)
+
+			// Exercise SUT
+			err := sut.ChangeRentalPeriodDays(fixture)
+
+			// Verify results
+			assert.NoError(t, err)
+			assert.Equal(t, sut.RentalPeriodDays(), fixture)
+		})
+	}
+}
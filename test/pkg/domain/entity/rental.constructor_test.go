@@ -0,0 +1,94 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type RentalConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.RentalConstructorImpl
+}
+
+func TestRentalConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(RentalConstructorTestSuite))
+}
+
+func (suite *RentalConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewRentalConstructorImpl()
+}
+
+func (suite *RentalConstructorTestSuite) TestNew_WhenItemIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[itemId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(entity.InvalidID, 7, checkedOutFixture, 3)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *RentalConstructorTestSuite) TestNew_WhenAccountIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[accountId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(101, 0, checkedOutFixture, 3)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *RentalConstructorTestSuite) TestNew_WhenRentalPeriodValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[rentalPeriodDays], problem=[must be positive]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(101, 7, checkedOutFixture, 0)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *RentalConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreateOutstandingRentalDueAfterPeriod() {
+	// Exercise SUT
+	actual, err := suite.sut.New(101, 7, checkedOutFixture, 3)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(101), actual.ItemID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(checkedOutFixture, actual.CheckedOutAt())
+	suite.Equal(dueFixture, actual.DueAt())
+	suite.Nil(actual.ReturnedAt())
+	suite.False(actual.IsReturned())
+	suite.Equal(entity.Money(0), actual.LateFee())
+	suite.Equal(0, actual.Renewals())
+}
+
+func (suite *RentalConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Setup fixture
+	returned := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(201, 101, 7, checkedOutFixture, dueFixture, &returned, 300, 2)
+
+	// Verify results
+	suite.Equal(entity.ID(201), actual.ID())
+	suite.Equal(entity.ID(101), actual.ItemID())
+	suite.Equal(entity.ID(7), actual.AccountID())
+	suite.Equal(checkedOutFixture, actual.CheckedOutAt())
+	suite.Equal(dueFixture, actual.DueAt())
+	suite.Equal(&returned, actual.ReturnedAt())
+	suite.Equal(entity.Money(300), actual.LateFee())
+	suite.Equal(2, actual.Renewals())
+}
@@ -0,0 +1,154 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+var (
+	checkedOutFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	dueFixture        = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+)
+
+func TestRental_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	returned := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+	fixture := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, &returned, 300, 2)
+
+	// Verify results
+	assert.Equal(t, entity.ID(201), fixture.ID())
+	assert.Equal(t, entity.ID(101), fixture.ItemID())
+	assert.Equal(t, entity.ID(7), fixture.AccountID())
+	assert.Equal(t, checkedOutFixture, fixture.CheckedOutAt())
+	assert.Equal(t, dueFixture, fixture.DueAt())
+	assert.Equal(t, &returned, fixture.ReturnedAt())
+	assert.Equal(t, entity.Money(300), fixture.LateFee())
+	assert.Equal(t, 2, fixture.Renewals())
+	assert.True(t, fixture.IsReturned())
+}
+
+func TestRental_IsOverdue(t *testing.T) {
+	returned := dueFixture.Add(time.Hour)
+	var tests = []struct {
+		returnedAt *time.Time
+		now        time.Time
+		expected   bool
+	}{
+		// Outstanding
+		{nil, dueFixture.Add(-time.Second), false},
+		{nil, dueFixture, false},
+		{nil, dueFixture.Add(time.Second), true},
+		// Returned
+		{&returned, dueFixture.Add(-time.Second), false},
+		{&returned, dueFixture.Add(48 * time.Hour), false},
+	}
+
+	for _, test := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, test.returnedAt, 0, 0)
+
+			// Exercise SUT
+			actual := sut.IsOverdue(test.now)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRental_Return_WhenOutstanding_ShouldRecordReturn(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, nil, 0, 0)
+	at := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	// Exercise SUT
+	err := sut.Return(at, 300)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.True(t, sut.IsReturned())
+	assert.Equal(t, &at, sut.ReturnedAt())
+	assert.Equal(t, entity.Money(300), sut.LateFee())
+}
+
+func TestRental_Return_WhenLateFeeIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, nil, 0, 0)
+	at := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[lateFee], problem=[must not be negative]"
+
+	// Exercise SUT
+	err := sut.Return(at, -1)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.False(t, sut.IsReturned())
+}
+
+func TestRental_Return_WhenAlreadyReturned_ShouldFail(t *testing.T) {
+	// Setup fixture
+	returned := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, &returned, 300, 0)
+
+	// Setup expectations
+	expectedErr := "cannot return rental - it is already returned"
+
+	// Exercise SUT
+	err := sut.Return(returned.Add(time.Hour), 0)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, &returned, sut.ReturnedAt())
+}
+
+func TestRental_Renew_WhenOutstanding_ShouldExtendDueDate(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, nil, 0, 1)
+
+	// Exercise SUT
+	err := sut.Renew(3)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, dueFixture.AddDate(0, 0, 3), sut.DueAt())
+	assert.Equal(t, 2, sut.Renewals())
+}
+
+func TestRental_Renew_WhenRentalPeriodIsNotPositive_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, nil, 0, 0)
+
+	// Setup expectations
+	expectedErr := "validation error: field=[rentalPeriodDays], problem=[must be positive]"
+
+	// Exercise SUT
+	err := sut.Renew(0)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, dueFixture, sut.DueAt())
+	assert.Equal(t, 0, sut.Renewals())
+}
+
+func TestRental_Renew_WhenAlreadyReturned_ShouldFail(t *testing.T) {
+	// Setup fixture
+	returned := time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)
+	sut := entity.TestRentalImplConstructor(201, 101, 7, checkedOutFixture, dueFixture, &returned, 0, 0)
+
+	// Setup expectations
+	expectedErr := "cannot renew rental - it is already returned"
+
+	// Exercise SUT
+	err := sut.Renew(3)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+	assert.Equal(t, dueFixture, sut.DueAt())
+}
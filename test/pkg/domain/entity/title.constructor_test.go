@@ -0,0 +1,91 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type TitleConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.TitleConstructorImpl
+}
+
+func TestTitleConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(TitleConstructorTestSuite))
+}
+
+func (suite *TitleConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewTitleConstructorImpl()
+}
+
+func (suite *TitleConstructorTestSuite) TestNew_WhenNameValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[title], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New("The Matrix ", 1999, 136, "", nil, nil, "")
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *TitleConstructorTestSuite) TestNew_WhenYearValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[year], problem=[must be between 1888 and 9999]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New("The Matrix", 0, 136, "", nil, nil, "")
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *TitleConstructorTestSuite) TestNew_WhenGenresValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[genres], problem=[must not be blank]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New("The Matrix", 1999, 136, "", []string{" "}, nil, "")
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *TitleConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreateEntity() {
+	// Exercise SUT
+	actual, err := suite.sut.New("The Matrix", 1999, 136, "some.synopsis",
+		[]string{"Action"}, []string{"Keanu Reeves"}, "R")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal("The Matrix", actual.Name())
+	suite.Equal(1999, actual.Year())
+	suite.Equal(136, actual.Runtime())
+	suite.Equal("some.synopsis", actual.Synopsis())
+	suite.Equal([]string{"Action"}, actual.Genres())
+	suite.Equal([]string{"Keanu Reeves"}, actual.Cast())
+	suite.Equal("R", actual.Rating())
+}
+
+func (suite *TitleConstructorTestSuite) TestReincarnate_ShouldCreateGivenEntity() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(entity.ID(101), "The Matrix", 1999, 136, "some.synopsis",
+		[]string{"Action"}, []string{"Keanu Reeves"}, "R")
+
+	// Verify results
+	suite.Equal(entity.ID(101), actual.ID())
+	suite.Equal("The Matrix", actual.Name())
+	suite.Equal(1999, actual.Year())
+	suite.Equal(136, actual.Runtime())
+	suite.Equal("some.synopsis", actual.Synopsis())
+	suite.Equal([]string{"Action"}, actual.Genres())
+	suite.Equal([]string{"Keanu Reeves"}, actual.Cast())
+	suite.Equal("R", actual.Rating())
+}
@@ -0,0 +1,221 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestTitle_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestTitleImplConstructor(101, "some.name", 1999, 136, "some.synopsis",
+		[]string{"some.genre"}, []string{"some.cast"}, "some.rating")
+
+	// Verify results
+	assert.Equal(t, entity.ID(101), fixture.ID())
+	assert.Equal(t, "some.name", fixture.Name())
+	assert.Equal(t, 1999, fixture.Year())
+	assert.Equal(t, 136, fixture.Runtime())
+	assert.Equal(t, "some.synopsis", fixture.Synopsis())
+	assert.Equal(t, []string{"some.genre"}, fixture.Genres())
+	assert.Equal(t, []string{"some.cast"}, fixture.Cast())
+	assert.Equal(t, "some.rating", fixture.Rating())
+}
+
+func TestTitle_Genres_ShouldReturnCopy(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestTitleImplConstructor(101, "", 1999, 0, "", []string{"some.genre"}, nil, "")
+
+	// Exercise SUT
+	actual := fixture.Genres()
+	actual[0] = "changed"
+
+	// Verify results
+	assert.Equal(t, []string{"some.genre"}, fixture.Genres())
+}
+
+func TestTitle_ChangeName_WhenGivenNameIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[title], problem=[must not be blank]"
+
+	// Exercise SUT
+	err := sut.ChangeName("")
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeName_WhenGivenNamePassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeName("The Matrix")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "The Matrix", sut.Name())
+}
+
+func TestTitle_ChangeYear_WhenGivenYearIsOutOfRange_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[year], problem=[must be between 1888 and 9999]"
+
+	// Exercise SUT
+	err1 := sut.ChangeYear(1887)
+	err2 := sut.ChangeYear(10000)
+
+	// Verify results
+	assert.EqualError(t, err1, expectedErr)
+	assert.EqualError(t, err2, expectedErr)
+}
+
+func TestTitle_ChangeYear_WhenGivenYearPassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeYear(1888)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, 1888, sut.Year())
+}
+
+func TestTitle_ChangeRuntime_WhenGivenRuntimeIsNegative_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[runtime], problem=[must not be negative]"
+
+	// Exercise SUT
+	err := sut.ChangeRuntime(-1)
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeRuntime_WhenGivenRuntimePassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeRuntime(136)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, 136, sut.Runtime())
+}
+
+func TestTitle_ChangeSynopsis_WhenGivenSynopsisIsNotTrimmed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[synopsis], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	err := sut.ChangeSynopsis(" duck")
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeSynopsis_WhenGivenSynopsisIsBlank_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "some.synopsis", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeSynopsis("")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "", sut.Synopsis())
+}
+
+func TestTitle_ChangeGenres_WhenAGenreIsBlank_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[genres], problem=[must not be blank]"
+
+	// Exercise SUT
+	err := sut.ChangeGenres([]string{"Action", ""})
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeGenres_WhenGenresPassValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeGenres([]string{"Action", "Sci-Fi"})
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Action", "Sci-Fi"}, sut.Genres())
+}
+
+func TestTitle_ChangeCast_WhenAMemberIsNotTrimmed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[cast], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	err := sut.ChangeCast([]string{"Keanu Reeves "})
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeCast_WhenCastPassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeCast([]string{"Keanu Reeves"})
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Keanu Reeves"}, sut.Cast())
+}
+
+func TestTitle_ChangeRating_WhenGivenRatingIsNotTrimmed_ShouldFail(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Setup expectations
+	expectedErr := "validation error: field=[rating], problem=[must not have whitespace at the beginning or the end]"
+
+	// Exercise SUT
+	err := sut.ChangeRating("R ")
+
+	// Verify results
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestTitle_ChangeRating_WhenGivenRatingPassesValidation_ShouldChange(t *testing.T) {
+	// Setup fixture
+	sut := entity.TestTitleImplConstructor(101, "", 1999, 0, "", nil, nil, "")
+
+	// Exercise SUT
+	err := sut.ChangeRating("R")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, "R", sut.Rating())
+}
@@ -0,0 +1,95 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type WebhookDeliveryConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.WebhookDeliveryConstructorImpl
+}
+
+func TestWebhookDeliveryConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryConstructorTestSuite))
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewWebhookDeliveryConstructorImpl()
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) TestNew_WhenSubscriptionIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[subscriptionId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(entity.InvalidID, 12, entity.EventItemCheckedIn, 101, nil, occurredFixture, attemptFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) TestNew_WhenEventIDValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[eventId], problem=[must be a positive id]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(401, 0, entity.EventItemCheckedIn, 101, nil, occurredFixture, attemptFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) TestNew_WhenEventTypeValidationFails_ShouldFail() {
+	// Setup expectations
+	expectedErr := "validation error: field=[eventType], problem=[must be one of inventory.item.created, inventory.item.checked-out, inventory.item.checked-in, inventory.item.held, inventory.item.renewed, inventory.item.deleted, inventory.item.moved]"
+
+	// Exercise SUT
+	actual, err := suite.sut.New(401, 12, "title.created", 101, nil, occurredFixture, attemptFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreatePendingDelivery() {
+	// Setup fixture
+	data := map[string]interface{}{"titleId": float64(11)}
+
+	// Exercise SUT
+	actual, err := suite.sut.New(401, 12, entity.EventItemCheckedIn, 101, data, occurredFixture, attemptFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal(entity.ID(401), actual.SubscriptionID())
+	suite.Equal(entity.ID(12), actual.EventID())
+	suite.Equal(entity.EventItemCheckedIn, actual.EventType())
+	suite.Equal(entity.ID(101), actual.EntityID())
+	suite.Equal(data, actual.Data())
+	suite.Equal(occurredFixture, actual.OccurredAt())
+	suite.Equal(entity.DeliveryPending, actual.Status())
+	suite.Equal(0, actual.Attempts())
+	suite.Equal(attemptFixture, actual.NextAttemptAt())
+	suite.Nil(actual.LastAttemptAt())
+}
+
+func (suite *WebhookDeliveryConstructorTestSuite) TestReincarnate_ShouldRestoreFields() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(501, 401, 12, entity.EventItemCheckedIn, 101, nil, occurredFixture,
+		entity.DeliveryDead, 8, retryFixture, &attemptFixture, 500, "mock.error")
+
+	// Verify results
+	suite.Equal(entity.ID(501), actual.ID())
+	suite.Equal(entity.DeliveryDead, actual.Status())
+	suite.Equal(8, actual.Attempts())
+	suite.Equal(retryFixture, actual.NextAttemptAt())
+	suite.Equal(&attemptFixture, actual.LastAttemptAt())
+	suite.Equal(500, actual.LastStatusCode())
+	suite.Equal("mock.error", actual.LastError())
+}
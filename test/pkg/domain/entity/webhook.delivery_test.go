@@ -0,0 +1,139 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+var (
+	occurredFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	attemptFixture  = time.Date(2020, 1, 2, 3, 5, 0, 0, time.UTC)
+	retryFixture    = time.Date(2020, 1, 2, 3, 5, 30, 0, time.UTC)
+)
+
+func TestWebhookDelivery_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 2)
+
+	// Verify results
+	assert.Equal(t, entity.ID(501), fixture.ID())
+	assert.Equal(t, entity.ID(401), fixture.SubscriptionID())
+	assert.Equal(t, entity.ID(12), fixture.EventID())
+	assert.Equal(t, entity.EventItemCheckedIn, fixture.EventType())
+	assert.Equal(t, entity.ID(101), fixture.EntityID())
+	assert.Nil(t, fixture.Data())
+	assert.Equal(t, occurredFixture, fixture.OccurredAt())
+	assert.Equal(t, entity.DeliveryPending, fixture.Status())
+	assert.Equal(t, 2, fixture.Attempts())
+	assert.Equal(t, occurredFixture, fixture.NextAttemptAt())
+	assert.Nil(t, fixture.LastAttemptAt())
+	assert.Equal(t, 0, fixture.LastStatusCode())
+	assert.Equal(t, "", fixture.LastError())
+}
+
+func TestWebhookDelivery_Succeed_WhenPending_ShouldPass(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 2)
+
+	// Exercise SUT
+	err := fixture.Succeed(attemptFixture, 204)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DeliveryDelivered, fixture.Status())
+	assert.Equal(t, 3, fixture.Attempts())
+	assert.Equal(t, &attemptFixture, fixture.LastAttemptAt())
+	assert.Equal(t, 204, fixture.LastStatusCode())
+	assert.Equal(t, "", fixture.LastError())
+}
+
+func TestWebhookDelivery_Retry_WhenPending_ShouldPass(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 0)
+
+	// Exercise SUT
+	err := fixture.Retry(attemptFixture, 503, "mock.error", retryFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DeliveryPending, fixture.Status())
+	assert.Equal(t, 1, fixture.Attempts())
+	assert.Equal(t, retryFixture, fixture.NextAttemptAt())
+	assert.Equal(t, &attemptFixture, fixture.LastAttemptAt())
+	assert.Equal(t, 503, fixture.LastStatusCode())
+	assert.Equal(t, "mock.error", fixture.LastError())
+}
+
+func TestWebhookDelivery_GiveUp_WhenPending_ShouldPass(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 7)
+
+	// Exercise SUT
+	err := fixture.GiveUp(attemptFixture, 0, "mock.error")
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DeliveryDead, fixture.Status())
+	assert.Equal(t, 8, fixture.Attempts())
+	assert.Equal(t, &attemptFixture, fixture.LastAttemptAt())
+	assert.Equal(t, 0, fixture.LastStatusCode())
+	assert.Equal(t, "mock.error", fixture.LastError())
+}
+
+func TestWebhookDelivery_Attempt_WhenNotPending_ShouldFail(t *testing.T) {
+	var tests = []struct {
+		name    string
+		attempt func(entity.WebhookDelivery) error
+	}{
+		{"Succeed", func(d entity.WebhookDelivery) error { return d.Succeed(attemptFixture, 200) }},
+		{"Retry", func(d entity.WebhookDelivery) error { return d.Retry(attemptFixture, 500, "mock.error", retryFixture) }},
+		{"GiveUp", func(d entity.WebhookDelivery) error { return d.GiveUp(attemptFixture, 500, "mock.error") }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Setup fixture
+			fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 0)
+			fixture.Succeed(occurredFixture, 200)
+
+			// Exercise SUT
+			err := test.attempt(fixture)
+
+			// Verify results
+			assert.EqualError(t, err, "cannot attempt webhook delivery - it is delivered")
+			assert.Equal(t, 1, fixture.Attempts())
+		})
+	}
+}
+
+func TestWebhookDelivery_Redeliver_WhenDead_ShouldPass(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 7)
+	fixture.GiveUp(attemptFixture, 500, "mock.error")
+
+	// Exercise SUT
+	err := fixture.Redeliver(retryFixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DeliveryPending, fixture.Status())
+	assert.Equal(t, 0, fixture.Attempts())
+	assert.Equal(t, retryFixture, fixture.NextAttemptAt())
+	assert.Equal(t, "mock.error", fixture.LastError())
+}
+
+func TestWebhookDelivery_Redeliver_WhenNotDead_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedIn, 101, occurredFixture, 1)
+
+	// Exercise SUT
+	err := fixture.Redeliver(retryFixture)
+
+	// Verify results
+	assert.EqualError(t, err, "cannot redeliver webhook delivery - conflict error: type=[webhook delivery], problem=[it is pending]")
+	assert.Equal(t, 1, fixture.Attempts())
+}
@@ -0,0 +1,125 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+type WebhookSubscriptionConstructorTestSuite struct {
+	suite.Suite
+	sut *entity.WebhookSubscriptionConstructorImpl
+}
+
+func TestWebhookSubscriptionConstructorTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriptionConstructorTestSuite))
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) SetupTest() {
+	suite.sut = entity.NewWebhookSubscriptionConstructorImpl()
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) TestNew_WhenURLValidationFails_ShouldFail() {
+	var tests = []string{
+		"",
+		"partner.example/hooks",
+		"/hooks",
+		"ftp://partner.example/hooks",
+		"https://",
+		"http://[::1",
+	}
+	for _, fixture := range tests {
+		suite.Run(fixture, func() {
+			// Setup expectations
+			expectedErr := "validation error: field=[url], problem=[must be an absolute http or https URL]"
+
+			// Exercise SUT
+			actual, err := suite.sut.New(fixture, []entity.EventType{entity.EventItemCheckedIn}, "some.long.secret.value", createdFixture)
+
+			// Verify results
+			suite.EqualError(err, expectedErr)
+			suite.Nil(actual)
+		})
+	}
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) TestNew_WhenEventTypesValidationFails_ShouldFail() {
+	var tests = []struct {
+		eventTypes  []entity.EventType
+		expectedErr string
+	}{
+		{
+			nil,
+			"validation error: field=[eventTypes], problem=[must not be empty]",
+		},
+		{
+			[]entity.EventType{"title.created"},
+			"validation error: field=[eventTypes], problem=[must be one of inventory.item.created, inventory.item.checked-out, inventory.item.checked-in, inventory.item.held, inventory.item.renewed, inventory.item.deleted, inventory.item.moved]",
+		},
+		{
+			[]entity.EventType{entity.EventItemCheckedIn, entity.EventItemCheckedIn},
+			"validation error: field=[eventTypes], problem=[must not repeat inventory.item.checked-in]",
+		},
+	}
+	for _, test := range tests {
+		suite.Run(test.expectedErr, func() {
+			// Exercise SUT
+			actual, err := suite.sut.New("https://partner.example/hooks", test.eventTypes, "some.long.secret.value", createdFixture)
+
+			// Verify results
+			suite.EqualError(err, test.expectedErr)
+			suite.Nil(actual)
+		})
+	}
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) TestNew_WhenSecretValidationFails_ShouldFail() {
+	var tests = []struct {
+		secret      string
+		expectedErr string
+	}{
+		{"", "validation error: field=[secret], problem=[must not be blank]"},
+		{" some.long.secret.value", "validation error: field=[secret], problem=[must not have whitespace at the beginning or the end]"},
+		{"short.secret", "validation error: field=[secret], problem=[must be at least 16 characters]"},
+	}
+	for _, test := range tests {
+		suite.Run(test.secret, func() {
+			// Exercise SUT
+			actual, err := suite.sut.New("https://partner.example/hooks", []entity.EventType{entity.EventItemCheckedIn}, test.secret, createdFixture)
+
+			// Verify results
+			suite.EqualError(err, test.expectedErr)
+			suite.Nil(actual)
+		})
+	}
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) TestNew_WhenValidationPasses_ShouldCreateSubscription() {
+	// Setup fixture
+	eventTypes := []entity.EventType{entity.EventItemCreated, entity.EventItemCheckedIn}
+
+	// Exercise SUT
+	actual, err := suite.sut.New("http://localhost:8080/hooks", eventTypes, "some.long.secret.value", createdFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.InvalidID, actual.ID())
+	suite.Equal("http://localhost:8080/hooks", actual.URL())
+	suite.Equal(eventTypes, actual.EventTypes())
+	suite.Equal("some.long.secret.value", actual.Secret())
+	suite.Equal(createdFixture, actual.CreatedAt())
+}
+
+func (suite *WebhookSubscriptionConstructorTestSuite) TestReincarnate_ShouldBypassValidation() {
+	// Exercise SUT
+	actual := suite.sut.Reincarnate(401, "", nil, "", createdFixture)
+
+	// Verify results
+	suite.Equal(entity.ID(401), actual.ID())
+	suite.Equal("", actual.URL())
+	suite.Nil(actual.EventTypes())
+	suite.Equal("", actual.Secret())
+	suite.Equal(createdFixture, actual.CreatedAt())
+}
@@ -0,0 +1,51 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+var createdFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestWebhookSubscription_Getters_ShouldReturnFields(t *testing.T) {
+	// Setup fixture
+	eventTypes := []entity.EventType{entity.EventItemCheckedIn}
+	fixture := entity.TestWebhookSubscriptionImplConstructor(401, "https://partner.example/hooks", eventTypes, "some.long.secret.value", createdFixture)
+
+	// Verify results
+	assert.Equal(t, entity.ID(401), fixture.ID())
+	assert.Equal(t, "https://partner.example/hooks", fixture.URL())
+	assert.Equal(t, eventTypes, fixture.EventTypes())
+	assert.Equal(t, "some.long.secret.value", fixture.Secret())
+	assert.Equal(t, createdFixture, fixture.CreatedAt())
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	var tests = []struct {
+		eventType entity.EventType
+		expected  bool
+	}{
+		{entity.EventItemCreated, true},
+		{entity.EventItemCheckedIn, true},
+		{entity.EventItemCheckedOut, false},
+		{entity.EventItemDeleted, false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.eventType), func(t *testing.T) {
+			// Setup fixture
+			fixture := entity.TestWebhookSubscriptionImplConstructor(401, "https://partner.example/hooks",
+				[]entity.EventType{entity.EventItemCreated, entity.EventItemCheckedIn}, "some.long.secret.value", createdFixture)
+
+			// Exercise SUT
+			actual := fixture.Subscribes(test.eventType)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestLateFeePolicy_Calculate(t *testing.T) {
+	due := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	defaultRule := domain.LateFeeRule{PerDay: 100, GraceDays: 1, Cap: 500}
+	overrides := map[entity.Format]domain.LateFeeRule{
+		entity.FormatVHS: {PerDay: 50},
+	}
+
+	var tests = []struct {
+		format     entity.Format
+		returnedAt time.Time
+		expected   domain.LateFee
+	}{
+		// On time
+		{entity.FormatDVD, due.Add(-day), domain.LateFee{}},
+		{entity.FormatDVD, due, domain.LateFee{}},
+		// Within the grace period
+		{entity.FormatDVD, due.Add(time.Second), domain.LateFee{DaysLate: 1, Amount: 0}},
+		{entity.FormatDVD, due.Add(day), domain.LateFee{DaysLate: 1, Amount: 0}},
+		// Part of a day counts as a whole day
+		{entity.FormatDVD, due.Add(day + time.Second), domain.LateFee{DaysLate: 2, Amount: 100}},
+		{entity.FormatDVD, due.Add(3 * day), domain.LateFee{DaysLate: 3, Amount: 200}},
+		// Capped
+		{entity.FormatDVD, due.Add(6 * day), domain.LateFee{DaysLate: 6, Amount: 500}},
+		{entity.FormatDVD, due.Add(30 * day), domain.LateFee{DaysLate: 30, Amount: 500}},
+		// Overridden - no grace period or cap
+		{entity.FormatVHS, due.Add(day), domain.LateFee{DaysLate: 1, Amount: 50}},
+		{entity.FormatVHS, due.Add(30 * day), domain.LateFee{DaysLate: 30, Amount: 1500}},
+	}
+
+	for _, test := range tests {
+		t.Run("", func(t *testing.T) {
+			// Setup fixture
+			sut := domain.NewLateFeePolicyImpl(defaultRule, overrides)
+
+			// Exercise SUT
+			actual := sut.Calculate(test.format, due, test.returnedAt)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
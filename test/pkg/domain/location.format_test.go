@@ -0,0 +1,105 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestNewLocationFormatImpl(t *testing.T) {
+	var tests = []struct {
+		template    string
+		expectedErr string
+	}{
+		{"{store}-{aisle}-{shelf}-{slot}", ""},
+		{"{aisle}{shelf}/{slot} @ {store}", "must separate {aisle} and {shelf} with something which is not a letter or digit"},
+		{"AISLE {aisle}, SHELF {shelf}, SLOT {slot} ({store})", ""},
+		{"{store}-{aisle}-{shelf}", "must have each of {store}, {aisle}, {shelf} and {slot}"},
+		{"{store}-{aisle}-{shelf}-{slot}-{slot}", "has {slot} more than once"},
+		{"{store}-{row}-{shelf}-{slot}", "has an unknown part {row}"},
+		{"{store}-{aisle}x{shelf}-{slot}", "must separate {aisle} and {shelf} with something which is not a letter or digit"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.template, func(t *testing.T) {
+			// Exercise SUT
+			actual, err := domain.NewLocationFormatImpl(test.template)
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.NotNil(t, actual)
+			} else {
+				assert.Nil(t, actual)
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestLocationFormat_Parse(t *testing.T) {
+	var tests = []struct {
+		template    string
+		text        string
+		expected    entity.Location
+		expectedErr string
+	}{
+		{"{store}-{aisle}-{shelf}-{slot}", "main-A-1-12", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, ""},
+		{"{store}-{aisle}-{shelf}-{slot}", "main-A-1", entity.Location{}, "validation error: field=[location], problem=[must look like {store}-{aisle}-{shelf}-{slot}]"},
+		{"{store}-{aisle}-{shelf}-{slot}", "main-A-1-12 ", entity.Location{}, "validation error: field=[location], problem=[must look like {store}-{aisle}-{shelf}-{slot}]"},
+		{"{store}-{aisle}-{shelf}-{slot}", "main-A-1-1 2", entity.Location{}, "validation error: field=[location], problem=[must look like {store}-{aisle}-{shelf}-{slot}]"},
+		// Parts may be given in any order
+		{"{slot}.{shelf}.{aisle}@{store}", "12.1.A@main", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, ""},
+		// Literal text which means something in a regexp
+		{"({store}) {aisle}.{shelf}*{slot}", "(main) A.1*12", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}, ""},
+		{"({store}) {aisle}.{shelf}*{slot}", "(main) A-1*12", entity.Location{}, "validation error: field=[location], problem=[must look like ({store}) {aisle}.{shelf}*{slot}]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			// Setup fixture
+			sut, err := domain.NewLocationFormatImpl(test.template)
+			assert.NoError(t, err)
+
+			// Exercise SUT
+			actual, err := sut.Parse(test.text)
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestLocationFormat_Format(t *testing.T) {
+	location := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	var tests = []struct {
+		template string
+		expected string
+	}{
+		{"{store}-{aisle}-{shelf}-{slot}", "main-A-1-12"},
+		{"{slot}.{shelf}.{aisle}@{store}", "12.1.A@main"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.template, func(t *testing.T) {
+			// Setup fixture
+			sut, err := domain.NewLocationFormatImpl(test.template)
+			assert.NoError(t, err)
+
+			// Exercise SUT
+			actual := sut.Format(location)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
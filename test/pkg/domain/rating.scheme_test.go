@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+)
+
+func TestRatingScheme_Validate(t *testing.T) {
+	overrides := map[string]int{"X": 18, "R": 18}
+
+	var tests = []struct {
+		rating      string
+		expectedErr string
+	}{
+		// Unrated
+		{"", ""},
+		// In the scheme
+		{"PG-13", ""},
+		// Added by override
+		{"X", ""},
+		// Not in the scheme
+		{"15", "validation error: field=[rating], problem=[must be one of G, PG, PG-13, R, NC-17, X]"},
+		{"pg", "validation error: field=[rating], problem=[must be one of G, PG, PG-13, R, NC-17, X]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rating, func(t *testing.T) {
+			// Setup fixture
+			sut := domain.NewRatingSchemeImpl(domain.RatingSchemes["mpaa"], overrides)
+
+			// Exercise SUT
+			err := sut.Validate(test.rating)
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRatingScheme_MinimumAge(t *testing.T) {
+	overrides := map[string]int{"R": 18}
+
+	var tests = []struct {
+		rating   string
+		expected int
+	}{
+		{"", 0},
+		{"G", 0},
+		{"PG-13", 13},
+		// Overridden
+		{"R", 18},
+		// Not in the scheme
+		{"18", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rating, func(t *testing.T) {
+			// Setup fixture
+			sut := domain.NewRatingSchemeImpl(domain.RatingSchemes["mpaa"], overrides)
+
+			// Exercise SUT
+			actual := sut.MinimumAge(test.rating)
+
+			// Verify results
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRatingScheme_CheckAge(t *testing.T) {
+	on := time.Date(2020, 6, 15, 12, 0, 0, 0, time.UTC)
+	born2002 := time.Date(2002, 6, 15, 0, 0, 0, 0, time.UTC)
+	born2003 := time.Date(2003, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		rating      string
+		dateOfBirth *time.Time
+		expectedErr string
+	}{
+		// Not restricted
+		{"U", nil, ""},
+		{"", nil, ""},
+		{"PG", &born2003, ""},
+		// Old enough
+		{"18", &born2002, ""},
+		{"15", &born2003, ""},
+		// Too young
+		{"18", &born2003, "age restriction error: rating=[18], minimumAge=[18], problem=[account holder is 17]"},
+		// Age not known
+		{"15", nil, "age restriction error: rating=[15], minimumAge=[15], problem=[account has no date of birth]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rating, func(t *testing.T) {
+			// Setup fixture
+			sut := domain.NewRatingSchemeImpl(domain.RatingSchemes["bbfc"], nil)
+			renter := entity.TestAccountImplConstructor(101, "Jane Doe", test.dateOfBirth, 0)
+
+			// Exercise SUT
+			err := sut.CheckAge(test.rating, renter, on)
+
+			// Verify results
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
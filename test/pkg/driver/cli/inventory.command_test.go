@@ -0,0 +1,284 @@
+package cli_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	jsonMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http/json"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/cli"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type InventoryCommandImplTestSuite struct {
+	suite.Suite
+	mockInventoryService *inventoryMocks.MockService
+	mockEncoderService   *jsonMocks.MockEncoderService
+	out                  *bytes.Buffer
+}
+
+func TestInventoryCommandImplTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryCommandImplTestSuite))
+}
+
+func (suite *InventoryCommandImplTestSuite) SetupTest() {
+	suite.mockInventoryService = &inventoryMocks.MockService{}
+	suite.mockEncoderService = &jsonMocks.MockEncoderService{}
+	suite.out = &bytes.Buffer{}
+}
+
+func (suite *InventoryCommandImplTestSuite) sut(output string) *cli.InventoryCommandImpl {
+	return cli.NewInventoryCommandImpl(
+		suite.mockInventoryService,
+		suite.mockEncoderService,
+		suite.out,
+		output,
+	)
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_GivenInvalidArgs_ShouldFail() {
+	// Setup fixture
+	usage := "usage: inventory list [FORMAT]|get ID|create TITLE_ID FORMAT BARCODE LOCATION" +
+		"|update ID TITLE_ID FORMAT BARCODE LOCATION|delete ID|checkout ID ACCOUNT_ID|checkin ID"
+	var tests = []struct {
+		args        []string
+		expectedErr string
+	}{
+		{[]string{}, "missing inventory subcommand - " + usage},
+		{[]string{"lend"}, "unknown inventory subcommand: lend - " + usage},
+		{[]string{"list", "vhs", "dvd"}, "list expects at most 1 argument(s) (got 2) - " + usage},
+		{[]string{"get"}, "get expects 1 argument(s) (got 0) - " + usage},
+		{[]string{"get", "abc"}, "ID must be a whole number (is abc)"},
+		{[]string{"create", "1", "vhs", "A1"}, "create expects 4 argument(s) (got 3) - " + usage},
+		{[]string{"create", "x", "vhs", "A1", "1-2-3"}, "TITLE_ID must be a whole number (is x)"},
+		{[]string{"update", "1", "y", "vhs", "A1", "1-2-3"}, "TITLE_ID must be a whole number (is y)"},
+		{[]string{"checkout", "1", "z"}, "ACCOUNT_ID must be a whole number (is z)"},
+	}
+
+	for _, test := range tests {
+		suite.Run(fmt.Sprintf("%v", test.args), func() {
+			// Exercise SUT
+			err := suite.sut("table").Run(test.args)
+
+			// Verify results
+			suite.EqualError(err, test.expectedErr)
+			suite.Empty(suite.out.String())
+		})
+	}
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_List_AsTable_ShouldPrintItems() {
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAllOfFormat", mock.Anything, entity.FormatVHS).Return([]inventory.ThinViewVO{
+		{ID: 101, TitleID: 7, Format: entity.FormatVHS, Barcode: "A1"},
+		{ID: 1002, TitleID: 8, Format: entity.FormatVHS, Barcode: "B22"},
+	}, nil)
+
+	// Setup expectations
+	expected := "ID    TITLE ID  FORMAT  BARCODE\n" +
+		"101   7         vhs     A1\n" +
+		"1002  8         vhs     B22\n"
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"list", "vhs"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_List_AsJSON_ShouldPrintEncodedItems() {
+	// Setup fixture
+	vosFixture := []inventory.ThinViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAll", mock.Anything).Return(vosFixture, nil)
+	suite.mockEncoderService.On("FromInventoryItemThinViews", vosFixture).Return([]byte(`[{"id":101}]`), nil)
+
+	// Exercise SUT
+	err := suite.sut("json").Run([]string{"list"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("[{\"id\":101}]\n", suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_List_WhenServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAll", mock.Anything).Return(nil, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"list"})
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+	suite.Empty(suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Get_AsTable_ShouldPrintItem() {
+	// Setup mocks
+	dueAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockInventoryService.On("ReadDetails", mock.Anything, entity.ID(101)).Return(&inventory.ViewVO{
+		ID:       101,
+		TitleID:  7,
+		Format:   entity.FormatVHS,
+		Barcode:  "A1",
+		Location: "1-2-3",
+		DueAt:    &dueAt,
+	}, nil)
+
+	// Setup expectations
+	expected := "ID         101\n" +
+		"TITLE ID   7\n" +
+		"FORMAT     vhs\n" +
+		"BARCODE    A1\n" +
+		"LOCATION   1-2-3\n" +
+		"AVAILABLE  false\n" +
+		"DUE AT     2020-01-02\n" +
+		"OVERDUE    false\n"
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"get", "101"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Get_AsJSON_WhenEncoderFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.ViewVO{ID: 101}
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadDetails", mock.Anything, entity.ID(101)).Return(voFixture, nil)
+	suite.mockEncoderService.On("FromInventoryItemView", voFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut("json").Run([]string{"get", "101"})
+
+	// Verify results
+	suite.EqualError(err, "could not print result - encode error: mock.error")
+	suite.Empty(suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Create_ShouldPrintNewID() {
+	// Setup expectations
+	expectedVo := &inventory.CreateItemVO{
+		TitleID:  7,
+		Format:   entity.FormatVHS,
+		Barcode:  "A1",
+		Location: "1-2-3",
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("Create", mock.Anything, expectedVo).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	err := suite.sut("json").Run([]string{"create", "7", "vhs", "A1", "1-2-3"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("101\n", suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Update_ShouldUpdateAndPrintNothing() {
+	// Setup expectations
+	expectedVo := &inventory.UpdateItemVO{
+		TitleID:  7,
+		Format:   entity.FormatDVD,
+		Barcode:  "A1",
+		Location: "1-2-3",
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("Update", mock.Anything, entity.ID(101), expectedVo).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"update", "101", "7", "dvd", "A1", "1-2-3"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Empty(suite.out.String())
+	suite.mockInventoryService.AssertExpectations(suite.T())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Delete_WhenServiceFails_ShouldFail() {
+	// Setup mocks
+	suite.mockInventoryService.On("Delete", mock.Anything, entity.ID(101)).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"delete", "101"})
+
+	// Verify results
+	suite.EqualError(err, "mock.error")
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Checkout_ShouldCheckoutToAccount() {
+	// Setup mocks
+	suite.mockInventoryService.On("Checkout", mock.Anything, entity.ID(101), &inventory.CheckoutVO{AccountID: 5}).
+		Return(nil)
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"checkout", "101", "5"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Empty(suite.out.String())
+	suite.mockInventoryService.AssertExpectations(suite.T())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Checkin_AsTable_ShouldPrintReceiptLine() {
+	// Setup mocks
+	suite.mockInventoryService.On("CheckIn", mock.Anything, entity.ID(101)).Return(&rental.ReceiptLineVO{
+		RentalID:   1,
+		ItemID:     101,
+		AccountID:  5,
+		DueAt:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		ReturnedAt: time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC),
+		DaysLate:   2,
+		LateFee:    200,
+	}, nil)
+
+	// Setup expectations
+	expected := "RENTAL ID         1\n" +
+		"ITEM ID           101\n" +
+		"ACCOUNT ID        5\n" +
+		"DUE AT            2020-01-02\n" +
+		"RETURNED AT       2020-01-04\n" +
+		"DAYS LATE         2\n" +
+		"LATE FEE (CENTS)  200\n"
+
+	// Exercise SUT
+	err := suite.sut("table").Run([]string{"checkin", "101"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, suite.out.String())
+}
+
+func (suite *InventoryCommandImplTestSuite) TestRun_Checkin_WhenItemWasNotRentedOut_ShouldSayNoRental() {
+	for _, output := range []string{"table", "json"} {
+		suite.Run(output, func() {
+			// Setup fixture
+			suite.out.Reset()
+
+			// Setup mocks
+			suite.mockInventoryService.On("CheckIn", mock.Anything, entity.ID(101)).Return(nil, nil)
+
+			// Exercise SUT
+			err := suite.sut(output).Run([]string{"checkin", "101"})
+
+			// Verify results
+			suite.NoError(err)
+			suite.Equal("checked in, no rental\n", suite.out.String())
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package cli_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	dbMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/db"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/cli"
+)
+
+type MigrateCommandImplTestSuite struct {
+	suite.Suite
+	mockMigrator *dbMocks.MockMigrator
+	out          *bytes.Buffer
+	sut          *cli.MigrateCommandImpl
+}
+
+func TestMigrateCommandImplTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateCommandImplTestSuite))
+}
+
+func (suite *MigrateCommandImplTestSuite) SetupTest() {
+	suite.mockMigrator = &dbMocks.MockMigrator{}
+	suite.out = &bytes.Buffer{}
+	suite.sut = cli.NewMigrateCommandImpl(
+		suite.mockMigrator,
+		suite.out,
+	)
+}
+
+func (suite *MigrateCommandImplTestSuite) TestRun_GivenInvalidArgs_ShouldFail() {
+	// Setup fixture
+	var tests = []struct {
+		args        []string
+		expectedErr string
+	}{
+		{[]string{}, "missing migrate subcommand - usage: migrate up|down N|goto V|version|force V"},
+		{[]string{"sideways"}, "unknown migrate subcommand: sideways - usage: migrate up|down N|goto V|version|force V"},
+		{[]string{"up", "1"}, "up expects 0 argument(s) (got 1) - usage: migrate up|down N|goto V|version|force V"},
+		{[]string{"down"}, "down expects 1 argument(s) (got 0) - usage: migrate up|down N|goto V|version|force V"},
+		{[]string{"down", "0"}, "down requires a positive number of steps (is 0)"},
+		{[]string{"goto", "-1"}, "goto requires a version (is -1)"},
+		{[]string{"force", "x"}, "force requires a version (is x)"},
+	}
+
+	for _, test := range tests {
+		suite.Run(fmt.Sprintf("%v", test.args), func() {
+			// Exercise SUT
+			err := suite.sut.Run(test.args)
+
+			// Verify results
+			suite.EqualError(err, test.expectedErr)
+			suite.Empty(suite.out.String())
+		})
+	}
+}
+
+func (suite *MigrateCommandImplTestSuite) TestRun_GivenValidArgs_ShouldMigrateAndPrintVersion() {
+	// Setup fixture
+	var tests = []struct {
+		args   []string
+		method string
+		arg    interface{}
+	}{
+		{[]string{"up"}, "Up", nil},
+		{[]string{"down", "2"}, "Down", 2},
+		{[]string{"goto", "3"}, "Goto", uint(3)},
+		{[]string{"force", "4"}, "Force", 4},
+		{[]string{"version"}, "", nil},
+	}
+
+	for _, test := range tests {
+		suite.Run(fmt.Sprintf("%v", test.args), func() {
+			suite.SetupTest()
+
+			// Setup mocks
+			switch {
+			case test.method == "":
+			case test.arg == nil:
+				suite.mockMigrator.On(test.method).Return(nil)
+			default:
+				suite.mockMigrator.On(test.method, test.arg).Return(nil)
+			}
+			suite.mockMigrator.On("Version").Return(uint(5), true, nil)
+
+			// Exercise SUT
+			err := suite.sut.Run(test.args)
+
+			// Verify results
+			suite.NoError(err)
+			suite.Equal("DB Migration Version: 5. Dirty: true\n", suite.out.String())
+			suite.mockMigrator.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *MigrateCommandImplTestSuite) TestRun_WhenMigratorFails_ShouldFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockMigrator.On("Up").Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.Run([]string{"up"})
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.Empty(suite.out.String())
+}
+
+func (suite *MigrateCommandImplTestSuite) TestRun_WhenVersionFails_ShouldFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockMigrator.On("Version").Return(uint(0), false, mockErr)
+
+	// Exercise SUT
+	err := suite.sut.Run([]string{"version"})
+
+	// Verify results
+	suite.Equal(mockErr, err)
+}
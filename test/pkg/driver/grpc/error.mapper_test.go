@@ -0,0 +1,93 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/db"
+	"github.com/liampulles/matchstick-video/pkg/domain/commonerror"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc"
+)
+
+type ErrorMapperImplTestSuite struct {
+	suite.Suite
+	sut *grpc.ErrorMapperImpl
+}
+
+func TestErrorMapperImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ErrorMapperImplTestSuite))
+}
+
+func (suite *ErrorMapperImplTestSuite) SetupTest() {
+	suite.sut = grpc.NewErrorMapperImpl()
+}
+
+func (suite *ErrorMapperImplTestSuite) TestToStatus_ShouldMapErrorsInTheChainToCodes() {
+	var tests = []struct {
+		errFixture   error
+		expectedCode codes.Code
+	}{
+		{
+			fmt.Errorf("wrapped: %w", context.DeadlineExceeded),
+			codes.DeadlineExceeded,
+		},
+		{
+			fmt.Errorf("wrapped: %w", context.Canceled),
+			codes.Canceled,
+		},
+		{
+			fmt.Errorf("wrapped: %w", commonerror.NewValidation("some.field", "some.reason")),
+			codes.InvalidArgument,
+		},
+		{
+			fmt.Errorf("wrapped: %w", commonerror.NewNotImplemented("some.package", "some.struct", "some.method")),
+			codes.Unimplemented,
+		},
+		{
+			fmt.Errorf("wrapped: %w", commonerror.NewAgeRestriction("R", 17, "some.problem")),
+			codes.PermissionDenied,
+		},
+		{
+			fmt.Errorf("wrapped: %w", commonerror.NewCreditLimit(1500, 1000)),
+			codes.FailedPrecondition,
+		},
+		{
+			fmt.Errorf("wrapped: %w", commonerror.NewConflict("some.type", "some.problem")),
+			codes.FailedPrecondition,
+		},
+		{
+			fmt.Errorf("wrapped: %w", db.NewNotFoundError("some.type")),
+			codes.NotFound,
+		},
+		{
+			fmt.Errorf("wrapped: %w", db.NewUniqueConstraintError(fmt.Errorf("some.cause"))),
+			codes.AlreadyExists,
+		},
+		{
+			fmt.Errorf("wrapped: %w", db.NewForeignKeyConstraintError(fmt.Errorf("some.cause"))),
+			codes.InvalidArgument,
+		},
+		{
+			fmt.Errorf("some.error"),
+			codes.Internal,
+		},
+	}
+
+	for i, test := range tests {
+		suite.Run(fmt.Sprintf("[%d]", i), func() {
+			// Exercise SUT
+			err := suite.sut.ToStatus(test.errFixture)
+
+			// Verify results
+			actual, ok := status.FromError(err)
+			suite.True(ok)
+			suite.Equal(test.expectedCode, actual.Code())
+			suite.Equal(test.errFixture.Error(), actual.Message())
+		})
+	}
+}
@@ -0,0 +1,475 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	goGrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpcMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/grpc"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc/inventorypb"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type InventoryServerImplTestSuite struct {
+	suite.Suite
+	mockInventoryService *inventoryMocks.MockService
+	mockErrorMapper      *grpcMocks.MockErrorMapper
+	sut                  *grpc.InventoryServerImpl
+}
+
+func TestInventoryServerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryServerImplTestSuite))
+}
+
+func (suite *InventoryServerImplTestSuite) SetupTest() {
+	suite.mockInventoryService = &inventoryMocks.MockService{}
+	suite.mockErrorMapper = &grpcMocks.MockErrorMapper{}
+	suite.sut = grpc.NewInventoryServerImpl(
+		suite.mockInventoryService,
+		suite.mockErrorMapper,
+	)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCreate_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+	reqFixture := &inventorypb.CreateItemRequest{
+		TitleId:  201,
+		Format:   "vhs",
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+
+	// Setup expectations
+	expectedVO := &inventory.CreateItemVO{
+		TitleID:  entity.ID(201),
+		Format:   entity.FormatVHS,
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("Create", ctx, expectedVO).Return(entity.InvalidID, mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(ctx, reqFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCreate_WhenServiceSucceeds_ShouldReturnID() {
+	// Setup fixture
+	ctx := context.Background()
+	reqFixture := &inventorypb.CreateItemRequest{
+		TitleId: 201,
+		Format:  "vhs",
+		Barcode: "some.barcode",
+	}
+
+	// Setup expectations
+	expectedVO := &inventory.CreateItemVO{
+		TitleID: entity.ID(201),
+		Format:  entity.FormatVHS,
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("Create", ctx, expectedVO).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(ctx, reqFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(int64(101), actual.GetId())
+}
+
+func (suite *InventoryServerImplTestSuite) TestRead_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("ReadDetails", ctx, entity.ID(101)).Return(nil, mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestRead_WhenRentedOut_ShouldReturnItemWithDueDate() {
+	// Setup fixture
+	ctx := context.Background()
+	dueAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Setup expectations
+	expected := &inventorypb.Item{
+		Id:       101,
+		TitleId:  201,
+		Format:   "vhs",
+		Barcode:  "some.barcode",
+		Location: "some.location",
+		DueAt:    timestamppb.New(dueAt),
+		Overdue:  true,
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadDetails", ctx, entity.ID(101)).Return(&inventory.ViewVO{
+		ID:       101,
+		TitleID:  201,
+		Format:   entity.FormatVHS,
+		Barcode:  "some.barcode",
+		Location: "some.location",
+		DueAt:    &dueAt,
+		Overdue:  true,
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected.String(), actual.String())
+}
+
+func (suite *InventoryServerImplTestSuite) TestRead_WhenAvailable_ShouldReturnItemWithoutDueDate() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadDetails", ctx, entity.ID(101)).Return(&inventory.ViewVO{
+		ID:        101,
+		Available: true,
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+
+	// Verify results
+	suite.NoError(err)
+	suite.True(actual.GetAvailable())
+	suite.Nil(actual.GetDueAt())
+}
+
+func (suite *InventoryServerImplTestSuite) TestList_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	stream := newStubListServer()
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("ReadAll", stream.Context()).Return(nil, mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	err := suite.sut.List(&inventorypb.ListItemsRequest{}, stream)
+
+	// Verify results
+	suite.Equal(expectedErr, err)
+	suite.Empty(stream.sent)
+}
+
+func (suite *InventoryServerImplTestSuite) TestList_WhenNoFormat_ShouldSendEachItem() {
+	// Setup fixture
+	stream := newStubListServer()
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAll", stream.Context()).Return([]inventory.ThinViewVO{
+		{ID: 101, TitleID: 201, Format: entity.FormatVHS, Barcode: "some.barcode"},
+		{ID: 102, TitleID: 202, Format: entity.FormatDVD, Barcode: "another.barcode"},
+	}, nil)
+
+	// Exercise SUT
+	err := suite.sut.List(&inventorypb.ListItemsRequest{}, stream)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Len(stream.sent, 2)
+	suite.Equal(int64(101), stream.sent[0].GetId())
+	suite.Equal(int64(201), stream.sent[0].GetTitleId())
+	suite.Equal("vhs", stream.sent[0].GetFormat())
+	suite.Equal("some.barcode", stream.sent[0].GetBarcode())
+	suite.Equal(int64(102), stream.sent[1].GetId())
+}
+
+func (suite *InventoryServerImplTestSuite) TestList_WhenFormatGiven_ShouldSendItemsOfFormat() {
+	// Setup fixture
+	stream := newStubListServer()
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAllOfFormat", stream.Context(), entity.FormatVHS).Return([]inventory.ThinViewVO{
+		{ID: 101, Format: entity.FormatVHS},
+	}, nil)
+
+	// Exercise SUT
+	err := suite.sut.List(&inventorypb.ListItemsRequest{Format: "vhs"}, stream)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Len(stream.sent, 1)
+	suite.Equal(int64(101), stream.sent[0].GetId())
+}
+
+func (suite *InventoryServerImplTestSuite) TestList_WhenSendFails_ShouldFail() {
+	// Setup fixture
+	stream := newStubListServer()
+	stream.err = fmt.Errorf("mock.error")
+
+	// Setup mocks
+	suite.mockInventoryService.On("ReadAll", stream.Context()).Return([]inventory.ThinViewVO{
+		{ID: 101},
+		{ID: 102},
+	}, nil)
+
+	// Exercise SUT
+	err := suite.sut.List(&inventorypb.ListItemsRequest{}, stream)
+
+	// Verify results
+	suite.Equal(stream.err, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestUpdate_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+	reqFixture := &inventorypb.UpdateItemRequest{Id: 101, Barcode: "some.barcode"}
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("Update", ctx, entity.ID(101), &inventory.UpdateItemVO{Barcode: "some.barcode"}).Return(mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Update(ctx, reqFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestUpdate_WhenServiceSucceeds_ShouldReturnEmpty() {
+	// Setup fixture
+	ctx := context.Background()
+	reqFixture := &inventorypb.UpdateItemRequest{
+		Id:       101,
+		TitleId:  201,
+		Format:   "dvd",
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+
+	// Setup expectations
+	expectedVO := &inventory.UpdateItemVO{
+		TitleID:  entity.ID(201),
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("Update", ctx, entity.ID(101), expectedVO).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Update(ctx, reqFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(&emptypb.Empty{}, actual)
+}
+
+func (suite *InventoryServerImplTestSuite) TestDelete_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("Delete", ctx, entity.ID(101)).Return(mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Delete(ctx, &inventorypb.DeleteItemRequest{Id: 101})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestDelete_WhenServiceSucceeds_ShouldReturnEmpty() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup mocks
+	suite.mockInventoryService.On("Delete", ctx, entity.ID(101)).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Delete(ctx, &inventorypb.DeleteItemRequest{Id: 101})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(&emptypb.Empty{}, actual)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCheckout_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("Checkout", ctx, entity.ID(101), &inventory.CheckoutVO{AccountID: 301}).Return(mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Checkout(ctx, &inventorypb.CheckoutRequest{Id: 101, AccountId: 301})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCheckout_WhenServiceSucceeds_ShouldReturnEmpty() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup mocks
+	suite.mockInventoryService.On("Checkout", ctx, entity.ID(101), &inventory.CheckoutVO{AccountID: 301}).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Checkout(ctx, &inventorypb.CheckoutRequest{Id: 101, AccountId: 301})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(&emptypb.Empty{}, actual)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCheckIn_WhenServiceFails_ShouldFailWithStatus() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup expectations
+	expectedErr := fmt.Errorf("mock.status")
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockInventoryService.On("CheckIn", ctx, entity.ID(101)).Return(nil, mockErr)
+	suite.mockErrorMapper.On("ToStatus", mockErr).Return(expectedErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(ctx, &inventorypb.CheckInRequest{Id: 101})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(expectedErr, err)
+}
+
+func (suite *InventoryServerImplTestSuite) TestCheckIn_WhenServiceSucceeds_ShouldReturnReceipt() {
+	// Setup fixture
+	ctx := context.Background()
+	dueAt := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	returnedAt := time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	expected := &inventorypb.Receipt{
+		RentalId:   401,
+		ItemId:     101,
+		AccountId:  301,
+		DueAt:      timestamppb.New(dueAt),
+		ReturnedAt: timestamppb.New(returnedAt),
+		DaysLate:   2,
+		LateFee:    200,
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("CheckIn", ctx, entity.ID(101)).Return(&rental.ReceiptLineVO{
+		RentalID:   401,
+		ItemID:     101,
+		AccountID:  301,
+		DueAt:      dueAt,
+		ReturnedAt: returnedAt,
+		DaysLate:   2,
+		LateFee:    200,
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(ctx, &inventorypb.CheckInRequest{Id: 101})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected.String(), actual.String())
+}
+
+func (suite *InventoryServerImplTestSuite) TestCheckIn_WhenItemWasNotRentedOut_ShouldReturnReceiptWithNoRental() {
+	// Setup fixture
+	ctx := context.Background()
+
+	// Setup expectations
+	expected := &inventorypb.Receipt{
+		ItemId: 101,
+	}
+
+	// Setup mocks
+	suite.mockInventoryService.On("CheckIn", ctx, entity.ID(101)).Return(nil, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(ctx, &inventorypb.CheckInRequest{Id: 101})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected.String(), actual.String())
+}
+
+// stubListServer records the items sent on a list stream.
+type stubListServer struct {
+	goGrpc.ServerStream
+	ctx  context.Context
+	sent []*inventorypb.ItemSummary
+	err  error
+}
+
+func newStubListServer() *stubListServer {
+	return &stubListServer{ctx: context.Background()}
+}
+
+func (s *stubListServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stubListServer) Send(summary *inventorypb.ItemSummary) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, summary)
+	return nil
+}
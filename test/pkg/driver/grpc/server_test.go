@@ -0,0 +1,193 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	goGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	configMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/config"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc"
+	"github.com/liampulles/matchstick-video/pkg/driver/grpc/inventorypb"
+)
+
+type ServerImplTestSuite struct {
+	suite.Suite
+	mockConfigStore *configMocks.MockStore
+	sut             *grpc.ServerImpl
+}
+
+func TestServerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerImplTestSuite))
+}
+
+func (suite *ServerImplTestSuite) SetupTest() {
+	suite.mockConfigStore = &configMocks.MockStore{}
+	suite.sut = grpc.NewServerImpl(
+		suite.mockConfigStore,
+		&inventorypb.UnimplementedInventoryServiceServer{},
+		nil,
+		nil,
+	)
+}
+
+func (suite *ServerImplTestSuite) TestRun_WhenPortIsTaken_ShouldFail() {
+	// Setup fixture
+	lis, err := net.Listen("tcp", ":0")
+	suite.Require().NoError(err)
+	defer lis.Close()
+	port := lis.Addr().(*net.TCPAddr).Port
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetGrpcPort").Return(port)
+
+	// Exercise SUT
+	err = suite.sut.Run()
+
+	// Verify results
+	suite.Error(err)
+	suite.Contains(err.Error(), "could not run gRPC server - listen error: ")
+}
+
+func (suite *ServerImplTestSuite) TestRun_ShouldServeInventoryService() {
+	// Setup fixture
+	lis, err := net.Listen("tcp", ":0")
+	suite.Require().NoError(err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetGrpcPort").Return(port)
+
+	// Exercise SUT
+	go suite.sut.Run()
+
+	// Verify results
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := goGrpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+		goGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		goGrpc.WithBlock(),
+	)
+	suite.Require().NoError(err)
+	defer conn.Close()
+	_, err = inventorypb.NewInventoryServiceClient(conn).Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+	suite.Equal(codes.Unimplemented, status.Code(err))
+}
+
+func (suite *ServerImplTestSuite) TestRun_WhenHandlerPanics_ShouldFailCallAndKeepServing() {
+	// Setup fixture
+	lis, err := net.Listen("tcp", ":0")
+	suite.Require().NoError(err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+	sut := grpc.NewServerImpl(suite.mockConfigStore, &panickingInventoryServer{}, nil, nil)
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetGrpcPort").Return(port)
+
+	// Exercise SUT
+	go sut.Run()
+
+	// Verify results
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := goGrpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+		goGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		goGrpc.WithBlock(),
+	)
+	suite.Require().NoError(err)
+	defer conn.Close()
+	client := inventorypb.NewInventoryServiceClient(conn)
+	for i := 0; i < 2; i++ {
+		_, err = client.Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+		suite.Equal(codes.Internal, status.Code(err))
+	}
+}
+
+func (suite *ServerImplTestSuite) TestRun_ShouldPassCallsThroughInterceptors() {
+	// Setup fixture
+	lis, err := net.Listen("tcp", ":0")
+	suite.Require().NoError(err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+	var intercepted []string
+	interceptor := func(ctx context.Context, req interface{}, info *goGrpc.UnaryServerInfo, handler goGrpc.UnaryHandler) (interface{}, error) {
+		intercepted = append(intercepted, info.FullMethod)
+		return handler(ctx, req)
+	}
+	sut := grpc.NewServerImpl(suite.mockConfigStore, &inventorypb.UnimplementedInventoryServiceServer{},
+		[]goGrpc.UnaryServerInterceptor{interceptor}, nil)
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetGrpcPort").Return(port)
+
+	// Exercise SUT
+	go sut.Run()
+	defer sut.Stop(context.Background())
+
+	// Verify results
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := goGrpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+		goGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		goGrpc.WithBlock(),
+	)
+	suite.Require().NoError(err)
+	defer conn.Close()
+	_, err = inventorypb.NewInventoryServiceClient(conn).Read(ctx, &inventorypb.ReadItemRequest{Id: 101})
+	suite.Equal(codes.Unimplemented, status.Code(err))
+	suite.Equal([]string{"/matchstick.inventory.InventoryService/Read"}, intercepted)
+}
+
+func (suite *ServerImplTestSuite) TestStop_ShouldStopRunning() {
+	// Setup fixture
+	lis, err := net.Listen("tcp", ":0")
+	suite.Require().NoError(err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetGrpcPort").Return(port)
+
+	// Exercise SUT
+	finished := make(chan error, 1)
+	go func() {
+		finished <- suite.sut.Run()
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := goGrpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+		goGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		goGrpc.WithBlock(),
+	)
+	suite.Require().NoError(err)
+	conn.Close()
+	err = suite.sut.Stop(ctx)
+
+	// Verify results
+	suite.NoError(err)
+	select {
+	case err := <-finished:
+		suite.NoError(err)
+	case <-ctx.Done():
+		suite.Fail("server did not stop running")
+	}
+}
+
+// panickingInventoryServer panics on every read.
+type panickingInventoryServer struct {
+	inventorypb.UnimplementedInventoryServiceServer
+}
+
+func (p *panickingInventoryServer) Read(context.Context, *inventorypb.ReadItemRequest) (*inventorypb.Item, error) {
+	panic("mock.panic")
+}
@@ -0,0 +1,113 @@
+package mux_test
+
+import (
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	configMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/config"
+
+	muxDriver "github.com/liampulles/matchstick-video/pkg/driver/http/mux"
+)
+
+type CORSImplTestSuite struct {
+	suite.Suite
+	mockConfigStore *configMocks.MockStore
+	sut             *muxDriver.CORSImpl
+}
+
+func TestCORSImplTestSuite(t *testing.T) {
+	suite.Run(t, new(CORSImplTestSuite))
+}
+
+func (suite *CORSImplTestSuite) SetupTest() {
+	suite.mockConfigStore = &configMocks.MockStore{}
+	suite.mockConfigStore.On("GetCORSAllowedHeaders").Return([]string{"Content-Type", "Idempotency-Key"})
+	suite.mockConfigStore.On("GetCORSMaxAge").Return(10 * time.Minute)
+	suite.sut = muxDriver.NewCORSImpl(
+		suite.mockConfigStore,
+	)
+}
+
+func (suite *CORSImplTestSuite) TestWrap_GivenAllowedOrigin_ShouldAllowItToReadResponse() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory", nil)
+	requestFixture.Header.Set("Origin", "https://shop.example.com")
+	recorder := httptest.NewRecorder()
+	nextCalled := false
+	next := goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		nextCalled = true
+	})
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetCORSAllowedOrigins").Return([]string{"http://localhost:3000", "https://shop.example.com"})
+	suite.mockConfigStore.On("GetCORSAllowCredentials").Return(true)
+
+	// Exercise SUT
+	suite.sut.Wrap(next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.True(nextCalled)
+	suite.Equal("https://shop.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	suite.Equal("true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+	suite.Equal("Origin", recorder.Header().Get("Vary"))
+}
+
+func (suite *CORSImplTestSuite) TestWrap_GivenOtherOrigin_ShouldNotAllowIt() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory", nil)
+	requestFixture.Header.Set("Origin", "https://evil.example.com")
+	recorder := httptest.NewRecorder()
+	next := goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {})
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetCORSAllowedOrigins").Return([]string{"https://shop.example.com"})
+
+	// Exercise SUT
+	suite.sut.Wrap(next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.Empty(recorder.Header().Get("Access-Control-Allow-Origin"))
+	suite.Empty(recorder.Header().Get("Access-Control-Allow-Credentials"))
+	suite.Equal("Origin", recorder.Header().Get("Vary"))
+}
+
+func (suite *CORSImplTestSuite) TestPreflight_GivenAllowedOrigin_ShouldDescribeWhatItMayDo() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodOptions, "/inventory/101", nil)
+	requestFixture.Header.Set("Origin", "https://shop.example.com")
+	requestFixture.Header.Set("Access-Control-Request-Method", "PUT")
+	recorder := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockConfigStore.On("GetCORSAllowedOrigins").Return([]string{"*"})
+	suite.mockConfigStore.On("GetCORSAllowedMethods").Return([]string{"get", "put"})
+
+	// Exercise SUT
+	suite.sut.Preflight([]string{"DELETE", "GET", "PUT"})(recorder, requestFixture)
+
+	// Verify results
+	suite.Equal(204, recorder.Code)
+	suite.Equal("DELETE, GET, PUT, OPTIONS", recorder.Header().Get("Allow"))
+	suite.Equal("GET, PUT", recorder.Header().Get("Access-Control-Allow-Methods"))
+	suite.Equal("Content-Type, Idempotency-Key", recorder.Header().Get("Access-Control-Allow-Headers"))
+	suite.Equal("600", recorder.Header().Get("Access-Control-Max-Age"))
+}
+
+func (suite *CORSImplTestSuite) TestPreflight_GivenNoOrigin_ShouldOnlyDescribeMethods() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodOptions, "/inventory", nil)
+	recorder := httptest.NewRecorder()
+
+	// Exercise SUT
+	suite.sut.Preflight([]string{"GET", "POST"})(recorder, requestFixture)
+
+	// Verify results
+	suite.Equal(204, recorder.Code)
+	suite.Equal("GET, POST, OPTIONS", recorder.Header().Get("Allow"))
+	suite.Empty(recorder.Header().Get("Access-Control-Allow-Methods"))
+	suite.Empty(recorder.Header().Get("Access-Control-Max-Age"))
+}
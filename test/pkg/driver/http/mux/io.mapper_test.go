@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io/ioutil"
 	goHttp "net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -68,7 +69,9 @@ func (suite *IOMapperImplTestSuite) TestMapRequest_WhenExtractBodyPasses_ShouldR
 		URL: &url.URL{
 			RawQuery: "something",
 		},
-		Body: body,
+		Header:     goHttp.Header{"Last-Event-Id": []string{"12", "13"}},
+		Body:       body,
+		RemoteAddr: "10.0.0.1:5000",
 	}
 
 	// Setup expectations
@@ -76,9 +79,12 @@ func (suite *IOMapperImplTestSuite) TestMapRequest_WhenExtractBodyPasses_ShouldR
 		"path": "param",
 	}
 	expected := &adapterHttp.Request{
+		Context:    requestFixture.Context(),
 		PathParam:  expectedPathParams,
 		QueryParam: map[string][]string{"something": []string{""}},
+		Header:     map[string]string{"Last-Event-Id": "12"},
 		Body:       []byte("some.data"),
+		RemoteAddr: "10.0.0.1:5000",
 	}
 
 	// Setup mocks
@@ -116,6 +122,33 @@ func (suite *IOMapperImplTestSuite) TestMapResponse_ShouldWriteToResponse() {
 	suite.Equal([]string{"some.content.type"}, mockHeaders["Content-Type"])
 }
 
+func (suite *IOMapperImplTestSuite) TestMapResponse_WhenStreamed_ShouldFlushAsWritten() {
+	// Setup fixture
+	recorder := httptest.NewRecorder()
+	var flushedBeforeStream bool
+	respFixture := &adapterHttp.Response{
+		ContentType: "text/event-stream",
+		StatusCode:  200,
+		Body:        []byte("ignored.data"),
+		Stream: func(w adapterHttp.StreamWriter) {
+			flushedBeforeStream = recorder.Flushed
+			w.Write([]byte("some.data"))
+			w.Flush()
+		},
+	}
+
+	// Exercise SUT
+	suite.sut.MapResponse(respFixture, recorder)
+
+	// Verify results
+	suite.Equal(200, recorder.Code)
+	suite.Equal("text/event-stream", recorder.Header().Get("Content-Type"))
+	suite.Equal("no-cache", recorder.Header().Get("Cache-Control"))
+	suite.Equal("some.data", recorder.Body.String())
+	suite.True(flushedBeforeStream)
+	suite.True(recorder.Flushed)
+}
+
 type errReader int
 
 func (errReader) Read(p []byte) (n int, err error) {
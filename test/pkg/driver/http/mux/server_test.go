@@ -2,7 +2,9 @@ package mux_test
 
 import (
 	goHttp "net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -19,6 +21,8 @@ type ServerConfigurationImplTestSuite struct {
 	mockConfigStore   *configMocks.MockStore
 	mockHandlerMapper *muxMocks.MockHandlerMapper
 	mockMuxWrapper    *muxMocks.MockWrapper
+	mockCORS          *muxMocks.MockCORS
+	middlewareFixture []muxDriver.Middleware
 	sut               *muxDriver.ServerConfigurationImpl
 }
 
@@ -30,10 +34,16 @@ func (suite *ServerConfigurationImplTestSuite) SetupTest() {
 	suite.mockConfigStore = &configMocks.MockStore{}
 	suite.mockHandlerMapper = &muxMocks.MockHandlerMapper{}
 	suite.mockMuxWrapper = &muxMocks.MockWrapper{}
+	suite.mockCORS = &muxMocks.MockCORS{}
+	suite.mockCORS.On("Preflight", mock.Anything).
+		Return(MockMuxHandler)
+	suite.middlewareFixture = []muxDriver.Middleware{mockMiddleware}
 	suite.sut = muxDriver.NewServerConfigurationImpl(
 		suite.mockConfigStore,
 		suite.mockHandlerMapper,
 		suite.mockMuxWrapper,
+		suite.mockCORS,
+		suite.middlewareFixture,
 	)
 }
 
@@ -56,24 +66,203 @@ func (suite *ServerConfigurationImplTestSuite) TestCreateRunnable_ShouldMapAllHa
 	mockRoute2 := &muxMocks.RouteMock{}
 	suite.mockMuxWrapper.On("NewRouter").
 		Return(mockRouter)
+	mockRouter.On("Use", mock.Anything).
+		Return()
 	suite.mockHandlerMapper.On("Map", mock.Anything).
 		Return(MockMuxHandler)
 	mockRouter.On("HandleFunc", "path.pattern.1", mock.Anything).
 		Return(mockRoute1)
 	mockRoute1.On("Methods", []string{"method.1"}).
 		Return(nil)
+	mockRoute1.On("Methods", []string{"OPTIONS"}).
+		Return(nil)
 	mockRouter.On("HandleFunc", "path.pattern.2", mock.Anything).
 		Return(mockRoute2)
 	mockRoute2.On("Methods", []string{"method.2"}).
 		Return(nil)
+	mockRoute2.On("Methods", []string{"OPTIONS"}).
+		Return(nil)
 	suite.mockConfigStore.On("GetPort").
 		Return(101)
+	suite.mockConfigStore.On("GetRouteTimeouts").
+		Return(map[string]time.Duration{})
+	suite.mockConfigStore.On("GetRequestTimeout").
+		Return(time.Duration(0))
 
 	// Exercise SUT
 	suite.sut.CreateRunnable(fixture)
 
 	// Verify mocks
+	mockRouter.AssertCalled(suite.T(), "Use", mock.Anything)
+	mockRoute1.AssertCalled(suite.T(), "Methods", []string{"OPTIONS"})
+	mockRoute2.AssertCalled(suite.T(), "Methods", []string{"OPTIONS"})
+	suite.mockCORS.AssertCalled(suite.T(), "Preflight", []string{"method.1"})
+	suite.mockCORS.AssertCalled(suite.T(), "Preflight", []string{"method.2"})
+}
+
+func (suite *ServerConfigurationImplTestSuite) TestCreateRunnable_ShouldApplyRouteTimeoutsToHandlers() {
+	// Setup fixture
+	fixture := map[http.HandlerPattern]http.Handler{
+		http.HandlerPattern{
+			Method:      "GET",
+			PathPattern: "/slow",
+		}: mockHander1,
+		http.HandlerPattern{
+			Method:      "GET",
+			PathPattern: "/default",
+		}: mockHander2,
+	}
+
+	// Setup mocks
+	var deadlines []time.Duration
+	recordingHandler := func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		deadline, _ := req.Context().Deadline()
+		deadlines = append(deadlines, time.Until(deadline).Round(time.Minute))
+	}
+	var registered = make(map[string]muxDriver.Handler)
+	mockRouter := &muxMocks.RouterMock{}
+	mockRoute := &muxMocks.RouteMock{}
+	suite.mockMuxWrapper.On("NewRouter").
+		Return(mockRouter)
+	mockRouter.On("Use", mock.Anything).
+		Return()
+	suite.mockHandlerMapper.On("Map", mock.Anything).
+		Return(recordingHandler)
+	mockRouter.On("HandleFunc", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			// Keep the handler, rather than the preflight after it
+			if _, ok := registered[args.String(0)]; !ok {
+				registered[args.String(0)] = args.Get(1).(muxDriver.Handler)
+			}
+		}).
+		Return(mockRoute)
+	mockRoute.On("Methods", mock.Anything).
+		Return(nil)
+	suite.mockConfigStore.On("GetPort").
+		Return(101)
+	suite.mockConfigStore.On("GetRouteTimeouts").
+		Return(map[string]time.Duration{"GET /slow": 10 * time.Minute})
+	suite.mockConfigStore.On("GetRequestTimeout").
+		Return(time.Minute)
 
+	// Exercise SUT
+	suite.sut.CreateRunnable(fixture)
+	registered["/slow"](httptest.NewRecorder(), httptest.NewRequest("GET", "/slow", nil))
+	registered["/default"](httptest.NewRecorder(), httptest.NewRequest("GET", "/default", nil))
+
+	// Verify results
+	suite.Equal([]time.Duration{10 * time.Minute, time.Minute}, deadlines)
+}
+
+func (suite *ServerConfigurationImplTestSuite) TestCreateRunnable_ShouldNotTimeOutStreamingHandlers() {
+	// Setup fixture
+	fixture := map[http.HandlerPattern]http.Handler{
+		http.HandlerPattern{
+			Method:      "GET",
+			PathPattern: "/inventory/events",
+		}: mockHander1,
+	}
+
+	// Setup mocks
+	hasDeadline := true
+	recordingHandler := func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		_, hasDeadline = req.Context().Deadline()
+	}
+	var registered muxDriver.Handler
+	mockRouter := &muxMocks.RouterMock{}
+	mockRoute := &muxMocks.RouteMock{}
+	suite.mockMuxWrapper.On("NewRouter").
+		Return(mockRouter)
+	mockRouter.On("Use", mock.Anything).
+		Return()
+	suite.mockHandlerMapper.On("Map", mock.Anything).
+		Return(recordingHandler)
+	mockRouter.On("HandleFunc", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			// Keep the handler, rather than the preflight after it
+			if registered == nil {
+				registered = args.Get(1).(muxDriver.Handler)
+			}
+		}).
+		Return(mockRoute)
+	mockRoute.On("Methods", mock.Anything).
+		Return(nil)
+	suite.mockConfigStore.On("GetPort").
+		Return(101)
+	suite.mockConfigStore.On("GetRouteTimeouts").
+		Return(map[string]time.Duration{})
+	suite.mockConfigStore.On("GetRequestTimeout").
+		Return(time.Minute)
+
+	// Exercise SUT
+	suite.sut.CreateRunnable(fixture)
+	registered(httptest.NewRecorder(), httptest.NewRequest("GET", "/inventory/events", nil))
+
+	// Verify results
+	suite.False(hasDeadline)
+}
+
+func (suite *ServerConfigurationImplTestSuite) TestCreateRunnable_ShouldRegisterLiteralPathsBeforeVariables() {
+	// Setup fixture
+	fixture := map[http.HandlerPattern]http.Handler{
+		{Method: "GET", PathPattern: "/inventory/{id}"}:              mockHander1,
+		{Method: "PUT", PathPattern: "/inventory/{id}"}:              mockHander1,
+		{Method: "GET", PathPattern: "/inventory/events"}:            mockHander2,
+		{Method: "GET", PathPattern: "/inventory/by-barcode/{code}"}: mockHander2,
+		{Method: "GET", PathPattern: "/inventory"}:                   mockHander2,
+	}
+
+	// Setup mocks
+	var registered []string
+	mockRouter := &muxMocks.RouterMock{}
+	mockRoute := &muxMocks.RouteMock{}
+	suite.mockMuxWrapper.On("NewRouter").
+		Return(mockRouter)
+	mockRouter.On("Use", mock.Anything).
+		Return()
+	suite.mockHandlerMapper.On("Map", mock.Anything).
+		Return(MockMuxHandler)
+	mockRouter.On("HandleFunc", mock.Anything, mock.Anything).
+		Return(mockRoute)
+	mockRoute.On("Methods", mock.Anything).
+		Run(func(args mock.Arguments) {
+			registered = append(registered, args.Get(0).([]string)[0])
+		}).
+		Return(nil)
+	suite.mockConfigStore.On("GetPort").
+		Return(101)
+	suite.mockConfigStore.On("GetRouteTimeouts").
+		Return(map[string]time.Duration{})
+	suite.mockConfigStore.On("GetRequestTimeout").
+		Return(time.Duration(0))
+
+	// Setup expectations
+	expectedPaths := []string{
+		"/inventory", "/inventory",
+		"/inventory/by-barcode/{code}", "/inventory/by-barcode/{code}",
+		"/inventory/events", "/inventory/events",
+		"/inventory/{id}", "/inventory/{id}", "/inventory/{id}",
+	}
+	expectedMethods := []string{
+		"GET", "OPTIONS",
+		"GET", "OPTIONS",
+		"GET", "OPTIONS",
+		"GET", "PUT", "OPTIONS",
+	}
+
+	// Exercise SUT
+	suite.sut.CreateRunnable(fixture)
+
+	// Verify results
+	var actualPaths []string
+	for _, call := range mockRouter.Calls {
+		if call.Method == "HandleFunc" {
+			actualPaths = append(actualPaths, call.Arguments.String(0))
+		}
+	}
+	suite.Equal(expectedPaths, actualPaths)
+	suite.Equal(expectedMethods, registered)
+	suite.mockCORS.AssertCalled(suite.T(), "Preflight", []string{"GET", "PUT"})
 }
 
 func mockHander1(req *http.Request) *http.Response {
@@ -87,3 +276,7 @@ func mockHander2(req *http.Request) *http.Response {
 func MockMuxHandler(res goHttp.ResponseWriter, req *goHttp.Request) {
 	return
 }
+
+func mockMiddleware(next goHttp.Handler) goHttp.Handler {
+	return next
+}
@@ -2,6 +2,7 @@ package mux_test
 
 import (
 	goHttp "net/http"
+	"net/http/httptest"
 	"net/url"
 
 	"testing"
@@ -26,6 +27,29 @@ func TestRouterImpl_HandleFunc_ShouldReturnMuxRoute(t *testing.T) {
 	assert.IsType(t, &gorillaMux.Route{}, actual)
 }
 
+func TestRouterImpl_Use_ShouldApplyMiddlewareToRoutes(t *testing.T) {
+	// Setup fixture
+	sut := mux.RouterImpl{
+		MuxRouter: gorillaMux.NewRouter(),
+	}
+	called := false
+	middlewareFixture := func(next goHttp.Handler) goHttp.Handler {
+		return goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+			called = true
+			next.ServeHTTP(res, req)
+		})
+	}
+	sut.HandleFunc("/pattern", MockMuxHandler)
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/pattern", nil)
+
+	// Exercise SUT
+	sut.Use(middlewareFixture)
+	sut.ServeHTTP(httptest.NewRecorder(), requestFixture)
+
+	// Verify results
+	assert.True(t, called)
+}
+
 func TestRouterImpl_ServeHTTP_ShouldPass(t *testing.T) {
 	// Setup fixture
 	sut := mux.RouterImpl{
@@ -71,6 +95,35 @@ func TestWrapperImpl_Vars_GivenEmptyRequest_ShouldReturnNil(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestWrapperImpl_PathTemplate_GivenUnroutedRequest_ShouldReturnEmpty(t *testing.T) {
+	// Setup fixture
+	requestFixture := &goHttp.Request{}
+	sut := mux.NewWrapperImpl()
+
+	// Exercise SUT
+	actual := sut.PathTemplate(requestFixture)
+
+	// Verify results
+	assert.Equal(t, "", actual)
+}
+
+func TestWrapperImpl_PathTemplate_GivenRoutedRequest_ShouldReturnTemplate(t *testing.T) {
+	// Setup fixture
+	router := gorillaMux.NewRouter()
+	sut := mux.NewWrapperImpl()
+	var actual string
+	router.HandleFunc("/inventory/{id}", func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		// Exercise SUT
+		actual = sut.PathTemplate(req)
+	})
+
+	// Route the request
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(goHttp.MethodGet, "/inventory/101", nil))
+
+	// Verify results
+	assert.Equal(t, "/inventory/{id}", actual)
+}
+
 type testResponseWriter struct {
 	data string
 }
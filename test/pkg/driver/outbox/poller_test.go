@@ -0,0 +1,56 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/outbox"
+)
+
+func TestPoller_Run_ShouldRelayBacklogUntilStopped(t *testing.T) {
+	// Setup fixture
+	ctx, cancel := context.WithCancel(context.Background())
+	mockRelay := &outboxMocks.MockRelay{}
+	sut := outbox.NewPollerImpl(ctx, mockRelay.RelayPending, time.Millisecond)
+
+	// Setup mocks
+	mockRelay.On("RelayPending", ctx).Return(100, nil).Twice()
+	mockRelay.On("RelayPending", ctx).Return(3, nil).Once()
+	mockRelay.On("RelayPending", ctx).Return(0, nil).Run(func(mock.Arguments) {
+		cancel()
+	})
+
+	// Exercise SUT
+	err := sut.Run()
+
+	// Verify results
+	assert.NoError(t, err)
+	mockRelay.AssertNumberOfCalls(t, "RelayPending", 4)
+}
+
+func TestPoller_Run_WhenRelayFails_ShouldKeepPolling(t *testing.T) {
+	// Setup fixture
+	ctx, cancel := context.WithCancel(context.Background())
+	mockRelay := &outboxMocks.MockRelay{}
+	sut := outbox.NewPollerImpl(ctx, mockRelay.RelayPending, time.Millisecond)
+
+	// Setup mocks
+	mockRelay.On("RelayPending", ctx).Return(1, fmt.Errorf("mock.error")).Once()
+	mockRelay.On("RelayPending", ctx).Return(0, nil).Run(func(mock.Arguments) {
+		cancel()
+	})
+
+	// Exercise SUT
+	err := sut.Run()
+
+	// Verify results
+	assert.NoError(t, err)
+	mockRelay.AssertNumberOfCalls(t, "RelayPending", 2)
+}
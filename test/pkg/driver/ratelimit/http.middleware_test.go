@@ -0,0 +1,158 @@
+package ratelimit_test
+
+import (
+	"fmt"
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	configMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/config"
+	httpMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/http"
+	muxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/http/mux"
+	ratelimitMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/ratelimit"
+
+	"github.com/liampulles/matchstick-video/pkg/adapter/http"
+	"github.com/liampulles/matchstick-video/pkg/driver/ratelimit"
+)
+
+type HTTPMiddlewareImplTestSuite struct {
+	suite.Suite
+	mockConfigStore      *configMocks.MockStore
+	mockMuxWrapper       *muxMocks.MockWrapper
+	mockClientIdentifier *httpMocks.MockClientIdentifier
+	mockBucketStore      *ratelimitMocks.MockBucketStore
+	nextCalled           bool
+	next                 goHttp.Handler
+	sut                  *ratelimit.HTTPMiddlewareImpl
+}
+
+func TestHTTPMiddlewareImplTestSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMiddlewareImplTestSuite))
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) SetupTest() {
+	suite.mockConfigStore = &configMocks.MockStore{}
+	suite.mockConfigStore.On("GetRateLimit").Return(100)
+	suite.mockConfigStore.On("GetRateLimitPeriod").Return(time.Minute)
+	suite.mockConfigStore.On("GetRouteRateLimits").Return(map[string]int{
+		"GET /inventory":  10,
+		"POST /inventory": 0,
+	})
+	suite.mockMuxWrapper = &muxMocks.MockWrapper{}
+	suite.mockClientIdentifier = &httpMocks.MockClientIdentifier{}
+	suite.mockClientIdentifier.On("Identify", "", mock.Anything).Return("ip:10.0.0.1")
+	suite.mockClientIdentifier.On("Identify", "secret", mock.Anything).Return("key:some.hash")
+	suite.mockBucketStore = &ratelimitMocks.MockBucketStore{}
+	suite.nextCalled = false
+	suite.next = goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		suite.nextCalled = true
+		res.WriteHeader(200)
+	})
+	suite.sut = ratelimit.NewHTTPMiddlewareImpl(
+		suite.mockConfigStore,
+		suite.mockMuxWrapper,
+		suite.mockClientIdentifier,
+		suite.mockBucketStore,
+	)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenAllowed_ShouldHandleAndSetHeaders() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory", nil)
+	requestFixture.RemoteAddr = "10.0.0.1:5000"
+	recorder := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory")
+	suite.mockBucketStore.On("Take", mock.Anything, "ip:10.0.0.1 GET /inventory", ratelimit.Limit{Burst: 10, Period: time.Minute}).
+		Return(&ratelimit.Result{Allowed: true, Remaining: 9, Reset: 5500 * time.Millisecond}, nil)
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.True(suite.nextCalled)
+	suite.Equal(200, recorder.Code)
+	suite.Equal("10", recorder.Header().Get("RateLimit-Limit"))
+	suite.Equal("9", recorder.Header().Get("RateLimit-Remaining"))
+	suite.Equal("6", recorder.Header().Get("RateLimit-Reset"))
+	suite.Empty(recorder.Header().Get("Retry-After"))
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenRefused_ShouldRespondWithTooManyRequests() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory/101", nil)
+	requestFixture.Header.Set(http.APIKeyHeader, "secret")
+	recorder := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory/{id}")
+	suite.mockBucketStore.On("Take", mock.Anything, "key:some.hash GET /inventory/{id}",
+		ratelimit.Limit{Burst: 100, Period: time.Minute}).
+		Return(&ratelimit.Result{Allowed: false, Remaining: 0, RetryAfter: 300 * time.Millisecond, Reset: time.Minute}, nil)
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.False(suite.nextCalled)
+	suite.Equal(429, recorder.Code)
+	suite.Equal("100", recorder.Header().Get("RateLimit-Limit"))
+	suite.Equal("0", recorder.Header().Get("RateLimit-Remaining"))
+	suite.Equal("60", recorder.Header().Get("RateLimit-Reset"))
+	suite.Equal("1", recorder.Header().Get("Retry-After"))
+	suite.Equal("rate limit exceeded: route=[GET /inventory/{id}]", recorder.Body.String())
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenRouteIsNotLimited_ShouldHandleWithoutHeaders() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodPost, "/inventory", nil)
+	recorder := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory")
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.True(suite.nextCalled)
+	suite.Empty(recorder.Header().Get("RateLimit-Limit"))
+	suite.mockBucketStore.AssertNotCalled(suite.T(), "Take", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_GivenPreflightRequest_ShouldHandleWithoutLimiting() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodOptions, "/inventory", nil)
+	recorder := httptest.NewRecorder()
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.True(suite.nextCalled)
+	suite.Empty(recorder.Header().Get("RateLimit-Limit"))
+	suite.mockBucketStore.AssertNotCalled(suite.T(), "Take", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenStoreFails_ShouldHandle() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory", nil)
+	recorder := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory")
+	suite.mockBucketStore.On("Take", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	suite.sut.Wrap(suite.next).ServeHTTP(recorder, requestFixture)
+
+	// Verify results
+	suite.True(suite.nextCalled)
+	suite.Equal(200, recorder.Code)
+}
@@ -0,0 +1,124 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/ratelimit"
+)
+
+type MemoryBucketStoreImplTestSuite struct {
+	suite.Suite
+	mockClock  *domainMocks.MockClock
+	nowFixture time.Time
+	limit      ratelimit.Limit
+	sut        *ratelimit.MemoryBucketStoreImpl
+}
+
+func TestMemoryBucketStoreImplTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryBucketStoreImplTestSuite))
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) SetupTest() {
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.limit = ratelimit.Limit{Burst: 2, Period: 10 * time.Second}
+	suite.at(0)
+	suite.sut = ratelimit.NewMemoryBucketStoreImpl(suite.mockClock)
+}
+
+// at makes the next call for the time return the given time after
+// nowFixture.
+func (suite *MemoryBucketStoreImplTestSuite) at(elapsed time.Duration) {
+	suite.mockClock.On("Now").Return(suite.nowFixture.Add(elapsed)).Once()
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) take(key string) *ratelimit.Result {
+	result, err := suite.sut.Take(context.Background(), key, suite.limit)
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) TestTake_GivenNewKey_ShouldAllowAndCountDown() {
+	// Setup mocks
+	suite.at(0)
+
+	// Setup expectations
+	expected := &ratelimit.Result{
+		Allowed:   true,
+		Remaining: 1,
+		Reset:     5 * time.Second,
+	}
+
+	// Exercise SUT
+	actual := suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) TestTake_WhenBucketIsEmpty_ShouldRefuseUntilATokenIsEarned() {
+	// Setup fixture
+	suite.at(0)
+	suite.take("ip:10.0.0.1 GET /inventory")
+	suite.at(time.Second)
+	suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Setup mocks
+	suite.at(2 * time.Second)
+
+	// Setup expectations
+	expected := &ratelimit.Result{
+		Allowed:    false,
+		Remaining:  0,
+		RetryAfter: 3 * time.Second,
+		Reset:      8 * time.Second,
+	}
+
+	// Exercise SUT
+	actual := suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) TestTake_WhenTokensAreEarned_ShouldAllowAgain() {
+	// Setup fixture
+	suite.at(0)
+	suite.take("ip:10.0.0.1 GET /inventory")
+	suite.at(0)
+	suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Setup mocks
+	suite.at(time.Hour)
+
+	// Exercise SUT
+	actual := suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Verify results
+	suite.True(actual.Allowed)
+	suite.Equal(1, actual.Remaining)
+}
+
+func (suite *MemoryBucketStoreImplTestSuite) TestTake_ShouldKeepSeparateBucketsForEachKey() {
+	// Setup fixture
+	suite.at(0)
+	suite.take("ip:10.0.0.1 GET /inventory")
+	suite.at(0)
+	suite.take("ip:10.0.0.1 GET /inventory")
+
+	// Setup mocks
+	suite.at(0)
+
+	// Exercise SUT
+	actual := suite.take("ip:10.0.0.2 GET /inventory")
+
+	// Verify results
+	suite.True(actual.Allowed)
+	suite.Equal(1, actual.Remaining)
+}
@@ -0,0 +1,123 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+type AccountServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *accountMocks.MockService
+	sut               *tracing.AccountServiceImpl
+}
+
+func TestAccountServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountServiceImplTestSuite))
+}
+
+func (suite *AccountServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &accountMocks.MockService{}
+	suite.sut = tracing.NewAccountServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *AccountServiceImplTestSuite) TestCreate_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &account.CreateAccountVO{Name: "some.name"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Create", traceContext, voFixture).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+	suite.assertSingleSpan("account.Service/Create", codes.Unset)
+}
+
+func (suite *AccountServiceImplTestSuite) TestReadDetails_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadDetails", traceContext, entity.ID(101)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("account.Service/ReadDetails", codes.Error)
+}
+
+func (suite *AccountServiceImplTestSuite) TestReadAll_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []account.ThinViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAll", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("account.Service/ReadAll", codes.Unset)
+}
+
+func (suite *AccountServiceImplTestSuite) TestUpdate_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &account.UpdateAccountVO{Name: "some.name"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Update", traceContext, entity.ID(101), voFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(context.Background(), entity.ID(101), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("account.Service/Update", codes.Unset)
+}
+
+func (suite *AccountServiceImplTestSuite) TestDelete_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Delete", traceContext, entity.ID(101)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("account.Service/Delete", codes.Unset)
+}
+
+func (suite *AccountServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
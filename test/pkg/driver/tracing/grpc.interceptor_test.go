@@ -0,0 +1,83 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	goGrpc "google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+type GRPCInterceptorTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	infoFixture       *goGrpc.UnaryServerInfo
+}
+
+func TestGRPCInterceptorTestSuite(t *testing.T) {
+	suite.Run(t, new(GRPCInterceptorTestSuite))
+}
+
+func (suite *GRPCInterceptorTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Provider").Return(provider)
+	suite.mockTracerService.On("Propagator").Return(propagation.TraceContext{})
+	suite.infoFixture = &goGrpc.UnaryServerInfo{FullMethod: "/matchstick.inventory.InventoryService/Read"}
+}
+
+func (suite *GRPCInterceptorTestSuite) TestUnaryServerInterceptor_GivenTraceparent_ShouldContinueTrace() {
+	// Setup fixture
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+	))
+	var handlerSpan trace.SpanContext
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return "some.response", nil
+	}
+
+	// Exercise SUT
+	actual, err := tracing.UnaryServerInterceptor(suite.mockTracerService)(ctx, "some.request", suite.infoFixture, handler)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal("some.response", actual)
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal("matchstick.inventory.InventoryService/Read", spans[0].Name())
+	suite.Equal(trace.SpanKindServer, spans[0].SpanKind())
+	suite.Equal("0af7651916cd43dd8448eb211c80319c", spans[0].SpanContext().TraceID().String())
+	suite.Equal("b7ad6b7169203331", spans[0].Parent().SpanID().String())
+	suite.Equal(spans[0].SpanContext().SpanID(), handlerSpan.SpanID())
+}
+
+func (suite *GRPCInterceptorTestSuite) TestUnaryServerInterceptor_WhenHandlerFails_ShouldMarkSpanAsFailed() {
+	// Setup fixture
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(grpcCodes.Internal, "mock.error")
+	}
+
+	// Exercise SUT
+	_, err := tracing.UnaryServerInterceptor(suite.mockTracerService)(context.Background(), "some.request", suite.infoFixture, handler)
+
+	// Verify results
+	suite.Error(err)
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(codes.Error, spans[0].Status().Code)
+}
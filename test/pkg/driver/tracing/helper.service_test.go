@@ -0,0 +1,119 @@
+package tracing_test
+
+import (
+	"context"
+	goSql "database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	sqlMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/db/sql"
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+// traceContext matches any context carrying a valid span.
+var traceContext = mock.MatchedBy(func(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+})
+
+type HelperServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	db                *goSql.DB
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *sqlMocks.MockHelperService
+	sut               *tracing.HelperServiceImpl
+}
+
+func TestHelperServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(HelperServiceImplTestSuite))
+}
+
+func (suite *HelperServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.db = &goSql.DB{}
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &sqlMocks.MockHelperService{}
+	suite.sut = tracing.NewHelperServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *HelperServiceImplTestSuite) TestExecForSingleItem_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup fixture
+	queryFixture := `
+	DELETE FROM some_table
+	WHERE
+		id=$1;`
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ExecForSingleItem", traceContext, suite.db, queryFixture, "some.type", 101).Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.ExecForSingleItem(context.Background(), suite.db, queryFixture, "some.type", 101)
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	span := suite.assertSingleSpan("sql/ExecForSingleItem", codes.Error)
+	suite.Contains(span.Attributes(), attribute.String("db.statement", "DELETE FROM some_table WHERE id=$1;"))
+}
+
+func (suite *HelperServiceImplTestSuite) TestSingleRowQuery_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("SingleRowQuery", traceContext, suite.db, "some.query", mock.Anything, "some.type", 101).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.SingleRowQuery(context.Background(), suite.db, "some.query", nil, "some.type", 101)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("sql/SingleRowQuery", codes.Unset)
+}
+
+func (suite *HelperServiceImplTestSuite) TestManyRowsQuery_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("ManyRowsQuery", traceContext, suite.db, "some.query", mock.Anything, "some.type").Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.ManyRowsQuery(context.Background(), suite.db, "some.query", nil, "some.type")
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("sql/ManyRowsQuery", codes.Unset)
+}
+
+func (suite *HelperServiceImplTestSuite) TestSingleQueryForID_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("SingleQueryForID", traceContext, suite.db, "some.query", "some.type").Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.SingleQueryForID(context.Background(), suite.db, "some.query", "some.type")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+	suite.assertSingleSpan("sql/SingleQueryForID", codes.Unset)
+}
+
+func (suite *HelperServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) sdktrace.ReadOnlySpan {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+	suite.Equal(trace.SpanKindClient, spans[0].SpanKind())
+	return spans[0]
+}
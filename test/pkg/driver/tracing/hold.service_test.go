@@ -0,0 +1,95 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	holdMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/hold"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+type HoldServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *holdMocks.MockService
+	sut               *tracing.HoldServiceImpl
+}
+
+func TestHoldServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldServiceImplTestSuite))
+}
+
+func (suite *HoldServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &holdMocks.MockService{}
+	suite.sut = tracing.NewHoldServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *HoldServiceImplTestSuite) TestPlace_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockDelegate.On("Place", traceContext, entity.ID(11), vo).Return(entity.ID(301), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(context.Background(), entity.ID(11), vo)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(301), actual)
+	suite.assertSingleSpan("hold.Service/Place", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.title.id", 11))
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.account.id", 7))
+}
+
+func (suite *HoldServiceImplTestSuite) TestReadQueue_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadQueue", traceContext, entity.ID(11)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadQueue(context.Background(), entity.ID(11))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("hold.Service/ReadQueue", codes.Error)
+}
+
+func (suite *HoldServiceImplTestSuite) TestCancel_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Cancel", traceContext, entity.ID(301)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Cancel(context.Background(), entity.ID(301))
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("hold.Service/Cancel", codes.Unset)
+}
+
+func (suite *HoldServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,110 @@
+package tracing_test
+
+import (
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	muxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/http/mux"
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+type HTTPMiddlewareImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockMuxWrapper    *muxMocks.MockWrapper
+	sut               *tracing.HTTPMiddlewareImpl
+}
+
+func TestHTTPMiddlewareImplTestSuite(t *testing.T) {
+	suite.Run(t, new(HTTPMiddlewareImplTestSuite))
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockTracerService.On("Propagator").Return(propagation.TraceContext{})
+	suite.mockMuxWrapper = &muxMocks.MockWrapper{}
+	suite.sut = tracing.NewHTTPMiddlewareImpl(
+		suite.mockTracerService,
+		suite.mockMuxWrapper,
+	)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_GivenTraceparent_ShouldContinueTrace() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory/101", nil)
+	requestFixture.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory/{id}")
+	var handlerSpan trace.SpanContext
+	next := goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		handlerSpan = trace.SpanContextFromContext(req.Context())
+		res.WriteHeader(404)
+	})
+
+	// Exercise SUT
+	suite.sut.Wrap(next).ServeHTTP(httptest.NewRecorder(), requestFixture)
+
+	// Verify results
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal("GET /inventory/{id}", spans[0].Name())
+	suite.Equal("0af7651916cd43dd8448eb211c80319c", spans[0].SpanContext().TraceID().String())
+	suite.Equal("b7ad6b7169203331", spans[0].Parent().SpanID().String())
+	suite.Equal(spans[0].SpanContext(), handlerSpan)
+	suite.Contains(spans[0].Attributes(), attribute.Int("http.status_code", 404))
+	suite.Equal(codes.Unset, spans[0].Status().Code)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenServerErrors_ShouldMarkSpanAsError() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodPost, "/inventory", nil)
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory")
+	next := goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		res.WriteHeader(500)
+	})
+
+	// Exercise SUT
+	suite.sut.Wrap(next).ServeHTTP(httptest.NewRecorder(), requestFixture)
+
+	// Verify results
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.False(spans[0].Parent().IsValid())
+	suite.Equal(codes.Error, spans[0].Status().Code)
+}
+
+func (suite *HTTPMiddlewareImplTestSuite) TestWrap_WhenHandlerFlushes_ShouldFlushResponse() {
+	// Setup fixture
+	requestFixture := httptest.NewRequest(goHttp.MethodGet, "/inventory/events", nil)
+	responseFixture := httptest.NewRecorder()
+
+	// Setup mocks
+	suite.mockMuxWrapper.On("PathTemplate", requestFixture).Return("/inventory/events")
+	next := goHttp.HandlerFunc(func(res goHttp.ResponseWriter, req *goHttp.Request) {
+		res.(goHttp.Flusher).Flush()
+	})
+
+	// Exercise SUT
+	suite.sut.Wrap(next).ServeHTTP(responseFixture, requestFixture)
+
+	// Verify results
+	suite.True(responseFixture.Flushed)
+}
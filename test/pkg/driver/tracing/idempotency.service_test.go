@@ -0,0 +1,97 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	idempotencyMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/idempotency"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+type IdempotencyServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *idempotencyMocks.MockService
+	sut               *tracing.IdempotencyServiceImpl
+}
+
+func TestIdempotencyServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyServiceImplTestSuite))
+}
+
+func (suite *IdempotencyServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &idempotencyMocks.MockService{}
+	suite.sut = tracing.NewIdempotencyServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *IdempotencyServiceImplTestSuite) TestBegin_WhenRequestWasHandled_ShouldRecordReplay() {
+	// Setup fixture
+	response := &idempotency.ResponseVO{StatusCode: 201}
+
+	// Setup mocks
+	suite.mockDelegate.On("Begin", traceContext, "ip:10.0.0.1", "some.key", "some.fingerprint").Return(response, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(context.Background(), "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(response, actual)
+	suite.assertSingleSpan("idempotency.Service/Begin", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Bool("matchstick.idempotency.replayed", true))
+}
+
+func (suite *IdempotencyServiceImplTestSuite) TestFinish_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup fixture
+	response := &idempotency.ResponseVO{StatusCode: 201}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("Finish", traceContext, "ip:10.0.0.1", "some.key", response).Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.Finish(context.Background(), "ip:10.0.0.1", "some.key", response)
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("idempotency.Service/Finish", codes.Error)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int("matchstick.idempotency.status_code", 201))
+}
+
+func (suite *IdempotencyServiceImplTestSuite) TestAbandon_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("Abandon", traceContext, "ip:10.0.0.1", "some.key").Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.Abandon(context.Background(), "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("idempotency.Service/Abandon", codes.Error)
+}
+
+func (suite *IdempotencyServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,317 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type InventoryServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *inventoryMocks.MockService
+	sut               *tracing.InventoryServiceImpl
+}
+
+func TestInventoryServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryServiceImplTestSuite))
+}
+
+func (suite *InventoryServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &inventoryMocks.MockService{}
+	suite.sut = tracing.NewInventoryServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestCreate_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{Barcode: "some.barcode"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Create", traceContext, voFixture).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+	suite.assertSingleSpan("inventory.Service/Create", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadDetails_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadDetails", traceContext, entity.ID(101)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("inventory.Service/ReadDetails", codes.Error)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadAll_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []inventory.ThinViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAll", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("inventory.Service/ReadAll", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadManyDetails_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	ids := []entity.ID{101, 102}
+	vos := []inventory.ViewVO{{ID: 101}, {ID: 102}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadManyDetails", traceContext, ids).Return(vos, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(context.Background(), ids)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vos, actual)
+	suite.assertSingleSpan("inventory.Service/ReadManyDetails", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64Slice("matchstick.entity.ids", []int64{101, 102}))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadAllOfFormat_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []inventory.ThinViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAllOfFormat", traceContext, entity.FormatVHS).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAllOfFormat(context.Background(), entity.FormatVHS)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("inventory.Service/ReadAllOfFormat", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadAllOfTitles_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	ids := []entity.ID{101, 102}
+	expected := []inventory.ThinViewVO{{ID: 201, TitleID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAllOfTitles", traceContext, ids).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAllOfTitles(context.Background(), ids)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("inventory.Service/ReadAllOfTitles", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64Slice("matchstick.entity.ids", []int64{101, 102}))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestUpdate_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &inventory.UpdateItemVO{Barcode: "some.barcode"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Update", traceContext, entity.ID(101), voFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(context.Background(), entity.ID(101), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("inventory.Service/Update", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestDelete_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Delete", traceContext, entity.ID(101)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("inventory.Service/Delete", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestCheckout_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockDelegate.On("Checkout", traceContext, entity.ID(101), vo).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Checkout(context.Background(), entity.ID(101), vo)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("inventory.Service/Checkout", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.account.id", 7))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestCheckIn_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	receipt := &rental.ReceiptLineVO{RentalID: 5}
+
+	// Setup mocks
+	suite.mockDelegate.On("CheckIn", traceContext, entity.ID(101)).Return(receipt, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receipt, actual)
+	suite.assertSingleSpan("inventory.Service/CheckIn", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestRenew_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	receipt := &rental.RenewalReceiptLineVO{RentalID: 5}
+
+	// Setup mocks
+	suite.mockDelegate.On("Renew", traceContext, entity.ID(101)).Return(receipt, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receipt, actual)
+	suite.assertSingleSpan("inventory.Service/Renew", codes.Unset)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestFulfilHold_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("FulfilHold", traceContext, entity.ID(301)).Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(context.Background(), entity.ID(301))
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("inventory.Service/FulfilHold", codes.Error)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestFindIDByBarcode_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("FindIDByBarcode", traceContext, "MV00000001").Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.FindIDByBarcode(context.Background(), "MV00000001")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+	suite.assertSingleSpan("inventory.Service/FindIDByBarcode", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.String("matchstick.inventory.barcode", "MV00000001"))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestMove_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &inventory.MoveVO{Location: "main-A-1-12"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Move", traceContext, entity.ID(101), vo).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Move(context.Background(), entity.ID(101), vo)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("inventory.Service/Move", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.entity.id", 101))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadMoves_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadMoves", traceContext, entity.ID(101)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadMoves(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("inventory.Service/ReadMoves", codes.Error)
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadShelf_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &inventory.ShelfViewVO{Store: "main", Aisle: "A", Shelf: "1"}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadShelf", traceContext, "main", "A", "1").Return(vo, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadShelf(context.Background(), "main", "A", "1")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vo, actual)
+	suite.assertSingleSpan("inventory.Service/ReadShelf", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.String("matchstick.location.shelf", "1"))
+}
+
+func (suite *InventoryServiceImplTestSuite) TestReadLabels_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	ids := []entity.ID{101, 102}
+	vos := []inventory.LabelVO{{ItemID: 101}, {ItemID: 102}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadLabels", traceContext, ids).Return(vos, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadLabels(context.Background(), ids)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vos, actual)
+	suite.assertSingleSpan("inventory.Service/ReadLabels", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64Slice("matchstick.entity.ids", []int64{101, 102}))
+}
+
+func (suite *InventoryServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,101 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	ledgerMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/ledger"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+type LedgerServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *ledgerMocks.MockService
+	sut               *tracing.LedgerServiceImpl
+}
+
+func TestLedgerServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerServiceImplTestSuite))
+}
+
+func (suite *LedgerServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &ledgerMocks.MockService{}
+	suite.sut = tracing.NewLedgerServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *LedgerServiceImplTestSuite) TestRecordPayment_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCard}
+
+	// Setup mocks
+	suite.mockDelegate.On("RecordPayment", traceContext, entity.ID(7), vo).Return(entity.ID(301), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordPayment(context.Background(), entity.ID(7), vo)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(301), actual)
+	suite.assertSingleSpan("ledger.Service/RecordPayment", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.account.id", 7))
+}
+
+func (suite *LedgerServiceImplTestSuite) TestRecordAdjustment_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup fixture
+	vo := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("RecordAdjustment", traceContext, entity.ID(7), vo).Return(entity.InvalidID, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordAdjustment(context.Background(), entity.ID(7), vo)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("ledger.Service/RecordAdjustment", codes.Error)
+}
+
+func (suite *LedgerServiceImplTestSuite) TestReadStatement_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &ledger.StatementVO{AccountID: 7, Balance: 150}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadStatement", traceContext, entity.ID(7)).Return(vo, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadStatement(context.Background(), entity.ID(7))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vo, actual)
+	suite.assertSingleSpan("ledger.Service/ReadStatement", codes.Unset)
+}
+
+func (suite *LedgerServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
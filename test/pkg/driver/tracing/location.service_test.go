@@ -0,0 +1,99 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	locationMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/location"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+type LocationServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *locationMocks.MockService
+	sut               *tracing.LocationServiceImpl
+}
+
+func TestLocationServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationServiceImplTestSuite))
+}
+
+func (suite *LocationServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &locationMocks.MockService{}
+	suite.sut = tracing.NewLocationServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *LocationServiceImplTestSuite) TestCreate_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	vo := &location.CreateLocationVO{Location: "main-A-1-12"}
+	view := &location.ViewVO{Location: "main-A-1-12"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Create", traceContext, vo).Return(view, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(context.Background(), vo)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(view, actual)
+	suite.assertSingleSpan("location.Service/Create", codes.Unset)
+}
+
+func (suite *LocationServiceImplTestSuite) TestReadAll_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadAll", traceContext).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("location.Service/ReadAll", codes.Error)
+}
+
+func (suite *LocationServiceImplTestSuite) TestDelete_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	loc := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Delete", traceContext, loc).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(context.Background(), loc)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("location.Service/Delete", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.String("matchstick.location.store", "main"))
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.String("matchstick.location.slot", "12"))
+}
+
+func (suite *LocationServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
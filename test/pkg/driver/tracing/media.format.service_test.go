@@ -0,0 +1,95 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	mediaformatMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/mediaformat"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type MediaFormatServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *mediaformatMocks.MockService
+	sut               *tracing.MediaFormatServiceImpl
+}
+
+func TestMediaFormatServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaFormatServiceImplTestSuite))
+}
+
+func (suite *MediaFormatServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &mediaformatMocks.MockService{}
+	suite.sut = tracing.NewMediaFormatServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *MediaFormatServiceImplTestSuite) TestReadDetails_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadDetails", traceContext, entity.FormatDVD).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(context.Background(), entity.FormatDVD)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("mediaformat.Service/ReadDetails", codes.Error)
+}
+
+func (suite *MediaFormatServiceImplTestSuite) TestReadAll_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []mediaformat.ViewVO{{Format: entity.FormatDVD}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAll", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("mediaformat.Service/ReadAll", codes.Unset)
+}
+
+func (suite *MediaFormatServiceImplTestSuite) TestUpdate_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{Name: "DVD"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Update", traceContext, entity.FormatDVD, voFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(context.Background(), entity.FormatDVD, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("mediaformat.Service/Update", codes.Unset)
+}
+
+func (suite *MediaFormatServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
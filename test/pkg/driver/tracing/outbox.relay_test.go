@@ -0,0 +1,77 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+type OutboxRelayImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *outboxMocks.MockRelay
+	sut               *tracing.OutboxRelayImpl
+}
+
+func TestOutboxRelayImplTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRelayImplTestSuite))
+}
+
+func (suite *OutboxRelayImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &outboxMocks.MockRelay{}
+	suite.sut = tracing.NewOutboxRelayImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *OutboxRelayImplTestSuite) TestRelayPending_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("RelayPending", traceContext).Return(3, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(3, actual)
+	suite.assertSingleSpan(codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int("matchstick.outbox.relayed", 3))
+}
+
+func (suite *OutboxRelayImplTestSuite) TestRelayPending_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("RelayPending", traceContext).Return(0, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(context.Background())
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.Equal(0, actual)
+	suite.assertSingleSpan(codes.Error)
+}
+
+func (suite *OutboxRelayImplTestSuite) assertSingleSpan(code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal("outbox.Relay/RelayPending", spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,79 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	rentalMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/rental"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type RentalServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *rentalMocks.MockService
+	sut               *tracing.RentalServiceImpl
+}
+
+func TestRentalServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(RentalServiceImplTestSuite))
+}
+
+func (suite *RentalServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &rentalMocks.MockService{}
+	suite.sut = tracing.NewRentalServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *RentalServiceImplTestSuite) TestReadOverdue_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []rental.ViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadOverdue", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadOverdue(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("rental.Service/ReadOverdue", codes.Unset)
+}
+
+func (suite *RentalServiceImplTestSuite) TestReadOverdue_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadOverdue", traceContext).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadOverdue(context.Background())
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("rental.Service/ReadOverdue", codes.Error)
+}
+
+func (suite *RentalServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,142 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type TitleServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *titleMocks.MockService
+	sut               *tracing.TitleServiceImpl
+}
+
+func TestTitleServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(TitleServiceImplTestSuite))
+}
+
+func (suite *TitleServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &titleMocks.MockService{}
+	suite.sut = tracing.NewTitleServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *TitleServiceImplTestSuite) TestCreate_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{Name: "some.name"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Create", traceContext, voFixture).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+	suite.assertSingleSpan("title.Service/Create", codes.Unset)
+}
+
+func (suite *TitleServiceImplTestSuite) TestReadDetails_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadDetails", traceContext, entity.ID(101)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("title.Service/ReadDetails", codes.Error)
+}
+
+func (suite *TitleServiceImplTestSuite) TestReadManyDetails_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	ids := []entity.ID{101, 102}
+	vos := []title.ViewVO{{ID: 101}, {ID: 102}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadManyDetails", traceContext, ids).Return(vos, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(context.Background(), ids)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vos, actual)
+	suite.assertSingleSpan("title.Service/ReadManyDetails", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64Slice("matchstick.entity.ids", []int64{101, 102}))
+}
+
+func (suite *TitleServiceImplTestSuite) TestReadAll_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []title.ThinViewVO{{ID: 101}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAll", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("title.Service/ReadAll", codes.Unset)
+}
+
+func (suite *TitleServiceImplTestSuite) TestUpdate_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &title.UpdateTitleVO{Name: "some.name"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Update", traceContext, entity.ID(101), voFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(context.Background(), entity.ID(101), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("title.Service/Update", codes.Unset)
+}
+
+func (suite *TitleServiceImplTestSuite) TestDelete_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Delete", traceContext, entity.ID(101)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(context.Background(), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("title.Service/Delete", codes.Unset)
+}
+
+func (suite *TitleServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,52 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/propagation"
+
+	configMocks "github.com/liampulles/matchstick-video/test/mock/pkg/adapter/config"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+func TestNewTracerServiceImpl_GivenUnknownExporter_ShouldFail(t *testing.T) {
+	// Setup mocks
+	mockConfigStore := &configMocks.MockStore{}
+	mockConfigStore.On("GetTraceServiceName").Return("some.service")
+	mockConfigStore.On("GetTraceExporter").Return("not.an.exporter")
+
+	// Setup expectations
+	expectedErr := "could not create tracer provider - unknown exporter: not.an.exporter"
+
+	// Exercise SUT
+	actual, err := tracing.NewTracerServiceImpl(mockConfigStore)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestNewTracerServiceImpl_GivenKnownExporter_ShouldPass(t *testing.T) {
+	for _, exporter := range []string{"", "none", "stdout", "otlp"} {
+		t.Run(exporter, func(t *testing.T) {
+			// Setup mocks
+			mockConfigStore := &configMocks.MockStore{}
+			mockConfigStore.On("GetTraceServiceName").Return("some.service")
+			mockConfigStore.On("GetTraceExporter").Return(exporter)
+			mockConfigStore.On("GetTraceOTLPEndpoint").Return("localhost:4318")
+
+			// Exercise SUT
+			actual, err := tracing.NewTracerServiceImpl(mockConfigStore)
+
+			// Verify results
+			assert.NoError(t, err)
+			assert.NotNil(t, actual.Tracer())
+			assert.NotNil(t, actual.Provider())
+			assert.Equal(t, propagation.TraceContext{}, actual.Propagator())
+			assert.NoError(t, actual.Shutdown(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+type WebhookDelivererImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *webhookMocks.MockDeliverer
+	sut               *tracing.WebhookDelivererImpl
+}
+
+func TestWebhookDelivererImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookDelivererImplTestSuite))
+}
+
+func (suite *WebhookDelivererImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &webhookMocks.MockDeliverer{}
+	suite.sut = tracing.NewWebhookDelivererImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *WebhookDelivererImplTestSuite) TestDeliverDue_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("DeliverDue", traceContext).Return(4, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(4, actual)
+	suite.assertSingleSpan(codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int("matchstick.webhook.attempted", 4))
+}
+
+func (suite *WebhookDelivererImplTestSuite) TestDeliverDue_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("DeliverDue", traceContext).Return(0, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(context.Background())
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.Equal(0, actual)
+	suite.assertSingleSpan(codes.Error)
+}
+
+func (suite *WebhookDelivererImplTestSuite) assertSingleSpan(code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal("webhook.Deliverer/DeliverDue", spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,94 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+)
+
+type WebhookSenderImplTestSuite struct {
+	suite.Suite
+	recorder            *tracetest.SpanRecorder
+	mockTracerService   *tracingMocks.MockTracerService
+	mockDelegate        *webhookMocks.MockSender
+	subscriptionFixture entity.WebhookSubscription
+	deliveryFixture     entity.WebhookDelivery
+	sut                 *tracing.WebhookSenderImpl
+}
+
+func TestWebhookSenderImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSenderImplTestSuite))
+}
+
+func (suite *WebhookSenderImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &webhookMocks.MockSender{}
+	nowFixture := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.subscriptionFixture = entity.TestWebhookSubscriptionImplConstructor(401, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", nowFixture)
+	suite.deliveryFixture = entity.TestWebhookDeliveryImplConstructor(501, 401, 12,
+		entity.EventItemCheckedOut, 101, nowFixture, 0)
+	suite.sut = tracing.NewWebhookSenderImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *WebhookSenderImplTestSuite) TestSend_WhenDelegateSucceeds_ShouldRecordClientSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Send", traceContext, suite.subscriptionFixture, suite.deliveryFixture).Return(204, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Send(context.Background(), suite.subscriptionFixture, suite.deliveryFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(204, actual)
+	span := suite.assertSingleSpan(codes.Unset)
+	suite.Equal(trace.SpanKindClient, span.SpanKind())
+	suite.Contains(span.Attributes(), attribute.Int64("matchstick.webhook.subscription.id", 401))
+	suite.Contains(span.Attributes(), attribute.Int64("matchstick.entity.id", 501))
+	suite.Contains(span.Attributes(), attribute.Int("http.status_code", 204))
+}
+
+func (suite *WebhookSenderImplTestSuite) TestSend_WhenDelegateFailsWithoutResponse_ShouldRecordErrorWithoutStatus() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("Send", traceContext, suite.subscriptionFixture, suite.deliveryFixture).Return(0, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Send(context.Background(), suite.subscriptionFixture, suite.deliveryFixture)
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.Equal(0, actual)
+	span := suite.assertSingleSpan(codes.Error)
+	for _, attr := range span.Attributes() {
+		suite.NotEqual(attribute.Key("http.status_code"), attr.Key)
+	}
+}
+
+func (suite *WebhookSenderImplTestSuite) assertSingleSpan(code codes.Code) sdktrace.ReadOnlySpan {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal("webhook.Sender/Send", spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+	return spans[0]
+}
@@ -0,0 +1,156 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	tracingMocks "github.com/liampulles/matchstick-video/test/mock/pkg/driver/tracing"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/driver/tracing"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type WebhookServiceImplTestSuite struct {
+	suite.Suite
+	recorder          *tracetest.SpanRecorder
+	mockTracerService *tracingMocks.MockTracerService
+	mockDelegate      *webhookMocks.MockService
+	sut               *tracing.WebhookServiceImpl
+}
+
+func TestWebhookServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceImplTestSuite))
+}
+
+func (suite *WebhookServiceImplTestSuite) SetupTest() {
+	suite.recorder = tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder))
+	suite.mockTracerService = &tracingMocks.MockTracerService{}
+	suite.mockTracerService.On("Tracer").Return(provider.Tracer("test"))
+	suite.mockDelegate = &webhookMocks.MockService{}
+	suite.sut = tracing.NewWebhookServiceImpl(
+		suite.mockDelegate,
+		suite.mockTracerService,
+	)
+}
+
+func (suite *WebhookServiceImplTestSuite) TestCreate_WhenDelegateSucceeds_ShouldRecordSpanAndReturn() {
+	// Setup fixture
+	voFixture := &webhook.CreateSubscriptionVO{URL: "https://example.com/hook"}
+
+	// Setup mocks
+	suite.mockDelegate.On("Create", traceContext, voFixture).Return(entity.ID(401), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(context.Background(), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(401), actual)
+	suite.assertSingleSpan("webhook.Service/Create", codes.Unset)
+}
+
+func (suite *WebhookServiceImplTestSuite) TestReadDetails_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("ReadDetails", traceContext, entity.ID(401)).Return(nil, mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(context.Background(), entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("webhook.Service/ReadDetails", codes.Error)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.entity.id", 401))
+}
+
+func (suite *WebhookServiceImplTestSuite) TestReadAll_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []webhook.SubscriptionViewVO{{ID: 401}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadAll", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("webhook.Service/ReadAll", codes.Unset)
+}
+
+func (suite *WebhookServiceImplTestSuite) TestDelete_ShouldRecordSpanAndReturn() {
+	// Setup mocks
+	suite.mockDelegate.On("Delete", traceContext, entity.ID(401)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(context.Background(), entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+	suite.assertSingleSpan("webhook.Service/Delete", codes.Unset)
+}
+
+func (suite *WebhookServiceImplTestSuite) TestReadDeliveries_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []webhook.DeliveryViewVO{{ID: 501}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadDeliveries", traceContext, entity.ID(401)).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeliveries(context.Background(), entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("webhook.Service/ReadDeliveries", codes.Unset)
+	suite.Contains(suite.recorder.Ended()[0].Attributes(), attribute.Int64("matchstick.webhook.subscription.id", 401))
+}
+
+func (suite *WebhookServiceImplTestSuite) TestReadDeadLetters_ShouldRecordSpanAndReturn() {
+	// Setup expectations
+	expected := []webhook.DeliveryViewVO{{ID: 501}}
+
+	// Setup mocks
+	suite.mockDelegate.On("ReadDeadLetters", traceContext).Return(expected, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeadLetters(context.Background())
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.assertSingleSpan("webhook.Service/ReadDeadLetters", codes.Unset)
+}
+
+func (suite *WebhookServiceImplTestSuite) TestRedeliver_WhenDelegateFails_ShouldRecordErrorAndFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockDelegate.On("Redeliver", traceContext, entity.ID(501)).Return(mockErr)
+
+	// Exercise SUT
+	err := suite.sut.Redeliver(context.Background(), entity.ID(501))
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.assertSingleSpan("webhook.Service/Redeliver", codes.Error)
+}
+
+func (suite *WebhookServiceImplTestSuite) assertSingleSpan(name string, code codes.Code) {
+	spans := suite.recorder.Ended()
+	suite.Require().Len(spans, 1)
+	suite.Equal(name, spans[0].Name())
+	suite.Equal(code, spans[0].Status().Code)
+}
@@ -0,0 +1,50 @@
+package account_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+type EntityFactoryTestSuite struct {
+	suite.Suite
+	mockConstructor *entityMocks.MockAccountConstructor
+	sut             *account.EntityFactoryImpl
+}
+
+func TestEntityFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityFactoryTestSuite))
+}
+
+func (suite *EntityFactoryTestSuite) SetupTest() {
+	suite.mockConstructor = &entityMocks.MockAccountConstructor{}
+	suite.sut = account.NewEntityFactoryImpl(suite.mockConstructor)
+}
+
+func (suite *EntityFactoryTestSuite) TestCreateFromVO_ShouldCallConstructorAndReturnEntityAndError() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	voFixture := &account.CreateAccountVO{
+		Name:        "some.name",
+		DateOfBirth: &dateOfBirth,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockError := fmt.Errorf("some.error")
+	suite.mockConstructor.On("New", "some.name", &dateOfBirth).
+		Return(mockEntity, mockError)
+
+	// Exercise SUT
+	actual, err := suite.sut.CreateFromVO(voFixture)
+
+	// Verify results
+	suite.EqualError(err, "some.error")
+	suite.Equal(actual, mockEntity)
+}
@@ -0,0 +1,90 @@
+package account_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+)
+
+type EntityModifierTestSuite struct {
+	suite.Suite
+	sut *account.EntityModifierImpl
+}
+
+func TestEntityModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityModifierTestSuite))
+}
+
+func (suite *EntityModifierTestSuite) SetupTest() {
+	suite.sut = account.NewEntityModifierImpl()
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateAccountVO_WhenEntityChangeNameFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &account.UpdateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ChangeName", "some.name").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity name change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateAccountVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateAccountVO_WhenEntityChangeDateOfBirthFails_ShouldFail() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	voFixture := &account.UpdateAccountVO{
+		Name:        "some.name",
+		DateOfBirth: &dateOfBirth,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeDateOfBirth", &dateOfBirth).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity date of birth change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateAccountVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateAccountVO_WhenChangesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	voFixture := &account.UpdateAccountVO{
+		Name:        "some.name",
+		DateOfBirth: &dateOfBirth,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeDateOfBirth", &dateOfBirth).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateAccountVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockEntity.AssertExpectations(suite.T())
+}
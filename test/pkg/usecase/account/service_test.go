@@ -0,0 +1,340 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+	rentalMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/rental"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockAccountRepository *accountMocks.MockRepository
+	mockRentalRepository  *rentalMocks.MockRepository
+	mockEntityFactory     *accountMocks.MockEntityFactory
+	mockEntityModifier    *accountMocks.MockEntityModifier
+	mockVoFactory         *accountMocks.MockVOFactory
+	mockRentalVoFactory   *rentalMocks.MockVOFactory
+	mockClock             *domainMocks.MockClock
+	ctxFixture            context.Context
+	nowFixture            time.Time
+	sut                   *account.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockAccountRepository = &accountMocks.MockRepository{}
+	suite.mockRentalRepository = &rentalMocks.MockRepository{}
+	suite.mockEntityFactory = &accountMocks.MockEntityFactory{}
+	suite.mockEntityModifier = &accountMocks.MockEntityModifier{}
+	suite.mockVoFactory = &accountMocks.MockVOFactory{}
+	suite.mockRentalVoFactory = &rentalMocks.MockVOFactory{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = account.NewServiceImpl(
+		suite.mockAccountRepository,
+		suite.mockRentalRepository,
+		suite.mockEntityFactory,
+		suite.mockEntityModifier,
+		suite.mockVoFactory,
+		suite.mockRentalVoFactory,
+		suite.mockClock,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenFactoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &account.CreateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create account - factory error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &account.CreateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockAccountRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create account - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup fixture
+	voFixture := &account.CreateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockAccountRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read account - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRentalRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByAccountID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read account - rental repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	rentalVOs := []rental.ViewVO{
+		{ID: entity.ID(201), Overdue: true},
+	}
+	expected := &account.ViewVO{
+		ID:      idFixture,
+		Rentals: rentalVOs,
+		Overdue: true,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	mockRentals := []entity.Rental{&entityMocks.MockRental{Data: "mock.rental"}}
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByAccountID", suite.ctxFixture, idFixture).Return(mockRentals, nil)
+	suite.mockRentalVoFactory.On("CreateViewVOsFromEntities", mockRentals, suite.nowFixture).Return(rentalVOs)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity, rentalVOs).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockAccountRepository.On("FindAll", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read accounts - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup expectations
+	expected := []account.ThinViewVO{
+		{ID: entity.ID(101)},
+	}
+
+	// Setup mocks
+	mockEntities := []entity.Account{&entityMocks.MockAccount{Data: "mock.data"}}
+	suite.mockAccountRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &account.UpdateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update account - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenModifierFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &account.UpdateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateAccountVO", mockEntity, voFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update account - modifier error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &account.UpdateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateAccountVO", mockEntity, voFixture).Return(nil)
+	suite.mockAccountRepository.On("Update", suite.ctxFixture, mockEntity).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update account - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenDelegatesSucceed_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &account.UpdateAccountVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{Data: "mock.data"}
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateAccountVO", mockEntity, voFixture).Return(nil)
+	suite.mockAccountRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockAccountRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not delete account - repository delete error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryPasses_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockAccountRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
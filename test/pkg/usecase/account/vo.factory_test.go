@@ -0,0 +1,113 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/account"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	sut *account.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.sut = account.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_WhenNoRentalIsOverdue_ShouldNotBeOverdue() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	rentalsFixture := []rental.ViewVO{
+		{ID: 201, Overdue: false},
+		{ID: 202, Overdue: false},
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ID").Return(entity.ID(7))
+	mockEntity.On("Name").Return("some.name")
+	mockEntity.On("DateOfBirth").Return(&dateOfBirth)
+	mockEntity.On("Balance").Return(entity.Money(250))
+
+	// Setup expectations
+	expected := &account.ViewVO{
+		ID:          entity.ID(7),
+		Name:        "some.name",
+		DateOfBirth: &dateOfBirth,
+		Balance:     entity.Money(250),
+		Rentals:     rentalsFixture,
+		Overdue:     false,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, rentalsFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_WhenAnyRentalIsOverdue_ShouldBeOverdue() {
+	// Setup fixture
+	dateOfBirth := time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+	rentalsFixture := []rental.ViewVO{
+		{ID: 201, Overdue: true},
+		{ID: 202, Overdue: false},
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockAccount{}
+	mockEntity.On("ID").Return(entity.ID(7))
+	mockEntity.On("Name").Return("some.name")
+	mockEntity.On("DateOfBirth").Return(&dateOfBirth)
+	mockEntity.On("Balance").Return(entity.Money(250))
+
+	// Setup expectations
+	expected := &account.ViewVO{
+		ID:          entity.ID(7),
+		Name:        "some.name",
+		DateOfBirth: &dateOfBirth,
+		Balance:     entity.Money(250),
+		Rentals:     rentalsFixture,
+		Overdue:     true,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, rentalsFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateThinViewVOsFromEntities_ShouldMapEntities() {
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockAccount{}
+	mockEntity1.On("ID").Return(entity.ID(7))
+	mockEntity1.On("Name").Return("some.name.1")
+	mockEntity2 := &entityMocks.MockAccount{}
+	mockEntity2.On("ID").Return(entity.ID(8))
+	mockEntity2.On("Name").Return("some.name.2")
+
+	// Setup expectations
+	expected := []account.ThinViewVO{
+		{ID: entity.ID(7), Name: "some.name.1"},
+		{ID: entity.ID(8), Name: "some.name.2"},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateThinViewVOsFromEntities([]entity.Account{mockEntity1, mockEntity2})
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,121 @@
+package hold_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	holdMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/hold"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+type QueueImplTestSuite struct {
+	suite.Suite
+	mockRepository *holdMocks.MockRepository
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	nowFixture     time.Time
+	sut            *hold.QueueImpl
+}
+
+func TestQueueImplTestSuite(t *testing.T) {
+	suite.Run(t, new(QueueImplTestSuite))
+}
+
+func (suite *QueueImplTestSuite) SetupTest() {
+	suite.mockRepository = &holdMocks.MockRepository{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = hold.NewQueueImpl(
+		suite.mockRepository,
+		suite.mockClock,
+		72*time.Hour,
+	)
+}
+
+func (suite *QueueImplTestSuite) TestAssignNext_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not assign hold - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.AssignNext(suite.ctxFixture, entity.ID(11), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *QueueImplTestSuite) TestAssignNext_WhenNoOneIsWaiting_ShouldReturnNil() {
+	// Setup mocks
+	suite.mockRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(nil, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.AssignNext(suite.ctxFixture, entity.ID(11), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *QueueImplTestSuite) TestAssignNext_WhenEntityFails_ShouldFail() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(mockEntity, nil)
+	mockEntity.On("Assign", entity.ID(101), suite.nowFixture.Add(72*time.Hour)).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not assign hold - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.AssignNext(suite.ctxFixture, entity.ID(11), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *QueueImplTestSuite) TestAssignNext_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(mockEntity, nil)
+	mockEntity.On("Assign", entity.ID(101), suite.nowFixture.Add(72*time.Hour)).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not assign hold - repository update error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.AssignNext(suite.ctxFixture, entity.ID(11), entity.ID(101))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *QueueImplTestSuite) TestAssignNext_WhenDelegatesSucceed_ShouldReturnAssignedHold() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(mockEntity, nil)
+	mockEntity.On("Assign", entity.ID(101), suite.nowFixture.Add(72*time.Hour)).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.AssignNext(suite.ctxFixture, entity.ID(11), entity.ID(101))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(mockEntity, actual)
+}
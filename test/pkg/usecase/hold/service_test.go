@@ -0,0 +1,487 @@
+package hold_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	holdMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/hold"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository      *holdMocks.MockRepository
+	mockTitleRepository *titleMocks.MockRepository
+	mockItemRepository  *holdMocks.MockItemRepository
+	mockConstructor     *entityMocks.MockHoldConstructor
+	mockQueue           *holdMocks.MockQueue
+	mockVoFactory       *holdMocks.MockVOFactory
+	mockOutboxWriter    *outboxMocks.MockWriter
+	mockTransactor      *domainMocks.MockTransactor
+	mockClock           *domainMocks.MockClock
+	ctxFixture          context.Context
+	nowFixture          time.Time
+	sut                 *hold.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &holdMocks.MockRepository{}
+	suite.mockTitleRepository = &titleMocks.MockRepository{}
+	suite.mockItemRepository = &holdMocks.MockItemRepository{}
+	suite.mockConstructor = &entityMocks.MockHoldConstructor{}
+	suite.mockQueue = &holdMocks.MockQueue{}
+	suite.mockVoFactory = &holdMocks.MockVOFactory{}
+	suite.mockOutboxWriter = &outboxMocks.MockWriter{}
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(nil)
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.SetupSUT()
+}
+
+// SetupSUT creates the SUT from the suite's mocks.
+func (suite *ServiceImplTestSuite) SetupSUT() {
+	suite.sut = hold.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockTitleRepository,
+		suite.mockItemRepository,
+		suite.mockConstructor,
+		suite.mockQueue,
+		suite.mockVoFactory,
+		suite.mockOutboxWriter,
+		suite.mockTransactor,
+		suite.mockClock,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenTitleRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, titleIDFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not place hold - title repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenTitleRepositoryStockFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockTitle(titleIDFixture)
+	suite.mockTitleRepository.On("FindStockByID", suite.ctxFixture, titleIDFixture).Return(title.Stock{}, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not place hold - title repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenTitleHasNoCopies_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockTitle(titleIDFixture)
+	suite.mockTitleRepository.On("FindStockByID", suite.ctxFixture, titleIDFixture).Return(title.Stock{Copies: 0, Available: 0}, nil)
+
+	// Setup expectations
+	expectedErr := "could not place hold - stock error: validation error: field=[titleId], problem=[has no copies to hold]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenCopiesAreAvailable_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockTitle(titleIDFixture)
+	suite.mockTitleRepository.On("FindStockByID", suite.ctxFixture, titleIDFixture).Return(title.Stock{Copies: 2, Available: 1}, nil)
+
+	// Setup expectations
+	expectedErr := "could not place hold - stock error: validation error: field=[titleId], problem=[has copies available - check one out instead]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenConstructorFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	suite.mockOutOfStock(titleIDFixture)
+	suite.mockConstructor.On("New", titleIDFixture, entity.ID(7), suite.nowFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not place hold - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockOutOfStock(titleIDFixture)
+	suite.mockConstructor.On("New", titleIDFixture, entity.ID(7), suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not place hold - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestPlace_WhenDelegatesSucceed_ShouldReturnID() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+	voFixture := &hold.PlaceHoldVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockOutOfStock(titleIDFixture)
+	suite.mockConstructor.On("New", titleIDFixture, entity.ID(7), suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(301), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Place(suite.ctxFixture, titleIDFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(301), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadQueue_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+
+	// Setup mocks
+	suite.mockRepository.On("FindActiveByTitleID", suite.ctxFixture, titleIDFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read holds - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadQueue(suite.ctxFixture, titleIDFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadQueue_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup fixture
+	titleIDFixture := entity.ID(11)
+
+	// Setup expectations
+	expected := []hold.ViewVO{
+		{ID: entity.ID(301), Status: entity.HoldWaiting},
+	}
+
+	// Setup mocks
+	mockEntities := []entity.Hold{&entityMocks.MockHold{Data: "some.hold"}}
+	suite.mockRepository.On("FindActiveByTitleID", suite.ctxFixture, titleIDFixture).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateViewVOsFromEntities", mockEntities).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadQueue(suite.ctxFixture, titleIDFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenTransactionFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(mockErr)
+	suite.SetupSUT()
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.mockRepository.AssertNotCalled(suite.T(), "FindByID", suite.ctxFixture, idFixture)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenEntityFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Status").Return(entity.HoldFulfilled)
+	mockEntity.On("Cancel").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - entity error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Status").Return(entity.HoldWaiting)
+	mockEntity.On("Cancel").Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenWaiting_ShouldNotPassOnACopy() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Status").Return(entity.HoldWaiting)
+	mockEntity.On("Cancel").Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockQueue.AssertNotCalled(suite.T(), "AssignNext")
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenItemRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	suite.mockReadyHold(idFixture)
+	suite.mockItemRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - item repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockQueue.AssertNotCalled(suite.T(), "AssignNext")
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenQueueFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockEntity := suite.mockReadyHold(idFixture)
+	suite.mockItem()
+	suite.mockQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), entity.ID(101)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - queue error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	mockEntity.AssertCalled(suite.T(), "Cancel")
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenNoHoldIsWaiting_ShouldNotRecordAnything() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	suite.mockReadyHold(idFixture)
+	mockItem := suite.mockItem()
+	suite.mockQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), entity.ID(101)).Return(nil, nil)
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockQueue.AssertCalled(suite.T(), "AssignNext", suite.ctxFixture, entity.ID(11), entity.ID(101))
+	mockItem.AssertNotCalled(suite.T(), "PutAside")
+	suite.mockOutboxWriter.AssertNotCalled(suite.T(), "Write")
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenOutboxWriteFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	suite.mockReadyHold(idFixture)
+	mockItem := suite.mockItem()
+	suite.mockNextHold(mockItem)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, entity.ID(101), mockItem).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not cancel hold - mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCancel_WhenReady_ShouldPutTheCopyAsideForTheNextHold() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	suite.mockReadyHold(idFixture)
+	mockItem := suite.mockItem()
+	suite.mockNextHold(mockItem)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, entity.ID(101), mockItem).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Cancel(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockItem.AssertCalled(suite.T(), "PutAside", entity.ID(302), entity.ID(8))
+	suite.mockOutboxWriter.AssertCalled(suite.T(), "Write", suite.ctxFixture, entity.ID(101), mockItem)
+}
+
+// mockTitle finds the title.
+func (suite *ServiceImplTestSuite) mockTitle(titleID entity.ID) {
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, titleID).Return(&entityMocks.MockTitle{Data: "some.title"}, nil)
+}
+
+// mockOutOfStock finds the title, with every copy out.
+func (suite *ServiceImplTestSuite) mockOutOfStock(titleID entity.ID) {
+	suite.mockTitle(titleID)
+	suite.mockTitleRepository.On("FindStockByID", suite.ctxFixture, titleID).Return(title.Stock{Copies: 2, Available: 0}, nil)
+}
+
+// mockReadyHold finds a hold with copy 101 of title 11 put aside,
+// which is cancelled successfully.
+func (suite *ServiceImplTestSuite) mockReadyHold(id entity.ID) *entityMocks.MockHold {
+	mockEntity := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, id).Return(mockEntity, nil)
+	mockEntity.On("Status").Return(entity.HoldReady)
+	mockEntity.On("Cancel").Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	mockEntity.On("ItemID").Return(entity.ID(101))
+	return mockEntity
+}
+
+// mockItem finds copy 101.
+func (suite *ServiceImplTestSuite) mockItem() *entityMocks.MockInventoryItem {
+	mockItem := &entityMocks.MockInventoryItem{Data: "some.item"}
+	suite.mockItemRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockItem, nil)
+	mockItem.On("ID").Return(entity.ID(101))
+	return mockItem
+}
+
+// mockNextHold assigns copy 101 to hold 302 of account 8.
+func (suite *ServiceImplTestSuite) mockNextHold(mockItem *entityMocks.MockInventoryItem) {
+	mockNext := &entityMocks.MockHold{Data: "next.hold"}
+	mockNext.On("ID").Return(entity.ID(302))
+	mockNext.On("AccountID").Return(entity.ID(8))
+	suite.mockQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), entity.ID(101)).Return(mockNext, nil)
+	mockItem.On("PutAside", entity.ID(302), entity.ID(8))
+}
@@ -0,0 +1,93 @@
+package hold_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/hold"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	placedFixture  time.Time
+	expiresFixture time.Time
+	sut            *hold.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.placedFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.expiresFixture = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	suite.sut = hold.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_ShouldMapFields() {
+	// Setup fixture
+	entityFixture := entity.TestHoldImplConstructor(301, 11, 7, suite.placedFixture, entity.HoldReady, 101, &suite.expiresFixture)
+
+	// Setup expectations
+	expected := &hold.ViewVO{
+		ID:        301,
+		TitleID:   11,
+		AccountID: 7,
+		PlacedAt:  suite.placedFixture,
+		Status:    entity.HoldReady,
+		ItemID:    101,
+		ExpiresAt: &suite.expiresFixture,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(entityFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOsFromEntities_ShouldMapEach() {
+	// Setup fixture
+	entitiesFixture := []entity.Hold{
+		entity.TestHoldImplConstructor(301, 11, 7, suite.placedFixture, entity.HoldReady, 101, &suite.expiresFixture),
+		entity.TestHoldImplConstructor(302, 11, 8, suite.placedFixture.Add(time.Hour), entity.HoldWaiting, entity.InvalidID, nil),
+	}
+
+	// Setup expectations
+	expected := []hold.ViewVO{
+		{
+			ID:        301,
+			TitleID:   11,
+			AccountID: 7,
+			PlacedAt:  suite.placedFixture,
+			Status:    entity.HoldReady,
+			ItemID:    101,
+			ExpiresAt: &suite.expiresFixture,
+		},
+		{
+			ID:        302,
+			TitleID:   11,
+			AccountID: 8,
+			PlacedAt:  suite.placedFixture.Add(time.Hour),
+			Status:    entity.HoldWaiting,
+			ItemID:    entity.InvalidID,
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOsFromEntities(entitiesFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOsFromEntities_WhenNoEntities_ShouldReturnNil() {
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOsFromEntities(nil)
+
+	// Verify results
+	suite.Nil(actual)
+}
@@ -0,0 +1,290 @@
+package idempotency_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	idempotencyMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/idempotency"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/idempotency"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository *idempotencyMocks.MockRepository
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	nowFixture     time.Time
+	sut            *idempotency.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &idempotencyMocks.MockRepository{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = idempotency.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockClock,
+		time.Hour,
+		time.Minute,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenDeleteFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).
+		Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not begin idempotent request - repository delete error: mock.error")
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 0)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenKeyIsNew_ShouldClaimIt() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, &idempotency.Record{
+		Client:      "ip:10.0.0.1",
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.nowFixture,
+	}).Return(true, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "FindByKey", 0)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenCreateFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not begin idempotent request - repository create error: mock.error")
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(nil, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not begin idempotent request - repository find error: mock.error")
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenKeyIsReleasedBeforeItIsFound_ShouldClaimItAgain() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil).Once()
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(nil, nil).Once()
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(true, nil).Once()
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 2)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenKeyKeepsBeingReleased_ShouldGiveUp() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(nil, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.IsType(&idempotency.InProgressError{}, err)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 3)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenKeyWasUsedForADifferentRequest_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(&idempotency.Record{
+		Key:         "some.key",
+		Fingerprint: "other.fingerprint",
+		Response:    &idempotency.ResponseVO{StatusCode: 201},
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.IsType(&idempotency.ReusedKeyError{}, err)
+	suite.EqualError(err, "idempotency key was used with a different request: key=[some.key]")
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenRequestIsStillBeingHandled_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(&idempotency.Record{
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.nowFixture.Add(-time.Minute),
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.IsType(&idempotency.InProgressError{}, err)
+	suite.EqualError(err, "request with idempotency key is still being handled: key=[some.key]")
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "DeleteUnfinishedCreatedBefore", 0)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenRequestRanOutOfTime_ShouldClaimItAgain() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil).Once()
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(&idempotency.Record{
+		Key:         "some.key",
+		Fingerprint: "other.fingerprint",
+		CreatedAt:   suite.nowFixture.Add(-time.Minute - time.Second),
+	}, nil)
+	suite.mockRepository.On("DeleteUnfinishedCreatedBefore", suite.ctxFixture, "ip:10.0.0.1", "some.key", suite.nowFixture.Add(-time.Minute)).
+		Return(true, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(true, nil).Once()
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 2)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenReleasingRequestWhichRanOutOfTimeFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(&idempotency.Record{
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		CreatedAt:   suite.nowFixture.Add(-time.Hour),
+	}, nil)
+	suite.mockRepository.On("DeleteUnfinishedCreatedBefore", suite.ctxFixture, "ip:10.0.0.1", "some.key", suite.nowFixture.Add(-time.Minute)).
+		Return(false, fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not begin idempotent request - repository delete error: mock.error")
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *ServiceImplTestSuite) TestBegin_WhenRequestWasHandled_ShouldReturnItsResponse() {
+	// Setup fixture
+	responseFixture := &idempotency.ResponseVO{
+		StatusCode:  201,
+		ContentType: "text/plain",
+		Body:        []byte("101"),
+	}
+
+	// Setup mocks
+	suite.mockRepository.On("DeleteCreatedBefore", suite.ctxFixture, suite.nowFixture.Add(-time.Hour)).Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mock.Anything).Return(false, nil)
+	suite.mockRepository.On("FindByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(&idempotency.Record{
+		Key:         "some.key",
+		Fingerprint: "some.fingerprint",
+		Response:    responseFixture,
+	}, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Begin(suite.ctxFixture, "ip:10.0.0.1", "some.key", "some.fingerprint")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(responseFixture, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestFinish_ShouldStoreResponse() {
+	// Setup fixture
+	responseFixture := &idempotency.ResponseVO{StatusCode: 204}
+
+	// Setup mocks
+	suite.mockRepository.On("Finish", suite.ctxFixture, "ip:10.0.0.1", "some.key", responseFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Finish(suite.ctxFixture, "ip:10.0.0.1", "some.key", responseFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceImplTestSuite) TestFinish_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("Finish", suite.ctxFixture, "ip:10.0.0.1", "some.key", mock.Anything).Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Finish(suite.ctxFixture, "ip:10.0.0.1", "some.key", &idempotency.ResponseVO{})
+
+	// Verify results
+	suite.EqualError(err, "could not finish idempotent request - repository finish error: mock.error")
+}
+
+func (suite *ServiceImplTestSuite) TestAbandon_ShouldDeleteKey() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Abandon(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceImplTestSuite) TestAbandon_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("DeleteByKey", suite.ctxFixture, "ip:10.0.0.1", "some.key").Return(fmt.Errorf("mock.error"))
+
+	// Exercise SUT
+	err := suite.sut.Abandon(suite.ctxFixture, "ip:10.0.0.1", "some.key")
+
+	// Verify results
+	suite.EqualError(err, "could not abandon idempotent request - repository delete error: mock.error")
+}
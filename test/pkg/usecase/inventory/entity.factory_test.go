@@ -6,15 +6,18 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
 	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
 
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
 )
 
 type EntityFactoryTestSuite struct {
 	suite.Suite
-	mockConstructor *entityMocks.MockInventoryItemConstructor
-	sut             *inventory.EntityFactoryImpl
+	mockConstructor    *entityMocks.MockInventoryItemConstructor
+	mockLocationFormat *domainMocks.MockLocationFormat
+	sut                *inventory.EntityFactoryImpl
 }
 
 func TestEntityFactoryTestSuite(t *testing.T) {
@@ -23,20 +26,49 @@ func TestEntityFactoryTestSuite(t *testing.T) {
 
 func (suite *EntityFactoryTestSuite) SetupTest() {
 	suite.mockConstructor = &entityMocks.MockInventoryItemConstructor{}
-	suite.sut = inventory.NewEntityFactoryImpl(suite.mockConstructor)
+	suite.mockLocationFormat = &domainMocks.MockLocationFormat{}
+	suite.sut = inventory.NewEntityFactoryImpl(suite.mockConstructor, suite.mockLocationFormat)
+}
+
+func (suite *EntityFactoryTestSuite) TestCreateFromVO_WhenLocationFormatFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{
+		TitleID:  11,
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	mockError := fmt.Errorf("some.error")
+	suite.mockLocationFormat.On("Parse", "some.location").Return(entity.Location{}, mockError)
+
+	// Setup expectations
+	expectedErr := "could not create entity from vo - location format error: some.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CreateFromVO(voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Nil(actual)
 }
 
 func (suite *EntityFactoryTestSuite) TestCreateFromVO_ShouldCallConstructorAndReturnEntityAndError() {
 	// Setup fixture
 	voFixture := &inventory.CreateItemVO{
-		Name:     "some.name",
+		TitleID:  11,
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
 		Location: "some.location",
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	mockError := fmt.Errorf("some.error")
-	suite.mockConstructor.On("NewAvailable", "some.name", "some.location").Return(mockEntity, mockError)
+	mockLocation := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockLocationFormat.On("Parse", "some.location").Return(mockLocation, nil)
+	suite.mockConstructor.On("NewAvailable", entity.ID(11), entity.FormatDVD, "some.barcode", mockLocation).Return(mockEntity, mockError)
 
 	// Exercise SUT
 	actual, err := suite.sut.CreateFromVO(voFixture)
@@ -6,14 +6,17 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
 	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
 
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
 )
 
 type EntityModifierTestSuite struct {
 	suite.Suite
-	sut *inventory.EntityModifierImpl
+	mockLocationFormat *domainMocks.MockLocationFormat
+	sut                *inventory.EntityModifierImpl
 }
 
 func TestEntityModifierTestSuite(t *testing.T) {
@@ -21,22 +24,98 @@ func TestEntityModifierTestSuite(t *testing.T) {
 }
 
 func (suite *EntityModifierTestSuite) SetupTest() {
-	suite.sut = inventory.NewEntityModifierImpl()
+	suite.mockLocationFormat = &domainMocks.MockLocationFormat{}
+	suite.sut = inventory.NewEntityModifierImpl(suite.mockLocationFormat)
 }
 
-func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChangeNameFails_ShouldFail() {
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChangeTitleFails_ShouldFail() {
 	// Setup fixture
 	voFixture := &inventory.UpdateItemVO{
-		Name: "some.name",
+		TitleID: 11,
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	mockErr := fmt.Errorf("mock.error")
-	mockEntity.On("ChangeName", "some.name").Return(mockErr)
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(mockErr)
 
 	// Setup expectations
-	expectedErr := "could not modify entity with update vo - entity name change error: mock.error"
+	expectedErr := "could not modify entity with update vo - entity title change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateItemVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChangeFormatFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.UpdateItemVO{
+		TitleID: 11,
+		Format:  entity.FormatDVD,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(nil)
+	mockEntity.On("ChangeFormat", entity.FormatDVD).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity format change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateItemVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChangeBarcodeFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.UpdateItemVO{
+		TitleID: 11,
+		Format:  entity.FormatDVD,
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(nil)
+	mockEntity.On("ChangeFormat", entity.FormatDVD).Return(nil)
+	mockEntity.On("ChangeBarcode", "some.barcode").Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity barcode change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateItemVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenLocationFormatFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.UpdateItemVO{
+		TitleID:  11,
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(nil)
+	mockEntity.On("ChangeFormat", entity.FormatDVD).Return(nil)
+	mockEntity.On("ChangeBarcode", "some.barcode").Return(nil)
+	suite.mockLocationFormat.On("Parse", "some.location").Return(entity.Location{}, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - location format error: mock.error"
 
 	// Exercise SUT
 	err := suite.sut.ModifyWithUpdateItemVO(mockEntity, voFixture)
@@ -48,15 +127,21 @@ func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChang
 func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChangeLocationFails_ShouldFail() {
 	// Setup fixture
 	voFixture := &inventory.UpdateItemVO{
-		Name:     "some.name",
+		TitleID:  11,
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
 		Location: "some.location",
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	mockErr := fmt.Errorf("mock.error")
-	mockEntity.On("ChangeName", "some.name").Return(nil)
-	mockEntity.On("ChangeLocation", "some.location").Return(mockErr)
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(nil)
+	mockEntity.On("ChangeFormat", entity.FormatDVD).Return(nil)
+	mockEntity.On("ChangeBarcode", "some.barcode").Return(nil)
+	mockLocation := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockLocationFormat.On("Parse", "some.location").Return(mockLocation, nil)
+	mockEntity.On("ChangeLocation", mockLocation).Return(mockErr)
 
 	// Setup expectations
 	expectedErr := "could not modify entity with update vo - entity location change error: mock.error"
@@ -71,14 +156,20 @@ func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenEntityChang
 func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenChangesSucceed_ShouldReturnAsExpected() {
 	// Setup fixture
 	voFixture := &inventory.UpdateItemVO{
-		Name:     "some.name",
+		TitleID:  11,
+		Format:   entity.FormatDVD,
+		Barcode:  "some.barcode",
 		Location: "some.location",
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
-	mockEntity.On("ChangeName", "some.name").Return(nil)
-	mockEntity.On("ChangeLocation", "some.location").Return(nil)
+	mockEntity.On("ChangeTitle", entity.ID(11)).Return(nil)
+	mockEntity.On("ChangeFormat", entity.FormatDVD).Return(nil)
+	mockEntity.On("ChangeBarcode", "some.barcode").Return(nil)
+	mockLocation := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockLocationFormat.On("Parse", "some.location").Return(mockLocation, nil)
+	mockEntity.On("ChangeLocation", mockLocation).Return(nil)
 
 	// Exercise SUT
 	err := suite.sut.ModifyWithUpdateItemVO(mockEntity, voFixture)
@@ -86,3 +177,66 @@ func (suite *EntityModifierTestSuite) TestModifyWithUpdateItemVO_WhenChangesSucc
 	// Verify results
 	suite.NoError(err)
 }
+
+func (suite *EntityModifierTestSuite) TestModifyWithMoveVO_WhenLocationFormatFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.MoveVO{
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockLocationFormat.On("Parse", "some.location").Return(entity.Location{}, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not modify entity with move vo - location format error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithMoveVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithMoveVO_WhenEntityChangeLocationFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.MoveVO{
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockErr := fmt.Errorf("mock.error")
+	mockLocation := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockLocationFormat.On("Parse", "some.location").Return(mockLocation, nil)
+	mockEntity.On("ChangeLocation", mockLocation).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not modify entity with move vo - entity location change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithMoveVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithMoveVO_WhenChangeSucceeds_ShouldReturnAsExpected() {
+	// Setup fixture
+	voFixture := &inventory.MoveVO{
+		Location: "some.location",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockLocation := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.mockLocationFormat.On("Parse", "some.location").Return(mockLocation, nil)
+	mockEntity.On("ChangeLocation", mockLocation).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithMoveVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
@@ -1,25 +1,58 @@
 package inventory_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
 	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+	holdMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/hold"
 	inventoryMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/inventory"
-
+	ledgerMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/ledger"
+	locationMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/location"
+	mediaformatMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/mediaformat"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+	rentalMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/rental"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
 	"github.com/liampulles/matchstick-video/pkg/usecase/inventory"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
 )
 
 type ServiceImplTestSuite struct {
 	suite.Suite
-	mockRepository     *inventoryMocks.MockRepository
-	mockEntityFactory  *inventoryMocks.MockEntityFactory
-	mockEntityModifier *inventoryMocks.MockEntityModifier
-	mockVoFactory      *inventoryMocks.MockVOFactory
-	sut                *inventory.ServiceImpl
+	mockRepository             *inventoryMocks.MockRepository
+	mockRentalRepository       *rentalMocks.MockRepository
+	mockFormatRepository       *mediaformatMocks.MockRepository
+	mockTitleRepository        *titleMocks.MockRepository
+	mockAccountRepository      *accountMocks.MockRepository
+	mockLedgerRepository       *ledgerMocks.MockRepository
+	mockHoldRepository         *holdMocks.MockRepository
+	mockLocationRepository     *locationMocks.MockRepository
+	mockMoveRepository         *inventoryMocks.MockMoveRepository
+	mockEntityFactory          *inventoryMocks.MockEntityFactory
+	mockEntityModifier         *inventoryMocks.MockEntityModifier
+	mockVoFactory              *inventoryMocks.MockVOFactory
+	mockRentalVoFactory        *rentalMocks.MockVOFactory
+	mockRentalConstructor      *entityMocks.MockRentalConstructor
+	mockLedgerEntryConstructor *entityMocks.MockLedgerEntryConstructor
+	mockLateFeePolicy          *domainMocks.MockLateFeePolicy
+	mockRatingScheme           *domainMocks.MockRatingScheme
+	mockHoldQueue              *holdMocks.MockQueue
+	mockOutboxWriter           *outboxMocks.MockWriter
+	mockTransactor             *domainMocks.MockTransactor
+	mockClock                  *domainMocks.MockClock
+	ctxFixture                 context.Context
+	nowFixture                 time.Time
+	sut                        *inventory.ServiceImpl
 }
 
 func TestServiceImplTestSuite(t *testing.T) {
@@ -28,21 +61,72 @@ func TestServiceImplTestSuite(t *testing.T) {
 
 func (suite *ServiceImplTestSuite) SetupTest() {
 	suite.mockRepository = &inventoryMocks.MockRepository{}
+	suite.mockRentalRepository = &rentalMocks.MockRepository{}
+	suite.mockFormatRepository = &mediaformatMocks.MockRepository{}
+	suite.mockTitleRepository = &titleMocks.MockRepository{}
+	suite.mockAccountRepository = &accountMocks.MockRepository{}
+	suite.mockLedgerRepository = &ledgerMocks.MockRepository{}
+	suite.mockHoldRepository = &holdMocks.MockRepository{}
+	suite.mockLocationRepository = &locationMocks.MockRepository{}
+	suite.mockMoveRepository = &inventoryMocks.MockMoveRepository{}
 	suite.mockEntityFactory = &inventoryMocks.MockEntityFactory{}
 	suite.mockEntityModifier = &inventoryMocks.MockEntityModifier{}
 	suite.mockVoFactory = &inventoryMocks.MockVOFactory{}
+	suite.mockRentalVoFactory = &rentalMocks.MockVOFactory{}
+	suite.mockRentalConstructor = &entityMocks.MockRentalConstructor{}
+	suite.mockLedgerEntryConstructor = &entityMocks.MockLedgerEntryConstructor{}
+	suite.mockLateFeePolicy = &domainMocks.MockLateFeePolicy{}
+	suite.mockRatingScheme = &domainMocks.MockRatingScheme{}
+	suite.mockHoldQueue = &holdMocks.MockQueue{}
+	suite.mockOutboxWriter = &outboxMocks.MockWriter{}
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(nil)
+	suite.SetupSUT()
+}
+
+// SetupSUT creates the SUT from the suite's mocks.
+func (suite *ServiceImplTestSuite) SetupSUT() {
+	suite.SetupSUTWithTransactor(suite.mockTransactor)
+}
+
+// SetupSUTWithTransactor creates the SUT from the suite's mocks, and
+// the given transactor.
+func (suite *ServiceImplTestSuite) SetupSUTWithTransactor(transactor domain.Transactor) {
 	suite.sut = inventory.NewServiceImpl(
 		suite.mockRepository,
+		suite.mockRentalRepository,
+		suite.mockFormatRepository,
+		suite.mockTitleRepository,
+		suite.mockAccountRepository,
+		suite.mockLedgerRepository,
+		suite.mockHoldRepository,
+		suite.mockLocationRepository,
+		suite.mockMoveRepository,
 		suite.mockEntityFactory,
 		suite.mockEntityModifier,
 		suite.mockVoFactory,
+		suite.mockRentalVoFactory,
+		suite.mockRentalConstructor,
+		suite.mockLedgerEntryConstructor,
+		suite.mockLateFeePolicy,
+		suite.mockRatingScheme,
+		suite.mockHoldQueue,
+		suite.mockOutboxWriter,
+		transactor,
+		2,
+		entity.Money(1000),
+		suite.mockClock,
 	)
 }
 
 func (suite *ServiceImplTestSuite) TestCreate_WhenFactoryFails_ShouldFail() {
 	// Setup fixture
 	voFixture := &inventory.CreateItemVO{
-		Name: "some.name",
+		Barcode: "some.barcode",
 	}
 
 	// Setup mocks
@@ -53,40 +137,135 @@ func (suite *ServiceImplTestSuite) TestCreate_WhenFactoryFails_ShouldFail() {
 	expectedErr := "could not create inventory item - factory error: mock.error"
 
 	// Exercise SUT
-	actual, err := suite.sut.Create(voFixture)
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenLocationRepositoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).Return(false, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create inventory item - location repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenLocationIsUnknown_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).Return(false, nil)
+
+	// Setup expectations
+	expectedErr := "could not create inventory item - validation error: field=[location], problem=[must be a known location]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
 
 	// Verify results
 	suite.Equal(actual, entity.InvalidID)
 	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
 }
 
 func (suite *ServiceImplTestSuite) TestCreate_WhenRepositoryFails_ShouldFail() {
 	// Setup fixture
 	voFixture := &inventory.CreateItemVO{
-		Name: "some.name",
+		Barcode: "some.barcode",
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
 	mockErr := fmt.Errorf("mock.error")
 	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
-	suite.mockRepository.On("Create", mockEntity).Return(entity.InvalidID, mockErr)
+	suite.mockKnownLocation(mockEntity)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, mockErr)
 
 	// Setup expectations
 	expectedErr := "could not create inventory item - repository create error: mock.error"
 
 	// Exercise SUT
-	actual, err := suite.sut.Create(voFixture)
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenOutboxWriterFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockKnownLocation(mockEntity)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(101), nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, entity.ID(101), mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create inventory item - mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
 
 	// Verify results
 	suite.Equal(actual, entity.InvalidID)
 	suite.EqualError(err, expectedErr)
 }
 
+func (suite *ServiceImplTestSuite) TestCreate_WhenTransactionFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &inventory.CreateItemVO{
+		Barcode: "some.barcode",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(mockErr)
+	suite.SetupSUT()
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.Equal(mockErr, err)
+	suite.mockEntityFactory.AssertNotCalled(suite.T(), "CreateFromVO", voFixture)
+}
+
 func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnExpected() {
 	// Setup fixture
 	voFixture := &inventory.CreateItemVO{
-		Name: "some.name",
+		Barcode: "some.barcode",
 	}
 
 	// Setup expectations
@@ -95,14 +274,17 @@ func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnE
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
 	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
-	suite.mockRepository.On("Create", mockEntity).Return(expected, nil)
+	suite.mockKnownLocation(mockEntity)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(expected, nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, expected, mockEntity).Return(nil)
 
 	// Exercise SUT
-	actual, err := suite.sut.Create(voFixture)
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
 
 	// Verify results
 	suite.NoError(err)
 	suite.Equal(actual, expected)
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
 }
 
 func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFails_ShouldFail() {
@@ -111,13 +293,34 @@ func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFails_ShouldFai
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(nil, mockErr)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
 
 	// Setup expectations
 	expectedErr := "could not read inventory item - repository find error: mock.error"
 
 	// Exercise SUT
-	actual, err := suite.sut.ReadDetails(idFixture)
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRentalRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory item - rental repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
 
 	// Verify results
 	suite.Nil(actual)
@@ -130,328 +333,2418 @@ func (suite *ServiceImplTestSuite) TestReadDetails_WhenDelegatesSucceed_ShouldRe
 
 	// Setup expectations
 	expected := &inventory.ViewVO{
-		Name: "some.name",
+		Barcode: "some.barcode",
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity).Return(expected)
+	mockRental := &entityMocks.MockRental{Data: "mock.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemID", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity, mockRental, suite.nowFixture).Return(expected)
 
 	// Exercise SUT
-	actual, err := suite.sut.ReadDetails(idFixture)
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
 
 	// Verify results
 	suite.NoError(err)
 	suite.Equal(actual, expected)
 }
 
-func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
+
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindAll").Return(nil, mockErr)
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).Return(nil, mockErr)
 
 	// Setup expectations
 	expectedErr := "could not read inventory items - repository find error: mock.error"
 
 	// Exercise SUT
-	actual, err := suite.sut.ReadAll()
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
 
 	// Verify results
 	suite.Nil(actual)
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnAsExpected() {
-	// Setup expectations
-	expected := []inventory.ThinViewVO{
-		inventory.ThinViewVO{
-			Name: "some.name",
-		},
-	}
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenRentalRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
 
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
-	mockEntities := []entity.InventoryItem{mockEntity}
-	suite.mockRepository.On("FindAll").Return(mockEntities, nil)
-	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities).Return(expected)
+	mockEntities := []entity.InventoryItem{&entityMocks.MockInventoryItem{Data: "mock.data"}}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).Return(mockEntities, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDs", suite.ctxFixture, idsFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory items - rental repository find error: mock.error"
 
 	// Exercise SUT
-	actual, err := suite.sut.ReadAll()
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
 
 	// Verify results
-	suite.NoError(err)
-	suite.Equal(actual, expected)
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryFindFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenDelegatesSucceed_ShouldReturnViewOfEachFound() {
 	// Setup fixture
-	idFixture := entity.ID(101)
-	voFixture := &inventory.UpdateItemVO{
-		Name: "new.name",
+	idsFixture := []entity.ID{101, 102, 103}
+
+	// Setup expectations
+	expected := []inventory.ViewVO{
+		{ID: 101, Barcode: "some.barcode"},
+		{ID: 103, Barcode: "another.barcode"},
 	}
 
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockInventoryItem{Data: "mock.data.1"}
+	mockEntity1.On("ID").Return(entity.ID(101))
+	mockEntity2 := &entityMocks.MockInventoryItem{Data: "mock.data.2"}
+	mockEntity2.On("ID").Return(entity.ID(103))
+	mockRental := &entityMocks.MockRental{Data: "mock.rental"}
+	mockRental.On("ItemID").Return(entity.ID(101))
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).
+		Return([]entity.InventoryItem{mockEntity1, mockEntity2}, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDs", suite.ctxFixture, idsFixture).
+		Return([]entity.Rental{mockRental}, nil)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity1, mockRental, suite.nowFixture).
+		Return(&expected[0])
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity2, nil, suite.nowFixture).
+		Return(&expected[1])
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestFindIDByBarcode_WhenRepositoryFails_ShouldFail() {
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", entity.ID(101)).Return(nil, mockErr)
+	suite.mockRepository.On("FindByBarcode", suite.ctxFixture, "MV00000001").Return(nil, mockErr)
 
 	// Setup expectations
-	expectedErr := "could not update inventory item - repository find error: mock.error"
+	expectedErr := "could not find inventory item by barcode - repository find error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Update(idFixture, voFixture)
+	actual, err := suite.sut.FindIDByBarcode(suite.ctxFixture, "MV00000001")
 
 	// Verify results
+	suite.Equal(entity.InvalidID, actual)
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestUpdate_WhenModifierFails_ShouldFail() {
-	// Setup fixture
-	idFixture := entity.ID(101)
-	voFixture := &inventory.UpdateItemVO{
-		Name: "new.name",
-	}
+func (suite *ServiceImplTestSuite) TestFindIDByBarcode_WhenRepositorySucceeds_ShouldReturnID() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockEntity.On("ID").Return(entity.ID(101))
+	suite.mockRepository.On("FindByBarcode", suite.ctxFixture, "MV00000001").Return(mockEntity, nil)
 
+	// Exercise SUT
+	actual, err := suite.sut.FindIDByBarcode(suite.ctxFixture, "MV00000001")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFails_ShouldFail() {
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
-	suite.mockRepository.On("FindByID", entity.ID(101)).Return(mockEntity, nil)
-	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(mockErr)
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(nil, mockErr)
 
 	// Setup expectations
-	expectedErr := "could not update inventory item - modifier error: mock.error"
+	expectedErr := "could not read inventory items - repository find error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Update(idFixture, voFixture)
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
 
 	// Verify results
+	suite.Nil(actual)
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryUpdateFails_ShouldFail() {
-	// Setup fixture
-	idFixture := entity.ID(101)
-	voFixture := &inventory.UpdateItemVO{
-		Name: "new.name",
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup expectations
+	expected := []inventory.ThinViewVO{
+		inventory.ThinViewVO{
+			Barcode: "some.barcode",
+		},
 	}
 
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockEntities := []entity.InventoryItem{mockEntity}
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(actual, expected)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAllOfFormat_WhenFormatIsInvalid_ShouldFail() {
 	// Setup expectations
-	expectedErr := "could not update inventory item - repository update error: mock.error"
+	expectedErr := "could not read inventory items - format error: validation error: field=[format], problem=[must be one of vhs, dvd, bluray, 4k]"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAllOfFormat(suite.ctxFixture, entity.Format("betamax"))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
 
+func (suite *ServiceImplTestSuite) TestReadAllOfFormat_WhenRepositoryFails_ShouldFail() {
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", entity.ID(101)).Return(mockEntity, nil)
-	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
-	suite.mockRepository.On("Update", mockEntity).Return(mockErr)
+	suite.mockRepository.On("FindAllOfFormat", suite.ctxFixture, entity.FormatVHS).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory items - repository find error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Update(idFixture, voFixture)
+	actual, err := suite.sut.ReadAllOfFormat(suite.ctxFixture, entity.FormatVHS)
 
 	// Verify results
+	suite.Nil(actual)
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestUpdate_WhenDelegatesSucceed_ShouldReturnAsExpected() {
-	// Setup fixture
-	idFixture := entity.ID(101)
-	voFixture := &inventory.UpdateItemVO{
-		Name: "new.name",
+func (suite *ServiceImplTestSuite) TestReadAllOfFormat_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup expectations
+	expected := []inventory.ThinViewVO{
+		{
+			Format:  entity.FormatVHS,
+			Barcode: "some.barcode",
+		},
 	}
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
-	suite.mockRepository.On("FindByID", entity.ID(101)).Return(mockEntity, nil)
-	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
-	suite.mockRepository.On("Update", mockEntity).Return(nil)
+	mockEntities := []entity.InventoryItem{mockEntity}
+	suite.mockRepository.On("FindAllOfFormat", suite.ctxFixture, entity.FormatVHS).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities).Return(expected)
 
 	// Exercise SUT
-	err := suite.sut.Update(idFixture, voFixture)
+	actual, err := suite.sut.ReadAllOfFormat(suite.ctxFixture, entity.FormatVHS)
 
 	// Verify results
 	suite.NoError(err)
+	suite.Equal(actual, expected)
 }
 
-func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestReadAllOfTitles_WhenRepositoryFails_ShouldFail() {
 	// Setup fixture
-	idFixture := entity.ID(101)
+	idsFixture := []entity.ID{101, 102}
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("DeleteByID", idFixture).Return(mockErr)
+	suite.mockRepository.On("FindAllOfTitles", suite.ctxFixture, idsFixture).Return(nil, mockErr)
 
 	// Setup expectations
-	expectedErr := "could not delete inventory item - repository delete error: mock.error"
+	expectedErr := "could not read inventory items - repository find error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Delete(idFixture)
+	actual, err := suite.sut.ReadAllOfTitles(suite.ctxFixture, idsFixture)
 
 	// Verify results
+	suite.Nil(actual)
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestDelete_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+func (suite *ServiceImplTestSuite) TestReadAllOfTitles_WhenDelegatesSucceed_ShouldReturnAsExpected() {
 	// Setup fixture
-	idFixture := entity.ID(101)
+	idsFixture := []entity.ID{101, 102}
+
+	// Setup expectations
+	expected := []inventory.ThinViewVO{
+		{
+			TitleID: 101,
+			Barcode: "some.barcode",
+		},
+	}
 
 	// Setup mocks
-	suite.mockRepository.On("DeleteByID", idFixture).Return(nil)
+	mockEntities := []entity.InventoryItem{&entityMocks.MockInventoryItem{Data: "mock.data"}}
+	suite.mockRepository.On("FindAllOfTitles", suite.ctxFixture, idsFixture).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities).Return(expected)
 
 	// Exercise SUT
-	err := suite.sut.Delete(idFixture)
+	actual, err := suite.sut.ReadAllOfTitles(suite.ctxFixture, idsFixture)
 
 	// Verify results
 	suite.NoError(err)
+	suite.Equal(expected, actual)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckout_WhenRepositoryFindFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryFindFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(nil, mockErr)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(nil, mockErr)
 
 	// Setup expectations
-	expectedErr := "could not checkout inventory item - repository find error: mock.error"
+	expectedErr := "could not update inventory item - repository find error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Checkout(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckout_WhenEntityFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenModifierFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
 
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	mockEntity.On("Checkout").Return(mockErr)
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(mockErr)
 
 	// Setup expectations
-	expectedErr := "could not checkout inventory item - entity error: mock.error"
+	expectedErr := "could not update inventory item - modifier error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.Checkout(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckout_WhenRepositoryUpdateFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryUpdateFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
+
+	// Setup expectations
+	expectedErr := "could not update inventory item - repository update error: mock.error"
 
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	mockEntity.On("Checkout").Return(nil)
-	suite.mockRepository.On("Update", mockEntity).Return(mockErr)
-
-	// Setup expectations
-	expectedErr := "could not checkout inventory item - repository update error: mock.error"
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(mockErr)
 
 	// Exercise SUT
-	err := suite.sut.Checkout(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckout_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenDelegatesSucceed_ShouldReturnAsExpected() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
 
 	// Setup mocks
-	mockEntity1 := &entityMocks.MockInventoryItem{Data: "some.data.1"}
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity1, nil)
-	mockEntity1.On("Checkout").Return(nil)
-	suite.mockRepository.On("Update", mockEntity1).Return(nil)
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
 
 	// Exercise SUT
-	err := suite.sut.Checkout(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.NoError(err)
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
+	suite.mockOutboxWriter.AssertExpectations(suite.T())
 }
 
-func (suite *ServiceImplTestSuite) TestCheckIn_WhenRepositoryFindFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenOutboxWriterFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
 
 	// Setup mocks
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(nil, mockErr)
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(mockErr)
 
 	// Setup expectations
-	expectedErr := "could not check in inventory item - repository find error: mock.error"
+	expectedErr := "could not update inventory item - mock.error"
 
 	// Exercise SUT
-	err := suite.sut.CheckIn(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckIn_WhenEntityFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenTransactionFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Barcode: "new.barcode",
+	}
 
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
 	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	mockEntity.On("CheckIn").Return(mockErr)
-
-	// Setup expectations
-	expectedErr := "could not check in inventory item - entity error: mock.error"
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(mockErr)
+	suite.SetupSUT()
 
 	// Exercise SUT
-	err := suite.sut.CheckIn(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
-	suite.EqualError(err, expectedErr)
+	suite.Equal(mockErr, err)
+	suite.mockRepository.AssertNotCalled(suite.T(), "FindByID", suite.ctxFixture, idFixture)
 }
 
-func (suite *ServiceImplTestSuite) TestCheckIn_WhenRepositoryUpdateFails_ShouldFail() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenMovedToUnknownLocation_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Location: "main-B-2-3",
+	}
 
 	// Setup mocks
-	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
-	mockErr := fmt.Errorf("mock.error")
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	mockEntity.On("CheckIn").Return(nil)
-	suite.mockRepository.On("Update", mockEntity).Return(mockErr)
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(false, nil)
 
 	// Setup expectations
-	expectedErr := "could not check in inventory item - repository update error: mock.error"
+	expectedErr := "could not update inventory item - validation error: field=[location], problem=[must be a known location]"
 
 	// Exercise SUT
-	err := suite.sut.CheckIn(idFixture)
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update")
 }
 
-func (suite *ServiceImplTestSuite) TestCheckIn_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+func (suite *ServiceImplTestSuite) TestUpdate_WhenMoveRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(true, nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockMoveRepository.On("Create", suite.ctxFixture, suite.moveFixture(idFixture)).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not update inventory item - move repository create error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenMoved_ShouldRecordMove() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.UpdateItemVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithUpdateItemVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(true, nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockMoveRepository.On("Create", suite.ctxFixture, suite.moveFixture(idFixture)).Return(entity.ID(7), nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockMoveRepository.AssertExpectations(suite.T())
+	suite.mockOutboxWriter.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not delete inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFails_ShouldFail() {
 	// Setup fixture
 	idFixture := entity.ID(101)
 
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
-	suite.mockRepository.On("FindByID", idFixture).Return(mockEntity, nil)
-	mockEntity.On("CheckIn").Return(nil)
-	suite.mockRepository.On("Update", mockEntity).Return(nil)
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Delete")
+	suite.mockRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not delete inventory item - repository delete error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenOutboxWriterFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Delete")
+	suite.mockRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not delete inventory item - mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Delete")
+	suite.mockRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockEntity.AssertCalled(suite.T(), "Delete")
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenFormatRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	suite.mockFormatRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - format repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenEntityFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	suite.mockNoHold(mockEntity, idFixture)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - entity error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenRentalConstructorFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - rental error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenRentalRepositoryCreateFails_ShouldFailWithoutUpdatingItem() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - rental repository create error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", suite.ctxFixture, mockEntity)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - repository update error: mock.error"
 
 	// Exercise SUT
-	err := suite.sut.CheckIn(idFixture)
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockInventoryItem{Data: "some.data.1"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity1, nil)
+	suite.mockFormat(mockEntity1)
+	mockEntity1.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity1, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity1)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity1).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity1).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
 
 	// Verify results
 	suite.NoError(err)
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
+	suite.mockOutboxWriter.AssertCalled(suite.T(), "Write", suite.ctxFixture, idFixture, mockEntity1)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenHoldRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenHeldForAnotherAccount_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(false)
+	mockHold.On("AccountID").Return(entity.ID(8))
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - conflict error: type=[inventory item], problem=[it is held for another account]"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockRentalRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenExpiredHoldFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(true)
+	mockHold.On("Expire").Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenExpiredHoldRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(true)
+	mockHold.On("Expire").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenExpiredHoldQueueFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(true)
+	mockHold.On("Expire").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(nil)
+	suite.mockHoldQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold queue error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenExpiredHoldPassedToAnotherAccount_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockNext := &entityMocks.MockHold{Data: "some.next.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	mockEntity.On("ID").Return(idFixture)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(true)
+	mockHold.On("Expire").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(nil)
+	suite.mockHoldQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), idFixture).Return(mockNext, nil)
+	mockNext.On("ID").Return(entity.ID(302))
+	mockNext.On("AccountID").Return(entity.ID(8))
+	mockEntity.On("PutAside", entity.ID(302), entity.ID(8)).Return()
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - conflict error: type=[inventory item], problem=[it is held for another account]"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	mockHold.AssertCalled(suite.T(), "Expire")
+	mockEntity.AssertCalled(suite.T(), "PutAside", entity.ID(302), entity.ID(8))
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenHoldFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockHeldFor(mockEntity, mockHold, idFixture, entity.ID(7))
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	mockHold.On("Fulfil").Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenHoldRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockHeldFor(mockEntity, mockHold, idFixture, entity.ID(7))
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	mockHold.On("Fulfil").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - hold repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenHeldForAccount_ShouldFulfilHold() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockHeldFor(mockEntity, mockHold, idFixture, entity.ID(7))
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	mockHold.On("Fulfil").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockHold.AssertCalled(suite.T(), "Fulfil")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenLedgerRepositoryBalanceFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockLedgerRepository.On("BalanceByAccountID", suite.ctxFixture, entity.ID(7)).Return(entity.Money(0), mockErr)
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - ledger repository balance error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenOverCreditLimit_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(1001))
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - credit limit error: balanceCents=[1001], limitCents=[1000]"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockRentalRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenOwingCreditLimit_ShouldCheckout() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(1000))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenTitleRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, entity.ID(11)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - title repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenRestrictedAndAccountRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockRating(mockEntity, "R", 17)
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - account repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenAccountHolderIsTooYoung_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockAccount := &entityMocks.MockAccount{Data: "some.account"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockRating(mockEntity, "R", 17)
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(mockAccount, nil)
+	suite.mockRatingScheme.On("CheckAge", "R", mockAccount, suite.nowFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not checkout inventory item - mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockRentalRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckout_WhenAccountHolderIsOldEnough_ShouldCheckout() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.CheckoutVO{AccountID: 7}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockAccount := &entityMocks.MockAccount{Data: "some.account"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", voFixture.AccountID).Return(nil)
+	suite.mockNoHold(mockEntity, idFixture)
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockRating(mockEntity, "R", 17)
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(mockAccount, nil)
+	suite.mockRatingScheme.On("CheckAge", "R", mockAccount, suite.nowFixture).Return(nil)
+	suite.mockRentalConstructor.On("New", idFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Checkout(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenEntityFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenRentalRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - rental repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenRentalFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{DaysLate: 2, Amount: entity.Money(200)}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - rental error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenLedgerEntryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{DaysLate: 2, Amount: entity.Money(200)}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(nil)
+	suite.mockCharge(mockRental, feeFixture.Amount, "late fee", nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - ledger entry error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockLedgerRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenLedgerRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{DaysLate: 2, Amount: entity.Money(200)}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(nil)
+	suite.mockCharge(mockRental, feeFixture.Amount, "late fee", mockCharge, nil)
+	suite.mockLedgerRepository.On("Create", suite.ctxFixture, mockCharge).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - ledger repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenRentalRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - rental repository update error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	suite.mockEmptyQueue(mockEntity, idFixture)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - repository update error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenHoldQueueFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	mockEntity.On("ID").Return(idFixture)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not check in inventory item - hold queue error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", suite.ctxFixture, mockEntity)
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenNotRented_ShouldOnlyUpdateItem() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	suite.mockEmptyQueue(mockEntity, idFixture)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	suite.mockRentalRepository.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenAHoldIsWaiting_ShouldPutItAside() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("CheckIn").Return(nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+	mockEntity.On("ID").Return(idFixture)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), idFixture).Return(mockHold, nil)
+	mockHold.On("ID").Return(entity.ID(31))
+	mockHold.On("AccountID").Return(entity.ID(7))
+	mockEntity.On("PutAside", entity.ID(31), entity.ID(7)).Return()
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+	mockEntity.AssertCalled(suite.T(), "PutAside", entity.ID(31), entity.ID(7))
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenReturnedOnTime_ShouldNotChargeAccount() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(nil)
+	suite.mockEmptyQueue(mockEntity, idFixture)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+	receiptFixture := &rental.ReceiptLineVO{RentalID: entity.ID(5)}
+	suite.mockRentalVoFactory.On("CreateReceiptLineVO", mockRental, feeFixture).Return(receiptFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receiptFixture, actual)
+	suite.mockLedgerEntryConstructor.AssertNotCalled(suite.T(), "NewCharge")
+}
+
+func (suite *ServiceImplTestSuite) TestCheckIn_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	feeFixture := domain.LateFee{DaysLate: 2, Amount: entity.Money(200)}
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockLateRental(idFixture, feeFixture)
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockEntity.On("CheckIn").Return(nil)
+	mockRental.On("Return", suite.nowFixture, feeFixture.Amount).Return(nil)
+	suite.mockCharge(mockRental, feeFixture.Amount, "late fee", mockCharge, nil)
+	suite.mockLedgerRepository.On("Create", suite.ctxFixture, mockCharge).Return(entity.ID(401), nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(nil)
+	suite.mockEmptyQueue(mockEntity, idFixture)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+	receiptFixture := &rental.ReceiptLineVO{RentalID: entity.ID(5)}
+	suite.mockRentalVoFactory.On("CreateReceiptLineVO", mockRental, feeFixture).Return(receiptFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.CheckIn(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receiptFixture, actual)
+	suite.mockLedgerRepository.AssertCalled(suite.T(), "Create", suite.ctxFixture, mockCharge)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(0)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRentalRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - rental repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenNotRented_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(nil, nil)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - conflict error: type=[inventory item], problem=[it is not rented out]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRenewalLimitReached_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(2)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - conflict error: type=[inventory item], problem=[it has been renewed the most times allowed (2)]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenHoldRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(1)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - hold repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenHoldWaiting_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(1)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(mockHold, nil)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - conflict error: type=[inventory item], problem=[a hold is waiting for its title]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenFormatRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, idFixture).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(1)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(nil, nil)
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	suite.mockFormatRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - format repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRentalFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	_, mockRental := suite.mockRenewable(idFixture, entity.Money(300))
+	mockErr := fmt.Errorf("mock.error")
+	mockRental.On("Renew", 3).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - rental error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenLedgerEntryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	_, mockRental := suite.mockRenewable(idFixture, entity.Money(300))
+	mockErr := fmt.Errorf("mock.error")
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockCharge(mockRental, entity.Money(300), "renewal fee", nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - ledger entry error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenLedgerRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	_, mockRental := suite.mockRenewable(idFixture, entity.Money(300))
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockErr := fmt.Errorf("mock.error")
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockCharge(mockRental, entity.Money(300), "renewal fee", mockCharge, nil)
+	suite.mockLedgerRepository.On("Create", suite.ctxFixture, mockCharge).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - ledger repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRentalRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	_, mockRental := suite.mockRenewable(idFixture, entity.Money(0))
+	mockErr := fmt.Errorf("mock.error")
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not renew inventory item - rental repository update error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenFormatIsFree_ShouldNotChargeAccount() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockRenewable(idFixture, entity.Money(0))
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(nil)
+	suite.mockRenewed(mockEntity, mockRental, idFixture)
+	receiptFixture := &rental.RenewalReceiptLineVO{RentalID: entity.ID(5)}
+	suite.mockRentalVoFactory.On("CreateRenewalReceiptLineVO", mockRental, entity.Money(0)).Return(receiptFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receiptFixture, actual)
+	suite.mockLedgerEntryConstructor.AssertNotCalled(suite.T(), "NewCharge", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity, mockRental := suite.mockRenewable(idFixture, entity.Money(300))
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockCharge(mockRental, entity.Money(300), "renewal fee", mockCharge, nil)
+	suite.mockLedgerRepository.On("Create", suite.ctxFixture, mockCharge).Return(entity.ID(401), nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(nil)
+	suite.mockRenewed(mockEntity, mockRental, idFixture)
+	receiptFixture := &rental.RenewalReceiptLineVO{RentalID: entity.ID(5)}
+	suite.mockRentalVoFactory.On("CreateRenewalReceiptLineVO", mockRental, entity.Money(300)).Return(receiptFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(receiptFixture, actual)
+	suite.mockLedgerRepository.AssertCalled(suite.T(), "Create", suite.ctxFixture, mockCharge)
+	mockRental.AssertCalled(suite.T(), "Renew", 3)
+	suite.mockOutboxWriter.AssertCalled(suite.T(), "Write", suite.ctxFixture, idFixture, mockEntity)
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
+}
+
+func (suite *ServiceImplTestSuite) TestRenew_WhenRentalRepositoryUpdateFails_ShouldRollBackCharge() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	transactor := &recordingTransactor{}
+	suite.SetupSUTWithTransactor(transactor)
+
+	// Setup mocks
+	_, mockRental := suite.mockRenewable(idFixture, entity.Money(300))
+	mockCharge := &entityMocks.MockLedgerEntry{Data: "some.charge"}
+	mockErr := fmt.Errorf("mock.error")
+	mockRental.On("Renew", 3).Return(nil)
+	suite.mockCharge(mockRental, entity.Money(300), "renewal fee", mockCharge, nil)
+	suite.mockLedgerRepository.On("Create", suite.ctxFixture, mockCharge).Return(entity.ID(401), nil)
+	suite.mockRentalRepository.On("Update", suite.ctxFixture, mockRental).Return(mockErr)
+
+	// Exercise SUT
+	actual, err := suite.sut.Renew(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not renew inventory item - rental repository update error: mock.error")
+	suite.mockLedgerRepository.AssertCalled(suite.T(), "Create", suite.ctxFixture, mockCharge)
+	suite.True(transactor.rolledBack)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenTransactionFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(mockErr)
+	suite.SetupSUT()
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Equal(mockErr, err)
+	suite.mockHoldRepository.AssertNotCalled(suite.T(), "FindByID", suite.ctxFixture, idFixture)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockHoldRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not fulfil hold - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenNotReady_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockHoldRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("Status").Return(entity.HoldWaiting)
+
+	// Setup expectations
+	expectedErr := "could not fulfil hold - conflict error: type=[hold], problem=[it is waiting]"
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenExpired_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	suite.mockHoldRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("Status").Return(entity.HoldReady)
+	mockHold.On("IsExpired", suite.nowFixture).Return(true)
+
+	// Setup expectations
+	expectedErr := "could not fulfil hold - conflict error: type=[hold], problem=[it has expired]"
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenCheckoutFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+
+	// Setup mocks
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockHoldRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("Status").Return(entity.HoldReady)
+	mockHold.On("IsExpired", suite.nowFixture).Return(false)
+	mockHold.On("AccountID").Return(entity.ID(7))
+	mockHold.On("ItemID").Return(entity.ID(101))
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not fulfil hold - could not checkout inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestFulfilHold_WhenDelegatesSucceed_ShouldCheckoutToAccount() {
+	// Setup fixture
+	idFixture := entity.ID(301)
+	itemIDFixture := entity.ID(101)
+
+	// Setup mocks
+	mockHold := &entityMocks.MockHold{Data: "some.hold"}
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	suite.mockHoldRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockHold, nil)
+	mockHold.On("Status").Return(entity.HoldReady)
+	mockHold.On("ItemID").Return(itemIDFixture)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, itemIDFixture).Return(mockEntity, nil)
+	suite.mockFormat(mockEntity)
+	mockEntity.On("Checkout", entity.ID(7)).Return(nil)
+	suite.mockHeldFor(mockEntity, mockHold, itemIDFixture, entity.ID(7))
+	suite.mockBalance(entity.ID(7), entity.Money(0))
+	suite.mockUnrestricted(mockEntity)
+	suite.mockRentalConstructor.On("New", itemIDFixture, entity.ID(7), suite.nowFixture, 3).Return(mockRental, nil)
+	suite.mockRentalRepository.On("Create", suite.ctxFixture, mockRental).Return(entity.ID(201), nil)
+	mockHold.On("Fulfil").Return(nil)
+	suite.mockHoldRepository.On("Update", suite.ctxFixture, mockHold).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, itemIDFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.FulfilHold(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockHold.AssertCalled(suite.T(), "Fulfil")
+}
+
+// mockLateRental finds a rented DVD, for which the policy
+// calculates the given fee.
+func (suite *ServiceImplTestSuite) TestMove_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not move inventory item - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenModifierFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not move inventory item - modifier error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenLocationRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(false, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not move inventory item - location repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(true, nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not move inventory item - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockMoveRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenMovedToSameLocation_ShouldNotRecordMove() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-A-1-12",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockMoveRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenDelegatesSucceed_ShouldRecordMove() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(true, nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockMoveRepository.On("Create", suite.ctxFixture, suite.moveFixture(idFixture)).Return(entity.ID(7), nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockTransactor.AssertCalled(suite.T(), "InTransaction", suite.ctxFixture)
+	suite.mockMoveRepository.AssertExpectations(suite.T())
+	suite.mockOutboxWriter.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceImplTestSuite) TestMove_WhenOutboxWriterFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &inventory.MoveVO{
+		Location: "main-B-2-3",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := suite.mockMoved(idFixture)
+	suite.mockEntityModifier.On("ModifyWithMoveVO", mockEntity, voFixture).Return(nil)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, suite.movedToFixture()).Return(true, nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+	suite.mockMoveRepository.On("Create", suite.ctxFixture, suite.moveFixture(idFixture)).Return(entity.ID(7), nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, idFixture, mockEntity).Return(mockErr)
+
+	// Setup expectations
+	expectedErr := "could not move inventory item - mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Move(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadMoves_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory item moves - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadMoves(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadMoves_WhenMoveRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockMoveRepository.On("FindByItemID", suite.ctxFixture, idFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory item moves - move repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadMoves(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadMoves_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	mockMoves := []entity.LocationMove{suite.moveFixture(idFixture)}
+	expected := []inventory.MoveViewVO{{ItemID: idFixture, From: "main-A-1-12", To: "main-B-2-3"}}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockMoveRepository.On("FindByItemID", suite.ctxFixture, idFixture).Return(mockMoves, nil)
+	suite.mockVoFactory.On("CreateMoveViewVOs", mockMoves).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadMoves(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadShelf_WhenLocationRepositoryFails_ShouldFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockLocationRepository.On("FindAllOnShelf", suite.ctxFixture, "main", "A", "1").Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read shelf - location repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadShelf(suite.ctxFixture, "main", "A", "1")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadShelf_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockLocations := []entity.Location{entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}}
+	suite.mockLocationRepository.On("FindAllOnShelf", suite.ctxFixture, "main", "A", "1").Return(mockLocations, nil)
+	suite.mockRepository.On("FindAllOnShelf", suite.ctxFixture, "main", "A", "1").Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read shelf - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadShelf(suite.ctxFixture, "main", "A", "1")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadShelf_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup mocks
+	mockLocations := []entity.Location{entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}}
+	mockEntities := []entity.InventoryItem{&entityMocks.MockInventoryItem{Data: "mock.data"}}
+	expected := &inventory.ShelfViewVO{Store: "main", Aisle: "A", Shelf: "1"}
+	suite.mockLocationRepository.On("FindAllOnShelf", suite.ctxFixture, "main", "A", "1").Return(mockLocations, nil)
+	suite.mockRepository.On("FindAllOnShelf", suite.ctxFixture, "main", "A", "1").Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateShelfViewVO", "main", "A", "1", mockLocations, mockEntities).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadShelf(suite.ctxFixture, "main", "A", "1")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadLabels_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory item labels - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadLabels(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadLabels_WhenTitleRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity, nil)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, entity.ID(11)).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not read inventory item labels - title repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadLabels(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadLabels_WhenDelegatesSucceed_ShouldReturnInOrder() {
+	// Setup fixture
+	idsFixture := []entity.ID{102, 101}
+
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockInventoryItem{Data: "mock.data.1"}
+	mockEntity2 := &entityMocks.MockInventoryItem{Data: "mock.data.2"}
+	mockTitle := &entityMocks.MockTitle{Data: "mock.title"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(101)).Return(mockEntity1, nil)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, entity.ID(102)).Return(mockEntity2, nil)
+	mockEntity1.On("TitleID").Return(entity.ID(11))
+	mockEntity2.On("TitleID").Return(entity.ID(11))
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, entity.ID(11)).Return(mockTitle, nil)
+	suite.mockVoFactory.On("CreateLabelVO", mockEntity1, mockTitle).Return(&inventory.LabelVO{ItemID: 101})
+	suite.mockVoFactory.On("CreateLabelVO", mockEntity2, mockTitle).Return(&inventory.LabelVO{ItemID: 102})
+
+	// Setup expectations
+	expected := []inventory.LabelVO{{ItemID: 102}, {ItemID: 101}}
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadLabels(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) mockLateRental(id entity.ID, fee domain.LateFee) (*entityMocks.MockInventoryItem, *entityMocks.MockRental) {
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	dueAt := suite.nowFixture.Add(-48 * time.Hour)
+	suite.mockRepository.On("FindByID", suite.ctxFixture, id).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, id).Return(mockRental, nil)
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockRental.On("DueAt").Return(dueAt)
+	suite.mockLateFeePolicy.On("Calculate", entity.FormatDVD, dueAt, suite.nowFixture).Return(fee)
+	return mockEntity, mockRental
+}
+
+// mockFormat makes the entity a DVD, which is rented for 3 days.
+func (suite *ServiceImplTestSuite) mockFormat(mockEntity *entityMocks.MockInventoryItem) {
+	mockFormat := &entityMocks.MockMediaFormat{Data: "some.format"}
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockFormat.On("RentalPeriodDays").Return(3)
+	suite.mockFormatRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockFormat, nil)
+}
+
+// mockRenewable finds a DVD rented out and renewed once, which no
+// one is waiting for. DVDs are renewed for 3 days at the given price.
+func (suite *ServiceImplTestSuite) mockRenewable(id entity.ID, price entity.Money) (*entityMocks.MockInventoryItem, *entityMocks.MockRental) {
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockRental := &entityMocks.MockRental{Data: "some.rental"}
+	mockFormat := &entityMocks.MockMediaFormat{Data: "some.format"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, id).Return(mockEntity, nil)
+	suite.mockRentalRepository.On("FindActiveByItemIDForUpdate", suite.ctxFixture, id).Return(mockRental, nil)
+	mockRental.On("Renewals").Return(1)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldRepository.On("FindNextWaitingByTitleID", suite.ctxFixture, entity.ID(11)).Return(nil, nil)
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockFormat.On("RentalPeriodDays").Return(3)
+	mockFormat.On("RentalPrice").Return(price)
+	suite.mockFormatRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockFormat, nil)
+	return mockEntity, mockRental
+}
+
+// mockRenewed has the renewal of the rental, rental 5 of account 7, due
+// back on the 5th, recorded.
+func (suite *ServiceImplTestSuite) mockRenewed(mockEntity *entityMocks.MockInventoryItem, mockRental *entityMocks.MockRental, id entity.ID) {
+	dueAt := time.Date(2020, 1, 5, 3, 4, 5, 0, time.UTC)
+	mockRental.On("AccountID").Return(entity.ID(7))
+	mockRental.On("DueAt").Return(dueAt)
+	mockEntity.On("Renew", entity.ID(7), dueAt).Return(nil)
+	suite.mockOutboxWriter.On("Write", suite.ctxFixture, id, mockEntity).Return(nil)
+}
+
+// mockCharge has the rental, rental 5 of account 7, charged the
+// given amount.
+func (suite *ServiceImplTestSuite) mockCharge(mockRental *entityMocks.MockRental, amount entity.Money, note string, charge entity.LedgerEntry, err error) {
+	mockRental.On("AccountID").Return(entity.ID(7))
+	mockRental.On("ID").Return(entity.ID(5))
+	suite.mockLedgerEntryConstructor.On("NewCharge", entity.ID(7), entity.ID(5), amount, note, suite.nowFixture).Return(charge, err)
+}
+
+// mockBalance has the account owe the given amount.
+func (suite *ServiceImplTestSuite) mockBalance(accountID entity.ID, balance entity.Money) {
+	suite.mockLedgerRepository.On("BalanceByAccountID", suite.ctxFixture, accountID).Return(balance, nil)
+}
+
+// mockUnrestricted gives the entity's title a rating which anyone
+// may rent.
+func (suite *ServiceImplTestSuite) mockUnrestricted(mockEntity *entityMocks.MockInventoryItem) {
+	suite.mockRating(mockEntity, "PG", 0)
+}
+
+// mockRating gives the entity's title, title 11, the given rating.
+func (suite *ServiceImplTestSuite) mockRating(mockEntity *entityMocks.MockInventoryItem, rating string, minimumAge int) {
+	mockTitle := &entityMocks.MockTitle{Data: "some.title"}
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockTitleRepository.On("FindByID", suite.ctxFixture, entity.ID(11)).Return(mockTitle, nil)
+	mockTitle.On("Rating").Return(rating)
+	suite.mockRatingScheme.On("MinimumAge", rating).Return(minimumAge)
+}
+
+// mockNoHold finds no hold for the entity.
+func (suite *ServiceImplTestSuite) mockNoHold(mockEntity *entityMocks.MockInventoryItem, id entity.ID) {
+	mockEntity.On("ID").Return(id)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, id).Return(nil, nil)
+}
+
+// mockEmptyQueue finds no one waiting for the entity's title.
+func (suite *ServiceImplTestSuite) mockEmptyQueue(mockEntity *entityMocks.MockInventoryItem, id entity.ID) {
+	mockEntity.On("ID").Return(id)
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	suite.mockHoldQueue.On("AssignNext", suite.ctxFixture, entity.ID(11), id).Return(nil, nil)
+}
+
+// mockHeldFor puts the entity aside for the given account.
+func (suite *ServiceImplTestSuite) mockHeldFor(mockEntity *entityMocks.MockInventoryItem, mockHold *entityMocks.MockHold, id entity.ID, accountID entity.ID) {
+	mockEntity.On("ID").Return(id)
+	suite.mockHoldRepository.On("FindReadyByItemID", suite.ctxFixture, id).Return(mockHold, nil)
+	mockHold.On("IsExpired", suite.nowFixture).Return(false)
+	mockHold.On("AccountID").Return(accountID)
+}
+
+func (suite *ServiceImplTestSuite) mockKnownLocation(mockEntity *entityMocks.MockInventoryItem) {
+	location := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	mockEntity.On("Location").Return(location)
+	suite.mockLocationRepository.On("Exists", suite.ctxFixture, location).Return(true, nil)
+}
+
+// mockMoved mocks an entity which is found at one location, and is
+// at another once modified.
+func (suite *ServiceImplTestSuite) mockMoved(id entity.ID) *entityMocks.MockInventoryItem {
+	mockEntity := &entityMocks.MockInventoryItem{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, id).Return(mockEntity, nil)
+	mockEntity.On("ID").Return(id)
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).Once()
+	mockEntity.On("Location").Return(suite.movedToFixture())
+	return mockEntity
+}
+
+func (suite *ServiceImplTestSuite) movedToFixture() entity.Location {
+	return entity.Location{Store: "main", Aisle: "B", Shelf: "2", Slot: "3"}
+}
+
+func (suite *ServiceImplTestSuite) moveFixture(id entity.ID) entity.LocationMove {
+	return entity.LocationMove{
+		ItemID:  id,
+		From:    entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"},
+		To:      suite.movedToFixture(),
+		MovedAt: suite.nowFixture,
+	}
+}
+
+// recordingTransactor runs work as the real transactor would, noting
+// whether it would have been rolled back.
+type recordingTransactor struct {
+	rolledBack bool
+}
+
+func (r *recordingTransactor) InTransaction(ctx context.Context, work func(ctx context.Context) error) error {
+	err := work(ctx)
+	r.rolledBack = err != nil
+	return err
 }
@@ -2,9 +2,11 @@ package inventory_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
 	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
 
 	"github.com/liampulles/matchstick-video/pkg/domain/entity"
@@ -13,7 +15,8 @@ import (
 
 type VOFactoryImplTestSuite struct {
 	suite.Suite
-	sut *inventory.VOFactoryImpl
+	mockLocationFormat *domainMocks.MockLocationFormat
+	sut                *inventory.VOFactoryImpl
 }
 
 func TestVOFactoryImplTestSuite(t *testing.T) {
@@ -21,51 +24,102 @@ func TestVOFactoryImplTestSuite(t *testing.T) {
 }
 
 func (suite *VOFactoryImplTestSuite) SetupTest() {
-	suite.sut = inventory.NewVOFactoryImpl()
+	suite.mockLocationFormat = &domainMocks.MockLocationFormat{}
+	suite.sut = inventory.NewVOFactoryImpl(suite.mockLocationFormat)
 }
 
-func (suite *VOFactoryImplTestSuite) TestCreateViewVOFromEntity_ShouldMapFields() {
+func (suite *VOFactoryImplTestSuite) TestCreateViewVOFromEntity_WhenNotRented_ShouldMapFields() {
 	// Setup mocks
 	mockEntity := &entityMocks.MockInventoryItem{}
 	mockEntity.On("ID").Return(entity.ID(101))
-	mockEntity.On("Name").Return("some.name")
-	mockEntity.On("Location").Return("some.location")
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockEntity.On("Barcode").Return("some.barcode")
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockLocationFormat.On("Format", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).Return("some.location")
 	mockEntity.On("IsAvailable").Return(true)
 
 	// Setup expectations
 	expected := &inventory.ViewVO{
 		ID:        entity.ID(101),
-		Name:      "some.name",
+		TitleID:   entity.ID(11),
+		Format:    entity.FormatDVD,
+		Barcode:   "some.barcode",
 		Location:  "some.location",
 		Available: true,
 	}
 
 	// Exercise SUT
-	actual := suite.sut.CreateViewVOFromEntity(mockEntity)
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, nil, time.Now())
 
 	// Verify results
 	suite.Equal(actual, expected)
 }
 
+func (suite *VOFactoryImplTestSuite) TestCreateViewVOFromEntity_WhenRented_ShouldIncludeDueDate() {
+	// Setup fixture
+	nowFixture := time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)
+	dueFixture := time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockEntity.On("ID").Return(entity.ID(101))
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockEntity.On("Barcode").Return("some.barcode")
+	mockEntity.On("Location").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"})
+	suite.mockLocationFormat.On("Format", entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}).Return("some.location")
+	mockEntity.On("IsAvailable").Return(false)
+	mockRental := &entityMocks.MockRental{}
+	mockRental.On("DueAt").Return(dueFixture)
+	mockRental.On("IsOverdue", nowFixture).Return(true)
+
+	// Setup expectations
+	expected := &inventory.ViewVO{
+		ID:        entity.ID(101),
+		TitleID:   entity.ID(11),
+		Format:    entity.FormatDVD,
+		Barcode:   "some.barcode",
+		Location:  "some.location",
+		Available: false,
+		DueAt:     &dueFixture,
+		Overdue:   true,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, mockRental, nowFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
 func (suite *VOFactoryImplTestSuite) TestCreateThinViewVOsFromEntities_ShouldMapFields() {
 	// Setup mocks
 	mockEntity1 := &entityMocks.MockInventoryItem{}
 	mockEntity1.On("ID").Return(entity.ID(101))
-	mockEntity1.On("Name").Return("some.name.1")
+	mockEntity1.On("TitleID").Return(entity.ID(11))
+	mockEntity1.On("Format").Return(entity.FormatDVD)
+	mockEntity1.On("Barcode").Return("some.barcode.1")
 	mockEntity2 := &entityMocks.MockInventoryItem{}
 	mockEntity2.On("ID").Return(entity.ID(102))
-	mockEntity2.On("Name").Return("some.name.2")
+	mockEntity2.On("TitleID").Return(entity.ID(12))
+	mockEntity2.On("Format").Return(entity.FormatVHS)
+	mockEntity2.On("Barcode").Return("some.barcode.2")
 	fixture := []entity.InventoryItem{mockEntity1, mockEntity2}
 
 	// Setup expectations
 	expected := []inventory.ThinViewVO{
 		inventory.ThinViewVO{
-			ID:   entity.ID(101),
-			Name: "some.name.1",
+			ID:      entity.ID(101),
+			TitleID: entity.ID(11),
+			Format:  entity.FormatDVD,
+			Barcode: "some.barcode.1",
 		},
 		inventory.ThinViewVO{
-			ID:   entity.ID(102),
-			Name: "some.name.2",
+			ID:      entity.ID(102),
+			TitleID: entity.ID(12),
+			Format:  entity.FormatVHS,
+			Barcode: "some.barcode.2",
 		},
 	}
 
@@ -75,3 +129,92 @@ func (suite *VOFactoryImplTestSuite) TestCreateThinViewVOsFromEntities_ShouldMap
 	// Verify results
 	suite.Equal(actual, expected)
 }
+
+func (suite *VOFactoryImplTestSuite) TestCreateMoveViewVOs_ShouldMapFields() {
+	// Setup fixture
+	fromFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	toFixture := entity.Location{Store: "main", Aisle: "B", Shelf: "2", Slot: "3"}
+	movedAtFixture := time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)
+	fixture := []entity.LocationMove{
+		{ItemID: 101, From: fromFixture, To: toFixture, MovedAt: movedAtFixture},
+	}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Format", fromFixture).Return("main-A-1-12")
+	suite.mockLocationFormat.On("Format", toFixture).Return("main-B-2-3")
+
+	// Setup expectations
+	expected := []inventory.MoveViewVO{
+		{ItemID: 101, From: "main-A-1-12", To: "main-B-2-3", MovedAt: movedAtFixture},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateMoveViewVOs(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryImplTestSuite) TestCreateLabelVO_ShouldMapFields() {
+	// Setup fixture
+	locationFixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	entityFixture := &entityMocks.MockInventoryItem{Data: "some.data"}
+	titleFixture := &entityMocks.MockTitle{Data: "some.title"}
+
+	// Setup mocks
+	entityFixture.On("ID").Return(entity.ID(101))
+	entityFixture.On("Barcode").Return("MV00000001")
+	entityFixture.On("Location").Return(locationFixture)
+	titleFixture.On("Name").Return("some.name")
+	suite.mockLocationFormat.On("Format", locationFixture).Return("main-A-1-12")
+
+	// Setup expectations
+	expected := &inventory.LabelVO{
+		ItemID:   101,
+		Barcode:  "MV00000001",
+		Title:    "some.name",
+		Location: "main-A-1-12",
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateLabelVO(entityFixture, titleFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryImplTestSuite) TestCreateShelfViewVO_ShouldGroupItemsBySlot() {
+	// Setup fixture
+	slot1 := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "1"}
+	slot2 := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "2"}
+	locationsFixture := []entity.Location{slot1, slot2}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Format", slot1).Return("main-A-1-1")
+	suite.mockLocationFormat.On("Format", slot2).Return("main-A-1-2")
+	mockEntity := &entityMocks.MockInventoryItem{}
+	mockEntity.On("ID").Return(entity.ID(101))
+	mockEntity.On("TitleID").Return(entity.ID(11))
+	mockEntity.On("Format").Return(entity.FormatDVD)
+	mockEntity.On("Barcode").Return("some.barcode")
+	mockEntity.On("Location").Return(slot2)
+
+	// Setup expectations
+	expected := &inventory.ShelfViewVO{
+		Store: "main",
+		Aisle: "A",
+		Shelf: "1",
+		Slots: []inventory.SlotViewVO{
+			{Slot: "1", Location: "main-A-1-1", Items: []inventory.ThinViewVO{}},
+			{Slot: "2", Location: "main-A-1-2", Items: []inventory.ThinViewVO{
+				{ID: 101, TitleID: 11, Format: entity.FormatDVD, Barcode: "some.barcode"},
+			}},
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateShelfViewVO("main", "A", "1", locationsFixture, []entity.InventoryItem{mockEntity})
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,266 @@
+package ledger_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	accountMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/account"
+	ledgerMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/ledger"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository        *ledgerMocks.MockRepository
+	mockAccountRepository *accountMocks.MockRepository
+	mockConstructor       *entityMocks.MockLedgerEntryConstructor
+	mockVoFactory         *ledgerMocks.MockVOFactory
+	mockClock             *domainMocks.MockClock
+	ctxFixture            context.Context
+	nowFixture            time.Time
+	sut                   *ledger.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &ledgerMocks.MockRepository{}
+	suite.mockAccountRepository = &accountMocks.MockRepository{}
+	suite.mockConstructor = &entityMocks.MockLedgerEntryConstructor{}
+	suite.mockVoFactory = &ledgerMocks.MockVOFactory{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = ledger.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockAccountRepository,
+		suite.mockConstructor,
+		suite.mockVoFactory,
+		suite.mockClock,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestRecordPayment_WhenAccountRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCard}
+
+	// Setup mocks
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record payment - account repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordPayment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestRecordPayment_WhenConstructorFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCard}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	suite.mockConstructor.On("NewPayment", entity.ID(7), entity.Money(250), entity.PaymentCard, suite.nowFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record payment - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordPayment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestRecordPayment_WhenRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCard}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	mockEntity := &entityMocks.MockLedgerEntry{Data: "some.entry"}
+	suite.mockConstructor.On("NewPayment", entity.ID(7), entity.Money(250), entity.PaymentCard, suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record payment - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordPayment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRecordPayment_WhenRepositoryCreatePasses_ShouldReturnID() {
+	// Setup fixture
+	voFixture := &ledger.RecordPaymentVO{Amount: 250, Method: entity.PaymentCard}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	mockEntity := &entityMocks.MockLedgerEntry{Data: "some.entry"}
+	suite.mockConstructor.On("NewPayment", entity.ID(7), entity.Money(250), entity.PaymentCard, suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(301), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordPayment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(301), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestRecordAdjustment_WhenAccountRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+
+	// Setup mocks
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record adjustment - account repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordAdjustment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestRecordAdjustment_WhenConstructorFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	suite.mockConstructor.On("NewAdjustment", entity.ID(7), entity.Money(-100), "goodwill", suite.nowFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record adjustment - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordAdjustment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestRecordAdjustment_WhenRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	mockEntity := &entityMocks.MockLedgerEntry{Data: "some.entry"}
+	suite.mockConstructor.On("NewAdjustment", entity.ID(7), entity.Money(-100), "goodwill", suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not record adjustment - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordAdjustment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRecordAdjustment_WhenRepositoryCreatePasses_ShouldReturnID() {
+	// Setup fixture
+	voFixture := &ledger.RecordAdjustmentVO{Amount: -100, Note: "goodwill"}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	mockEntity := &entityMocks.MockLedgerEntry{Data: "some.entry"}
+	suite.mockConstructor.On("NewAdjustment", entity.ID(7), entity.Money(-100), "goodwill", suite.nowFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(301), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.RecordAdjustment(suite.ctxFixture, entity.ID(7), voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(301), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadStatement_WhenAccountRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, entity.ID(7)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read ledger - account repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadStatement(suite.ctxFixture, entity.ID(7))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadStatement_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	suite.mockRepository.On("FindByAccountID", suite.ctxFixture, entity.ID(7)).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read ledger - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadStatement(suite.ctxFixture, entity.ID(7))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadStatement_WhenRepositoryFindPasses_ShouldReturnStatement() {
+	// Setup fixture
+	entitiesFixture := []entity.LedgerEntry{
+		&entityMocks.MockLedgerEntry{Data: "some.entry"},
+	}
+
+	// Setup expectations
+	expected := &ledger.StatementVO{AccountID: 7, Balance: 250}
+
+	// Setup mocks
+	suite.mockAccount(entity.ID(7))
+	suite.mockRepository.On("FindByAccountID", suite.ctxFixture, entity.ID(7)).Return(entitiesFixture, nil)
+	suite.mockVoFactory.On("CreateStatementVOFromEntities", entity.ID(7), entitiesFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadStatement(suite.ctxFixture, entity.ID(7))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+// mockAccount finds the account.
+func (suite *ServiceImplTestSuite) mockAccount(id entity.ID) {
+	suite.mockAccountRepository.On("FindByID", suite.ctxFixture, id).Return(&entityMocks.MockAccount{Data: "some.account"}, nil)
+}
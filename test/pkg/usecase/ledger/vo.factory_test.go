@@ -0,0 +1,111 @@
+package ledger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/ledger"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	recordedFixture time.Time
+	sut             *ledger.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.recordedFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.sut = ledger.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_ShouldMapFields() {
+	// Setup fixture
+	entityFixture := entity.TestLedgerEntryImplConstructor(301, 7, entity.LedgerCharge, 250, "", 101, "late fee", suite.recordedFixture)
+
+	// Setup expectations
+	expected := &ledger.ViewVO{
+		ID:         301,
+		AccountID:  7,
+		Kind:       entity.LedgerCharge,
+		Amount:     250,
+		RentalID:   101,
+		Note:       "late fee",
+		RecordedAt: suite.recordedFixture,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(entityFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateStatementVOFromEntities_ShouldMapEachAndTotalBalance() {
+	// Setup fixture
+	entitiesFixture := []entity.LedgerEntry{
+		entity.TestLedgerEntryImplConstructor(301, 7, entity.LedgerCharge, 250, "", 101, "late fee", suite.recordedFixture),
+		entity.TestLedgerEntryImplConstructor(302, 7, entity.LedgerPayment, 200, entity.PaymentCash, entity.InvalidID, "", suite.recordedFixture.Add(time.Hour)),
+		entity.TestLedgerEntryImplConstructor(303, 7, entity.LedgerAdjustment, -30, "", entity.InvalidID, "goodwill", suite.recordedFixture.Add(2*time.Hour)),
+	}
+
+	// Setup expectations
+	expected := &ledger.StatementVO{
+		AccountID: 7,
+		Balance:   20,
+		Entries: []ledger.ViewVO{
+			{
+				ID:         301,
+				AccountID:  7,
+				Kind:       entity.LedgerCharge,
+				Amount:     250,
+				RentalID:   101,
+				Note:       "late fee",
+				RecordedAt: suite.recordedFixture,
+			},
+			{
+				ID:         302,
+				AccountID:  7,
+				Kind:       entity.LedgerPayment,
+				Amount:     200,
+				Method:     entity.PaymentCash,
+				RentalID:   entity.InvalidID,
+				RecordedAt: suite.recordedFixture.Add(time.Hour),
+			},
+			{
+				ID:         303,
+				AccountID:  7,
+				Kind:       entity.LedgerAdjustment,
+				Amount:     -30,
+				RentalID:   entity.InvalidID,
+				Note:       "goodwill",
+				RecordedAt: suite.recordedFixture.Add(2 * time.Hour),
+			},
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateStatementVOFromEntities(7, entitiesFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateStatementVOFromEntities_WhenNoEntities_ShouldHaveZeroBalance() {
+	// Setup expectations
+	expected := &ledger.StatementVO{
+		AccountID: 7,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateStatementVOFromEntities(7, nil)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,177 @@
+package location_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	locationMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/location"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository     *locationMocks.MockRepository
+	mockLocationFormat *domainMocks.MockLocationFormat
+	mockVoFactory      *locationMocks.MockVOFactory
+	ctxFixture         context.Context
+	locationFixture    entity.Location
+	sut                *location.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &locationMocks.MockRepository{}
+	suite.mockLocationFormat = &domainMocks.MockLocationFormat{}
+	suite.mockVoFactory = &locationMocks.MockVOFactory{}
+	suite.ctxFixture = context.Background()
+	suite.locationFixture = entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+	suite.sut = location.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockLocationFormat,
+		suite.mockVoFactory,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenLocationFormatFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &location.CreateLocationVO{Location: "nonsense"}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Parse", "nonsense").Return(entity.Location{}, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not create location - location format error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenLocationIsInvalid_ShouldFail() {
+	// Setup fixture
+	voFixture := &location.CreateLocationVO{Location: "main-A-1-"}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Parse", "main-A-1-").Return(entity.Location{Store: "main", Aisle: "A", Shelf: "1"}, nil)
+
+	// Setup expectations
+	expectedErr := "could not create location - location error: validation error: field=[location.slot], problem=[must not be blank]"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &location.CreateLocationVO{Location: "main-A-1-12"}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Parse", "main-A-1-12").Return(suite.locationFixture, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, suite.locationFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not create location - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnView() {
+	// Setup fixture
+	voFixture := &location.CreateLocationVO{Location: "main-A-1-12"}
+
+	// Setup expectations
+	expected := &location.ViewVO{Location: "main-A-1-12"}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Parse", "main-A-1-12").Return(suite.locationFixture, nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, suite.locationFixture).Return(nil)
+	suite.mockVoFactory.On("CreateViewVO", suite.locationFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read locations - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnViews() {
+	// Setup fixture
+	locationsFixture := []entity.Location{suite.locationFixture}
+
+	// Setup expectations
+	expected := []location.ViewVO{{Location: "main-A-1-12"}}
+
+	// Setup mocks
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(locationsFixture, nil)
+	suite.mockVoFactory.On("CreateViewVOs", locationsFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("Delete", suite.ctxFixture, suite.locationFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not delete location - repository delete error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, suite.locationFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositorySucceeds_ShouldPass() {
+	// Setup mocks
+	suite.mockRepository.On("Delete", suite.ctxFixture, suite.locationFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, suite.locationFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
@@ -0,0 +1,74 @@
+package location_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/location"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	mockLocationFormat *domainMocks.MockLocationFormat
+	sut                *location.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.mockLocationFormat = &domainMocks.MockLocationFormat{}
+	suite.sut = location.NewVOFactoryImpl(suite.mockLocationFormat)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVO_ShouldMapFields() {
+	// Setup fixture
+	fixture := entity.Location{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Format", fixture).Return("main-A-1-12")
+
+	// Setup expectations
+	expected := &location.ViewVO{
+		Location: "main-A-1-12",
+		Store:    "main",
+		Aisle:    "A",
+		Shelf:    "1",
+		Slot:     "12",
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVO(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOs_ShouldMapEach() {
+	// Setup fixture
+	fixture := []entity.Location{
+		{Store: "main", Aisle: "A", Shelf: "1", Slot: "12"},
+		{Store: "main", Aisle: "A", Shelf: "1", Slot: "13"},
+	}
+
+	// Setup mocks
+	suite.mockLocationFormat.On("Format", fixture[0]).Return("main-A-1-12")
+	suite.mockLocationFormat.On("Format", fixture[1]).Return("main-A-1-13")
+
+	// Setup expectations
+	expected := []location.ViewVO{
+		{Location: "main-A-1-12", Store: "main", Aisle: "A", Shelf: "1", Slot: "12"},
+		{Location: "main-A-1-13", Store: "main", Aisle: "A", Shelf: "1", Slot: "13"},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOs(fixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,113 @@
+package mediaformat_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type EntityModifierTestSuite struct {
+	suite.Suite
+	sut *mediaformat.EntityModifierImpl
+}
+
+func TestEntityModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityModifierTestSuite))
+}
+
+func (suite *EntityModifierTestSuite) SetupTest() {
+	suite.sut = mediaformat.NewEntityModifierImpl()
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateFormatVO_WhenEntityChangeNameFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("ChangeName", "some.name").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity name change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateFormatVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateFormatVO_WhenEntityChangeRentalPriceFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{
+		Name:        "some.name",
+		RentalPrice: 300,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeRentalPrice", entity.Money(300)).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity rental price change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateFormatVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateFormatVO_WhenEntityChangeRentalPeriodFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{
+		Name:             "some.name",
+		RentalPrice:      300,
+		RentalPeriodDays: 3,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeRentalPrice", entity.Money(300)).Return(nil)
+	mockEntity.On("ChangeRentalPeriodDays", 3).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity rental period change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateFormatVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateFormatVO_WhenChangesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{
+		Name:             "some.name",
+		RentalPrice:      300,
+		RentalPeriodDays: 3,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeRentalPrice", entity.Money(300)).Return(nil)
+	mockEntity.On("ChangeRentalPeriodDays", 3).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateFormatVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
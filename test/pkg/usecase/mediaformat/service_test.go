@@ -0,0 +1,227 @@
+package mediaformat_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	mediaformatMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/mediaformat"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository     *mediaformatMocks.MockRepository
+	mockEntityModifier *mediaformatMocks.MockEntityModifier
+	mockVoFactory      *mediaformatMocks.MockVOFactory
+	ctxFixture         context.Context
+	sut                *mediaformat.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &mediaformatMocks.MockRepository{}
+	suite.mockEntityModifier = &mediaformatMocks.MockEntityModifier{}
+	suite.mockVoFactory = &mediaformatMocks.MockVOFactory{}
+	suite.ctxFixture = context.Background()
+	suite.sut = mediaformat.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockEntityModifier,
+		suite.mockVoFactory,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read format - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, entity.FormatDVD)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryStockFails_ShouldFail() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockEntity, nil)
+	suite.mockRepository.On("FindStockByFormat", suite.ctxFixture, entity.FormatDVD).
+		Return(mediaformat.Stock{}, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read format - repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, entity.FormatDVD)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	stockFixture := mediaformat.Stock{Copies: 5, Available: 2}
+
+	// Setup expectations
+	expected := &mediaformat.ViewVO{
+		Format: entity.FormatDVD,
+		Copies: 5,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockEntity, nil)
+	suite.mockRepository.On("FindStockByFormat", suite.ctxFixture, entity.FormatDVD).Return(stockFixture, nil)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity, stockFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, entity.FormatDVD)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read formats - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryStockFails_ShouldFail() {
+	// Setup mocks
+	mockEntities := []entity.MediaFormat{&entityMocks.MockMediaFormat{Data: "mock.data"}}
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockRepository.On("FindAllStock", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read formats - repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	stockFixture := map[entity.Format]mediaformat.Stock{
+		entity.FormatDVD: {Copies: 5, Available: 2},
+	}
+
+	// Setup expectations
+	expected := []mediaformat.ViewVO{
+		{Format: entity.FormatDVD},
+	}
+
+	// Setup mocks
+	mockEntities := []entity.MediaFormat{&entityMocks.MockMediaFormat{Data: "mock.data"}}
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockRepository.On("FindAllStock", suite.ctxFixture).Return(stockFixture, nil)
+	suite.mockVoFactory.On("CreateViewVOsFromEntities", mockEntities, stockFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{Name: "some.name"}
+
+	// Setup mocks
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update format - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, entity.FormatDVD, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenModifierFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{Name: "some.name"}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateFormatVO", mockEntity, voFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update format - modifier error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, entity.FormatDVD, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{Name: "some.name"}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateFormatVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update format - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, entity.FormatDVD, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenDelegatesSucceed_ShouldPass() {
+	// Setup fixture
+	voFixture := &mediaformat.UpdateFormatVO{Name: "some.name"}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockMediaFormat{Data: "mock.data"}
+	suite.mockRepository.On("FindByFormat", suite.ctxFixture, entity.FormatDVD).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateFormatVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, entity.FormatDVD, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
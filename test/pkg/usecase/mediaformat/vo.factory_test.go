@@ -0,0 +1,90 @@
+package mediaformat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/mediaformat"
+)
+
+type VOFactoryImplTestSuite struct {
+	suite.Suite
+	sut *mediaformat.VOFactoryImpl
+}
+
+func TestVOFactoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryImplTestSuite))
+}
+
+func (suite *VOFactoryImplTestSuite) SetupTest() {
+	suite.sut = mediaformat.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryImplTestSuite) TestCreateViewVOFromEntity_ShouldMapFields() {
+	// Setup mocks
+	mockEntity := mockMediaFormat(entity.FormatDVD, "DVD", 300, 3)
+
+	// Setup expectations
+	expected := &mediaformat.ViewVO{
+		Format:           entity.FormatDVD,
+		Name:             "DVD",
+		RentalPrice:      300,
+		RentalPeriodDays: 3,
+		Copies:           5,
+		AvailableCopies:  2,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, mediaformat.Stock{Copies: 5, Available: 2})
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryImplTestSuite) TestCreateViewVOsFromEntities_ShouldMapFieldsAndDefaultMissingStock() {
+	// Setup fixture
+	fixture := []entity.MediaFormat{
+		mockMediaFormat(entity.FormatVHS, "VHS", 150, 7),
+		mockMediaFormat(entity.FormatDVD, "DVD", 300, 3),
+	}
+	stockFixture := map[entity.Format]mediaformat.Stock{
+		entity.FormatDVD: {Copies: 5, Available: 2},
+	}
+
+	// Setup expectations
+	expected := []mediaformat.ViewVO{
+		{
+			Format:           entity.FormatVHS,
+			Name:             "VHS",
+			RentalPrice:      150,
+			RentalPeriodDays: 7,
+		},
+		{
+			Format:           entity.FormatDVD,
+			Name:             "DVD",
+			RentalPrice:      300,
+			RentalPeriodDays: 3,
+			Copies:           5,
+			AvailableCopies:  2,
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOsFromEntities(fixture, stockFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func mockMediaFormat(format entity.Format, name string, price entity.Money, days int) *entityMocks.MockMediaFormat {
+	mockEntity := &entityMocks.MockMediaFormat{}
+	mockEntity.On("Format").Return(format)
+	mockEntity.On("Name").Return(name)
+	mockEntity.On("RentalPrice").Return(price)
+	mockEntity.On("RentalPeriodDays").Return(days)
+	return mockEntity
+}
@@ -0,0 +1,184 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type FeedImplTestSuite struct {
+	suite.Suite
+	mockRepository *outboxMocks.MockRepository
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	cancel         context.CancelFunc
+	nowFixture     time.Time
+	sut            *outbox.FeedImpl
+}
+
+func TestFeedImplTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedImplTestSuite))
+}
+
+func (suite *FeedImplTestSuite) SetupTest() {
+	suite.mockRepository = &outboxMocks.MockRepository{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture, suite.cancel = context.WithCancel(context.Background())
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.sut = outbox.NewFeedImpl(
+		suite.mockRepository,
+		suite.mockClock,
+		time.Millisecond,
+		time.Minute,
+		10,
+	)
+}
+
+func (suite *FeedImplTestSuite) TearDownTest() {
+	suite.cancel()
+}
+
+func (suite *FeedImplTestSuite) TestLatest_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindLatestID", suite.ctxFixture).Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not find latest outbox message - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Latest(suite.ctxFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *FeedImplTestSuite) TestLatest_WhenRepositoryPasses_ShouldReturnID() {
+	// Setup mocks
+	suite.mockRepository.On("FindLatestID", suite.ctxFixture).Return(entity.ID(12), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Latest(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(12), actual)
+}
+
+func (suite *FeedImplTestSuite) TestFollow_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not follow outbox messages - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Follow(suite.ctxFixture, entity.ID(12), suite.collect(nil, 1))
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *FeedImplTestSuite) TestFollow_WhenFnFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(suite.messages(13), nil)
+
+	// Setup expectations
+	expectedErr := "could not follow outbox messages - mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Follow(suite.ctxFixture, entity.ID(12), func([]outbox.Message) error {
+		return fmt.Errorf("mock.error")
+	})
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *FeedImplTestSuite) TestFollow_ShouldPassOnNewMessagesUntilDone() {
+	// Setup mocks
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(suite.messages(13, 14), nil).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(14), 10).Return(suite.messages(), nil).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(14), 10).Return(suite.messages(15), nil).Once()
+
+	// Setup expectations
+	expected := [][]outbox.Message{suite.messages(13, 14), suite.messages(), suite.messages(15)}
+
+	// Exercise SUT
+	var actual [][]outbox.Message
+	err := suite.sut.Follow(suite.ctxFixture, entity.ID(12), suite.collect(&actual, 3))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *FeedImplTestSuite) TestFollow_WhenIDIsMissing_ShouldHoldBackLaterMessagesUntilFound() {
+	// Setup mocks
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(suite.messages(13, 15), nil).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(13), 10).Return(suite.messages(15), nil).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(13), 10).Return(suite.messages(14, 15), nil).Once()
+
+	// Setup expectations
+	expected := [][]outbox.Message{suite.messages(13), suite.messages(), suite.messages(14, 15)}
+
+	// Exercise SUT
+	var actual [][]outbox.Message
+	err := suite.sut.Follow(suite.ctxFixture, entity.ID(12), suite.collect(&actual, 3))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *FeedImplTestSuite) TestFollow_WhenIDIsMissingForGapTimeout_ShouldSkipIt() {
+	// Setup mocks
+	suite.mockClock.On("Now").Return(suite.nowFixture).Once()
+	suite.mockClock.On("Now").Return(suite.nowFixture.Add(time.Minute)).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(suite.messages(14, 15, 17), nil).Once()
+	suite.mockRepository.On("FindAfter", suite.ctxFixture, entity.ID(12), 10).Return(suite.messages(14, 15, 17), nil).Once()
+
+	// Setup expectations
+	expected := [][]outbox.Message{suite.messages(), suite.messages(14, 15)}
+
+	// Exercise SUT
+	var actual [][]outbox.Message
+	err := suite.sut.Follow(suite.ctxFixture, entity.ID(12), suite.collect(&actual, 2))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+// collect returns a fn which keeps what it is called with, and stops
+// following after the given number of calls.
+func (suite *FeedImplTestSuite) collect(into *[][]outbox.Message, calls int) func([]outbox.Message) error {
+	return func(msgs []outbox.Message) error {
+		if into != nil {
+			*into = append(*into, msgs)
+		}
+		calls--
+		if calls == 0 {
+			suite.cancel()
+		}
+		return nil
+	}
+}
+
+func (suite *FeedImplTestSuite) messages(ids ...entity.ID) []outbox.Message {
+	msgs := make([]outbox.Message, 0)
+	for _, id := range ids {
+		msgs = append(msgs, outbox.Message{ID: id, EntityID: 101, Type: entity.EventItemCheckedIn})
+	}
+	return msgs
+}
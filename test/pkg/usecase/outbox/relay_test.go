@@ -0,0 +1,155 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type RelayImplTestSuite struct {
+	suite.Suite
+	mockRepository *outboxMocks.MockRepository
+	mockSink1      *outboxMocks.MockSink
+	mockSink2      *outboxMocks.MockSink
+	mockTransactor *domainMocks.MockTransactor
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	nowFixture     time.Time
+	sut            *outbox.RelayImpl
+}
+
+func TestRelayImplTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayImplTestSuite))
+}
+
+func (suite *RelayImplTestSuite) SetupTest() {
+	suite.mockRepository = &outboxMocks.MockRepository{}
+	suite.mockSink1 = &outboxMocks.MockSink{}
+	suite.mockSink2 = &outboxMocks.MockSink{}
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(nil)
+	suite.sut = outbox.NewRelayImpl(
+		suite.mockRepository,
+		[]outbox.Sink{suite.mockSink1, suite.mockSink2},
+		suite.mockTransactor,
+		suite.mockClock,
+		10,
+	)
+}
+
+func (suite *RelayImplTestSuite) TestRelayPending_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindUnpublished", suite.ctxFixture, 10).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not relay outbox messages - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *RelayImplTestSuite) TestRelayPending_WhenRepositoryMarkFails_ShouldFail() {
+	// Setup fixture
+	msg := outbox.Message{ID: 1, EntityID: 101}
+
+	// Setup mocks
+	suite.mockRepository.On("FindUnpublished", suite.ctxFixture, 10).Return([]outbox.Message{msg}, nil)
+	suite.mockSink1.On("Publish", suite.ctxFixture, msg).Return(nil)
+	suite.mockSink2.On("Publish", suite.ctxFixture, msg).Return(nil)
+	suite.mockRepository.On("MarkPublished", suite.ctxFixture, entity.ID(1), suite.nowFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not relay outbox messages - repository mark error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *RelayImplTestSuite) TestRelayPending_WhenSinkFails_ShouldKeepEarlierMessagesAndFail() {
+	// Setup fixture
+	msg1 := outbox.Message{ID: 1, EntityID: 101}
+	msg2 := outbox.Message{ID: 2, EntityID: 102}
+	msg3 := outbox.Message{ID: 3, EntityID: 103}
+
+	// Setup mocks
+	suite.mockRepository.On("FindUnpublished", suite.ctxFixture, 10).Return([]outbox.Message{msg1, msg2, msg3}, nil)
+	suite.mockSink1.On("Publish", suite.ctxFixture, msg1).Return(nil)
+	suite.mockSink2.On("Publish", suite.ctxFixture, msg1).Return(nil)
+	suite.mockRepository.On("MarkPublished", suite.ctxFixture, entity.ID(1), suite.nowFixture).Return(nil)
+	suite.mockSink1.On("Publish", suite.ctxFixture, msg2).Return(nil)
+	suite.mockSink2.On("Publish", suite.ctxFixture, msg2).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not relay outbox messages - sink publish error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(1, actual)
+	suite.mockRepository.AssertNotCalled(suite.T(), "MarkPublished", suite.ctxFixture, entity.ID(2), suite.nowFixture)
+	suite.mockSink1.AssertNotCalled(suite.T(), "Publish", suite.ctxFixture, msg3)
+}
+
+func (suite *RelayImplTestSuite) TestRelayPending_WhenTransactionFails_ShouldFail() {
+	// Setup mocks
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(fmt.Errorf("mock.error"))
+	sut := outbox.NewRelayImpl(suite.mockRepository, nil, suite.mockTransactor, suite.mockClock, 10)
+
+	// Setup expectations
+	expectedErr := "could not relay outbox messages - mock.error"
+
+	// Exercise SUT
+	actual, err := sut.RelayPending(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *RelayImplTestSuite) TestRelayPending_WhenDelegatesSucceed_ShouldPublishToEverySinkAndMark() {
+	// Setup fixture
+	msg1 := outbox.Message{ID: 1, EntityID: 101}
+	msg2 := outbox.Message{ID: 2, EntityID: 102}
+
+	// Setup mocks
+	suite.mockRepository.On("FindUnpublished", suite.ctxFixture, 10).Return([]outbox.Message{msg1, msg2}, nil)
+	for _, msg := range []outbox.Message{msg1, msg2} {
+		suite.mockSink1.On("Publish", suite.ctxFixture, msg).Return(nil)
+		suite.mockSink2.On("Publish", suite.ctxFixture, msg).Return(nil)
+		suite.mockRepository.On("MarkPublished", suite.ctxFixture, msg.ID, suite.nowFixture).Return(nil)
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.RelayPending(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(2, actual)
+	suite.mockSink1.AssertExpectations(suite.T())
+	suite.mockSink2.AssertExpectations(suite.T())
+	suite.mockRepository.AssertExpectations(suite.T())
+}
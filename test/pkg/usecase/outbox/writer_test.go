@@ -0,0 +1,106 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	outboxMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/outbox"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+)
+
+type WriterImplTestSuite struct {
+	suite.Suite
+	mockRepository *outboxMocks.MockRepository
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	nowFixture     time.Time
+	sut            *outbox.WriterImpl
+}
+
+func TestWriterImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WriterImplTestSuite))
+}
+
+func (suite *WriterImplTestSuite) SetupTest() {
+	suite.mockRepository = &outboxMocks.MockRepository{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = outbox.NewWriterImpl(
+		suite.mockRepository,
+		suite.mockClock,
+	)
+}
+
+func (suite *WriterImplTestSuite) TestWrite_WhenRepositoryFails_ShouldFail() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockEntity.On("PullEvents").Return([]entity.Event{
+		{Type: entity.EventItemCheckedIn, Data: map[string]interface{}{}},
+	})
+	suite.mockRepository.On("Create", suite.ctxFixture, outbox.Message{
+		EntityID:   101,
+		Type:       entity.EventItemCheckedIn,
+		Data:       map[string]interface{}{},
+		OccurredAt: suite.nowFixture,
+	}).Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not write outbox message - repository create error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Write(suite.ctxFixture, 101, mockEntity)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *WriterImplTestSuite) TestWrite_GivenEvents_ShouldCreateMessageForEach() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockEntity.On("PullEvents").Return([]entity.Event{
+		{Type: entity.EventItemCheckedOut, Data: map[string]interface{}{"accountId": entity.ID(7)}},
+		{Type: entity.EventItemCheckedIn, Data: map[string]interface{}{}},
+	})
+	suite.mockRepository.On("Create", suite.ctxFixture, outbox.Message{
+		EntityID:   101,
+		Type:       entity.EventItemCheckedOut,
+		Data:       map[string]interface{}{"accountId": entity.ID(7)},
+		OccurredAt: suite.nowFixture,
+	}).Return(entity.ID(1), nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, outbox.Message{
+		EntityID:   101,
+		Type:       entity.EventItemCheckedIn,
+		Data:       map[string]interface{}{},
+		OccurredAt: suite.nowFixture,
+	}).Return(entity.ID(2), nil)
+
+	// Exercise SUT
+	err := suite.sut.Write(suite.ctxFixture, 101, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 2)
+}
+
+func (suite *WriterImplTestSuite) TestWrite_GivenNoEvents_ShouldCreateNothing() {
+	// Setup mocks
+	mockEntity := &entityMocks.MockInventoryItem{Data: "some.data"}
+	mockEntity.On("PullEvents").Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Write(suite.ctxFixture, 101, mockEntity)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "Create", 0)
+}
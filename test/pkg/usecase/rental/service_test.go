@@ -0,0 +1,79 @@
+package rental_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	rentalMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/rental"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository *rentalMocks.MockRepository
+	mockVoFactory  *rentalMocks.MockVOFactory
+	mockClock      *domainMocks.MockClock
+	ctxFixture     context.Context
+	nowFixture     time.Time
+	sut            *rental.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &rentalMocks.MockRepository{}
+	suite.mockVoFactory = &rentalMocks.MockVOFactory{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = rental.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockVoFactory,
+		suite.mockClock,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestReadOverdue_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindOverdue", suite.ctxFixture, suite.nowFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read overdue rentals - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadOverdue(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadOverdue_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup expectations
+	expected := []rental.ViewVO{
+		{ID: entity.ID(101), Overdue: true},
+	}
+
+	// Setup mocks
+	mockEntities := []entity.Rental{&entityMocks.MockRental{Data: "mock.data"}}
+	suite.mockRepository.On("FindOverdue", suite.ctxFixture, suite.nowFixture).Return(mockEntities, nil)
+	suite.mockVoFactory.On("CreateViewVOsFromEntities", mockEntities, suite.nowFixture).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadOverdue(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,131 @@
+package rental_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain"
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/rental"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	checkedOutFixture time.Time
+	dueFixture        time.Time
+	sut               *rental.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.checkedOutFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.dueFixture = time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	suite.sut = rental.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_WhenBeforeDue_ShouldNotBeOverdue() {
+	// Setup fixture
+	entityFixture := entity.TestRentalImplConstructor(101, 7, 8, suite.checkedOutFixture, suite.dueFixture, nil, 0, 0)
+	nowFixture := suite.dueFixture.Add(-time.Hour)
+
+	// Setup expectations
+	expected := &rental.ViewVO{
+		ID:           101,
+		ItemID:       7,
+		AccountID:    8,
+		CheckedOutAt: suite.checkedOutFixture,
+		DueAt:        suite.dueFixture,
+		Overdue:      false,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(entityFixture, nowFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOsFromEntities_ShouldJudgeEachAgainstNow() {
+	// Setup fixture
+	entitiesFixture := []entity.Rental{
+		entity.TestRentalImplConstructor(101, 7, 8, suite.checkedOutFixture, suite.dueFixture, nil, 0, 0),
+		entity.TestRentalImplConstructor(102, 9, 8, suite.checkedOutFixture, suite.dueFixture.AddDate(0, 0, 2), nil, 0, 0),
+	}
+	nowFixture := suite.dueFixture.Add(time.Hour)
+
+	// Setup expectations
+	expected := []rental.ViewVO{
+		{
+			ID:           101,
+			ItemID:       7,
+			AccountID:    8,
+			CheckedOutAt: suite.checkedOutFixture,
+			DueAt:        suite.dueFixture,
+			Overdue:      true,
+		},
+		{
+			ID:           102,
+			ItemID:       9,
+			AccountID:    8,
+			CheckedOutAt: suite.checkedOutFixture,
+			DueAt:        suite.dueFixture.AddDate(0, 0, 2),
+			Overdue:      false,
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOsFromEntities(entitiesFixture, nowFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateReceiptLineVO_ShouldIncludeFee() {
+	// Setup fixture
+	returnedFixture := suite.dueFixture.Add(36 * time.Hour)
+	entityFixture := entity.TestRentalImplConstructor(101, 7, 8, suite.checkedOutFixture, suite.dueFixture, &returnedFixture, 200, 0)
+	feeFixture := domain.LateFee{DaysLate: 2, Amount: 200}
+
+	// Setup expectations
+	expected := &rental.ReceiptLineVO{
+		RentalID:   101,
+		ItemID:     7,
+		AccountID:  8,
+		DueAt:      suite.dueFixture,
+		ReturnedAt: returnedFixture,
+		DaysLate:   2,
+		LateFee:    200,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateReceiptLineVO(entityFixture, feeFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateRenewalReceiptLineVO_ShouldIncludeFee() {
+	// Setup fixture
+	entityFixture := entity.TestRentalImplConstructor(101, 7, 8, suite.checkedOutFixture, suite.dueFixture, nil, 0, 1)
+
+	// Setup expectations
+	expected := &rental.RenewalReceiptLineVO{
+		RentalID:   101,
+		ItemID:     7,
+		AccountID:  8,
+		DueAt:      suite.dueFixture,
+		Renewals:   1,
+		RenewalFee: 300,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateRenewalReceiptLineVO(entityFixture, entity.Money(300))
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
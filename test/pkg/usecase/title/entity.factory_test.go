@@ -0,0 +1,54 @@
+package title_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type EntityFactoryTestSuite struct {
+	suite.Suite
+	mockConstructor *entityMocks.MockTitleConstructor
+	sut             *title.EntityFactoryImpl
+}
+
+func TestEntityFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityFactoryTestSuite))
+}
+
+func (suite *EntityFactoryTestSuite) SetupTest() {
+	suite.mockConstructor = &entityMocks.MockTitleConstructor{}
+	suite.sut = title.NewEntityFactoryImpl(suite.mockConstructor)
+}
+
+func (suite *EntityFactoryTestSuite) TestCreateFromVO_ShouldCallConstructorAndReturnEntityAndError() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{
+		Name:     "some.name",
+		Year:     1999,
+		Runtime:  136,
+		Synopsis: "some.synopsis",
+		Genres:   []string{"some.genre"},
+		Cast:     []string{"some.cast"},
+		Rating:   "some.rating",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockError := fmt.Errorf("some.error")
+	suite.mockConstructor.On("New", "some.name", 1999, 136, "some.synopsis",
+		[]string{"some.genre"}, []string{"some.cast"}, "some.rating").
+		Return(mockEntity, mockError)
+
+	// Exercise SUT
+	actual, err := suite.sut.CreateFromVO(voFixture)
+
+	// Verify results
+	suite.EqualError(err, "some.error")
+	suite.Equal(actual, mockEntity)
+}
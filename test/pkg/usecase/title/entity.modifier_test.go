@@ -0,0 +1,127 @@
+package title_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type EntityModifierTestSuite struct {
+	suite.Suite
+	sut *title.EntityModifierImpl
+}
+
+func TestEntityModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityModifierTestSuite))
+}
+
+func (suite *EntityModifierTestSuite) SetupTest() {
+	suite.sut = title.NewEntityModifierImpl()
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateTitleVO_WhenEntityChangeNameFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ChangeName", "some.name").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity name change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateTitleVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateTitleVO_WhenEntityChangeYearFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+		Year: 1999,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeYear", 1999).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity year change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateTitleVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateTitleVO_WhenEntityChangeCastFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.UpdateTitleVO{
+		Name:     "some.name",
+		Year:     1999,
+		Runtime:  136,
+		Synopsis: "some.synopsis",
+		Genres:   []string{"some.genre"},
+		Cast:     []string{"some.cast"},
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeYear", 1999).Return(nil)
+	mockEntity.On("ChangeRuntime", 136).Return(nil)
+	mockEntity.On("ChangeSynopsis", "some.synopsis").Return(nil)
+	mockEntity.On("ChangeGenres", []string{"some.genre"}).Return(nil)
+	mockEntity.On("ChangeCast", []string{"some.cast"}).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not modify entity with update vo - entity cast change error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateTitleVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *EntityModifierTestSuite) TestModifyWithUpdateTitleVO_WhenChangesSucceed_ShouldReturnAsExpected() {
+	// Setup fixture
+	voFixture := &title.UpdateTitleVO{
+		Name:     "some.name",
+		Year:     1999,
+		Runtime:  136,
+		Synopsis: "some.synopsis",
+		Genres:   []string{"some.genre"},
+		Cast:     []string{"some.cast"},
+		Rating:   "some.rating",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ChangeName", "some.name").Return(nil)
+	mockEntity.On("ChangeYear", 1999).Return(nil)
+	mockEntity.On("ChangeRuntime", 136).Return(nil)
+	mockEntity.On("ChangeSynopsis", "some.synopsis").Return(nil)
+	mockEntity.On("ChangeGenres", []string{"some.genre"}).Return(nil)
+	mockEntity.On("ChangeCast", []string{"some.cast"}).Return(nil)
+	mockEntity.On("ChangeRating", "some.rating").Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.ModifyWithUpdateTitleVO(mockEntity, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	mockEntity.AssertExpectations(suite.T())
+}
@@ -0,0 +1,489 @@
+package title_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	titleMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/title"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockRepository     *titleMocks.MockRepository
+	mockEntityFactory  *titleMocks.MockEntityFactory
+	mockEntityModifier *titleMocks.MockEntityModifier
+	mockVoFactory      *titleMocks.MockVOFactory
+	mockRatingScheme   *domainMocks.MockRatingScheme
+	ctxFixture         context.Context
+	sut                *title.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockRepository = &titleMocks.MockRepository{}
+	suite.mockEntityFactory = &titleMocks.MockEntityFactory{}
+	suite.mockEntityModifier = &titleMocks.MockEntityModifier{}
+	suite.mockVoFactory = &titleMocks.MockVOFactory{}
+	suite.mockRatingScheme = &domainMocks.MockRatingScheme{}
+	suite.ctxFixture = context.Background()
+	suite.sut = title.NewServiceImpl(
+		suite.mockRepository,
+		suite.mockEntityFactory,
+		suite.mockEntityModifier,
+		suite.mockVoFactory,
+		suite.mockRatingScheme,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenFactoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(nil, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create title - factory error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG")
+	mockErr := fmt.Errorf("mock.error")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockRatingScheme.On("Validate", "PG").Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.InvalidID, mockErr)
+
+	// Setup expectations
+	expectedErr := "could not create title - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(actual, entity.InvalidID)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenRatingIsNotInScheme_ShouldFail() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("X")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockRatingScheme.On("Validate", "X").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not create title - rating error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup fixture
+	voFixture := &title.CreateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG")
+	suite.mockEntityFactory.On("CreateFromVO", voFixture).Return(mockEntity, nil)
+	suite.mockRatingScheme.On("Validate", "PG").Return(nil)
+	suite.mockRepository.On("Create", suite.ctxFixture, mockEntity).Return(entity.ID(101), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(101), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read title - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryStockFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("FindStockByID", suite.ctxFixture, idFixture).Return(title.Stock{}, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read title - repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup expectations
+	expected := &title.ViewVO{
+		ID:     idFixture,
+		Copies: 2,
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	stock := title.Stock{Copies: 2, Available: 1}
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockRepository.On("FindStockByID", suite.ctxFixture, idFixture).Return(stock, nil)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity, stock).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
+
+	// Setup mocks
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read titles - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenRepositoryStockFails_ShouldFail() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102}
+
+	// Setup mocks
+	mockEntities := []entity.Title{&entityMocks.MockTitle{Data: "mock.data"}}
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).Return(mockEntities, nil)
+	suite.mockRepository.On("FindStockByIDs", suite.ctxFixture, idsFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read titles - repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadManyDetails_WhenDelegatesSucceed_ShouldReturnViewOfEachFound() {
+	// Setup fixture
+	idsFixture := []entity.ID{101, 102, 103}
+
+	// Setup expectations
+	expected := []title.ViewVO{
+		{ID: 101, Copies: 2},
+		{ID: 103},
+	}
+
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockTitle{Data: "mock.data.1"}
+	mockEntity1.On("ID").Return(entity.ID(101))
+	mockEntity2 := &entityMocks.MockTitle{Data: "mock.data.2"}
+	mockEntity2.On("ID").Return(entity.ID(103))
+	stock := map[entity.ID]title.Stock{entity.ID(101): {Copies: 2, Available: 1}}
+	suite.mockRepository.On("FindByIDs", suite.ctxFixture, idsFixture).
+		Return([]entity.Title{mockEntity1, mockEntity2}, nil)
+	suite.mockRepository.On("FindStockByIDs", suite.ctxFixture, idsFixture).Return(stock, nil)
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity1, title.Stock{Copies: 2, Available: 1}).
+		Return(&expected[0])
+	suite.mockVoFactory.On("CreateViewVOFromEntity", mockEntity2, title.Stock{}).
+		Return(&expected[1])
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadManyDetails(suite.ctxFixture, idsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read titles - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryStockFails_ShouldFail() {
+	// Setup mocks
+	mockEntities := []entity.Title{&entityMocks.MockTitle{Data: "mock.data"}}
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockRepository.On("FindAllStock", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read titles - repository stock error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnExpected() {
+	// Setup expectations
+	expected := []title.ThinViewVO{
+		{ID: entity.ID(101)},
+	}
+
+	// Setup mocks
+	mockEntities := []entity.Title{&entityMocks.MockTitle{Data: "mock.data"}}
+	stock := map[entity.ID]title.Stock{entity.ID(101): {Copies: 1}}
+	suite.mockRepository.On("FindAll", suite.ctxFixture).Return(mockEntities, nil)
+	suite.mockRepository.On("FindAllStock", suite.ctxFixture).Return(stock, nil)
+	suite.mockVoFactory.On("CreateThinViewVOsFromEntities", mockEntities, stock).Return(expected)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update title - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenModifierFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateTitleVO", mockEntity, voFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update title - modifier error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenChangedRatingIsNotInScheme_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG").Once()
+	mockEntity.On("Rating").Return("X")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateTitleVO", mockEntity, voFixture).Return(nil)
+	suite.mockRatingScheme.On("Validate", "X").Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update title - rating error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRatingIsUnchanged_ShouldNotValidateIt() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("X")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateTitleVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockRatingScheme.AssertNotCalled(suite.T(), "Validate", "X")
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateTitleVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not update title - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestUpdate_WhenDelegatesSucceed_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+	voFixture := &title.UpdateTitleVO{
+		Name: "some.name",
+	}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{Data: "mock.data"}
+	mockEntity.On("Rating").Return("PG")
+	suite.mockRepository.On("FindByID", suite.ctxFixture, idFixture).Return(mockEntity, nil)
+	suite.mockEntityModifier.On("ModifyWithUpdateTitleVO", mockEntity, voFixture).Return(nil)
+	suite.mockRepository.On("Update", suite.ctxFixture, mockEntity).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Update(suite.ctxFixture, idFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryFails_ShouldFail() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not delete title - repository delete error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryPasses_ShouldPass() {
+	// Setup fixture
+	idFixture := entity.ID(101)
+
+	// Setup mocks
+	suite.mockRepository.On("DeleteByID", suite.ctxFixture, idFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, idFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
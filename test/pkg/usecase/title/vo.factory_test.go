@@ -0,0 +1,101 @@
+package title_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/title"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	sut *title.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.sut = title.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateViewVOFromEntity_ShouldMapEntityAndStock() {
+	// Setup fixture
+	stockFixture := title.Stock{Copies: 3, Available: 1}
+
+	// Setup mocks
+	mockEntity := &entityMocks.MockTitle{}
+	mockEntity.On("ID").Return(entity.ID(101))
+	mockEntity.On("Name").Return("some.name")
+	mockEntity.On("Year").Return(1999)
+	mockEntity.On("Runtime").Return(136)
+	mockEntity.On("Synopsis").Return("some.synopsis")
+	mockEntity.On("Genres").Return([]string{"some.genre"})
+	mockEntity.On("Cast").Return([]string{"some.cast"})
+	mockEntity.On("Rating").Return("some.rating")
+
+	// Setup expectations
+	expected := &title.ViewVO{
+		ID:              entity.ID(101),
+		Name:            "some.name",
+		Year:            1999,
+		Runtime:         136,
+		Synopsis:        "some.synopsis",
+		Genres:          []string{"some.genre"},
+		Cast:            []string{"some.cast"},
+		Rating:          "some.rating",
+		Copies:          3,
+		AvailableCopies: 1,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateViewVOFromEntity(mockEntity, stockFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateThinViewVOsFromEntities_ShouldMapEntitiesAndDefaultMissingStock() {
+	// Setup fixture
+	stockFixture := map[entity.ID]title.Stock{
+		entity.ID(101): {Copies: 2, Available: 2},
+	}
+
+	// Setup mocks
+	mockEntity1 := &entityMocks.MockTitle{}
+	mockEntity1.On("ID").Return(entity.ID(101))
+	mockEntity1.On("Name").Return("some.name.1")
+	mockEntity1.On("Year").Return(1999)
+	mockEntity2 := &entityMocks.MockTitle{}
+	mockEntity2.On("ID").Return(entity.ID(102))
+	mockEntity2.On("Name").Return("some.name.2")
+	mockEntity2.On("Year").Return(2003)
+
+	// Setup expectations
+	expected := []title.ThinViewVO{
+		{
+			ID:              entity.ID(101),
+			Name:            "some.name.1",
+			Year:            1999,
+			Copies:          2,
+			AvailableCopies: 2,
+		},
+		{
+			ID:   entity.ID(102),
+			Name: "some.name.2",
+			Year: 2003,
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateThinViewVOsFromEntities(
+		[]entity.Title{mockEntity1, mockEntity2}, stockFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -0,0 +1,229 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type DelivererImplTestSuite struct {
+	suite.Suite
+	mockSubscriptionRepository *webhookMocks.MockSubscriptionRepository
+	mockDeliveryRepository     *webhookMocks.MockDeliveryRepository
+	mockSender                 *webhookMocks.MockSender
+	mockTransactor             *domainMocks.MockTransactor
+	mockBackoffPolicy          *domainMocks.MockBackoffPolicy
+	mockClock                  *domainMocks.MockClock
+	ctxFixture                 context.Context
+	nowFixture                 time.Time
+	subscriptionFixture        entity.WebhookSubscription
+	sut                        *webhook.DelivererImpl
+}
+
+func TestDelivererImplTestSuite(t *testing.T) {
+	suite.Run(t, new(DelivererImplTestSuite))
+}
+
+func (suite *DelivererImplTestSuite) SetupTest() {
+	suite.mockSubscriptionRepository = &webhookMocks.MockSubscriptionRepository{}
+	suite.mockDeliveryRepository = &webhookMocks.MockDeliveryRepository{}
+	suite.mockSender = &webhookMocks.MockSender{}
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockBackoffPolicy = &domainMocks.MockBackoffPolicy{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.subscriptionFixture = entity.TestWebhookSubscriptionImplConstructor(401, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.nowFixture)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(nil)
+	suite.sut = webhook.NewDelivererImpl(
+		suite.mockSubscriptionRepository,
+		suite.mockDeliveryRepository,
+		suite.mockSender,
+		suite.mockTransactor,
+		suite.mockBackoffPolicy,
+		suite.mockClock,
+		10,
+	)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenTransactionFails_ShouldFail() {
+	// Setup mocks
+	suite.mockTransactor = &domainMocks.MockTransactor{}
+	suite.mockTransactor.On("InTransaction", suite.ctxFixture).Return(fmt.Errorf("mock.error"))
+	sut := webhook.NewDelivererImpl(suite.mockSubscriptionRepository, suite.mockDeliveryRepository,
+		suite.mockSender, suite.mockTransactor, suite.mockBackoffPolicy, suite.mockClock, 10)
+
+	// Setup expectations
+	expectedErr := "could not deliver webhooks - mock.error"
+
+	// Exercise SUT
+	actual, err := sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenDeliveryRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDue", suite.ctxFixture, suite.nowFixture, 10).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not deliver webhooks - delivery repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenSubscriptionRepositoryFindFails_ShouldFail() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(501, 0)
+
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDue", suite.ctxFixture, suite.nowFixture, 10).
+		Return([]entity.WebhookDelivery{deliveryFixture}, nil)
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not deliver webhooks - subscription repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+	suite.mockSender.AssertNumberOfCalls(suite.T(), "Send", 0)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenDeliveryRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(501, 0)
+
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDue", suite.ctxFixture, suite.nowFixture, 10).
+		Return([]entity.WebhookDelivery{deliveryFixture}, nil)
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(suite.subscriptionFixture, nil)
+	suite.mockSender.On("Send", suite.ctxFixture, suite.subscriptionFixture, deliveryFixture).Return(200, nil)
+	suite.mockDeliveryRepository.On("Update", suite.ctxFixture, deliveryFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not deliver webhooks - delivery repository update error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.Equal(0, actual)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenNothingIsDue_ShouldPass() {
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDue", suite.ctxFixture, suite.nowFixture, 10).Return(nil, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(0, actual)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenSendSucceeds_ShouldMarkDelivered() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(501, 0)
+
+	// Setup mocks
+	suite.expectBatch(deliveryFixture)
+	suite.mockSender.On("Send", suite.ctxFixture, suite.subscriptionFixture, deliveryFixture).Return(204, nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(1, actual)
+	suite.Equal(entity.DeliveryDelivered, deliveryFixture.Status())
+	suite.Equal(1, deliveryFixture.Attempts())
+	suite.Equal(204, deliveryFixture.LastStatusCode())
+	suite.mockBackoffPolicy.AssertNumberOfCalls(suite.T(), "Next", 0)
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenSendFailsAndPolicyAllowsRetry_ShouldScheduleRetry() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(501, 2)
+	nextFixture := suite.nowFixture.Add(2 * time.Minute)
+
+	// Setup mocks
+	suite.expectBatch(deliveryFixture)
+	suite.mockSender.On("Send", suite.ctxFixture, suite.subscriptionFixture, deliveryFixture).
+		Return(503, fmt.Errorf("mock.error"))
+	suite.mockBackoffPolicy.On("Next", 3, suite.nowFixture).Return(nextFixture, true)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(1, actual)
+	suite.Equal(entity.DeliveryPending, deliveryFixture.Status())
+	suite.Equal(3, deliveryFixture.Attempts())
+	suite.Equal(nextFixture, deliveryFixture.NextAttemptAt())
+	suite.Equal(503, deliveryFixture.LastStatusCode())
+	suite.Equal("mock.error", deliveryFixture.LastError())
+}
+
+func (suite *DelivererImplTestSuite) TestDeliverDue_WhenSendFailsAndPolicyGivesUp_ShouldMarkDead() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(501, 7)
+
+	// Setup mocks
+	suite.expectBatch(deliveryFixture)
+	suite.mockSender.On("Send", suite.ctxFixture, suite.subscriptionFixture, deliveryFixture).
+		Return(0, fmt.Errorf("mock.error"))
+	suite.mockBackoffPolicy.On("Next", 8, suite.nowFixture).Return(time.Time{}, false)
+
+	// Exercise SUT
+	actual, err := suite.sut.DeliverDue(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(1, actual)
+	suite.Equal(entity.DeliveryDead, deliveryFixture.Status())
+	suite.Equal(8, deliveryFixture.Attempts())
+	suite.Equal(0, deliveryFixture.LastStatusCode())
+	suite.Equal("mock.error", deliveryFixture.LastError())
+}
+
+func (suite *DelivererImplTestSuite) expectBatch(deliveries ...entity.WebhookDelivery) {
+	suite.mockDeliveryRepository.On("FindDue", suite.ctxFixture, suite.nowFixture, 10).Return(deliveries, nil)
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(suite.subscriptionFixture, nil)
+	for _, delivery := range deliveries {
+		suite.mockDeliveryRepository.On("Update", suite.ctxFixture, delivery).Return(nil)
+	}
+}
+
+func (suite *DelivererImplTestSuite) delivery(id entity.ID, attempts int) entity.WebhookDelivery {
+	return entity.TestWebhookDeliveryImplConstructor(id, 401, 12, entity.EventItemCheckedOut, 101,
+		suite.nowFixture.Add(-time.Hour), attempts)
+}
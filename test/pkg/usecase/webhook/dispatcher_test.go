@@ -0,0 +1,159 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/outbox"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type DispatcherImplTestSuite struct {
+	suite.Suite
+	mockSubscriptionRepository *webhookMocks.MockSubscriptionRepository
+	mockDeliveryRepository     *webhookMocks.MockDeliveryRepository
+	mockDeliveryConstructor    *entityMocks.MockWebhookDeliveryConstructor
+	mockClock                  *domainMocks.MockClock
+	ctxFixture                 context.Context
+	nowFixture                 time.Time
+	msgFixture                 outbox.Message
+	sut                        *webhook.DispatcherImpl
+}
+
+func TestDispatcherImplTestSuite(t *testing.T) {
+	suite.Run(t, new(DispatcherImplTestSuite))
+}
+
+func (suite *DispatcherImplTestSuite) SetupTest() {
+	suite.mockSubscriptionRepository = &webhookMocks.MockSubscriptionRepository{}
+	suite.mockDeliveryRepository = &webhookMocks.MockDeliveryRepository{}
+	suite.mockDeliveryConstructor = &entityMocks.MockWebhookDeliveryConstructor{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.msgFixture = outbox.Message{
+		ID:         12,
+		Type:       entity.EventItemCheckedOut,
+		EntityID:   101,
+		Data:       map[string]interface{}{"accountId": float64(7)},
+		OccurredAt: suite.nowFixture.Add(-time.Minute),
+	}
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = webhook.NewDispatcherImpl(
+		suite.mockSubscriptionRepository,
+		suite.mockDeliveryRepository,
+		suite.mockDeliveryConstructor,
+		suite.mockClock,
+	)
+}
+
+func (suite *DispatcherImplTestSuite) TestPublish_WhenSubscriptionRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByEventType", suite.ctxFixture, entity.EventItemCheckedOut).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not dispatch outbox message - subscription repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Publish(suite.ctxFixture, suite.msgFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DispatcherImplTestSuite) TestPublish_WhenConstructorFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByEventType", suite.ctxFixture, entity.EventItemCheckedOut).
+		Return([]entity.WebhookSubscription{suite.subscription(401)}, nil)
+	suite.expectNew(401).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not dispatch outbox message - entity error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Publish(suite.ctxFixture, suite.msgFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DispatcherImplTestSuite) TestPublish_WhenDeliveryRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	deliveryFixture := suite.delivery(401)
+
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByEventType", suite.ctxFixture, entity.EventItemCheckedOut).
+		Return([]entity.WebhookSubscription{suite.subscription(401)}, nil)
+	suite.expectNew(401).Return(deliveryFixture, nil)
+	suite.mockDeliveryRepository.On("Create", suite.ctxFixture, deliveryFixture).
+		Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not dispatch outbox message - delivery repository create error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Publish(suite.ctxFixture, suite.msgFixture)
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *DispatcherImplTestSuite) TestPublish_WhenNobodySubscribes_ShouldPass() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByEventType", suite.ctxFixture, entity.EventItemCheckedOut).
+		Return(nil, nil)
+
+	// Exercise SUT
+	err := suite.sut.Publish(suite.ctxFixture, suite.msgFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockDeliveryRepository.AssertNumberOfCalls(suite.T(), "Create", 0)
+}
+
+func (suite *DispatcherImplTestSuite) TestPublish_WhenDelegatesSucceed_ShouldQueueDeliveryForEachSubscription() {
+	// Setup fixture
+	delivery1 := suite.delivery(401)
+	delivery2 := suite.delivery(402)
+
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByEventType", suite.ctxFixture, entity.EventItemCheckedOut).
+		Return([]entity.WebhookSubscription{suite.subscription(401), suite.subscription(402)}, nil)
+	suite.expectNew(401).Return(delivery1, nil)
+	suite.expectNew(402).Return(delivery2, nil)
+	suite.mockDeliveryRepository.On("Create", suite.ctxFixture, delivery1).Return(entity.ID(501), nil)
+	suite.mockDeliveryRepository.On("Create", suite.ctxFixture, delivery2).Return(entity.ID(502), nil)
+
+	// Exercise SUT
+	err := suite.sut.Publish(suite.ctxFixture, suite.msgFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.mockDeliveryRepository.AssertNumberOfCalls(suite.T(), "Create", 2)
+}
+
+func (suite *DispatcherImplTestSuite) expectNew(subscriptionID entity.ID) *mock.Call {
+	return suite.mockDeliveryConstructor.On("New", subscriptionID, suite.msgFixture.ID, suite.msgFixture.Type,
+		suite.msgFixture.EntityID, suite.msgFixture.Data, suite.msgFixture.OccurredAt, suite.nowFixture)
+}
+
+func (suite *DispatcherImplTestSuite) subscription(id entity.ID) entity.WebhookSubscription {
+	return entity.TestWebhookSubscriptionImplConstructor(id, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.nowFixture)
+}
+
+func (suite *DispatcherImplTestSuite) delivery(subscriptionID entity.ID) entity.WebhookDelivery {
+	return entity.TestWebhookDeliveryImplConstructor(entity.InvalidID, subscriptionID, suite.msgFixture.ID,
+		suite.msgFixture.Type, suite.msgFixture.EntityID, suite.msgFixture.OccurredAt, 0)
+}
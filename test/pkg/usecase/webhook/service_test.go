@@ -0,0 +1,379 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	domainMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain"
+	entityMocks "github.com/liampulles/matchstick-video/test/mock/pkg/domain/entity"
+	webhookMocks "github.com/liampulles/matchstick-video/test/mock/pkg/usecase/webhook"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type ServiceImplTestSuite struct {
+	suite.Suite
+	mockSubscriptionRepository  *webhookMocks.MockSubscriptionRepository
+	mockDeliveryRepository      *webhookMocks.MockDeliveryRepository
+	mockSubscriptionConstructor *entityMocks.MockWebhookSubscriptionConstructor
+	mockVoFactory               *webhookMocks.MockVOFactory
+	mockClock                   *domainMocks.MockClock
+	ctxFixture                  context.Context
+	nowFixture                  time.Time
+	sut                         *webhook.ServiceImpl
+}
+
+func TestServiceImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceImplTestSuite))
+}
+
+func (suite *ServiceImplTestSuite) SetupTest() {
+	suite.mockSubscriptionRepository = &webhookMocks.MockSubscriptionRepository{}
+	suite.mockDeliveryRepository = &webhookMocks.MockDeliveryRepository{}
+	suite.mockSubscriptionConstructor = &entityMocks.MockWebhookSubscriptionConstructor{}
+	suite.mockVoFactory = &webhookMocks.MockVOFactory{}
+	suite.mockClock = &domainMocks.MockClock{}
+	suite.ctxFixture = context.Background()
+	suite.nowFixture = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockClock.On("Now").Return(suite.nowFixture)
+	suite.sut = webhook.NewServiceImpl(
+		suite.mockSubscriptionRepository,
+		suite.mockDeliveryRepository,
+		suite.mockSubscriptionConstructor,
+		suite.mockVoFactory,
+		suite.mockClock,
+	)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenConstructorFails_ShouldFail() {
+	// Setup fixture
+	voFixture := suite.createVO()
+
+	// Setup mocks
+	suite.mockSubscriptionConstructor.On("New", voFixture.URL, voFixture.EventTypes, voFixture.Secret, suite.nowFixture).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not create webhook subscription - entity error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenRepositoryCreateFails_ShouldFail() {
+	// Setup fixture
+	voFixture := suite.createVO()
+	subscriptionFixture := suite.subscription(entity.InvalidID)
+
+	// Setup mocks
+	suite.mockSubscriptionConstructor.On("New", voFixture.URL, voFixture.EventTypes, voFixture.Secret, suite.nowFixture).
+		Return(subscriptionFixture, nil)
+	suite.mockSubscriptionRepository.On("Create", suite.ctxFixture, subscriptionFixture).
+		Return(entity.InvalidID, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not create webhook subscription - repository create error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.Equal(entity.InvalidID, actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestCreate_WhenDelegatesSucceed_ShouldReturnID() {
+	// Setup fixture
+	voFixture := suite.createVO()
+	subscriptionFixture := suite.subscription(entity.InvalidID)
+
+	// Setup mocks
+	suite.mockSubscriptionConstructor.On("New", voFixture.URL, voFixture.EventTypes, voFixture.Secret, suite.nowFixture).
+		Return(subscriptionFixture, nil)
+	suite.mockSubscriptionRepository.On("Create", suite.ctxFixture, subscriptionFixture).
+		Return(entity.ID(401), nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.Create(suite.ctxFixture, voFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.ID(401), actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read webhook subscription - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDetails_WhenDelegatesSucceed_ShouldReturnView() {
+	// Setup fixture
+	subscriptionFixture := suite.subscription(401)
+	voFixture := &webhook.SubscriptionViewVO{ID: 401}
+
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(subscriptionFixture, nil)
+	suite.mockVoFactory.On("CreateSubscriptionViewVOFromEntity", subscriptionFixture).Return(voFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDetails(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(voFixture, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindAll", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read webhook subscriptions - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadAll_WhenDelegatesSucceed_ShouldReturnViews() {
+	// Setup fixture
+	subscriptionsFixture := []entity.WebhookSubscription{suite.subscription(401)}
+	vosFixture := []webhook.SubscriptionViewVO{{ID: 401}}
+
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindAll", suite.ctxFixture).Return(subscriptionsFixture, nil)
+	suite.mockVoFactory.On("CreateSubscriptionViewVOsFromEntities", subscriptionsFixture).Return(vosFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadAll(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vosFixture, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryDeleteFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("DeleteByID", suite.ctxFixture, entity.ID(401)).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not delete webhook subscription - repository delete error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestDelete_WhenRepositoryDeleteSucceeds_ShouldPass() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("DeleteByID", suite.ctxFixture, entity.ID(401)).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Delete(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDeliveries_WhenSubscriptionRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read webhook deliveries - subscription repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeliveries(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+	suite.mockDeliveryRepository.AssertNumberOfCalls(suite.T(), "FindBySubscriptionID", 0)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDeliveries_WhenDeliveryRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(suite.subscription(401), nil)
+	suite.mockDeliveryRepository.On("FindBySubscriptionID", suite.ctxFixture, entity.ID(401)).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read webhook deliveries - delivery repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeliveries(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDeliveries_WhenDelegatesSucceed_ShouldReturnViews() {
+	// Setup fixture
+	deliveriesFixture := []entity.WebhookDelivery{suite.delivery(501)}
+	vosFixture := []webhook.DeliveryViewVO{{ID: 501}}
+
+	// Setup mocks
+	suite.mockSubscriptionRepository.On("FindByID", suite.ctxFixture, entity.ID(401)).
+		Return(suite.subscription(401), nil)
+	suite.mockDeliveryRepository.On("FindBySubscriptionID", suite.ctxFixture, entity.ID(401)).
+		Return(deliveriesFixture, nil)
+	suite.mockVoFactory.On("CreateDeliveryViewVOsFromEntities", deliveriesFixture).Return(vosFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeliveries(suite.ctxFixture, entity.ID(401))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vosFixture, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDeadLetters_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDead", suite.ctxFixture).Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not read webhook dead letters - repository find error: mock.error"
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeadLetters(suite.ctxFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestReadDeadLetters_WhenDelegatesSucceed_ShouldReturnViews() {
+	// Setup fixture
+	deliveriesFixture := []entity.WebhookDelivery{suite.deadDelivery(501)}
+	vosFixture := []webhook.DeliveryViewVO{{ID: 501}}
+
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindDead", suite.ctxFixture).Return(deliveriesFixture, nil)
+	suite.mockVoFactory.On("CreateDeliveryViewVOsFromEntities", deliveriesFixture).Return(vosFixture)
+
+	// Exercise SUT
+	actual, err := suite.sut.ReadDeadLetters(suite.ctxFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(vosFixture, actual)
+}
+
+func (suite *ServiceImplTestSuite) TestRedeliver_WhenRepositoryFindFails_ShouldFail() {
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindByID", suite.ctxFixture, entity.ID(501)).
+		Return(nil, fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not redeliver webhook delivery - repository find error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Redeliver(suite.ctxFixture, entity.ID(501))
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRedeliver_WhenDeliveryIsNotDead_ShouldFail() {
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindByID", suite.ctxFixture, entity.ID(501)).
+		Return(suite.delivery(501), nil)
+
+	// Setup expectations
+	expectedErr := "could not redeliver webhook delivery - entity error: cannot redeliver webhook delivery - conflict error: type=[webhook delivery], problem=[it is pending]"
+
+	// Exercise SUT
+	err := suite.sut.Redeliver(suite.ctxFixture, entity.ID(501))
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+	suite.mockDeliveryRepository.AssertNumberOfCalls(suite.T(), "Update", 0)
+}
+
+func (suite *ServiceImplTestSuite) TestRedeliver_WhenRepositoryUpdateFails_ShouldFail() {
+	// Setup fixture
+	deliveryFixture := suite.deadDelivery(501)
+
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindByID", suite.ctxFixture, entity.ID(501)).Return(deliveryFixture, nil)
+	suite.mockDeliveryRepository.On("Update", suite.ctxFixture, deliveryFixture).Return(fmt.Errorf("mock.error"))
+
+	// Setup expectations
+	expectedErr := "could not redeliver webhook delivery - repository update error: mock.error"
+
+	// Exercise SUT
+	err := suite.sut.Redeliver(suite.ctxFixture, entity.ID(501))
+
+	// Verify results
+	suite.EqualError(err, expectedErr)
+}
+
+func (suite *ServiceImplTestSuite) TestRedeliver_WhenDelegatesSucceed_ShouldMakeDeliveryDueNow() {
+	// Setup fixture
+	deliveryFixture := suite.deadDelivery(501)
+
+	// Setup mocks
+	suite.mockDeliveryRepository.On("FindByID", suite.ctxFixture, entity.ID(501)).Return(deliveryFixture, nil)
+	suite.mockDeliveryRepository.On("Update", suite.ctxFixture, deliveryFixture).Return(nil)
+
+	// Exercise SUT
+	err := suite.sut.Redeliver(suite.ctxFixture, entity.ID(501))
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(entity.DeliveryPending, deliveryFixture.Status())
+	suite.Equal(0, deliveryFixture.Attempts())
+	suite.Equal(suite.nowFixture, deliveryFixture.NextAttemptAt())
+}
+
+func (suite *ServiceImplTestSuite) createVO() *webhook.CreateSubscriptionVO {
+	return &webhook.CreateSubscriptionVO{
+		URL:        "https://example.com/hook",
+		EventTypes: []entity.EventType{entity.EventItemCheckedOut},
+		Secret:     "some.secret.which.is.long",
+	}
+}
+
+func (suite *ServiceImplTestSuite) subscription(id entity.ID) entity.WebhookSubscription {
+	return entity.TestWebhookSubscriptionImplConstructor(id, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.nowFixture)
+}
+
+func (suite *ServiceImplTestSuite) delivery(id entity.ID) entity.WebhookDelivery {
+	return entity.TestWebhookDeliveryImplConstructor(id, 401, 12, entity.EventItemCheckedOut, 101, suite.nowFixture, 0)
+}
+
+func (suite *ServiceImplTestSuite) deadDelivery(id entity.ID) entity.WebhookDelivery {
+	delivery := suite.delivery(id)
+	suite.Require().NoError(delivery.GiveUp(suite.nowFixture, 500, "mock.error"))
+	return delivery
+}
@@ -0,0 +1,108 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/liampulles/matchstick-video/pkg/domain/entity"
+	"github.com/liampulles/matchstick-video/pkg/usecase/webhook"
+)
+
+type VOFactoryTestSuite struct {
+	suite.Suite
+	createdFixture time.Time
+	sut            *webhook.VOFactoryImpl
+}
+
+func TestVOFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VOFactoryTestSuite))
+}
+
+func (suite *VOFactoryTestSuite) SetupTest() {
+	suite.createdFixture = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.sut = webhook.NewVOFactoryImpl()
+}
+
+func (suite *VOFactoryTestSuite) TestCreateSubscriptionViewVOFromEntity_ShouldMapFieldsButNotSecret() {
+	// Setup fixture
+	entityFixture := entity.TestWebhookSubscriptionImplConstructor(401, "https://example.com/hook",
+		[]entity.EventType{entity.EventItemCheckedOut}, "some.secret.which.is.long", suite.createdFixture)
+
+	// Setup expectations
+	expected := &webhook.SubscriptionViewVO{
+		ID:         401,
+		URL:        "https://example.com/hook",
+		EventTypes: []entity.EventType{entity.EventItemCheckedOut},
+		CreatedAt:  suite.createdFixture,
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateSubscriptionViewVOFromEntity(entityFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateSubscriptionViewVOsFromEntities_ShouldMapEach() {
+	// Setup fixture
+	entitiesFixture := []entity.WebhookSubscription{
+		entity.TestWebhookSubscriptionImplConstructor(401, "https://example.com/a",
+			[]entity.EventType{entity.EventItemCreated}, "some.secret.which.is.long", suite.createdFixture),
+		entity.TestWebhookSubscriptionImplConstructor(402, "https://example.com/b",
+			[]entity.EventType{entity.EventItemDeleted}, "some.secret.which.is.long", suite.createdFixture),
+	}
+
+	// Setup expectations
+	expected := []webhook.SubscriptionViewVO{
+		{ID: 401, URL: "https://example.com/a", EventTypes: []entity.EventType{entity.EventItemCreated}, CreatedAt: suite.createdFixture},
+		{ID: 402, URL: "https://example.com/b", EventTypes: []entity.EventType{entity.EventItemDeleted}, CreatedAt: suite.createdFixture},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateSubscriptionViewVOsFromEntities(entitiesFixture)
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
+
+func (suite *VOFactoryTestSuite) TestCreateDeliveryViewVOsFromEntities_ShouldOnlyGiveNextAttemptWhenPending() {
+	// Setup fixture
+	attemptedFixture := suite.createdFixture.Add(time.Minute)
+	pending := entity.TestWebhookDeliveryImplConstructor(501, 401, 12, entity.EventItemCheckedOut, 101, suite.createdFixture, 0)
+	dead := entity.TestWebhookDeliveryImplConstructor(502, 401, 13, entity.EventItemCheckedIn, 101, suite.createdFixture, 7)
+	suite.Require().NoError(dead.GiveUp(attemptedFixture, 500, "mock.error"))
+
+	// Setup expectations
+	expected := []webhook.DeliveryViewVO{
+		{
+			ID:             501,
+			SubscriptionID: 401,
+			EventID:        12,
+			EventType:      entity.EventItemCheckedOut,
+			EntityID:       101,
+			Status:         entity.DeliveryPending,
+			Attempts:       0,
+			NextAttemptAt:  &suite.createdFixture,
+		},
+		{
+			ID:             502,
+			SubscriptionID: 401,
+			EventID:        13,
+			EventType:      entity.EventItemCheckedIn,
+			EntityID:       101,
+			Status:         entity.DeliveryDead,
+			Attempts:       8,
+			LastAttemptAt:  &attemptedFixture,
+			LastStatusCode: 500,
+			LastError:      "mock.error",
+		},
+	}
+
+	// Exercise SUT
+	actual := suite.sut.CreateDeliveryViewVOsFromEntities([]entity.WebhookDelivery{pending, dead})
+
+	// Verify results
+	suite.Equal(expected, actual)
+}
@@ -1,6 +1,7 @@
 package wire_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,20 +21,186 @@ func TestCreateServerFactory_GivenInvalidConfig_ShouldFail(t *testing.T) {
 	expectedErr := "could not fetch config: value of PORT property can not be converted to int (is not.an.int)"
 
 	// Exercise SUT
-	actual, err := wire.CreateServerFactory(fixture)
+	actual, shutdown, err := wire.CreateServerFactory(fixture)
 
 	// Verify results
 	assert.Nil(t, actual)
+	assert.Nil(t, shutdown)
+	assert.EqualError(t, err, expectedErr)
+}
+
+func TestCreateServerFactory_GivenInvalidConfig_ShouldFailValidation(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"TRACE_EXPORTER": "not.an.exporter",
+	})
+
+	// Setup expectations
+	expectedErr := "invalid config: TRACE_EXPORTER must be one of none, stdout, otlp (is not.an.exporter)"
+
+	// Exercise SUT
+	actual, shutdown, err := wire.CreateServerFactory(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.Nil(t, shutdown)
 	assert.EqualError(t, err, expectedErr)
 }
 
 func TestCreateServerFactory_GivenBadDBConfig_ShouldFail(t *testing.T) {
 	// Setup fixture
 	fixture := goConfig.MapSource(map[string]string{
-		"DB_HOST": "not.a.url",
+		"DB_HOST":            "not.a.url",
+		"DB_CONNECT_TIMEOUT": "0",
+	})
+
+	// Exercise SUT
+	actual, shutdown, err := wire.CreateServerFactory(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.Nil(t, shutdown)
+	assert.Error(t, err)
+}
+
+func TestCreateServerFactory_GivenUnreachableDB_ShouldRetryThenFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_HOST":            "127.0.0.1",
+		"DB_PORT":            "1",
+		"DB_CONNECT_TIMEOUT": "100ms",
+		"DB_CONNECT_BACKOFF": "10ms",
+	})
+
+	// Setup expectations
+	expectedErr := "could not create database service - could not init db: could not create postgres db - ping error: gave up after 100ms: "
+
+	// Exercise SUT
+	actual, shutdown, err := wire.CreateServerFactory(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.Nil(t, shutdown)
+	assert.ErrorContains(t, err, expectedErr)
+}
+
+func TestCreateApp_GivenUnknownCommand_ShouldReturnFailingRunnable(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+
+	// Exercise SUT
+	actual, shutdown := wire.CreateApp([]string{"not", "a", "command"}, fixture)
+
+	// Verify results
+	assert.EqualError(t, actual(), "unknown command: not a command")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestCreateApp_GivenUnknownFlag_ShouldReturnFailingRunnable(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+
+	// Exercise SUT
+	actual, shutdown := wire.CreateApp([]string{"--not-a-flag"}, fixture)
+
+	// Verify results
+	assert.EqualError(t, actual(), "flag provided but not defined: -not-a-flag")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestCreateApp_GivenConfigPrintWithInvalidConfig_ShouldReturnFailingRunnable(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_PORT": "0",
+	})
+
+	// Exercise SUT
+	actual, shutdown := wire.CreateApp([]string{"config", "print"}, fixture)
+
+	// Verify results
+	assert.EqualError(t, actual(), "invalid config: DB_PORT must be between 1 and 65535 (is 0)")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestCreateConfigPrinter_GivenValidConfig_ShouldPass(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+
+	// Exercise SUT
+	actual, err := wire.CreateConfigPrinter(fixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.NotNil(t, actual)
+}
+
+func TestCreateApp_GivenMigrateWithInvalidConfig_ShouldReturnFailingRunnable(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"AUTO_MIGRATE": "sometimes",
 	})
+
+	// Exercise SUT
+	actual, shutdown := wire.CreateApp([]string{"migrate", "up"}, fixture)
+
+	// Verify results
+	assert.EqualError(t, actual(), "could not fetch config: value of AUTO_MIGRATE property can not be converted to bool (is sometimes)")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestCreateMigrateCommand_GivenBadDBConfig_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_HOST":            "not.a.url",
+		"DB_CONNECT_TIMEOUT": "0",
+	})
+
+	// Exercise SUT
+	actual, shutdown, err := wire.CreateMigrateCommand(fixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+	assert.Nil(t, shutdown)
+	assert.ErrorContains(t, err, "could not create migrator - could not init db: ")
+}
+
+func TestCreateApp_GivenInventoryWithInvalidConfig_ShouldReturnFailingRunnable(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{})
+
+	// Exercise SUT
+	actual, shutdown := wire.CreateApp([]string{"--cli-output=yaml", "inventory", "list"}, fixture)
+
+	// Verify results
+	assert.EqualError(t, actual(), "invalid config: CLI_OUTPUT must be one of table, json (is yaml)")
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestCreateInventoryCommand_GivenServerURL_ShouldNotNeedDB(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"CLI_SERVER_URL":     "http://localhost:8080",
+		"DB_HOST":            "not.a.url",
+		"DB_CONNECT_TIMEOUT": "0",
+	})
+
+	// Exercise SUT
+	actual, err := wire.CreateInventoryCommand(fixture)
+
+	// Verify results
+	assert.NoError(t, err)
+	assert.NotNil(t, actual)
+}
+
+func TestCreateInventoryCommand_GivenBadDBConfig_ShouldFail(t *testing.T) {
+	// Setup fixture
+	fixture := goConfig.MapSource(map[string]string{
+		"DB_HOST":            "not.a.url",
+		"DB_CONNECT_TIMEOUT": "0",
+	})
+
 	// Exercise SUT
-	actual, err := wire.CreateServerFactory(fixture)
+	actual, err := wire.CreateInventoryCommand(fixture)
 
 	// Verify results
 	assert.Nil(t, actual)